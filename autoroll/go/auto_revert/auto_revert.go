@@ -0,0 +1,321 @@
+package auto_revert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/go/git"
+	"go.skia.org/infra/go/gitiles"
+	"go.skia.org/infra/go/httputils"
+	"go.skia.org/infra/go/human"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/task_scheduler/go/rpc"
+	tree_status_types "go.skia.org/infra/tree_status/go/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Signal is a post-submit signal which indicates whether the parent is
+// healthy.
+type Signal interface {
+	// Name returns a human-readable name for the Signal.
+	Name() string
+
+	// Failure returns a description of the failure if the Signal indicates
+	// that the parent was broken by the roll which landed at the given time
+	// as the given commit, or the empty string if it was not.
+	Failure(ctx context.Context, since time.Time, commit string) (string, error)
+}
+
+// observer is implemented by Signals which need to watch the parent while no
+// roll is being checked, so that they can tell whether a failure started
+// before or after a roll landed.
+type observer interface {
+	// Observe records the current state of the Signal.
+	Observe(ctx context.Context) error
+}
+
+// AutoReverter watches post-submit signals to determine whether a roll broke
+// the parent after it landed.
+type AutoReverter struct {
+	signals []Signal
+	window  time.Duration
+}
+
+// New returns an AutoReverter which watches the signals described by the given
+// config. The given client is used for requests to the tree status and Task
+// Scheduler. Health checks use a separate, unauthenticated client which does
+// not treat non-2xx responses as errors.
+func New(c *config.AutoRevertConfig, client *http.Client) (*AutoReverter, error) {
+	if err := c.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	window, err := human.ParseDuration(c.Window)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	var signals []Signal
+	if c.TreeStatusUrl != "" {
+		signals = append(signals, &treeStatusSignal{
+			client: client,
+			url:    c.TreeStatusUrl,
+		})
+	}
+	if c.TaskScheduler != nil {
+		signals = append(signals, &taskSchedulerSignal{
+			client:  rpc.NewTaskSchedulerServiceProtobufClient(c.TaskScheduler.Host, client),
+			gitiles: gitiles.NewRepo(c.TaskScheduler.Repo, client),
+			repo:    c.TaskScheduler.Repo,
+			jobs:    c.TaskScheduler.Jobs,
+		})
+	}
+	if len(c.HealthCheckUrl) > 0 {
+		healthCheckClient := httputils.DefaultClientConfig().Client()
+		for _, url := range c.HealthCheckUrl {
+			signals = append(signals, &healthCheckSignal{
+				client: healthCheckClient,
+				url:    url,
+			})
+		}
+	}
+	return NewWithSignals(window, signals...), nil
+}
+
+// NewWithSignals returns an AutoReverter which watches the given Signals.
+func NewWithSignals(window time.Duration, signals ...Signal) *AutoReverter {
+	return &AutoReverter{
+		signals: signals,
+		window:  window,
+	}
+}
+
+// Observe updates the signals which track the health of the parent over time.
+// It should be called regularly whenever Check is not, so that those signals
+// can tell whether the parent was healthy when a roll landed.
+func (a *AutoReverter) Observe(ctx context.Context) {
+	for _, signal := range a.signals {
+		if o, ok := signal.(observer); ok {
+			if err := o.Observe(ctx); err != nil {
+				sklog.Errorf("Failed to check %s: %s", signal.Name(), err)
+			}
+		}
+	}
+}
+
+// Check returns a description of the failures if any of the signals indicate
+// that a roll which landed at the given time as the given commit broke the
+// parent, or the empty string if none of them do. Rolls which landed longer ago
+// than the window are never considered to have broken the parent.
+func (a *AutoReverter) Check(ctx context.Context, landed time.Time, commit string) (string, error) {
+	if now.Now(ctx).Sub(landed) > a.window {
+		a.Observe(ctx)
+		return "", nil
+	}
+	var failures []string
+	var errs []string
+	for _, signal := range a.signals {
+		failure, err := signal.Failure(ctx, landed, commit)
+		if err != nil {
+			sklog.Errorf("Failed to check %s: %s", signal.Name(), err)
+			errs = append(errs, fmt.Sprintf("%s: %s", signal.Name(), err))
+		} else if failure != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", signal.Name(), failure))
+		}
+	}
+	// A failure of any one signal is enough to revert, even if we failed to
+	// check the others.
+	if len(failures) > 0 {
+		return strings.Join(failures, "\n"), nil
+	}
+	if len(errs) > 0 {
+		return "", skerr.Fmt("failed to check post-submit signals:\n%s", strings.Join(errs, "\n"))
+	}
+	return "", nil
+}
+
+// treeStatusSignal is a Signal which indicates a failure if the tree was
+// closed after the roll landed.
+type treeStatusSignal struct {
+	client *http.Client
+	url    string
+}
+
+// Name implements Signal.
+func (s *treeStatusSignal) Name() string {
+	return "tree status"
+}
+
+// Failure implements Signal.
+func (s *treeStatusSignal) Failure(ctx context.Context, since time.Time, _ string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", skerr.Wrapf(err, "failed to retrieve tree status from %s", s.url)
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return "", skerr.Fmt("got status %d from %s", resp.StatusCode, s.url)
+	}
+	var status tree_status_types.Status
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return "", skerr.Wrapf(err, "failed to decode tree status from %s", s.url)
+	}
+	if status.GeneralState == tree_status_types.ClosedState && !status.Date.Before(since) {
+		return fmt.Sprintf("tree was closed at %s: %s", status.Date.UTC().Format(time.RFC3339), status.Message), nil
+	}
+	return "", nil
+}
+
+// taskSchedulerSignal is a Signal which indicates a failure if the first run
+// of any of the given jobs at the roll's commit or one of its descendants
+// failed. Mishaps are not considered failures, since they are not caused by the
+// code under test.
+type taskSchedulerSignal struct {
+	client  rpc.TaskSchedulerService
+	gitiles gitiles.GitilesRepo
+	repo    string
+	jobs    []string
+}
+
+// Name implements Signal.
+func (s *taskSchedulerSignal) Name() string {
+	return "Task Scheduler"
+}
+
+// Failure implements Signal.
+func (s *taskSchedulerSignal) Failure(ctx context.Context, since time.Time, commit string) (string, error) {
+	if commit == "" {
+		return "", skerr.Fmt("the commit which landed the roll is unknown")
+	}
+	// includesRoll caches whether each revision is the roll's commit or one
+	// of its descendants.
+	includesRoll := map[string]bool{commit: true}
+	var failures []string
+	for _, name := range s.jobs {
+		resp, err := s.client.SearchJobs(ctx, &rpc.SearchJobsRequest{
+			Repo:         s.repo,
+			HasRepo:      true,
+			Name:         name,
+			HasName:      true,
+			TimeStart:    timestamppb.New(since),
+			HasTimeStart: true,
+			TimeEnd:      timestamppb.New(now.Now(ctx)),
+			HasTimeEnd:   true,
+		})
+		if err != nil {
+			return "", skerr.Wrapf(err, "failed to search for %s jobs", name)
+		}
+		// Forced jobs and try jobs may run at arbitrary revisions, so only
+		// consider regularly-scheduled jobs. Jobs may also be created after
+		// the roll landed for older revisions, eg. by backfilling, so only
+		// consider those which ran with the roll.
+		var jobs []*rpc.Job
+		for _, job := range resp.Jobs {
+			if job.IsForce || job.GetRepoState().GetPatch().GetIssue() != "" {
+				continue
+			}
+			revision := job.GetRepoState().GetRevision()
+			ok, found := includesRoll[revision]
+			if !found {
+				// The roll's commit is an ancestor of the revision iff
+				// there are no commits which are reachable from the
+				// former but not from the latter.
+				commits, err := s.gitiles.Log(ctx, git.LogFromTo(revision, commit), gitiles.LogLimit(1))
+				if err != nil {
+					return "", skerr.Wrapf(err, "failed to determine whether %s includes %s", revision, commit)
+				}
+				ok = len(commits) == 0
+				includesRoll[revision] = ok
+			}
+			if ok {
+				jobs = append(jobs, job)
+			}
+		}
+		if len(jobs) == 0 {
+			continue
+		}
+		sort.Slice(jobs, func(i, j int) bool {
+			return jobs[i].CreatedAt.AsTime().Before(jobs[j].CreatedAt.AsTime())
+		})
+		if first := jobs[0]; first.Status == rpc.JobStatus_JOB_STATUS_FAILURE {
+			failures = append(failures, fmt.Sprintf("%s failed at %s (job %s)", name, first.GetRepoState().GetRevision(), first.Id))
+		}
+	}
+	return strings.Join(failures, "; "), nil
+}
+
+// healthCheckSignal is a Signal which indicates a failure if the given URL
+// stops responding with a 2xx status code after the roll landed. The URL must
+// have been observed to be healthy at the last check before the roll landed, or
+// at some point after, so that a URL which was already failing is not blamed on
+// the roll.
+type healthCheckSignal struct {
+	client *http.Client
+	url    string
+
+	// lastHealthy is the most recent time at which the URL was healthy.
+	lastHealthy time.Time
+	// failingSince is the time at which the URL was first observed to be
+	// failing after lastHealthy, or zero if it is healthy.
+	failingSince time.Time
+}
+
+// Name implements Signal.
+func (s *healthCheckSignal) Name() string {
+	return "health check " + s.url
+}
+
+// Observe implements observer.
+func (s *healthCheckSignal) Observe(ctx context.Context) error {
+	_, err := s.check(ctx)
+	return err
+}
+
+// check requests the URL, records the result, and returns a description of the
+// failure if it is not healthy.
+func (s *healthCheckSignal) check(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", skerr.Wrapf(err, "failed to request %s", s.url)
+	}
+	defer util.Close(resp.Body)
+	ts := now.Now(ctx)
+	if resp.StatusCode < http.StatusOK || resp.StatusCode > 299 {
+		if s.failingSince.IsZero() {
+			s.failingSince = ts
+		}
+		return fmt.Sprintf("got status %s", resp.Status), nil
+	}
+	s.lastHealthy = ts
+	s.failingSince = time.Time{}
+	return "", nil
+}
+
+// Failure implements Signal.
+func (s *healthCheckSignal) Failure(ctx context.Context, since time.Time, _ string) (string, error) {
+	failure, err := s.check(ctx)
+	if err != nil || failure == "" {
+		return "", err
+	}
+	if s.lastHealthy.IsZero() || s.failingSince.Before(since) {
+		// Either we don't know whether the URL was healthy when the roll
+		// landed, or it was already failing.
+		return "", nil
+	}
+	return failure, nil
+}
@@ -0,0 +1,264 @@
+package auto_revert
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/gitiles"
+	"go.skia.org/infra/go/mockhttpclient"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/vcsinfo"
+	"go.skia.org/infra/task_scheduler/go/rpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const treeStatusURL = "https://tree-status/current"
+
+var landed = time.Unix(1682445445, 0).UTC()
+
+func TestTreeStatusSignal(t *testing.T) {
+	ctx := context.Background()
+	urlmock := mockhttpclient.NewURLMock()
+	s := &treeStatusSignal{
+		client: urlmock.Client(),
+		url:    treeStatusURL,
+	}
+
+	// Tree is open.
+	urlmock.MockOnce(treeStatusURL, mockhttpclient.MockGetDialogue([]byte(`{"general_state":"open","message":"Open","date":"2023-04-25T19:00:00Z"}`)))
+	failure, err := s.Failure(ctx, landed, "")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// Tree was closed before the roll landed.
+	urlmock.MockOnce(treeStatusURL, mockhttpclient.MockGetDialogue([]byte(`{"general_state":"closed","message":"Closed for maintenance","date":"2023-04-25T17:00:00Z"}`)))
+	failure, err = s.Failure(ctx, landed, "")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// Tree was closed after the roll landed.
+	urlmock.MockOnce(treeStatusURL, mockhttpclient.MockGetDialogue([]byte(`{"general_state":"closed","message":"Build failed","date":"2023-04-25T19:00:00Z"}`)))
+	failure, err = s.Failure(ctx, landed, "")
+	require.NoError(t, err)
+	require.Equal(t, "tree was closed at 2023-04-25T19:00:00Z: Build failed", failure)
+
+	// Server error.
+	urlmock.MockOnce(treeStatusURL, mockhttpclient.MockGetError("500 Internal Server Error", 500))
+	_, err = s.Failure(ctx, landed, "")
+	require.ErrorContains(t, err, "got status 500")
+	require.True(t, urlmock.Empty())
+}
+
+// fakeTaskScheduler is a fake rpc.TaskSchedulerService which only implements
+// SearchJobs.
+type fakeTaskScheduler struct {
+	rpc.TaskSchedulerService
+	jobs []*rpc.Job
+}
+
+// SearchJobs implements rpc.TaskSchedulerService.
+func (f *fakeTaskScheduler) SearchJobs(ctx context.Context, req *rpc.SearchJobsRequest) (*rpc.SearchJobsResponse, error) {
+	var rv []*rpc.Job
+	for _, job := range f.jobs {
+		if job.Name == req.Name && !job.CreatedAt.AsTime().Before(req.TimeStart.AsTime()) {
+			rv = append(rv, job)
+		}
+	}
+	return &rpc.SearchJobsResponse{Jobs: rv}, nil
+}
+
+func makeJob(id, name string, created time.Time, status rpc.JobStatus) *rpc.Job {
+	return &rpc.Job{
+		Id:        id,
+		Name:      name,
+		CreatedAt: timestamppb.New(created),
+		Status:    status,
+		RepoState: &rpc.RepoState{
+			Repo:     "https://parent.git",
+			Revision: "rev-" + id,
+		},
+	}
+}
+
+// fakeGitiles is a fake gitiles.GitilesRepo which only implements Log for
+// "from..to" expressions, where "to" is the roll's commit.
+type fakeGitiles struct {
+	gitiles.GitilesRepo
+	// includesRoll lists the revisions which are the roll's commit or one
+	// of its descendants.
+	includesRoll map[string]bool
+}
+
+// Log implements gitiles.GitilesRepo.
+func (f *fakeGitiles) Log(ctx context.Context, logExpr string, opts ...gitiles.LogOption) ([]*vcsinfo.LongCommit, error) {
+	from := strings.Split(logExpr, "..")[0]
+	if f.includesRoll[from] {
+		return nil, nil
+	}
+	return []*vcsinfo.LongCommit{{ShortCommit: &vcsinfo.ShortCommit{Hash: "roll"}}}, nil
+}
+
+func TestTaskSchedulerSignal(t *testing.T) {
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(time.Hour))
+	ts := &fakeTaskScheduler{}
+	s := &taskSchedulerSignal{
+		client: ts,
+		gitiles: &fakeGitiles{
+			includesRoll: map[string]bool{
+				"rev-2": true,
+				"rev-3": true,
+				"rev-4": true,
+				"rev-5": true,
+				"rev-6": true,
+			},
+		},
+		repo: "https://parent.git",
+		jobs: []string{"Build", "Test"},
+	}
+
+	// No jobs yet.
+	failure, err := s.Failure(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// Failures before the roll landed, or after the first run, aren't
+	// attributed to the roll.
+	ts.jobs = []*rpc.Job{
+		makeJob("1", "Build", landed.Add(-time.Minute), rpc.JobStatus_JOB_STATUS_FAILURE),
+		makeJob("3", "Build", landed.Add(20*time.Minute), rpc.JobStatus_JOB_STATUS_FAILURE),
+		makeJob("2", "Build", landed.Add(10*time.Minute), rpc.JobStatus_JOB_STATUS_SUCCESS),
+		makeJob("4", "Test", landed.Add(10*time.Minute), rpc.JobStatus_JOB_STATUS_MISHAP),
+	}
+	failure, err = s.Failure(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// Forced jobs are ignored.
+	forced := makeJob("5", "Test", landed.Add(time.Minute), rpc.JobStatus_JOB_STATUS_FAILURE)
+	forced.IsForce = true
+	ts.jobs = append(ts.jobs, forced)
+	failure, err = s.Failure(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// Jobs created after the roll landed at revisions which don't include
+	// it are ignored.
+	ts.jobs = append(ts.jobs, makeJob("7", "Test", landed.Add(2*time.Minute), rpc.JobStatus_JOB_STATUS_FAILURE))
+	failure, err = s.Failure(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// The first run of Test with the roll failed.
+	ts.jobs = append(ts.jobs, makeJob("6", "Test", landed.Add(5*time.Minute), rpc.JobStatus_JOB_STATUS_FAILURE))
+	failure, err = s.Failure(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Equal(t, "Test failed at rev-6 (job 6)", failure)
+
+	// We can't tell which jobs include the roll if we don't know its commit.
+	_, err = s.Failure(ctx, landed, "")
+	require.ErrorContains(t, err, "the commit which landed the roll is unknown")
+}
+
+func TestHealthCheckSignal(t *testing.T) {
+	urlmock := mockhttpclient.NewURLMock()
+	const url = "https://my-service/healthz"
+	s := &healthCheckSignal{
+		client: urlmock.Client(),
+		url:    url,
+	}
+	at := func(ts time.Time) context.Context {
+		return context.WithValue(context.Background(), now.ContextKey, ts)
+	}
+	healthy := func() {
+		urlmock.MockOnce(url, mockhttpclient.MockGetDialogue([]byte("ok")))
+	}
+	failing := func() {
+		urlmock.MockOnce(url, mockhttpclient.MockGetError("503 Service Unavailable", 503))
+	}
+
+	// We haven't seen the URL healthy, so we can't blame the roll.
+	failing()
+	failure, err := s.Failure(at(landed.Add(time.Minute)), landed, "")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// The URL was already failing before the roll landed.
+	healthy()
+	require.NoError(t, s.Observe(at(landed.Add(-10*time.Minute))))
+	failing()
+	require.NoError(t, s.Observe(at(landed.Add(-5*time.Minute))))
+	failing()
+	failure, err = s.Failure(at(landed.Add(time.Minute)), landed, "")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+
+	// The URL recovered after the roll landed, then broke again.
+	healthy()
+	failure, err = s.Failure(at(landed.Add(2*time.Minute)), landed, "")
+	require.NoError(t, err)
+	require.Empty(t, failure)
+	failing()
+	failure, err = s.Failure(at(landed.Add(3*time.Minute)), landed, "")
+	require.NoError(t, err)
+	require.Equal(t, "got status 503 Service Unavailable", failure)
+
+	// The URL was healthy before the roll landed and failing after.
+	healthy()
+	require.NoError(t, s.Observe(at(landed.Add(time.Hour))))
+	nextLanded := landed.Add(2 * time.Hour)
+	failing()
+	failure, err = s.Failure(at(nextLanded.Add(time.Minute)), nextLanded, "")
+	require.NoError(t, err)
+	require.Equal(t, "got status 503 Service Unavailable", failure)
+	require.True(t, urlmock.Empty())
+}
+
+// fakeSignal is a Signal which returns a fixed result.
+type fakeSignal struct {
+	name    string
+	failure string
+	err     error
+}
+
+// Name implements Signal.
+func (s *fakeSignal) Name() string {
+	return s.name
+}
+
+// Failure implements Signal.
+func (s *fakeSignal) Failure(ctx context.Context, since time.Time, commit string) (string, error) {
+	return s.failure, s.err
+}
+
+func TestAutoReverter_Check(t *testing.T) {
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(time.Hour))
+	ok := &fakeSignal{name: "ok"}
+	broken := &fakeSignal{name: "broken", failure: "it broke"}
+	erroring := &fakeSignal{name: "erroring", err: skerr.Fmt("oops")}
+
+	// All signals are healthy.
+	a := NewWithSignals(2*time.Hour, ok)
+	reason, err := a.Check(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	// One signal failed, while another couldn't be checked.
+	a.signals = []Signal{ok, erroring, broken}
+	reason, err = a.Check(ctx, landed, "roll")
+	require.NoError(t, err)
+	require.Equal(t, "broken: it broke", reason)
+
+	// The roll landed too long ago.
+	reason, err = a.Check(ctx, landed.Add(-2*time.Hour), "roll")
+	require.NoError(t, err)
+	require.Empty(t, reason)
+
+	// No signal failed, but one couldn't be checked.
+	a.signals = []Signal{ok, erroring}
+	_, err = a.Check(ctx, landed, "roll")
+	require.ErrorContains(t, err, "erroring: oops")
+}
@@ -41,6 +41,7 @@ import (
 	"go.skia.org/infra/go/gerrit"
 	"go.skia.org/infra/go/gitauth"
 	"go.skia.org/infra/go/github"
+	"go.skia.org/infra/go/gitlab"
 	"go.skia.org/infra/go/httputils"
 	"go.skia.org/infra/go/secret"
 	"go.skia.org/infra/go/sklog"
@@ -248,6 +249,7 @@ func main() {
 	// so that we can get rid of these vars and the various conditionals.
 	var g *gerrit.Gerrit
 	var githubClient *github.GitHub
+	var gitlabClient *gitlab.GitLab
 
 	// The rollers use the gitcookie created by gitauth package.
 	if !*local {
@@ -326,6 +328,50 @@ func main() {
 		if err != nil {
 			sklog.Fatalf("Could not create Github client: %s", err)
 		}
+	} else if cfg.GetGitlab() != nil {
+		gitlabCfg := cfg.GetGitlab()
+		var gToken string
+		if *local {
+			pathToGitLabToken := filepath.Join(user.HomeDir, gitlab.TOKEN_FILENAME)
+			gBody, err := os.ReadFile(pathToGitLabToken)
+			if err != nil {
+				sklog.Fatalf("Couldn't find GitLab token in %s: %s.", pathToGitLabToken, err)
+			}
+			gToken = strings.TrimSpace(string(gBody))
+		} else {
+			gBody, err := secretClient.Get(ctx, secretProject, gitlabCfg.TokenSecret, secret.VersionLatest)
+			if err != nil {
+				sklog.Fatalf("Failed to retrieve secret %s: %s", gitlabCfg.TokenSecret, err)
+			}
+			gToken = strings.TrimSpace(gBody)
+
+			// Setup the required SSH key from secrets if we are not running
+			// locally and if the file does not already exist.
+			sshKeyDestDir := filepath.Join(user.HomeDir, ".ssh")
+			sshKeyDest := filepath.Join(sshKeyDestDir, gitlab.SSH_KEY_FILENAME)
+			if _, err := os.Stat(sshKeyDest); os.IsNotExist(err) {
+				sshKey, err := secretClient.Get(ctx, secretProject, gitlabCfg.SshKeySecret, secret.VersionLatest)
+				if err != nil {
+					sklog.Fatalf("Failed to retrieve secret %s: %s", gitlabCfg.SshKeySecret, err)
+				}
+				if _, err := fileutil.EnsureDirExists(sshKeyDestDir); err != nil {
+					sklog.Fatalf("Could not create %s: %s", sshKeyDest, err)
+				}
+				if err := os.WriteFile(sshKeyDest, []byte(sshKey), 0600); err != nil {
+					sklog.Fatalf("Could not write to %s: %s", sshKeyDest, err)
+				}
+			}
+			// Make sure ssh uses the GitLab key for the GitLab host.
+			if err := gitlab.AddSSHConfig(sshKeyDestDir, gitlabCfg.Url); err != nil {
+				sklog.Fatalf("Could not configure ssh for GitLab: %s", err)
+			}
+			// Make sure the GitLab host is added to known_hosts.
+			gitlab.AddToKnownHosts(ctx, gitlabCfg.Url)
+		}
+
+		// Instantiate gitlabClient using the access token.
+		gitlabHttpClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: gToken}))
+		gitlabClient = gitlab.NewGitLab(gitlabCfg.Url, gitlabCfg.Project, gitlabHttpClient)
 	}
 
 	sklog.Info("Creating status DB.")
@@ -356,7 +402,7 @@ func main() {
 		httputils.RunHealthCheckServer(*port)
 	}
 
-	arb, err := roller.NewAutoRoller(ctx, &cfg, emailer, chatBotConfigReader, g, githubClient, *workdir, serverURL, gcsClient, client, rollerName, *local, statusDB, manualRolls, rollerCleanup)
+	arb, err := roller.NewAutoRoller(ctx, &cfg, emailer, chatBotConfigReader, g, githubClient, gitlabClient, *workdir, serverURL, gcsClient, client, rollerName, *local, statusDB, manualRolls, rollerCleanup)
 	if err != nil {
 		sklog.Fatal(err)
 	}
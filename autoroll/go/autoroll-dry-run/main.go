@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/dry_run"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/chrome_branch"
+	"go.skia.org/infra/go/httputils"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+func main() {
+	const (
+		flagConfig             = "config"
+		flagParentRepo         = "parent-repo"
+		flagChildRepo          = "child-repo"
+		flagChildRevisions     = "child-revisions"
+		flagRollTo             = "roll-to"
+		flagStrategy           = "strategy"
+		flagWorkdir            = "workdir"
+		flagFakeChromeBranches = "fake-chrome-branches"
+	)
+	app := &cli.App{
+		Name: "autoroll-dry-run",
+		Description: `autoroll-dry-run simulates a roll using local git repos in place of the
+configured parent and child repos. It prints the not-yet-rolled revisions, the
+commit message and the diff which the roller would upload, without talking to
+a code review server.`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     flagConfig,
+				Usage:    "Roller config file, in text proto format.",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     flagParentRepo,
+				Usage:    "Local checkout (or other git URL) to use in place of the parent repo.",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  flagChildRepo,
+				Usage: "Local checkout (or other git URL) to use in place of the child repo.",
+			},
+			&cli.StringFlag{
+				Name:  flagChildRevisions,
+				Usage: "JSON file containing a list of revisions, newest first, to use in place of the child. Required for children which are not git repos, eg. CIPD, GCS or Docker.",
+			},
+			&cli.StringFlag{
+				Name:  flagRollTo,
+				Usage: "ID of the revision to roll to. If not provided, the revision is chosen using --strategy.",
+			},
+			&cli.StringFlag{
+				Name:  flagStrategy,
+				Usage: "Roll strategy used to choose the revision to roll to. The time_batch and bisect strategies depend on the roller's recent rolls and are not supported; use --roll-to instead.",
+				Value: "batch",
+			},
+			&cli.StringFlag{
+				Name:  flagWorkdir,
+				Usage: "Directory in which to create checkouts. Defaults to a temporary directory which is removed afterward.",
+			},
+			&cli.BoolFlag{
+				Name:  flagFakeChromeBranches,
+				Usage: "Use fake Chrome release branches instead of retrieving them, for fully offline runs.",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			opts := dry_run.Options{
+				ParentRepo: ctx.String(flagParentRepo),
+				ChildRepo:  ctx.String(flagChildRepo),
+				RollTo:     ctx.String(flagRollTo),
+				Strategy:   ctx.String(flagStrategy),
+				Workdir:    ctx.String(flagWorkdir),
+			}
+			return run(ctx.Context, ctx.String(flagConfig), ctx.String(flagChildRevisions), ctx.Bool(flagFakeChromeBranches), opts)
+		},
+	}
+	if err := app.RunContext(context.Background(), os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run performs the dry run and prints the results.
+func run(ctx context.Context, configFile, childRevisionsFile string, fakeChromeBranches bool, opts dry_run.Options) error {
+	configBytes, err := os.ReadFile(configFile)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	var cfg config.Config
+	if err := prototext.Unmarshal(configBytes, &cfg); err != nil {
+		return skerr.Wrapf(err, "failed to parse %s", configFile)
+	}
+	if err := cfg.Validate(); err != nil {
+		return skerr.Wrapf(err, "invalid config")
+	}
+
+	if childRevisionsFile != "" {
+		b, err := os.ReadFile(childRevisionsFile)
+		if err != nil {
+			return skerr.Wrap(err)
+		}
+		var revs []*revision.Revision
+		if err := json.Unmarshal(b, &revs); err != nil {
+			return skerr.Wrapf(err, "failed to parse %s", childRevisionsFile)
+		}
+		if len(revs) == 0 {
+			return skerr.Fmt("%s contains no revisions", childRevisionsFile)
+		}
+		opts.ChildRevisions = revs
+	}
+
+	var cbc chrome_branch.Client
+	if fakeChromeBranches {
+		cbc = fakeChromeBranchClient{}
+	} else {
+		cbc = chrome_branch.NewClient(httputils.DefaultClientConfig().With2xxOnly().Client())
+	}
+	reg, err := config_vars.NewRegistry(ctx, cbc)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+
+	if opts.Workdir == "" {
+		tmp, err := os.MkdirTemp("", "autoroll-dry-run")
+		if err != nil {
+			return skerr.Wrap(err)
+		}
+		defer util.RemoveAll(tmp)
+		opts.Workdir = tmp
+	}
+
+	result, err := dry_run.Run(ctx, &cfg, reg, opts)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	fmt.Print(result.String())
+	return nil
+}
+
+// fakeChromeBranchClient is a chrome_branch.Client which returns fake
+// branches.
+type fakeChromeBranchClient struct{}
+
+// Get implements chrome_branch.Client.
+func (fakeChromeBranchClient) Get(_ context.Context) (*chrome_branch.Branches, []*chrome_branch.Branch, error) {
+	v := config_vars.FakeVars()
+	return v.Branches.Chromium, v.Branches.ActiveMilestones, nil
+}
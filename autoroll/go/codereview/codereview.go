@@ -13,6 +13,8 @@ import (
 	"go.skia.org/infra/go/gerrit"
 	"go.skia.org/infra/go/github"
 	"go.skia.org/infra/go/gitiles"
+	"go.skia.org/infra/go/gitlab"
+	"go.skia.org/infra/go/skerr"
 )
 
 // CodeReview outlines the autoroller's interaction with a code review system.
@@ -32,6 +34,10 @@ type CodeReview interface {
 	// autoroll.AutoRollIssue struct, to avoid passing it around.
 	RetrieveRoll(context.Context, *autoroll.AutoRollIssue, *recent_rolls.RecentRolls, *revision.Revision, *revision.Revision, func(context.Context, RollImpl) error) (RollImpl, error)
 
+	// Revert uploads a change which reverts the given landed roll, with the
+	// given commit message. Returns the URL of the revert.
+	Revert(ctx context.Context, issue int64, message string) (string, error)
+
 	// UserEmail returns the email address of the authenticated user.
 	UserEmail() string
 
@@ -87,6 +93,19 @@ func (c *gerritCodeReview) RetrieveRoll(ctx context.Context, issue *autoroll.Aut
 	return newGerritRoll(ctx, c.cfg, issue, c.gerritClient, c.client, recent, c.issueUrlBase, rollingFrom, rollingTo, finishedCallback)
 }
 
+// Revert implements CodeReview.
+func (c *gerritCodeReview) Revert(ctx context.Context, issue int64, message string) (string, error) {
+	ci, err := c.gerritClient.GetIssueProperties(ctx, issue)
+	if err != nil {
+		return "", skerr.Wrapf(err, "failed to retrieve issue %d", issue)
+	}
+	revert, err := c.gerritClient.Revert(ctx, ci, message)
+	if err != nil {
+		return "", skerr.Wrapf(err, "failed to revert issue %d", issue)
+	}
+	return c.gerritClient.Url(revert.Issue), nil
+}
+
 // UserEmail implements CodeReview.
 func (c *gerritCodeReview) UserEmail() string {
 	return c.userEmail
@@ -151,6 +170,11 @@ func (c *githubCodeReview) RetrieveRoll(ctx context.Context, issue *autoroll.Aut
 	return newGithubRoll(ctx, issue, c.githubClient, recent, c.issueUrlBase, c.cfg, rollingFrom, rollingTo, finishedCallback)
 }
 
+// Revert implements CodeReview.
+func (c *githubCodeReview) Revert(ctx context.Context, issue int64, message string) (string, error) {
+	return "", skerr.Fmt("reverts are not supported for GitHub")
+}
+
 // UserEmail implements CodeReview.
 func (c *githubCodeReview) UserEmail() string {
 	return c.userEmail
@@ -165,3 +189,79 @@ func (c *githubCodeReview) UserName() string {
 func (c *githubCodeReview) Client() interface{} {
 	return c.githubClient
 }
+
+// gitlabCodeReview is a CodeReview backed by GitLab.
+type gitlabCodeReview struct {
+	cfg            *config.GitLabConfig
+	fullHistoryUrl string
+	gitlabClient   *gitlab.GitLab
+	issueUrlBase   string
+	userEmail      string
+	userName       string
+}
+
+// NewGitLab returns a gitlabCodeReview instance.
+func NewGitLab(cfg *config.GitLabConfig, gitlabClient *gitlab.GitLab) (CodeReview, error) {
+	ctx := context.TODO()
+	user, err := gitlabClient.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to retrieve GitLab user")
+	}
+	userEmail := user.Email
+	if userEmail == "" {
+		userEmail = user.PublicEmail
+	}
+	if userEmail == "" {
+		return nil, errors.New("Found no email address for GitLab user.")
+	}
+	if user.Username == "" {
+		return nil, errors.New("Found no username for GitLab user.")
+	}
+	project, err := gitlabClient.GetProject(ctx)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to retrieve GitLab project %q", cfg.Project)
+	}
+	return &gitlabCodeReview{
+		cfg:            cfg,
+		fullHistoryUrl: project.WebURL + "/-/merge_requests?scope=all&state=all&author_username=" + user.Username,
+		gitlabClient:   gitlabClient,
+		issueUrlBase:   project.WebURL + "/-/merge_requests/",
+		userEmail:      userEmail,
+		userName:       user.Username,
+	}, nil
+}
+
+// GetIssueUrlBase implements CodeReview.
+func (c *gitlabCodeReview) GetIssueUrlBase() string {
+	return c.issueUrlBase
+}
+
+// GetFullHistoryUrl implements CodeReview.
+func (c *gitlabCodeReview) GetFullHistoryUrl() string {
+	return c.fullHistoryUrl
+}
+
+// RetrieveRoll implements CodeReview.
+func (c *gitlabCodeReview) RetrieveRoll(ctx context.Context, issue *autoroll.AutoRollIssue, recent *recent_rolls.RecentRolls, rollingFrom, rollingTo *revision.Revision, finishedCallback func(context.Context, RollImpl) error) (RollImpl, error) {
+	return newGitLabRoll(ctx, issue, c.gitlabClient, recent, c.issueUrlBase, rollingFrom, rollingTo, finishedCallback)
+}
+
+// Revert implements CodeReview.
+func (c *gitlabCodeReview) Revert(ctx context.Context, issue int64, message string) (string, error) {
+	return "", skerr.Fmt("reverts are not supported for GitLab")
+}
+
+// UserEmail implements CodeReview.
+func (c *gitlabCodeReview) UserEmail() string {
+	return c.userEmail
+}
+
+// UserName implements CodeReview.
+func (c *gitlabCodeReview) UserName() string {
+	return c.userName
+}
+
+// Client implements CodeReview.
+func (c *gitlabCodeReview) Client() interface{} {
+	return c.gitlabClient
+}
@@ -0,0 +1,29 @@
+package codereview
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/gerrit"
+	"go.skia.org/infra/go/gerrit/mocks"
+)
+
+func TestGerritCodeReview_Revert(t *testing.T) {
+	ctx := context.Background()
+	g := &mocks.GerritInterface{}
+	ci := &gerrit.ChangeInfo{
+		Issue:   123,
+		Project: "my-project",
+	}
+	g.On("GetIssueProperties", ctx, int64(123)).Return(ci, nil)
+	g.On("Revert", ctx, ci, "Revert the roll").Return(&gerrit.ChangeInfo{Issue: 456}, nil)
+	g.On("Url", int64(456)).Return("https://fake-review/c/456")
+	cr := &gerritCodeReview{
+		gerritClient: g,
+	}
+	url, err := cr.Revert(ctx, 123, "Revert the roll")
+	require.NoError(t, err)
+	require.Equal(t, "https://fake-review/c/456", url)
+	g.AssertExpectations(t)
+}
@@ -0,0 +1,330 @@
+package codereview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.skia.org/infra/autoroll/go/recent_rolls"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/autoroll"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+// fanOutRoll is an implementation of RollImpl which combines the CLs of a roll
+// into multiple parents. The roll succeeds only if every CL lands; if any CL
+// fails after others have landed, the landed CLs are reverted. Only the
+// combined AutoRollIssue, whose Issue is the primary CL, is stored in the DB.
+type fanOutRoll struct {
+	cr               CodeReview
+	finishedCallback func(context.Context, RollImpl) error
+	issue            *autoroll.AutoRollIssue
+	recent           *recent_rolls.RecentRolls
+	result           string
+	reverted         bool
+	rolls            []RollImpl
+	subIssues        []*autoroll.AutoRollIssue
+	rollingFrom      *revision.Revision
+	rollingTo        *revision.Revision
+}
+
+// NewFanOutRoll returns a RollImpl which combines the primary CL given by
+// issue.Issue with the secondary CLs given by issue.FanOutIssues.
+func NewFanOutRoll(ctx context.Context, cr CodeReview, issue *autoroll.AutoRollIssue, recent *recent_rolls.RecentRolls, rollingFrom, rollingTo *revision.Revision, cb func(context.Context, RollImpl) error) (RollImpl, error) {
+	r := &fanOutRoll{
+		cr:               cr,
+		finishedCallback: cb,
+		issue:            issue,
+		recent:           recent,
+		rollingFrom:      rollingFrom,
+		rollingTo:        rollingTo,
+	}
+	for _, issueNum := range append([]int64{issue.Issue}, issue.FanOutIssues...) {
+		subIssue := &autoroll.AutoRollIssue{
+			Attempt:      issue.Attempt,
+			AttemptStart: issue.AttemptStart,
+			IsDryRun:     issue.IsDryRun,
+			Issue:        issueNum,
+			Manual:       issue.Manual,
+			RollingFrom:  issue.RollingFrom,
+			RollingTo:    issue.RollingTo,
+		}
+		// The individual CLs are not stored in the DB and do not trigger the
+		// callback; the fanOutRoll handles both.
+		roll, err := cr.RetrieveRoll(ctx, subIssue, nil, rollingFrom, rollingTo, nil)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "failed to retrieve issue %d", issueNum)
+		}
+		r.rolls = append(r.rolls, roll)
+		r.subIssues = append(r.subIssues, subIssue)
+	}
+	if err := r.updateIssue(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return r, nil
+}
+
+// updateIssue combines the states of the individual CLs into r.issue.
+func (r *fanOutRoll) updateIssue() error {
+	primary := r.subIssues[0]
+	i := r.issue
+	i.Closed = true
+	i.Committed = true
+	i.CqSuccess = true
+	i.DryRunSuccess = true
+	allCqFinished := true
+	anyCqFailed := false
+	allDryRunFinished := true
+	anyDryRunFailed := false
+	i.Created = primary.Created
+	i.Modified = primary.Modified
+	i.TryResults = nil
+	for _, sub := range r.subIssues {
+		i.Closed = i.Closed && sub.Closed
+		i.Committed = i.Committed && sub.Committed
+		i.CqSuccess = i.CqSuccess && sub.CqSuccess
+		i.DryRunSuccess = i.DryRunSuccess && sub.DryRunSuccess
+		allCqFinished = allCqFinished && sub.CqFinished
+		anyCqFailed = anyCqFailed || (sub.CqFinished && !sub.CqSuccess)
+		allDryRunFinished = allDryRunFinished && sub.DryRunFinished
+		anyDryRunFailed = anyDryRunFailed || (sub.DryRunFinished && !sub.DryRunSuccess)
+		if sub.Created.Before(i.Created) {
+			i.Created = sub.Created
+		}
+		if sub.Modified.After(i.Modified) {
+			i.Modified = sub.Modified
+		}
+		i.TryResults = append(i.TryResults, sub.TryResults...)
+	}
+	i.CqFinished = !i.IsDryRun && (allCqFinished || anyCqFailed)
+	i.CqSuccess = i.CqSuccess && i.CqFinished
+	i.DryRunFinished = i.IsDryRun && (allDryRunFinished || anyDryRunFailed)
+	i.DryRunSuccess = i.DryRunSuccess && i.DryRunFinished
+	i.Patchsets = primary.Patchsets
+	i.Subject = primary.Subject
+	if r.result != "" {
+		i.Result = r.result
+	} else {
+		i.Result = autoroll.RollResult(i)
+	}
+	return i.Validate()
+}
+
+// revertAndClose reverts any CLs which have already landed and closes the
+// rest, so that the parents are left consistent with one another.
+func (r *fanOutRoll) revertAndClose(ctx context.Context, result, msg string) error {
+	if r.reverted {
+		return nil
+	}
+	for idx, roll := range r.rolls {
+		sub := r.subIssues[idx]
+		if sub.Committed {
+			revertMsg := fmt.Sprintf("Revert %q\n\nThis roll landed but the corresponding roll into another parent failed.\n\n%s", sub.Subject, msg)
+			revertURL, err := r.cr.Revert(ctx, sub.Issue, revertMsg)
+			if err != nil {
+				return skerr.Wrapf(err, "failed to revert issue %d", sub.Issue)
+			}
+			sklog.Infof("Reverted issue %d: %s", sub.Issue, revertURL)
+		} else if !roll.IsClosed() {
+			if err := roll.Close(ctx, result, msg); err != nil {
+				return skerr.Wrapf(err, "failed to close issue %d", sub.Issue)
+			}
+		}
+	}
+	r.reverted = true
+	return nil
+}
+
+// See documentation for RollImpl interface.
+func (r *fanOutRoll) InsertIntoDB(ctx context.Context) error {
+	return r.recent.Add(ctx, r.issue)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) AddComment(ctx context.Context, msg string) error {
+	for _, roll := range r.rolls {
+		if err := roll.AddComment(ctx, msg); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) Attempt() int {
+	return r.issue.Attempt
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) AttemptStart() time.Time {
+	return r.issue.AttemptStart
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) Close(ctx context.Context, result, msg string) error {
+	sklog.Infof("Closing fan-out roll %d (result %q) with message: %s", r.issue.Issue, result, msg)
+	r.result = result
+	if err := r.revertAndClose(ctx, result, msg); err != nil {
+		return skerr.Wrap(err)
+	}
+	return r.Update(ctx)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsClosed() bool {
+	return r.issue.Closed
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsFinished() bool {
+	return r.issue.CqFinished
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsSuccess() bool {
+	return r.issue.CqSuccess
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsCommitted() bool {
+	return r.issue.Committed
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsDryRunFinished() bool {
+	return r.issue.DryRunFinished
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsDryRunSuccess() bool {
+	return r.issue.DryRunSuccess
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IsManual() bool {
+	return r.issue.Manual
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IssueID() string {
+	return r.rolls[0].IssueID()
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) IssueURL() string {
+	return r.rolls[0].IssueURL()
+}
+
+// See documentation for state_machine.RollCLImpl interface. Only the CLs which
+// failed are retried.
+func (r *fanOutRoll) RetryCQ(ctx context.Context) error {
+	for idx, roll := range r.rolls {
+		sub := r.subIssues[idx]
+		if !roll.IsClosed() && sub.CqFinished && !sub.CqSuccess {
+			if err := roll.RetryCQ(ctx); err != nil {
+				return skerr.Wrapf(err, "failed to retry the CQ on issue %d", sub.Issue)
+			}
+		}
+	}
+	r.issue.Attempt++
+	r.issue.AttemptStart = time.Now()
+	return r.Update(ctx)
+}
+
+// See documentation for state_machine.RollCLImpl interface. Only the CLs which
+// failed are retried.
+func (r *fanOutRoll) RetryDryRun(ctx context.Context) error {
+	for idx, roll := range r.rolls {
+		sub := r.subIssues[idx]
+		if !roll.IsClosed() && sub.DryRunFinished && !sub.DryRunSuccess {
+			if err := roll.RetryDryRun(ctx); err != nil {
+				return skerr.Wrapf(err, "failed to retry the dry run on issue %d", sub.Issue)
+			}
+		}
+	}
+	r.issue.Attempt++
+	r.issue.AttemptStart = time.Now()
+	return r.Update(ctx)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) Result() string {
+	return r.issue.Result
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) RollingFrom() *revision.Revision {
+	return r.rollingFrom
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) RollingTo() *revision.Revision {
+	return r.rollingTo
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) SwitchToDryRun(ctx context.Context) error {
+	for _, roll := range r.rolls {
+		if !roll.IsClosed() {
+			if err := roll.SwitchToDryRun(ctx); err != nil {
+				return skerr.Wrap(err)
+			}
+		}
+	}
+	r.issue.IsDryRun = true
+	return r.Update(ctx)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *fanOutRoll) SwitchToNormal(ctx context.Context) error {
+	for _, roll := range r.rolls {
+		if !roll.IsClosed() {
+			if err := roll.SwitchToNormal(ctx); err != nil {
+				return skerr.Wrap(err)
+			}
+		}
+	}
+	r.issue.IsDryRun = false
+	return r.Update(ctx)
+}
+
+// See documentation for state_machine.RollCLImpl interface. If any CL was
+// closed without landing, the remaining CLs are closed and any which already
+// landed are reverted.
+func (r *fanOutRoll) Update(ctx context.Context) error {
+	alreadyClosed := r.IsClosed()
+	for idx, roll := range r.rolls {
+		if !roll.IsClosed() {
+			if err := roll.Update(ctx); err != nil {
+				return skerr.Wrapf(err, "failed to update issue %d", r.subIssues[idx].Issue)
+			}
+		}
+	}
+	if !alreadyClosed {
+		for _, sub := range r.subIssues {
+			if sub.Closed && !sub.Committed {
+				if r.result == "" {
+					r.result = autoroll.ROLL_RESULT_FAILURE
+				}
+				if err := r.revertAndClose(ctx, r.result, fmt.Sprintf("Issue %d was closed without landing.", sub.Issue)); err != nil {
+					return skerr.Wrap(err)
+				}
+				break
+			}
+		}
+	}
+	if err := r.updateIssue(); err != nil {
+		return skerr.Wrap(err)
+	}
+	if err := r.recent.Update(ctx, r.issue); err != nil {
+		return skerr.Wrap(err)
+	}
+	if r.IsClosed() && !alreadyClosed && r.finishedCallback != nil {
+		return r.finishedCallback(ctx, r)
+	}
+	return nil
+}
+
+// fanOutRoll implements RollImpl.
+var _ RollImpl = &fanOutRoll{}
@@ -0,0 +1,167 @@
+package codereview
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/recent_rolls"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/autoroll"
+)
+
+// fakeSubRoll is a RollImpl whose state is controlled directly by the test via
+// its AutoRollIssue.
+type fakeSubRoll struct {
+	RollImpl
+	issue *autoroll.AutoRollIssue
+}
+
+func (r *fakeSubRoll) AddComment(_ context.Context, _ string) error { return nil }
+func (r *fakeSubRoll) Update(_ context.Context) error               { return nil }
+func (r *fakeSubRoll) IsClosed() bool                               { return r.issue.Closed }
+func (r *fakeSubRoll) IssueID() string                              { return fmt.Sprintf("%d", r.issue.Issue) }
+func (r *fakeSubRoll) IssueURL() string                             { return fmt.Sprintf("http://issue/%d", r.issue.Issue) }
+
+func (r *fakeSubRoll) Close(_ context.Context, result, _ string) error {
+	r.issue.Closed = true
+	r.issue.Result = result
+	return nil
+}
+
+func (r *fakeSubRoll) RetryCQ(_ context.Context) error {
+	r.issue.CqFinished = false
+	r.issue.CqSuccess = false
+	return nil
+}
+
+// fakeFanOutCodeReview is a CodeReview which returns fakeSubRolls and records
+// reverts.
+type fakeFanOutCodeReview struct {
+	CodeReview
+	rolls    map[int64]*fakeSubRoll
+	reverted []int64
+}
+
+func (c *fakeFanOutCodeReview) RetrieveRoll(_ context.Context, issue *autoroll.AutoRollIssue, _ *recent_rolls.RecentRolls, _, _ *revision.Revision, _ func(context.Context, RollImpl) error) (RollImpl, error) {
+	issue.Subject = fmt.Sprintf("Roll %d", issue.Issue)
+	issue.Created = time.Unix(issue.Issue, 0)
+	issue.Modified = time.Unix(100+issue.Issue, 0)
+	issue.Result = autoroll.RollResult(issue)
+	roll := &fakeSubRoll{issue: issue}
+	c.rolls[issue.Issue] = roll
+	return roll, nil
+}
+
+func (c *fakeFanOutCodeReview) Revert(_ context.Context, issue int64, _ string) (string, error) {
+	c.reverted = append(c.reverted, issue)
+	return fmt.Sprintf("http://issue/%d", 1000+issue), nil
+}
+
+func setupFanOutRoll(t *testing.T) (context.Context, *fakeFanOutCodeReview, *recent_rolls.RecentRolls, RollImpl, *int) {
+	ctx := context.Background()
+	cr := &fakeFanOutCodeReview{rolls: map[int64]*fakeSubRoll{}}
+	recent, err := recent_rolls.NewRecentRolls(ctx, &memRollsDB{rolls: map[int64]*autoroll.AutoRollIssue{}}, "test-roller")
+	require.NoError(t, err)
+	finished := 0
+	issue := &autoroll.AutoRollIssue{
+		Issue:        1,
+		FanOutIssues: []int64{2, 3},
+		RollingFrom:  "a",
+		RollingTo:    "b",
+	}
+	roll, err := NewFanOutRoll(ctx, cr, issue, recent, &revision.Revision{Id: "a"}, &revision.Revision{Id: "b"}, func(context.Context, RollImpl) error {
+		finished++
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, roll.InsertIntoDB(ctx))
+	return ctx, cr, recent, roll, &finished
+}
+
+func land(issue *autoroll.AutoRollIssue) {
+	issue.Closed = true
+	issue.Committed = true
+	issue.CqFinished = true
+	issue.CqSuccess = true
+	issue.Result = autoroll.ROLL_RESULT_SUCCESS
+}
+
+func TestFanOutRoll_AllLand(t *testing.T) {
+	ctx, cr, recent, roll, finished := setupFanOutRoll(t)
+	require.Equal(t, "1", roll.IssueID())
+	require.False(t, roll.IsClosed())
+	require.Equal(t, autoroll.ROLL_RESULT_IN_PROGRESS, roll.Result())
+
+	// The roll is not committed until every CL lands.
+	land(cr.rolls[1].issue)
+	land(cr.rolls[3].issue)
+	require.NoError(t, roll.Update(ctx))
+	require.False(t, roll.IsClosed())
+	require.False(t, roll.IsFinished())
+	require.Equal(t, 0, *finished)
+
+	land(cr.rolls[2].issue)
+	require.NoError(t, roll.Update(ctx))
+	require.True(t, roll.IsClosed())
+	require.True(t, roll.IsCommitted())
+	require.True(t, roll.IsSuccess())
+	require.Equal(t, autoroll.ROLL_RESULT_SUCCESS, roll.Result())
+	require.Equal(t, 1, *finished)
+	require.Empty(t, cr.reverted)
+
+	// Only the combined issue is stored.
+	stored, err := recent.Get(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, stored.Committed)
+	require.Equal(t, []int64{2, 3}, stored.FanOutIssues)
+	require.Equal(t, time.Unix(1, 0), stored.Created)
+	require.Equal(t, time.Unix(103, 0), stored.Modified)
+	require.Len(t, recent.GetRecentRolls(), 1)
+}
+
+func TestFanOutRoll_PartialLandingIsReverted(t *testing.T) {
+	ctx, cr, _, roll, finished := setupFanOutRoll(t)
+
+	// One CL lands and another fails the CQ; the roll is finished but not
+	// yet closed, so that the failed CL may be retried.
+	land(cr.rolls[1].issue)
+	cr.rolls[2].issue.CqFinished = true
+	require.NoError(t, roll.Update(ctx))
+	require.True(t, roll.IsFinished())
+	require.False(t, roll.IsSuccess())
+	require.False(t, roll.IsClosed())
+
+	require.NoError(t, roll.RetryCQ(ctx))
+	require.False(t, roll.IsFinished())
+	require.Equal(t, 1, roll.Attempt())
+
+	// Somebody abandons the failed CL. The landed CL is reverted and the
+	// remaining CL is closed.
+	cr.rolls[2].issue.Closed = true
+	require.NoError(t, roll.Update(ctx))
+	require.True(t, roll.IsClosed())
+	require.False(t, roll.IsCommitted())
+	require.Equal(t, autoroll.ROLL_RESULT_FAILURE, roll.Result())
+	require.Equal(t, []int64{1}, cr.reverted)
+	require.True(t, cr.rolls[3].issue.Closed)
+	require.Equal(t, 1, *finished)
+
+	// Further updates don't revert again.
+	require.NoError(t, roll.Update(ctx))
+	require.Equal(t, []int64{1}, cr.reverted)
+}
+
+func TestFanOutRoll_Close(t *testing.T) {
+	ctx, cr, _, roll, finished := setupFanOutRoll(t)
+	land(cr.rolls[3].issue)
+	require.NoError(t, roll.Close(ctx, autoroll.ROLL_RESULT_FAILURE, "Roll failed"))
+	require.True(t, roll.IsClosed())
+	require.Equal(t, autoroll.ROLL_RESULT_FAILURE, roll.Result())
+	require.Equal(t, []int64{3}, cr.reverted)
+	require.True(t, cr.rolls[1].issue.Closed)
+	require.True(t, cr.rolls[2].issue.Closed)
+	require.Equal(t, 1, *finished)
+}
@@ -19,6 +19,7 @@ import (
 	"go.skia.org/infra/go/gerrit"
 	"go.skia.org/infra/go/github"
 	"go.skia.org/infra/go/gitiles"
+	"go.skia.org/infra/go/gitlab"
 	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/go/travisci"
@@ -116,6 +117,12 @@ func updateIssueFromGerritChangeInfo(i *autoroll.AutoRollIssue, ci *gerrit.Chang
 	}
 	i.Closed = ci.IsClosed()
 	i.Committed = ci.Committed
+	// Gerrit adds a patchset if it rebases a change when submitting it, so
+	// the most recent patchset of a merged change is the commit which landed.
+	i.CommitHash = ""
+	if ci.Committed && len(ci.Patchsets) > 0 {
+		i.CommitHash = ci.Patchsets[len(ci.Patchsets)-1].ID
+	}
 	i.Created = ci.Created
 	i.Modified = ci.Updated
 	i.Patchsets = ps
@@ -355,8 +362,11 @@ func (r *gerritRoll) Update(ctx context.Context) error {
 	if r.result != "" {
 		r.issue.Result = r.result
 	}
-	if err := r.recent.Update(ctx, r.issue); err != nil {
-		return err
+	// Rolls which are part of a fan-out roll are not stored individually.
+	if r.recent != nil {
+		if err := r.recent.Update(ctx, r.issue); err != nil {
+			return err
+		}
 	}
 	if r.IsClosed() && !alreadyClosed && r.finishedCallback != nil {
 		return r.finishedCallback(ctx, r)
@@ -484,6 +494,10 @@ func updateIssueFromGitHubPullRequest(i *autoroll.AutoRollIssue, pullRequest *gi
 	}
 	i.Closed = pullRequest.GetState() == github.CLOSED_STATE
 	i.Committed = pullRequest.GetMerged()
+	i.CommitHash = ""
+	if i.Committed {
+		i.CommitHash = pullRequest.GetMergeCommitSHA()
+	}
 	i.Created = pullRequest.GetCreatedAt()
 	i.Modified = pullRequest.GetUpdatedAt()
 	i.Patchsets = ps
@@ -701,3 +715,301 @@ func (r *githubRoll) IssueID() string {
 func (r *githubRoll) IssueURL() string {
 	return r.issueUrl
 }
+
+// gitlabRoll is an implementation of RollImpl.
+type gitlabRoll struct {
+	finishedCallback func(context.Context, RollImpl) error
+	g                *gitlab.GitLab
+	issue            *autoroll.AutoRollIssue
+	issueUrl         string
+	mergeRequest     *gitlab.MergeRequest
+	recent           *recent_rolls.RecentRolls
+	result           string
+	retrieveRoll     func(context.Context) (*gitlab.MergeRequest, error)
+	rollingFrom      *revision.Revision
+	rollingTo        *revision.Revision
+}
+
+// updateIssueFromGitLab loads details about the merge request from the GitLab
+// API and updates the AutoRollIssue accordingly.
+func updateIssueFromGitLab(ctx context.Context, a *autoroll.AutoRollIssue, g *gitlab.GitLab) (*gitlab.MergeRequest, error) {
+	mr, err := g.GetMergeRequest(ctx, a.Issue)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to get merge request %d", a.Issue)
+	}
+	versions, err := g.GetMergeRequestVersions(ctx, a.Issue)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to get versions of merge request %d", a.Issue)
+	}
+	// Use the jobs of the most recent pipeline as the try results.
+	a.TryResults = []*autoroll.TryResult{}
+	if mr.HeadPipeline != nil {
+		jobs, err := g.GetPipelineJobs(ctx, mr.HeadPipeline.ID)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "failed to get jobs for pipeline %d", mr.HeadPipeline.ID)
+		}
+		a.TryResults = autoroll.TryResultsFromGitLabJobs(jobs)
+	}
+	if err := updateIssueFromGitLabMergeRequest(a, mr, len(versions)); err != nil {
+		return nil, skerr.Wrapf(err, "failed to convert issue format")
+	}
+	return mr, nil
+}
+
+// updateIssueFromGitLabMergeRequest updates the AutoRollIssue instance based
+// on the given MergeRequest, which has the given number of diff versions.
+func updateIssueFromGitLabMergeRequest(i *autoroll.AutoRollIssue, mr *gitlab.MergeRequest, numVersions int) error {
+	if i.Issue != mr.IID {
+		return fmt.Errorf("Merge request number %d differs from existing issue number %d!", mr.IID, i.Issue)
+	}
+	merged := mr.State == gitlab.MERGE_REQUEST_STATE_MERGED
+	closed := merged || mr.State == gitlab.MERGE_REQUEST_STATE_CLOSED
+	pipelineFinished := mr.HeadPipeline != nil && gitlab.StatusFinished(mr.HeadPipeline.Status)
+	pipelineSuccess := mr.HeadPipeline != nil && mr.HeadPipeline.Status == gitlab.STATUS_SUCCESS
+	if i.IsDryRun {
+		i.CqFinished = false
+		i.CqSuccess = false
+		i.DryRunFinished = closed || mr.HasConflicts || pipelineFinished
+		i.DryRunSuccess = merged || (i.DryRunFinished && !mr.HasConflicts && pipelineSuccess)
+	} else {
+		// GitLab unsets merge_when_pipeline_succeeds if the pipeline fails,
+		// so its absence on an open merge request indicates failure.
+		i.CqFinished = closed || mr.HasConflicts || !mr.MergeWhenPipelineSucceeds
+		i.CqSuccess = merged
+		i.DryRunFinished = false
+		i.DryRunSuccess = false
+	}
+
+	ps := make([]int64, 0, numVersions)
+	for v := 1; v <= numVersions; v++ {
+		ps = append(ps, int64(v))
+	}
+	i.Closed = closed
+	i.Committed = merged
+	i.CommitHash = ""
+	if merged {
+		// There is no merge commit if the merge request was fast-forwarded,
+		// in which case the squash commit, if any, or otherwise the head of
+		// the merge request is what landed.
+		i.CommitHash = mr.MergeCommitSHA
+		if i.CommitHash == "" {
+			i.CommitHash = mr.SquashCommitSHA
+		}
+		if i.CommitHash == "" {
+			i.CommitHash = mr.SHA
+		}
+	}
+	i.Created = mr.CreatedAt
+	i.Modified = mr.UpdatedAt
+	i.Patchsets = ps
+	i.Subject = mr.Title
+	i.Result = autoroll.RollResult(i)
+	return i.Validate()
+}
+
+// newGitLabRoll obtains a gitlabRoll instance from the given merge request
+// number.
+func newGitLabRoll(ctx context.Context, issue *autoroll.AutoRollIssue, g *gitlab.GitLab, recent *recent_rolls.RecentRolls, issueUrlBase string, rollingFrom, rollingTo *revision.Revision, cb func(context.Context, RollImpl) error) (RollImpl, error) {
+	mr, err := updateIssueFromGitLab(ctx, issue, g)
+	if err != nil {
+		return nil, err
+	}
+	return &gitlabRoll{
+		finishedCallback: cb,
+		g:                g,
+		issue:            issue,
+		issueUrl:         fmt.Sprintf("%s%d", issueUrlBase, issue.Issue),
+		mergeRequest:     mr,
+		recent:           recent,
+		retrieveRoll: func(ctx context.Context) (*gitlab.MergeRequest, error) {
+			return updateIssueFromGitLab(ctx, issue, g)
+		},
+		rollingFrom: rollingFrom,
+		rollingTo:   rollingTo,
+	}, nil
+}
+
+// See documentation for RollImpl interface.
+func (r *gitlabRoll) InsertIntoDB(ctx context.Context) error {
+	return r.recent.Add(ctx, r.issue)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) AddComment(ctx context.Context, msg string) error {
+	return r.g.AddComment(ctx, r.mergeRequest.IID, msg)
+}
+
+// Helper function for modifying a roll CL which might fail due to the CL being
+// closed by a human or some other process, in which case we don't want to error
+// out.
+func (r *gitlabRoll) withModify(ctx context.Context, action string, fn func() error) error {
+	if err := fn(); err != nil {
+		// It's possible that somebody closed the merge request (or it
+		// was merged) while we were working. If that's the case, log an
+		// error and move on.
+		if err2 := r.Update(ctx); err2 != nil {
+			return fmt.Errorf("Failed to %s with error:\n%s\nAnd failed to update it with error:\n%s", action, err, err2)
+		}
+		if r.IsClosed() {
+			sklog.Errorf("Attempted to %s but it is already closed! Error: %s", action, err)
+			return nil
+		}
+		return err
+	}
+	return r.Update(ctx)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) Close(ctx context.Context, result, msg string) error {
+	sklog.Infof("Closing merge request %d (result %q) with message: %s", r.mergeRequest.IID, result, msg)
+	r.result = result
+	return r.withModify(ctx, "close the merge request", func() error {
+		if err := r.g.AddComment(ctx, r.mergeRequest.IID, msg); err != nil {
+			return err
+		}
+		return r.g.CloseMergeRequest(ctx, r.mergeRequest.IID)
+	})
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) Update(ctx context.Context) error {
+	alreadyClosed := r.IsClosed()
+	mr, err := r.retrieveRoll(ctx)
+	if err != nil {
+		return err
+	}
+	r.mergeRequest = mr
+	if r.result != "" {
+		r.issue.Result = r.result
+	}
+	if err := r.recent.Update(ctx, r.issue); err != nil {
+		return err
+	}
+	if r.IsClosed() && !alreadyClosed && r.finishedCallback != nil {
+		return r.finishedCallback(ctx, r)
+	}
+	return nil
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsClosed() bool {
+	return r.issue.Closed
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsFinished() bool {
+	return r.issue.CqFinished
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsSuccess() bool {
+	return r.issue.CqSuccess
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsCommitted() bool {
+	return r.issue.Committed
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsDryRunFinished() bool {
+	return r.issue.DryRunFinished
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsDryRunSuccess() bool {
+	return r.issue.DryRunSuccess
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IsManual() bool {
+	return r.issue.Manual
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) Result() string {
+	return autoroll.RollResult(r.issue)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) RollingTo() *revision.Revision {
+	return r.rollingTo
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) RollingFrom() *revision.Revision {
+	return r.rollingFrom
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) SwitchToDryRun(ctx context.Context) error {
+	return r.withModify(ctx, "switch the merge request to dry run", func() error {
+		if r.mergeRequest.MergeWhenPipelineSucceeds {
+			if err := r.g.CancelMergeWhenPipelineSucceeds(ctx, r.mergeRequest.IID); err != nil {
+				return err
+			}
+		}
+		r.issue.IsDryRun = true
+		return nil
+	})
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) SwitchToNormal(ctx context.Context) error {
+	return r.withModify(ctx, "switch the merge request out of dry run", func() error {
+		if err := r.g.MergeWhenPipelineSucceeds(ctx, r.mergeRequest.IID, r.mergeRequest.SHA); err != nil {
+			return err
+		}
+		r.issue.IsDryRun = false
+		return nil
+	})
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) RetryCQ(ctx context.Context) error {
+	return r.withModify(ctx, "run a new pipeline and merge when it succeeds", func() error {
+		if _, err := r.g.CreateMergeRequestPipeline(ctx, r.mergeRequest.IID); err != nil {
+			return err
+		}
+		if err := r.g.MergeWhenPipelineSucceeds(ctx, r.mergeRequest.IID, r.mergeRequest.SHA); err != nil {
+			return err
+		}
+		r.issue.IsDryRun = false
+		r.issue.Attempt++
+		r.issue.AttemptStart = time.Now()
+		return nil
+	})
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) RetryDryRun(ctx context.Context) error {
+	return r.withModify(ctx, "run a new pipeline", func() error {
+		if _, err := r.g.CreateMergeRequestPipeline(ctx, r.mergeRequest.IID); err != nil {
+			return err
+		}
+		r.issue.IsDryRun = true
+		r.issue.Attempt++
+		r.issue.AttemptStart = time.Now()
+		return nil
+	})
+}
+
+// See documentation for state_machine.RollClImpl interface.
+func (r *gitlabRoll) Attempt() int {
+	return r.issue.Attempt
+}
+
+// See documentation for state_machine.RollClImpl interface.
+func (r *gitlabRoll) AttemptStart() time.Time {
+	return r.issue.AttemptStart
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IssueID() string {
+	return fmt.Sprintf("%d", r.issue.Issue)
+}
+
+// See documentation for state_machine.RollCLImpl interface.
+func (r *gitlabRoll) IssueURL() string {
+	return r.issueUrl
+}
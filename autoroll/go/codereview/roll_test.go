@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -22,6 +23,8 @@ import (
 	"go.skia.org/infra/go/gerrit"
 	gerrit_testutils "go.skia.org/infra/go/gerrit/testutils"
 	"go.skia.org/infra/go/github"
+	"go.skia.org/infra/go/gitlab"
+	gitlab_testutils "go.skia.org/infra/go/gitlab/testutils"
 	"go.skia.org/infra/go/mockhttpclient"
 	"go.skia.org/infra/go/testutils"
 )
@@ -462,6 +465,7 @@ func testUpdateFromGerritChangeInfo(t *testing.T, cfg *gerrit.Config) {
 	ci.Status = gerrit.ChangeStatusMerged
 	expect.Closed = true
 	expect.Committed = true
+	expect.CommitHash = rev.ID
 	expect.CqSuccess = true
 	expect.Result = autoroll.ROLL_RESULT_SUCCESS
 	require.NoError(t, updateIssueFromGerritChangeInfo(a, ci, cfg))
@@ -476,6 +480,7 @@ func testUpdateFromGerritChangeInfo(t *testing.T, cfg *gerrit.Config) {
 	ci.Committed = false
 	ci.Status = gerrit.ChangeStatusAbandoned
 	expect.Committed = false
+	expect.CommitHash = ""
 	expect.CqFinished = true // Not really, but the CL is finished.
 	expect.CqSuccess = false
 	expect.Result = autoroll.ROLL_RESULT_FAILURE
@@ -537,6 +542,7 @@ func testUpdateFromGerritChangeInfo(t *testing.T, cfg *gerrit.Config) {
 	ci.Committed = true
 	ci.Status = gerrit.ChangeStatusMerged
 	expect.Committed = true
+	expect.CommitHash = rev.ID
 	expect.DryRunSuccess = true
 	expect.Result = autoroll.ROLL_RESULT_DRY_RUN_SUCCESS
 	require.NoError(t, updateIssueFromGerritChangeInfo(a, ci, cfg))
@@ -550,6 +556,7 @@ func testUpdateFromGerritChangeInfo(t *testing.T, cfg *gerrit.Config) {
 	ci.Status = gerrit.ChangeStatusNew
 	expect.Closed = false
 	expect.Committed = false
+	expect.CommitHash = ""
 	expect.CqFinished = false
 	expect.CqSuccess = false
 	expect.DryRunSuccess = true
@@ -642,8 +649,10 @@ func TestUpdateFromGitHubPullRequest(t *testing.T) {
 
 	// CQ succeeded.
 	pr.Merged = boolPtr(true)
+	pr.MergeCommitSHA = stringPtr("fedcba98")
 	expect.Closed = true
 	expect.Committed = true
+	expect.CommitHash = "fedcba98"
 	expect.CqFinished = true
 	expect.CqSuccess = true
 	expect.Result = autoroll.ROLL_RESULT_SUCCESS
@@ -655,6 +664,7 @@ func TestUpdateFromGitHubPullRequest(t *testing.T) {
 
 	// Dry run active.
 	pr.Merged = boolPtr(false)
+	expect.CommitHash = ""
 	pr.State = stringPtr("")
 	expect.TryResults = []*autoroll.TryResult{
 		{
@@ -714,7 +724,9 @@ func TestUpdateFromGitHubPullRequest(t *testing.T) {
 
 	// CL was landed while dry run was still running.
 	pr.Merged = boolPtr(true)
+	pr.MergeCommitSHA = stringPtr("fedcba98")
 	expect.Committed = true
+	expect.CommitHash = "fedcba98"
 	expect.CqSuccess = false
 	expect.DryRunFinished = true
 	expect.DryRunSuccess = true
@@ -724,6 +736,7 @@ func TestUpdateFromGitHubPullRequest(t *testing.T) {
 
 	// Dry run success.
 	pr.Merged = boolPtr(false)
+	expect.CommitHash = ""
 	pr.State = stringPtr("")
 	expect.Closed = false
 	expect.Committed = false
@@ -736,3 +749,252 @@ func TestUpdateFromGitHubPullRequest(t *testing.T) {
 	require.NoError(t, updateIssueFromGitHubPullRequest(a, pr))
 	assertdeep.Equal(t, expect, a)
 }
+
+func TestUpdateFromGitLabMergeRequest(t *testing.T) {
+
+	now := time.Now()
+	a := &autoroll.AutoRollIssue{
+		Issue:       123,
+		RollingFrom: "abc123",
+		RollingTo:   "def456",
+	}
+
+	// Ensure that we don't overwrite the issue number.
+	require.EqualError(t, updateIssueFromGitLabMergeRequest(a, &gitlab.MergeRequest{}, 1), "Merge request number 0 differs from existing issue number 123!")
+
+	// Normal, in-progress merge request.
+	mr := &gitlab.MergeRequest{
+		IID:                       a.Issue,
+		State:                     gitlab.MERGE_REQUEST_STATE_OPENED,
+		Title:                     "roll the deps",
+		CreatedAt:                 now,
+		UpdatedAt:                 now,
+		MergeWhenPipelineSucceeds: true,
+		HeadPipeline: &gitlab.Pipeline{
+			ID:     1,
+			Status: gitlab.STATUS_RUNNING,
+		},
+	}
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 1))
+	expect := &autoroll.AutoRollIssue{
+		Created:     now,
+		Issue:       123,
+		Modified:    now,
+		Patchsets:   []int64{1},
+		Result:      autoroll.ROLL_RESULT_IN_PROGRESS,
+		RollingFrom: "abc123",
+		RollingTo:   "def456",
+		Subject:     "roll the deps",
+	}
+	assertdeep.Equal(t, expect, a)
+
+	// Pipeline failed; GitLab unsets merge_when_pipeline_succeeds.
+	mr.HeadPipeline.Status = gitlab.STATUS_FAILED
+	mr.MergeWhenPipelineSucceeds = false
+	expect.CqFinished = true
+	expect.Result = autoroll.ROLL_RESULT_FAILURE
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 1))
+	assertdeep.Equal(t, expect, a)
+
+	// Merge conflicts.
+	mr.HeadPipeline.Status = gitlab.STATUS_RUNNING
+	mr.MergeWhenPipelineSucceeds = true
+	mr.HasConflicts = true
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 2))
+	expect.Patchsets = []int64{1, 2}
+	assertdeep.Equal(t, expect, a)
+
+	// Merged.
+	mr.HasConflicts = false
+	mr.HeadPipeline.Status = gitlab.STATUS_SUCCESS
+	mr.State = gitlab.MERGE_REQUEST_STATE_MERGED
+	mr.MergeCommitSHA = "fedcba98"
+	expect.Closed = true
+	expect.Committed = true
+	expect.CommitHash = "fedcba98"
+	expect.CqSuccess = true
+	expect.Result = autoroll.ROLL_RESULT_SUCCESS
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 2))
+	assertdeep.Equal(t, expect, a)
+
+	// Dry run in progress.
+	a.IsDryRun = true
+	mr.State = gitlab.MERGE_REQUEST_STATE_OPENED
+	mr.MergeWhenPipelineSucceeds = false
+	mr.HeadPipeline.Status = gitlab.STATUS_PENDING
+	expect.IsDryRun = true
+	expect.Closed = false
+	expect.Committed = false
+	expect.CommitHash = ""
+	expect.CqFinished = false
+	expect.CqSuccess = false
+	expect.Result = autoroll.ROLL_RESULT_DRY_RUN_IN_PROGRESS
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 2))
+	assertdeep.Equal(t, expect, a)
+
+	// Dry run failed.
+	mr.HeadPipeline.Status = gitlab.STATUS_FAILED
+	expect.DryRunFinished = true
+	expect.Result = autoroll.ROLL_RESULT_DRY_RUN_FAILURE
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 2))
+	assertdeep.Equal(t, expect, a)
+
+	// Dry run succeeded.
+	mr.HeadPipeline.Status = gitlab.STATUS_SUCCESS
+	expect.DryRunSuccess = true
+	expect.Result = autoroll.ROLL_RESULT_DRY_RUN_SUCCESS
+	require.NoError(t, updateIssueFromGitLabMergeRequest(a, mr, 2))
+	assertdeep.Equal(t, expect, a)
+}
+
+// memRollsDB is an in-memory implementation of recent_rolls.DB.
+type memRollsDB struct {
+	rolls map[int64]*autoroll.AutoRollIssue
+}
+
+func (d *memRollsDB) Put(_ context.Context, _ string, roll *autoroll.AutoRollIssue) error {
+	d.rolls[roll.Issue] = roll.Copy()
+	return nil
+}
+
+func (d *memRollsDB) Get(_ context.Context, _ string, issue int64) (*autoroll.AutoRollIssue, error) {
+	roll, ok := d.rolls[issue]
+	if !ok {
+		return nil, fmt.Errorf("No such roll %d", issue)
+	}
+	return roll.Copy(), nil
+}
+
+func (d *memRollsDB) GetRolls(_ context.Context, _ string, _ string) ([]*autoroll.AutoRollIssue, string, error) {
+	rv := make([]*autoroll.AutoRollIssue, 0, len(d.rolls))
+	for _, roll := range d.rolls {
+		rv = append(rv, roll.Copy())
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].Issue > rv[j].Issue
+	})
+	return rv, "", nil
+}
+
+func TestGitLabRoll(t *testing.T) {
+	ctx := context.Background()
+	f := gitlab_testutils.NewFakeGitLab(t)
+	cr, err := NewGitLab(&config.GitLabConfig{
+		Url:     f.URL,
+		Project: gitlab_testutils.FakeProject,
+	}, f.GitLab)
+	require.NoError(t, err)
+	require.Equal(t, gitlab_testutils.FakeUserName, cr.UserName())
+	require.Equal(t, gitlab_testutils.FakeUserEmail, cr.UserEmail())
+	require.Equal(t, f.ProjectURL()+"/-/merge_requests/", cr.GetIssueUrlBase())
+	recent, err := recent_rolls.NewRecentRolls(ctx, &memRollsDB{rolls: map[int64]*autoroll.AutoRollIssue{}}, "test-roller")
+	require.NoError(t, err)
+
+	// Upload and retrieve the roll.
+	fromRev := &revision.Revision{Id: "abc123"}
+	toRev := &revision.Revision{Id: "def456"}
+	mr, err := f.GitLab.CreateMergeRequest(ctx, &gitlab.CreateMergeRequestOptions{
+		SourceBranch: "roll",
+		TargetBranch: "main",
+		Title:        "Roll abc123..def456",
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.GitLab.MergeWhenPipelineSucceeds(ctx, mr.IID, mr.SHA))
+	issue := &autoroll.AutoRollIssue{
+		Issue:       mr.IID,
+		RollingFrom: fromRev.Id,
+		RollingTo:   toRev.Id,
+	}
+	finished := 0
+	roll, err := cr.RetrieveRoll(ctx, issue, recent, fromRev, toRev, func(context.Context, RollImpl) error {
+		finished++
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, roll.InsertIntoDB(ctx))
+	require.Equal(t, fmt.Sprintf("%s/-/merge_requests/%d", f.ProjectURL(), mr.IID), roll.IssueURL())
+	require.False(t, roll.IsFinished())
+	require.False(t, roll.IsDryRunFinished())
+	require.Equal(t, toRev, roll.RollingTo())
+
+	// Switch to dry run.
+	require.NoError(t, roll.SwitchToDryRun(ctx))
+	require.False(t, f.MergeRequest(mr.IID).MergeWhenPipelineSucceeds)
+	require.False(t, roll.IsDryRunFinished())
+	f.SetPipelineJobs(mr.IID, gitlab.STATUS_FAILED, &gitlab.Job{Name: "test", Status: gitlab.STATUS_FAILED})
+	require.NoError(t, roll.Update(ctx))
+	require.True(t, roll.IsDryRunFinished())
+	require.False(t, roll.IsDryRunSuccess())
+	require.Equal(t, autoroll.ROLL_RESULT_DRY_RUN_FAILURE, roll.Result())
+	require.Equal(t, []*autoroll.TryResult{
+		{
+			Builder:  "test",
+			Category: autoroll.TRYBOT_CATEGORY_CQ,
+			Result:   autoroll.TRYBOT_RESULT_FAILURE,
+			Status:   autoroll.TRYBOT_STATUS_COMPLETED,
+		},
+	}, issue.TryResults)
+
+	// Retry the dry run.
+	require.NoError(t, roll.RetryDryRun(ctx))
+	require.Equal(t, 1, roll.Attempt())
+	require.False(t, roll.IsDryRunFinished())
+
+	// Switch to normal mode while the pipeline is running.
+	require.NoError(t, roll.SwitchToNormal(ctx))
+	require.True(t, f.MergeRequest(mr.IID).MergeWhenPipelineSucceeds)
+	require.False(t, roll.IsFinished())
+	f.SetPipelineJobs(mr.IID, gitlab.STATUS_FAILED, &gitlab.Job{Name: "test", Status: gitlab.STATUS_FAILED})
+	require.NoError(t, roll.Update(ctx))
+	require.True(t, roll.IsFinished())
+	require.False(t, roll.IsSuccess())
+	require.Equal(t, 0, finished)
+
+	// Retry and land the roll.
+	require.NoError(t, roll.RetryCQ(ctx))
+	require.Equal(t, 2, roll.Attempt())
+	require.True(t, f.MergeRequest(mr.IID).MergeWhenPipelineSucceeds)
+	require.False(t, roll.IsFinished())
+	f.SetPipelineJobs(mr.IID, gitlab.STATUS_SUCCESS, &gitlab.Job{Name: "test", Status: gitlab.STATUS_SUCCESS})
+	require.NoError(t, roll.Update(ctx))
+	require.True(t, roll.IsFinished())
+	require.True(t, roll.IsSuccess())
+	require.True(t, roll.IsCommitted())
+	require.True(t, roll.IsClosed())
+	require.Equal(t, 1, finished)
+
+	// Closing a merged roll is a no-op.
+	require.NoError(t, roll.Close(ctx, autoroll.ROLL_RESULT_FAILURE, "closing"))
+	require.Equal(t, gitlab.MERGE_REQUEST_STATE_MERGED, f.MergeRequest(mr.IID).State)
+}
+
+func TestGitLabRoll_Close(t *testing.T) {
+	ctx := context.Background()
+	f := gitlab_testutils.NewFakeGitLab(t)
+	cr, err := NewGitLab(&config.GitLabConfig{
+		Url:     f.URL,
+		Project: gitlab_testutils.FakeProject,
+	}, f.GitLab)
+	require.NoError(t, err)
+	recent, err := recent_rolls.NewRecentRolls(ctx, &memRollsDB{rolls: map[int64]*autoroll.AutoRollIssue{}}, "test-roller")
+	require.NoError(t, err)
+	mr, err := f.GitLab.CreateMergeRequest(ctx, &gitlab.CreateMergeRequestOptions{
+		SourceBranch: "roll",
+		TargetBranch: "main",
+		Title:        "Roll abc123..def456",
+	})
+	require.NoError(t, err)
+	issue := &autoroll.AutoRollIssue{
+		Issue:       mr.IID,
+		IsDryRun:    true,
+		RollingFrom: "abc123",
+		RollingTo:   "def456",
+	}
+	roll, err := cr.RetrieveRoll(ctx, issue, recent, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, roll.InsertIntoDB(ctx))
+	require.NoError(t, roll.Close(ctx, autoroll.ROLL_RESULT_FAILURE, "closing"))
+	require.True(t, roll.IsClosed())
+	require.Equal(t, gitlab.MERGE_REQUEST_STATE_CLOSED, f.MergeRequest(mr.IID).State)
+	require.Equal(t, []string{"closing"}, f.Comments(mr.IID))
+}
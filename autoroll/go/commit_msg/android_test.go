@@ -11,7 +11,7 @@ func TestNamedTemplateAndroid_AllFeatures(t *testing.T) {
 
 	b := fakeBuilder(t)
 	b.cfg.BuiltIn = config.CommitMsgConfig_ANDROID
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -52,7 +52,7 @@ func TestNamedTemplateAndroid_NoLog(t *testing.T) {
 	b := fakeBuilder(t)
 	b.cfg.BuiltIn = config.CommitMsgConfig_ANDROID
 	b.cfg.IncludeLog = false
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -90,7 +90,7 @@ func TestNamedTemplateAndroid_NoBugs(t *testing.T) {
 	b := fakeBuilder(t)
 	b.cfg.BugProject = ""
 	b.cfg.BuiltIn = config.CommitMsgConfig_ANDROID
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -136,7 +136,7 @@ func TestNamedTemplateAndroid_Minimal(t *testing.T) {
 	b.cfg.IncludeTests = false
 	b.cfg.ExtraFooters = nil
 	b.transitiveDeps = nil
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -161,7 +161,7 @@ func TestNamedTemplateAndroid_NoCR_AllFeatures(t *testing.T) {
 
 	b := fakeBuilder(t)
 	b.cfg.BuiltIn = config.CommitMsgConfig_ANDROID_NO_CR
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -205,7 +205,7 @@ func TestNamedTemplateAndroid_Custom(t *testing.T) {
 	// Use a custom template which derives from the Android template.
 	b.cfg.BuiltIn = config.CommitMsgConfig_ANDROID
 	b.cfg.Custom = `{{- define "subject" -}}Custom subject{{- end -}}`
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Custom subject
 
@@ -1,6 +1,7 @@
 package commit_msg
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -11,7 +12,7 @@ func TestNamedTemplateCanary(t *testing.T) {
 
 	b := fakeBuilder(t)
 	b.cfg.BuiltIn = config.CommitMsgConfig_CANARY
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Canary roll fake/child/src to cccccccccccc
 
@@ -38,7 +39,7 @@ func TestNamedTemplateCanary_WithExternalChangeId(t *testing.T) {
 	from, to, revs, reviewers, contacts, canary, manualRollRequester := FakeCommitMsgInputs()
 	to.ExternalChangeId = "12345"
 
-	result, err := b.Build(from, to, revs, reviewers, contacts, canary, manualRollRequester)
+	result, err := b.Build(context.Background(), from, to, revs, reviewers, contacts, canary, manualRollRequester)
 	require.NoError(t, err)
 	require.Equal(t, `Canary roll fake/child/src to cccccccccccc
 
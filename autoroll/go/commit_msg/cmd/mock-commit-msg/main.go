@@ -17,6 +17,7 @@ import (
 	"go.skia.org/infra/autoroll/go/config"
 	"go.skia.org/infra/autoroll/go/config_vars"
 	"go.skia.org/infra/autoroll/go/repo_manager"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
 	"go.skia.org/infra/autoroll/go/revision"
 	"go.skia.org/infra/autoroll/go/roller"
 	"go.skia.org/infra/autoroll/go/status"
@@ -155,6 +156,9 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		if s, ok := rm.(repo_manager.LogOptionsSetter); ok {
+			s.SetLogOptions(git_common.LogOptionsForCommitMsg(cfg.CommitMsg))
+		}
 
 		statusDB, err := status.NewDB(ctx, firestore.FIRESTORE_PROJECT, namespace, *firestoreInstance, ts)
 		if err != nil {
@@ -242,7 +246,7 @@ func main() {
 	}
 
 	// Build the commit message.
-	genCommitMsg, err := b.Build(from, to, revs, reviewers, cfg.Contacts, false, "")
+	genCommitMsg, err := b.Build(ctx, from, to, revs, reviewers, cfg.Contacts, false, "")
 	if err != nil {
 		log.Fatalf("Failed to build commit message: %s", err)
 	}
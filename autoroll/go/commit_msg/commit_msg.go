@@ -2,6 +2,7 @@ package commit_msg
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"regexp"
 	"sort"
@@ -13,6 +14,7 @@ import (
 	"go.skia.org/infra/autoroll/go/config_vars"
 	"go.skia.org/infra/autoroll/go/revision"
 	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/go/util"
 )
 
@@ -64,6 +66,7 @@ type Builder struct {
 	childName      string
 	parentBugLink  string
 	parentName     string
+	readFile       FileReader
 	reg            *config_vars.Registry
 	serverURL      string
 	transitiveDeps []*config.TransitiveDepConfig
@@ -99,17 +102,36 @@ func NewBuilder(c *config.CommitMsgConfig, reg *config_vars.Registry, childName,
 	}, nil
 }
 
+// SetFileReader sets the FileReader used to read files from the Child, eg. a
+// changelog. If no FileReader is set, release notes from a changelog are not
+// included in commit messages.
+func (b *Builder) SetFileReader(readFile FileReader) {
+	b.readFile = readFile
+}
+
 // Build a commit message for the given roll.
-func (b *Builder) Build(from, to *revision.Revision, rolling []*revision.Revision, reviewers, contacts []string, canary bool, manualRollRequester string) (string, error) {
-	return buildCommitMsg(b.cfg, b.reg.Vars(), b.childName, b.parentName, b.serverURL, b.childBugLink, b.parentBugLink, b.transitiveDeps, from, to, rolling, reviewers, contacts, canary, manualRollRequester, b.wordWrapChars)
+func (b *Builder) Build(ctx context.Context, from, to *revision.Revision, rolling []*revision.Revision, reviewers, contacts []string, canary bool, manualRollRequester string) (string, error) {
+	changelog := ""
+	if path := b.cfg.GetReleaseNotes().GetChangelogPath(); path != "" && b.readFile != nil {
+		// Failure to retrieve the release notes should not prevent the roll.
+		var err error
+		changelog, err = changelogReleaseNotes(ctx, b.readFile, path, from, to)
+		if err != nil {
+			sklog.Warningf("Failed to retrieve release notes: %s", err)
+		}
+	}
+	return buildCommitMsg(b.cfg, b.reg.Vars(), b.childName, b.parentName, b.serverURL, b.childBugLink, b.parentBugLink, b.transitiveDeps, from, to, rolling, reviewers, contacts, canary, manualRollRequester, changelog, b.wordWrapChars)
 }
 
 // buildCommitMsg builds a commit message for the given roll.
-func buildCommitMsg(c *config.CommitMsgConfig, cv *config_vars.Vars, childName, parentName, serverURL, childBugLink, parentBugLink string, transitiveDeps []*config.TransitiveDepConfig, from, to *revision.Revision, rolling []*revision.Revision, reviewers, contacts []string, canary bool, manualRollRequester string, wordWrapChars int) (string, error) {
+func buildCommitMsg(c *config.CommitMsgConfig, cv *config_vars.Vars, childName, parentName, serverURL, childBugLink, parentBugLink string, transitiveDeps []*config.TransitiveDepConfig, from, to *revision.Revision, rolling []*revision.Revision, reviewers, contacts []string, canary bool, manualRollRequester, changelog string, wordWrapChars int) (string, error) {
 	vars, err := makeVars(c, cv, childName, parentName, serverURL, childBugLink, parentBugLink, transitiveDeps, from, to, rolling, reviewers, contacts, manualRollRequester)
 	if err != nil {
 		return "", skerr.Wrap(err)
 	}
+	if changelog != "" {
+		vars.ReleaseNoteEntries = append([]string{changelog}, vars.ReleaseNoteEntries...)
+	}
 	// Create and execute the commit message template.
 	commitMsgTmpl := tmplCommitMsg
 	if canary {
@@ -258,6 +280,15 @@ func makeVars(c *config.CommitMsgConfig, cv *config_vars.Vars, childName, parent
 		}
 	}
 
+	// Digest of the revisions in the roll.
+	vars.RevisionGroups = groupRevisions(c, revsCopy)
+	if c.IncludeAuthorSummary {
+		vars.AuthorSummary = summarizeAuthors(revsCopy)
+	}
+	if c.GetReleaseNotes().GetTagAnnotations() {
+		vars.ReleaseNoteEntries = tagReleaseNotes(revsCopy)
+	}
+
 	// Transitive deps. Note that we can't verify that the repo manager
 	// implementation actually included these changes in the roll; we assume
 	// that it would do so if working correctly and would error out otherwise.
@@ -300,6 +331,7 @@ func makeVars(c *config.CommitMsgConfig, cv *config_vars.Vars, childName, parent
 type commitMsgVars struct {
 	*config.CommitMsgConfig
 	*config_vars.Vars
+	AuthorSummary       []*authorSummary
 	Bugs                []string
 	ChildBugLink        string
 	ChildLogURL         string
@@ -310,7 +342,9 @@ type commitMsgVars struct {
 	ManualRollRequester string
 	ParentBugLink       string
 	ParentName          string
+	ReleaseNoteEntries  []string
 	Reviewers           []string
+	RevisionGroups      []*revisionGroup
 	Revisions           []*revision.Revision
 	RollingFrom         *revision.Revision
 	RollingTo           *revision.Revision
@@ -71,6 +71,12 @@ func fakeRegistry(t *testing.T) *config_vars.Registry {
 	return reg
 }
 
+// buildFake builds a commit message using FakeCommitMsgInputs.
+func buildFake(b *Builder) (string, error) {
+	from, to, revs, reviewers, contacts, canary, manualRollRequester := FakeCommitMsgInputs()
+	return b.Build(context.Background(), from, to, revs, reviewers, contacts, canary, manualRollRequester)
+}
+
 // fakeBuilder returns a Builder instance.
 func fakeBuilder(t *testing.T) *Builder {
 	reg := fakeRegistry(t)
@@ -203,7 +209,7 @@ Change-Id: If3fd7d9b2ec5aaf7f048df1029b732b28378999d
 `
 	}
 
-	msg, err := b.Build(from, to, revs, reviewers, contacts, false, manualRollRequester)
+	msg, err := b.Build(context.Background(), from, to, revs, reviewers, contacts, false, manualRollRequester)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
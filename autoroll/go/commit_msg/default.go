@@ -53,9 +53,27 @@ var (
 
 {{ end -}}
 {{- if .IncludeLog -}}
+{{ if .RevisionGroups -}}
+{{ range .RevisionGroups }}{{ .Name }}:
 {{ range .Revisions }}{{ .Timestamp.Format "2006-01-02" }} {{ .Author }} {{ .Description }}
 {{ end }}
 {{ end -}}
+{{ else -}}
+{{ range .Revisions }}{{ .Timestamp.Format "2006-01-02" }} {{ .Author }} {{ .Description }}
+{{ end }}
+{{ end -}}
+{{ end -}}
+{{ if .AuthorSummary -}}
+Authors:
+{{ range .AuthorSummary }}  {{ .Author }} ({{ .Count }})
+{{ end }}
+{{ end -}}
+{{ if .ReleaseNoteEntries -}}
+Release notes:
+{{ range .ReleaseNoteEntries }}{{ . }}
+
+{{ end }}
+{{- end -}}
 {{ if len .TransitiveDeps -}}
 Also rolling transitive DEPS:
 {{ range .TransitiveDeps }}  {{ .String }}
@@ -1,6 +1,7 @@
 package commit_msg
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -9,7 +10,7 @@ import (
 func TestNamedTemplateDefault_AllFeatures(t *testing.T) {
 
 	b := fakeBuilder(t)
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -48,7 +49,7 @@ func TestNamedTemplateDefault_NoLog(t *testing.T) {
 
 	b := fakeBuilder(t)
 	b.cfg.IncludeLog = false
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -84,7 +85,7 @@ func TestNamedTemplateDefault_NoBugProject(t *testing.T) {
 
 	b := fakeBuilder(t)
 	b.cfg.BugProject = ""
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -127,7 +128,7 @@ func TestNamedTemplateDefault_NoBugs(t *testing.T) {
 	for _, rev := range revs {
 		rev.Bugs = nil
 	}
-	result, err := b.Build(from, to, revs, emails, canary, contacts, manualRollRequester)
+	result, err := b.Build(context.Background(), from, to, revs, emails, canary, contacts, manualRollRequester)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -174,7 +175,7 @@ func TestNamedTemplateDefault_Minimal(t *testing.T) {
 	b.cfg.IncludeTbrLine = false
 	b.cfg.IncludeTests = false
 	b.transitiveDeps = nil
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -201,7 +202,7 @@ Seriously, this can be anything at all.
 
 Variables from config_vars should work, eg. m{{.Branches.Chromium.Beta.Milestone}}, v8:{{.Branches.Chromium.Beta.V8Branch}}-lkgr
 {{end}}`
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Completely custom commit message.
 
@@ -216,7 +217,7 @@ func TestNamedTemplateDefault_BugLinks(t *testing.T) {
 	b := fakeBuilder(t)
 	b.childBugLink = fakeChildBugLink
 	b.parentBugLink = fakeParentBugLink
-	result, err := b.Build(FakeCommitMsgInputs())
+	result, err := buildFake(b)
 	require.NoError(t, err)
 	require.Equal(t, `Roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -259,7 +260,7 @@ func TestNamedTemplateDefault_ManualRoll(t *testing.T) {
 	b := fakeBuilder(t)
 	from, to, revs, emails, canary, contacts, manualRollRequester := FakeCommitMsgInputs()
 	manualRollRequester = "manual-requester@google.com"
-	result, err := b.Build(from, to, revs, emails, canary, contacts, manualRollRequester)
+	result, err := b.Build(context.Background(), from, to, revs, emails, canary, contacts, manualRollRequester)
 	require.NoError(t, err)
 	require.Equal(t, `Manual roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
@@ -303,7 +304,7 @@ func TestNamedTemplateDefault_WordWrap(t *testing.T) {
 	// Make this a manual roll so that the first line is longer than 72 chars,
 	// so we can verify that it doesn't get broken.
 	manualRollRequester = "manual-requester@google.com"
-	result, err := b.Build(from, to, revs, emails, canary, contacts, manualRollRequester)
+	result, err := b.Build(context.Background(), from, to, revs, emails, canary, contacts, manualRollRequester)
 	require.NoError(t, err)
 	require.Equal(t, `Manual roll fake/child/src from aaaaaaaaaaaa to cccccccccccc (2 revisions)
 
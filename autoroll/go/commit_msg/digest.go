@@ -0,0 +1,264 @@
+package commit_msg
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/vfs"
+)
+
+const (
+	// groupOther is the name of the group containing revisions which don't
+	// belong to any other group.
+	groupOther = "Other"
+)
+
+var (
+	// conventionalCommitRegex matches the type prefix of a Conventional Commit
+	// description, eg. "feat(parser)!: add a thing".
+	conventionalCommitRegex = regexp.MustCompile(`^(\w+)(?:\([^)]*\))?!?:\s`)
+
+	// conventionalCommitTypes maps known Conventional Commit types to group
+	// names, in the order in which the groups are listed.
+	conventionalCommitTypes = []struct {
+		types []string
+		name  string
+	}{
+		{types: []string{"feat", "feature"}, name: "Features"},
+		{types: []string{"fix", "bugfix"}, name: "Bug Fixes"},
+		{types: []string{"perf"}, name: "Performance"},
+		{types: []string{"revert"}, name: "Reverts"},
+		{types: []string{"refactor"}, name: "Refactoring"},
+		{types: []string{"docs", "doc"}, name: "Documentation"},
+		{types: []string{"test", "tests"}, name: "Tests"},
+		{types: []string{"build", "ci"}, name: "Build"},
+		{types: []string{"style"}, name: "Style"},
+		{types: []string{"chore"}, name: "Chores"},
+	}
+)
+
+// FileReader reads the contents of the file at the given path within the Child
+// at the given Revision.
+type FileReader func(ctx context.Context, rev *revision.Revision, path string) ([]byte, error)
+
+// VFSFileReader returns a FileReader which reads files from the vfs.FS
+// returned by getVFS, eg. child.Child.VFS.
+func VFSFileReader(getVFS func(context.Context, *revision.Revision) (vfs.FS, error)) FileReader {
+	return func(ctx context.Context, rev *revision.Revision, path string) ([]byte, error) {
+		fs, err := getVFS(ctx, rev)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		return vfs.ReadFile(ctx, fs, path)
+	}
+}
+
+// revisionGroup is a named group of revisions in a roll.
+type revisionGroup struct {
+	Name      string
+	Revisions []*revision.Revision
+}
+
+// authorSummary is the number of revisions in a roll by a given author.
+type authorSummary struct {
+	Author string
+	Count  int
+}
+
+// groupRevisions groups the given revisions according to the CommitMsgConfig.
+// Returns nil if the revisions are not to be grouped. The order of the
+// revisions within each group is preserved.
+func groupRevisions(c *config.CommitMsgConfig, revs []*revision.Revision) []*revisionGroup {
+	switch c.GroupBy {
+	case config.CommitMsgConfig_CONVENTIONAL_COMMIT_TYPE:
+		return groupByConventionalCommitType(revs)
+	case config.CommitMsgConfig_FILE_AREA:
+		return groupByFileArea(c.FileAreas, revs)
+	default:
+		return nil
+	}
+}
+
+// groupByConventionalCommitType groups the given revisions by the type prefix
+// of their descriptions. Known types are listed first, followed by unknown
+// types in alphabetical order, followed by revisions with no type.
+func groupByConventionalCommitType(revs []*revision.Revision) []*revisionGroup {
+	groupNames := map[string]string{}
+	var order []string
+	for _, t := range conventionalCommitTypes {
+		for _, typ := range t.types {
+			groupNames[typ] = t.name
+		}
+		order = append(order, t.name)
+	}
+	groups := map[string]*revisionGroup{}
+	var unknown []string
+	for _, rev := range revs {
+		name := groupOther
+		if m := conventionalCommitRegex.FindStringSubmatch(rev.Description); m != nil {
+			typ := strings.ToLower(m[1])
+			if known, ok := groupNames[typ]; ok {
+				name = known
+			} else {
+				name = typ
+			}
+		}
+		g, ok := groups[name]
+		if !ok {
+			g = &revisionGroup{Name: name}
+			groups[name] = g
+			if !isKnownGroup(name) && name != groupOther {
+				unknown = append(unknown, name)
+			}
+		}
+		g.Revisions = append(g.Revisions, rev)
+	}
+	sort.Strings(unknown)
+	order = append(order, unknown...)
+	order = append(order, groupOther)
+	rv := make([]*revisionGroup, 0, len(groups))
+	for _, name := range order {
+		if g, ok := groups[name]; ok {
+			rv = append(rv, g)
+		}
+	}
+	return rv
+}
+
+// isKnownGroup returns true iff the given name is the name of the group for
+// a known Conventional Commit type.
+func isKnownGroup(name string) bool {
+	for _, t := range conventionalCommitTypes {
+		if t.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// groupByFileArea groups the given revisions by the areas whose files they
+// modify, in the order in which the areas are configured. A revision which
+// modifies multiple areas is listed under each of them. Revisions which modify
+// no area, or whose changed files are unknown, are listed last.
+func groupByFileArea(areas []*config.CommitMsgConfig_FileArea, revs []*revision.Revision) []*revisionGroup {
+	groups := make([]*revisionGroup, 0, len(areas)+1)
+	for _, area := range areas {
+		groups = append(groups, &revisionGroup{Name: area.Name})
+	}
+	other := &revisionGroup{Name: groupOther}
+	for _, rev := range revs {
+		found := false
+		for idx, area := range areas {
+			if modifiesArea(rev, area) {
+				groups[idx].Revisions = append(groups[idx].Revisions, rev)
+				found = true
+			}
+		}
+		if !found {
+			other.Revisions = append(other.Revisions, rev)
+		}
+	}
+	groups = append(groups, other)
+	rv := make([]*revisionGroup, 0, len(groups))
+	for _, g := range groups {
+		if len(g.Revisions) > 0 {
+			rv = append(rv, g)
+		}
+	}
+	return rv
+}
+
+// modifiesArea returns true iff the given revision modifies any file within
+// the given area.
+func modifiesArea(rev *revision.Revision, area *config.CommitMsgConfig_FileArea) bool {
+	for _, file := range rev.Files {
+		for _, path := range area.Paths {
+			path = strings.TrimSuffix(path, "/")
+			if file == path || strings.HasPrefix(file, path+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// summarizeAuthors returns the number of revisions by each author, with the
+// most prolific authors first.
+func summarizeAuthors(revs []*revision.Revision) []*authorSummary {
+	counts := map[string]int{}
+	for _, rev := range revs {
+		if rev.Author != "" {
+			counts[rev.Author]++
+		}
+	}
+	rv := make([]*authorSummary, 0, len(counts))
+	for author, count := range counts {
+		rv = append(rv, &authorSummary{Author: author, Count: count})
+	}
+	sort.Slice(rv, func(i, j int) bool {
+		if rv[i].Count != rv[j].Count {
+			return rv[i].Count > rv[j].Count
+		}
+		return rv[i].Author < rv[j].Author
+	})
+	return rv
+}
+
+// tagReleaseNotes returns the release notes attached to the given revisions,
+// eg. via tag annotations.
+func tagReleaseNotes(revs []*revision.Revision) []string {
+	var rv []string
+	for _, rev := range revs {
+		if notes := strings.TrimSpace(rev.ReleaseNotes); notes != "" {
+			rv = append(rv, notes)
+		}
+	}
+	return rv
+}
+
+// changelogReleaseNotes reads the changelog at the given path at both of the
+// given revisions and returns the lines which were added in between. The
+// changelog need not exist at the from-revision.
+func changelogReleaseNotes(ctx context.Context, readFile FileReader, path string, from, to *revision.Revision) (string, error) {
+	newContents, err := readFile(ctx, to, path)
+	if err != nil {
+		return "", skerr.Wrapf(err, "failed to read %s at %s", path, to.Id)
+	}
+	oldContents, err := readFile(ctx, from, path)
+	if err != nil {
+		oldContents = nil
+	}
+	return changelogDiff(string(oldContents), string(newContents)), nil
+}
+
+// changelogDiff returns the entries which were added to a changelog, given
+// its old and new contents. Most changelogs are only added to, at either the
+// beginning or the end; otherwise, the lines of newContents which are not
+// present in oldContents are returned, in order. Leading and trailing blank
+// lines are removed.
+func changelogDiff(oldContents, newContents string) string {
+	if strings.HasSuffix(newContents, oldContents) {
+		return strings.Trim(strings.TrimSuffix(newContents, oldContents), "\n")
+	}
+	if strings.HasPrefix(newContents, oldContents) {
+		return strings.Trim(strings.TrimPrefix(newContents, oldContents), "\n")
+	}
+	oldLines := map[string]int{}
+	for _, line := range strings.Split(oldContents, "\n") {
+		oldLines[line]++
+	}
+	var added []string
+	for _, line := range strings.Split(newContents, "\n") {
+		if oldLines[line] > 0 {
+			oldLines[line]--
+			continue
+		}
+		added = append(added, line)
+	}
+	return strings.Trim(strings.Join(added, "\n"), "\n")
+}
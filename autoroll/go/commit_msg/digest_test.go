@@ -0,0 +1,166 @@
+package commit_msg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+)
+
+func fakeDigestRevisions() []*revision.Revision {
+	ts := time.Unix(1587081600, 0).UTC()
+	return []*revision.Revision{
+		{Id: "5", Author: "b@google.com", Description: "fix(parser): handle empty input", Files: []string{"src/parser/parse.go"}, Timestamp: ts},
+		{Id: "4", Author: "a@google.com", Description: "docs: update README", Files: []string{"README.md", "docs/index.md"}, Timestamp: ts},
+		{Id: "3", Author: "a@google.com", Description: "feat!: new API", Files: []string{"src/api/api.go", "docs/api.md"}, Timestamp: ts, ReleaseNotes: "Version 2.0\n\nBreaking API changes."},
+		{Id: "2", Author: "c@google.com", Description: "Update the thing", Timestamp: ts},
+		{Id: "1", Author: "b@google.com", Description: "wip: partial work", Files: []string{"srcfile.go"}, Timestamp: ts},
+	}
+}
+
+func groupIDs(groups []*revisionGroup) map[string][]string {
+	rv := map[string][]string{}
+	for _, g := range groups {
+		for _, rev := range g.Revisions {
+			rv[g.Name] = append(rv[g.Name], rev.Id)
+		}
+	}
+	return rv
+}
+
+func groupNames(groups []*revisionGroup) []string {
+	rv := make([]string, 0, len(groups))
+	for _, g := range groups {
+		rv = append(rv, g.Name)
+	}
+	return rv
+}
+
+func TestGroupRevisions_None(t *testing.T) {
+	require.Nil(t, groupRevisions(&config.CommitMsgConfig{}, fakeDigestRevisions()))
+}
+
+func TestGroupRevisions_ConventionalCommitType(t *testing.T) {
+	groups := groupRevisions(&config.CommitMsgConfig{
+		GroupBy: config.CommitMsgConfig_CONVENTIONAL_COMMIT_TYPE,
+	}, fakeDigestRevisions())
+	require.Equal(t, []string{"Features", "Bug Fixes", "Documentation", "wip", "Other"}, groupNames(groups))
+	require.Equal(t, map[string][]string{
+		"Features":      {"3"},
+		"Bug Fixes":     {"5"},
+		"Documentation": {"4"},
+		"wip":           {"1"},
+		"Other":         {"2"},
+	}, groupIDs(groups))
+}
+
+func TestGroupRevisions_FileArea(t *testing.T) {
+	groups := groupRevisions(&config.CommitMsgConfig{
+		GroupBy: config.CommitMsgConfig_FILE_AREA,
+		FileAreas: []*config.CommitMsgConfig_FileArea{
+			{Name: "Source", Paths: []string{"src/"}},
+			{Name: "Docs", Paths: []string{"docs", "README.md"}},
+			{Name: "Unused", Paths: []string{"unused"}},
+		},
+	}, fakeDigestRevisions())
+	require.Equal(t, []string{"Source", "Docs", "Other"}, groupNames(groups))
+	require.Equal(t, map[string][]string{
+		"Source": {"5", "3"},
+		"Docs":   {"4", "3"},
+		"Other":  {"2", "1"},
+	}, groupIDs(groups))
+}
+
+func TestSummarizeAuthors(t *testing.T) {
+	require.Equal(t, []*authorSummary{
+		{Author: "a@google.com", Count: 2},
+		{Author: "b@google.com", Count: 2},
+		{Author: "c@google.com", Count: 1},
+	}, summarizeAuthors(fakeDigestRevisions()))
+}
+
+func TestChangelogDiff(t *testing.T) {
+	old := "## 1.0\n\n* First release.\n"
+
+	// Prepended entries.
+	require.Equal(t, "## 1.1\n\n* Fixed a bug.", changelogDiff(old, "## 1.1\n\n* Fixed a bug.\n\n"+old))
+	// Appended entries.
+	require.Equal(t, "## 1.1\n* Fixed a bug.", changelogDiff(old, old+"\n## 1.1\n* Fixed a bug.\n"))
+	// New file.
+	require.Equal(t, "## 1.0\n\n* First release.", changelogDiff("", old))
+	// Edited in the middle.
+	require.Equal(t, "* Second item.", changelogDiff("## 1.0\n* First item.\n* Third item.\n", "## 1.0\n* First item.\n* Second item.\n* Third item.\n"))
+	// No change.
+	require.Equal(t, "", changelogDiff(old, old))
+}
+
+func TestBuild_Digest(t *testing.T) {
+	b := fakeBuilder(t)
+	b.cfg.ChildLogUrlTmpl = ""
+	b.cfg.GroupBy = config.CommitMsgConfig_CONVENTIONAL_COMMIT_TYPE
+	b.cfg.IncludeAuthorSummary = true
+	b.cfg.ReleaseNotes = &config.ReleaseNotesConfig{
+		ChangelogPath:  "CHANGELOG.md",
+		TagAnnotations: true,
+	}
+	b.transitiveDeps = nil
+	b.SetFileReader(func(_ context.Context, rev *revision.Revision, path string) ([]byte, error) {
+		require.Equal(t, "CHANGELOG.md", path)
+		if rev.Id == "0" {
+			return []byte("## 1.0\n"), nil
+		}
+		return []byte("## 2.0\n* New API.\n\n## 1.0\n"), nil
+	})
+	from := &revision.Revision{Id: "0", Display: "0"}
+	revs := fakeDigestRevisions()
+	msg, err := b.Build(context.Background(), from, revs[0], revs, []string{"reviewer@google.com"}, nil, false, "")
+	require.NoError(t, err)
+	require.Contains(t, msg, `Roll fake/child/src from 0 to 5 (5 revisions)
+
+Features:
+2020-04-17 a@google.com feat!: new API
+
+Bug Fixes:
+2020-04-17 b@google.com fix(parser): handle empty input
+
+Documentation:
+2020-04-17 a@google.com docs: update README
+
+wip:
+2020-04-17 b@google.com wip: partial work
+
+Other:
+2020-04-17 c@google.com Update the thing
+
+Authors:
+  a@google.com (2)
+  b@google.com (2)
+  c@google.com (1)
+
+Release notes:
+## 2.0
+* New API.
+
+Version 2.0
+
+Breaking API changes.
+
+If this roll has caused a breakage`)
+
+	// Failure to read the changelog does not prevent the roll.
+	b.SetFileReader(func(_ context.Context, _ *revision.Revision, _ string) ([]byte, error) {
+		return nil, errors.New("no such file")
+	})
+	msg, err = b.Build(context.Background(), from, revs[0], revs, []string{"reviewer@google.com"}, nil, false, "")
+	require.NoError(t, err)
+	require.Contains(t, msg, `Release notes:
+Version 2.0
+
+Breaking API changes.
+
+If this roll has caused a breakage`)
+}
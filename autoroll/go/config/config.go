@@ -11,12 +11,17 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/Masterminds/semver"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/package_files"
 	"go.skia.org/infra/autoroll/go/strategy"
 	"go.skia.org/infra/autoroll/go/time_window"
+	"go.skia.org/infra/go/bazel"
 	"go.skia.org/infra/go/deepequal"
 	"go.skia.org/infra/go/deepequal/assertdeep"
+	"go.skia.org/infra/go/human"
 	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/util"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -182,8 +187,11 @@ func (c *Config) Validate() error {
 	if c.GetGoogle3() != nil {
 		cr = append(cr, c.GetGoogle3())
 	}
+	if c.GetGitlab() != nil {
+		cr = append(cr, c.GetGitlab())
+	}
 	if len(cr) != 1 {
-		return skerr.Fmt("Exactly one of Gerrit, Github, or Google3 is required.")
+		return skerr.Fmt("Exactly one of Gerrit, Github, GitLab, or Google3 is required.")
 	}
 	if err := cr[0].Validate(); err != nil {
 		return skerr.Wrap(err)
@@ -202,23 +210,13 @@ func (c *Config) Validate() error {
 	if c.GetParentChildRepoManager() != nil {
 		pc := c.GetParentChildRepoManager()
 		rm = append(rm, pc)
-		if p := pc.GetDepsLocalGerritParent(); p != nil {
-			parentTransitiveDeps = p.DepsLocal.GitCheckout.Dep.Transitive
-		} else if p := pc.GetDepsLocalGithubParent(); p != nil {
-			parentTransitiveDeps = p.DepsLocal.GitCheckout.Dep.Transitive
-		} else if p := pc.GetGitCheckoutGithubFileParent(); p != nil {
-			parentTransitiveDeps = p.GitCheckout.GitCheckout.Dep.Transitive
-		} else if p := pc.GetGitilesParent(); p != nil {
-			parentTransitiveDeps = p.Dep.Transitive
-		} else if p := pc.GetGitCheckoutGerritParent(); p != nil {
-			parentTransitiveDeps = p.GitCheckout.Dep.Transitive
-		}
-		if c := pc.GetGitCheckoutChild(); c != nil {
-			childTransitiveDeps = c.GitCheckout.Dependencies
-		} else if c := pc.GetGitCheckoutGithubChild(); c != nil {
-			childTransitiveDeps = c.GitCheckout.GitCheckout.Dependencies
-		} else if c := pc.GetGitilesChild(); c != nil {
-			childTransitiveDeps = c.Gitiles.Dependencies
+		parentTransitiveDeps, childTransitiveDeps = pc.transitiveDeps()
+	}
+	if c.GetFanOutRepoManager() != nil {
+		fo := c.GetFanOutRepoManager()
+		rm = append(rm, fo)
+		if len(fo.ParentChild) > 0 {
+			parentTransitiveDeps, childTransitiveDeps = fo.ParentChild[0].transitiveDeps()
 		}
 	}
 	if c.GetAndroidRepoManager() != nil {
@@ -240,6 +238,18 @@ func (c *Config) Validate() error {
 		return skerr.Wrap(err)
 	}
 
+	// Only some Children provide the details of each revision needed to
+	// group revisions by file area or to include tag annotations.
+	if c.CommitMsg.GroupBy == CommitMsgConfig_FILE_AREA || c.CommitMsg.GetReleaseNotes().GetTagAnnotations() {
+		pc := c.GetParentChildRepoManager()
+		if fo := c.GetFanOutRepoManager(); fo != nil && len(fo.ParentChild) > 0 {
+			pc = fo.ParentChild[0]
+		}
+		if !pc.logsRevisionDetails() {
+			return skerr.Fmt("GroupBy FILE_AREA and ReleaseNotes.TagAnnotations are only supported for Children which use a local git checkout.")
+		}
+	}
+
 	isNoCheckout := rm[0].NoCheckout()
 	if isNoCheckout && c.Kubernetes.Disk != "" {
 		return skerr.Fmt("kubernetes.disk is not valid for no-checkout repo managers.")
@@ -260,6 +270,36 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.GetFanOutRepoManager() != nil && c.GetGerrit() == nil {
+		return skerr.Fmt("FanOutRepoManager is only supported for rollers which use Gerrit.")
+	}
+
+	// The GitLab client is created from the top level GitLab config, so the
+	// GitLab config of the parent must not differ from it.
+	if p := c.GetParentChildRepoManager().GetGitCheckoutGitlabParent(); p != nil {
+		if c.GetGitlab() == nil {
+			return skerr.Fmt("GitCheckoutGitLabParent is only supported for rollers which use GitLab.")
+		}
+		if !deepequal.DeepEqual(c.GetGitlab(), p.Gitlab) {
+			return skerr.Fmt("top level GitLab config differs from GitLab config set on parent: %s", assertdeep.Diff(c.GetGitlab(), p.Gitlab))
+		}
+	}
+
+	if c.AutoRevert != nil {
+		if c.GetGerrit() == nil {
+			return skerr.Fmt("AutoRevert is only supported for rollers which use Gerrit.")
+		}
+		if err := c.AutoRevert.Validate(); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+
+	if c.StrategyOptions != nil {
+		if err := c.StrategyOptions.Validate(); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+
 	if len(c.TransitiveDeps) != len(parentTransitiveDeps) {
 		return skerr.Fmt("top level transitive dependency count %d does not match transitive dependency count %d set on parent", len(c.TransitiveDeps), len(parentTransitiveDeps))
 	}
@@ -301,6 +341,9 @@ func (c *Config) GetRepoManagerConfig() RepoManagerConfig {
 	if c.GetGoogle3RepoManager() != nil {
 		return c.GetGoogle3RepoManager()
 	}
+	if c.GetFanOutRepoManager() != nil {
+		return c.GetFanOutRepoManager()
+	}
 	return nil
 }
 
@@ -319,6 +362,28 @@ func (c *CommitMsgConfig) Validate() error {
 	// TODO(borenet): The commit_msg package has a test which uses fake data to
 	// execute the template and ensure that it is valid. We should make use of
 	// that somehow.
+	if _, ok := CommitMsgConfig_GroupBy_name[int32(c.GroupBy)]; !ok {
+		return skerr.Fmt("Unknown GroupBy: %v", c.GroupBy)
+	}
+	if c.GroupBy == CommitMsgConfig_FILE_AREA && len(c.FileAreas) == 0 {
+		return skerr.Fmt("FileAreas are required when GroupBy is FILE_AREA.")
+	}
+	for _, area := range c.FileAreas {
+		if err := area.Validate(); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Validate implements util.Validator.
+func (c *CommitMsgConfig_FileArea) Validate() error {
+	if c.Name == "" {
+		return skerr.Fmt("Name is required.")
+	}
+	if len(c.Paths) == 0 {
+		return skerr.Fmt("At least one path is required for FileArea %q.", c.Name)
+	}
 	return nil
 }
 
@@ -358,6 +423,23 @@ func (c *GitHubConfig) Validate() error {
 	return nil
 }
 
+// Validate implements util.Validator.
+func (c *GitLabConfig) Validate() error {
+	if c.Url == "" {
+		return skerr.Fmt("Url is required.")
+	}
+	if c.Project == "" {
+		return skerr.Fmt("Project is required.")
+	}
+	if c.TokenSecret == "" {
+		return skerr.Fmt("TokenSecret is required.")
+	}
+	if c.SshKeySecret == "" {
+		return skerr.Fmt("SshKeySecret is required.")
+	}
+	return nil
+}
+
 // Validate implements util.Validator.
 func (c *Google3Config) Validate() error {
 	return nil
@@ -455,6 +537,55 @@ func (c *ThrottleConfig) Validate() error {
 	return nil
 }
 
+// Validate implements util.Validator.
+func (c *StrategyOptions) Validate() error {
+	if c.TimeBatchInterval != "" {
+		if _, err := human.ParseDuration(c.TimeBatchInterval); err != nil {
+			return skerr.Wrapf(err, "invalid TimeBatchInterval")
+		}
+	}
+	if c.MaxFilesChanged < 0 {
+		return skerr.Fmt("MaxFilesChanged must not be negative.")
+	}
+	if c.MaxLinesChanged < 0 {
+		return skerr.Fmt("MaxLinesChanged must not be negative.")
+	}
+	return nil
+}
+
+// Validate implements util.Validator.
+func (c *AutoRevertConfig) Validate() error {
+	if c.Window == "" {
+		return skerr.Fmt("Window is required.")
+	}
+	if _, err := human.ParseDuration(c.Window); err != nil {
+		return skerr.Wrapf(err, "invalid Window")
+	}
+	if c.TreeStatusUrl == "" && c.TaskScheduler == nil && len(c.HealthCheckUrl) == 0 {
+		return skerr.Fmt("At least one of TreeStatusUrl, TaskScheduler, or HealthCheckUrl is required.")
+	}
+	if c.TaskScheduler != nil {
+		if err := c.TaskScheduler.Validate(); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Validate implements util.Validator.
+func (c *AutoRevertTaskSchedulerConfig) Validate() error {
+	if c.Host == "" {
+		return skerr.Fmt("Host is required.")
+	}
+	if c.Repo == "" {
+		return skerr.Fmt("Repo is required.")
+	}
+	if len(c.Jobs) == 0 {
+		return skerr.Fmt("At least one job is required.")
+	}
+	return nil
+}
+
 // Validate implements util.Validator.
 func (c *TransitiveDepConfig) Validate() error {
 	if c.Child == nil {
@@ -698,6 +829,9 @@ func (c *ParentChildRepoManagerConfig) Validate() error {
 	if c.GetDockerChild() != nil {
 		children = append(children, c.GetDockerChild())
 	}
+	if c.GetPackageRegistryChild() != nil {
+		children = append(children, c.GetPackageRegistryChild())
+	}
 	if len(children) != 1 {
 		return skerr.Fmt("Exactly one Child is required, config has %d.", len(children))
 	}
@@ -706,6 +840,9 @@ func (c *ParentChildRepoManagerConfig) Validate() error {
 	}
 
 	parents := []util.Validator{}
+	if c.GetBazelParent() != nil {
+		parents = append(parents, c.GetBazelParent())
+	}
 	if c.GetCopyParent() != nil {
 		parents = append(parents, c.GetCopyParent())
 	}
@@ -721,12 +858,18 @@ func (c *ParentChildRepoManagerConfig) Validate() error {
 	if c.GetGitCheckoutGerritParent() != nil {
 		parents = append(parents, c.GetGitCheckoutGerritParent())
 	}
+	if c.GetGitCheckoutGitlabParent() != nil {
+		parents = append(parents, c.GetGitCheckoutGitlabParent())
+	}
 	if c.GetGitilesParent() != nil {
 		parents = append(parents, c.GetGitilesParent())
 	}
 	if c.GetGoModGerritParent() != nil {
 		parents = append(parents, c.GetGoModGerritParent())
 	}
+	if c.GetPackageRegistryParent() != nil {
+		parents = append(parents, c.GetPackageRegistryParent())
+	}
 	if len(parents) != 1 {
 		return skerr.Fmt("Exactly one Parent is required.")
 	}
@@ -744,6 +887,11 @@ func (c *ParentChildRepoManagerConfig) Validate() error {
 			return skerr.Wrap(err)
 		}
 	}
+	for _, rf := range c.GetSignatureRevisionFilter() {
+		if err := rf.Validate(); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
 	return nil
 }
 
@@ -772,6 +920,9 @@ func (c *ParentChildRepoManagerConfig) NoCheckout() bool {
 	if c.GetGitCheckoutGerritParent() != nil {
 		return false
 	}
+	if c.GetGitCheckoutGitlabParent() != nil {
+		return false
+	}
 	return true
 }
 
@@ -783,11 +934,98 @@ func (c *ParentChildRepoManagerConfig) ValidStrategies() []string {
 	if c.GetFuchsiaSdkChild() != nil {
 		return []string{strategy.ROLL_STRATEGY_BATCH}
 	}
-	return []string{
+	rv := []string{
 		strategy.ROLL_STRATEGY_BATCH,
 		strategy.ROLL_STRATEGY_N_BATCH,
 		strategy.ROLL_STRATEGY_SINGLE,
+		strategy.ROLL_STRATEGY_TIME_BATCH,
+		strategy.ROLL_STRATEGY_BISECT,
+	}
+	// Only git checkouts provide the size of each revision.
+	if c.GetGitCheckoutChild() != nil || c.GetGitCheckoutGithubChild() != nil {
+		rv = append(rv, strategy.ROLL_STRATEGY_SIZE_BATCH)
 	}
+	return rv
+}
+
+// logsRevisionDetails returns true if the Child records the files changed by
+// each revision and the annotations of the tags which point to it.
+func (c *ParentChildRepoManagerConfig) logsRevisionDetails() bool {
+	return c.GetGitCheckoutChild() != nil || c.GetGitCheckoutGithubChild() != nil
+}
+
+// transitiveDeps returns the transitive dependencies configured on the Parent
+// and Child, respectively.
+func (c *ParentChildRepoManagerConfig) transitiveDeps() ([]*TransitiveDepConfig, []*VersionFileConfig) {
+	var parentTransitiveDeps []*TransitiveDepConfig
+	var childTransitiveDeps []*VersionFileConfig
+	if p := c.GetDepsLocalGerritParent(); p != nil {
+		parentTransitiveDeps = p.DepsLocal.GitCheckout.Dep.Transitive
+	} else if p := c.GetDepsLocalGithubParent(); p != nil {
+		parentTransitiveDeps = p.DepsLocal.GitCheckout.Dep.Transitive
+	} else if p := c.GetGitCheckoutGithubFileParent(); p != nil {
+		parentTransitiveDeps = p.GitCheckout.GitCheckout.Dep.Transitive
+	} else if p := c.GetGitilesParent(); p != nil {
+		parentTransitiveDeps = p.Dep.Transitive
+	} else if p := c.GetGitCheckoutGerritParent(); p != nil {
+		parentTransitiveDeps = p.GitCheckout.Dep.Transitive
+	} else if p := c.GetGitCheckoutGitlabParent(); p != nil {
+		parentTransitiveDeps = p.GitCheckout.Dep.Transitive
+	} else if p := c.GetBazelParent(); p != nil {
+		parentTransitiveDeps = p.Gitiles.Dep.Transitive
+	} else if p := c.GetPackageRegistryParent(); p != nil {
+		parentTransitiveDeps = p.Gitiles.Dep.Transitive
+	}
+	if ch := c.GetGitCheckoutChild(); ch != nil {
+		childTransitiveDeps = ch.GitCheckout.Dependencies
+	} else if ch := c.GetGitCheckoutGithubChild(); ch != nil {
+		childTransitiveDeps = ch.GitCheckout.GitCheckout.Dependencies
+	} else if ch := c.GetGitilesChild(); ch != nil {
+		childTransitiveDeps = ch.Gitiles.Dependencies
+	}
+	return parentTransitiveDeps, childTransitiveDeps
+}
+
+// Validate implements util.Validator.
+func (c *FanOutRepoManagerConfig) Validate() error {
+	if len(c.ParentChild) < 2 {
+		return skerr.Fmt("At least two ParentChild are required.")
+	}
+	// All entries must be identical apart from the Parent.
+	var first *ParentChildRepoManagerConfig
+	for idx, pc := range c.ParentChild {
+		if err := pc.Validate(); err != nil {
+			return skerr.Wrapf(err, "ParentChild %d failed validation", idx)
+		}
+		withoutParent := proto.Clone(pc).(*ParentChildRepoManagerConfig)
+		withoutParent.Parent = nil
+		if first == nil {
+			first = withoutParent
+		} else if !proto.Equal(first, withoutParent) {
+			return skerr.Fmt("ParentChild %d differs from ParentChild 0 in more than the Parent", idx)
+		}
+	}
+	return nil
+}
+
+// DefaultStrategy implements RepoManagerConfig.
+func (c *FanOutRepoManagerConfig) DefaultStrategy() string {
+	return c.ParentChild[0].DefaultStrategy()
+}
+
+// NoCheckout implements RepoManagerConfig.
+func (c *FanOutRepoManagerConfig) NoCheckout() bool {
+	for _, pc := range c.ParentChild {
+		if !pc.NoCheckout() {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidStrategies implements RepoManagerConfig.
+func (c *FanOutRepoManagerConfig) ValidStrategies() []string {
+	return c.ParentChild[0].ValidStrategies()
 }
 
 // Validate implements util.Validator.
@@ -861,6 +1099,50 @@ func (c *FreeTypeParentConfig) Validate() error {
 	return nil
 }
 
+// Validate implements util.Validator.
+func (c *BazelParentConfig) Validate() error {
+	if c.Gitiles == nil {
+		return skerr.Fmt("Gitiles is required.")
+	}
+	if err := c.Gitiles.Validate(); err != nil {
+		return skerr.Wrap(err)
+	}
+	if c.Gitiles.Dep.Primary.Regex != "" {
+		return skerr.Fmt("Regex is not supported for BazelParent.")
+	}
+	if !bazel.IsBazelFile(c.Gitiles.Dep.Primary.Path) {
+		return skerr.Fmt("Path %q is not a Bazel file.", c.Gitiles.Dep.Primary.Path)
+	}
+	return nil
+}
+
+// Validate implements util.Validator.
+func (c *PackageRegistryParentConfig) Validate() error {
+	if c.Gitiles == nil {
+		return skerr.Fmt("Gitiles is required.")
+	}
+	if err := c.Gitiles.Validate(); err != nil {
+		return skerr.Wrap(err)
+	}
+	if c.Gitiles.Dep.Primary.Regex != "" {
+		return skerr.Fmt("Regex is not supported for PackageRegistryParent.")
+	}
+	manifest := c.Gitiles.Dep.Primary.Path
+	if !package_files.IsPackageFile(manifest) {
+		return skerr.Fmt("Path %q is not a supported package manifest.", manifest)
+	}
+	if c.Lockfile != "" {
+		if package_files.IsPackageJSON(manifest) && !strings.HasSuffix(c.Lockfile, package_files.PackageLockJSON) {
+			return skerr.Fmt("Lockfile for %q must be %s.", manifest, package_files.PackageLockJSON)
+		} else if package_files.IsCargoToml(manifest) && !strings.HasSuffix(c.Lockfile, package_files.CargoLock) {
+			return skerr.Fmt("Lockfile for %q must be %s.", manifest, package_files.CargoLock)
+		} else if package_files.IsRequirementsFile(manifest) {
+			return skerr.Fmt("Lockfile is not supported for %q.", manifest)
+		}
+	}
+	return nil
+}
+
 // Validate implements util.Validator.
 func (c *GitilesParentConfig) Validate() error {
 	if c.Gitiles == nil {
@@ -1146,6 +1428,23 @@ func (c *GitCheckoutGerritParentConfig) Validate() error {
 	return nil
 }
 
+// Validate implements util.Validator.
+func (c *GitCheckoutGitLabParentConfig) Validate() error {
+	if c.GitCheckout == nil {
+		return skerr.Fmt("GitCheckout is required.")
+	}
+	if err := c.GitCheckout.Validate(); err != nil {
+		return skerr.Wrap(err)
+	}
+	if c.Gitlab == nil {
+		return skerr.Fmt("Gitlab is required.")
+	}
+	if err := c.Gitlab.Validate(); err != nil {
+		return skerr.Wrap(err)
+	}
+	return nil
+}
+
 // Validate implements util.Validator.
 func (c *BuildbucketRevisionFilterConfig) Validate() error {
 	if c.Project == "" {
@@ -1174,6 +1473,46 @@ func (c *CIPDRevisionFilterConfig) Validate() error {
 	return nil
 }
 
+// Validate implements util.Validator.
+func (c *SignatureRevisionFilterConfig) Validate() error {
+	if len(c.PublicKey) == 0 {
+		return skerr.Fmt("At least one PublicKey is required.")
+	}
+	if c.GetGit() != nil {
+		return skerr.Wrap(c.GetGit().Validate())
+	} else if c.GetArtifact() != nil {
+		return skerr.Wrap(c.GetArtifact().Validate())
+	}
+	return skerr.Fmt("One of Git or Artifact is required.")
+}
+
+// Validate implements util.Validator.
+func (c *GitSignatureConfig) Validate() error {
+	if c.RepoUrl == "" {
+		return skerr.Fmt("RepoUrl is required.")
+	}
+	if c.TagRegex != "" {
+		if _, err := regexp.Compile(c.TagRegex); err != nil {
+			return skerr.Wrapf(err, "invalid TagRegex")
+		}
+	}
+	return nil
+}
+
+// Validate implements util.Validator.
+func (c *ArtifactSignatureConfig) Validate() error {
+	if c.SignatureUrlTmpl == "" {
+		return skerr.Fmt("SignatureUrlTmpl is required.")
+	}
+	if strings.Count(c.SignatureUrlTmpl, "%s") != 1 {
+		return skerr.Fmt("SignatureUrlTmpl must contain exactly one %%s.")
+	}
+	if c.ArtifactUrlTmpl != "" && strings.Count(c.ArtifactUrlTmpl, "%s") != 1 {
+		return skerr.Fmt("ArtifactUrlTmpl must contain exactly one %%s.")
+	}
+	return nil
+}
+
 // Validate implements util.Validator.
 func (c *DockerChildConfig) Validate() error {
 	if c.Registry == "" {
@@ -1188,6 +1527,22 @@ func (c *DockerChildConfig) Validate() error {
 	return nil
 }
 
+// Validate implements util.Validator.
+func (c *PackageRegistryChildConfig) Validate() error {
+	if _, ok := PackageRegistry_name[int32(c.Registry)]; !ok {
+		return skerr.Fmt("Unknown Registry %d.", c.Registry)
+	}
+	if c.PackageName == "" {
+		return skerr.Fmt("PackageName is required.")
+	}
+	if c.VersionConstraint != "" {
+		if _, err := semver.NewConstraint(c.VersionConstraint); err != nil {
+			return skerr.Wrapf(err, "VersionConstraint is invalid")
+		}
+	}
+	return nil
+}
+
 // Copy returns a deep copy.
 func (c *TransitiveDepConfig) Copy() *TransitiveDepConfig {
 	return &TransitiveDepConfig{
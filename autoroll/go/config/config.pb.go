@@ -21,6 +21,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PackageRegistry lists the supported package registries.
+type PackageRegistry int32
+
+const (
+	PackageRegistry_NPM    PackageRegistry = 0
+	PackageRegistry_PYPI   PackageRegistry = 1
+	PackageRegistry_CRATES PackageRegistry = 2
+)
+
+// Enum value maps for PackageRegistry.
+var (
+	PackageRegistry_name = map[int32]string{
+		0: "NPM",
+		1: "PYPI",
+		2: "CRATES",
+	}
+	PackageRegistry_value = map[string]int32{
+		"NPM":    0,
+		"PYPI":   1,
+		"CRATES": 2,
+	}
+)
+
+func (x PackageRegistry) Enum() *PackageRegistry {
+	p := new(PackageRegistry)
+	*p = x
+	return p
+}
+
+func (x PackageRegistry) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageRegistry) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_proto_enumTypes[0].Descriptor()
+}
+
+func (PackageRegistry) Type() protoreflect.EnumType {
+	return &file_config_proto_enumTypes[0]
+}
+
+func (x PackageRegistry) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageRegistry.Descriptor instead.
+func (PackageRegistry) EnumDescriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{0}
+}
+
 // PreUploadStep lists the known pre-upload steps which may be run before roll
 // CLs are uploaded. These must be kept in sync with
 // go/repo_manager/parent/pre_upload_steps.go.
@@ -87,11 +137,11 @@ func (x PreUploadStep) String() string {
 }
 
 func (PreUploadStep) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[0].Descriptor()
+	return file_config_proto_enumTypes[1].Descriptor()
 }
 
 func (PreUploadStep) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[0]
+	return &file_config_proto_enumTypes[1]
 }
 
 func (x PreUploadStep) Number() protoreflect.EnumNumber {
@@ -100,7 +150,7 @@ func (x PreUploadStep) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PreUploadStep.Descriptor instead.
 func (PreUploadStep) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{0}
+	return file_config_proto_rawDescGZIP(), []int{1}
 }
 
 // Mode describes the valid operating modes of an autoroller.
@@ -152,11 +202,11 @@ func (x Mode) String() string {
 }
 
 func (Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[1].Descriptor()
+	return file_config_proto_enumTypes[2].Descriptor()
 }
 
 func (Mode) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[1]
+	return &file_config_proto_enumTypes[2]
 }
 
 func (x Mode) Number() protoreflect.EnumNumber {
@@ -165,7 +215,7 @@ func (x Mode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Mode.Descriptor instead.
 func (Mode) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{1}
+	return file_config_proto_rawDescGZIP(), []int{2}
 }
 
 // BuiltIn lists the built-in commit message templates.
@@ -210,11 +260,11 @@ func (x CommitMsgConfig_BuiltIn) String() string {
 }
 
 func (CommitMsgConfig_BuiltIn) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[2].Descriptor()
+	return file_config_proto_enumTypes[3].Descriptor()
 }
 
 func (CommitMsgConfig_BuiltIn) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[2]
+	return &file_config_proto_enumTypes[3]
 }
 
 func (x CommitMsgConfig_BuiltIn) Number() protoreflect.EnumNumber {
@@ -226,6 +276,62 @@ func (CommitMsgConfig_BuiltIn) EnumDescriptor() ([]byte, []int) {
 	return file_config_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// GroupBy lists the ways in which the revisions in the log may be grouped.
+type CommitMsgConfig_GroupBy int32
+
+const (
+	// NONE lists the revisions in reverse chronological order.
+	CommitMsgConfig_NONE CommitMsgConfig_GroupBy = 0
+	// CONVENTIONAL_COMMIT_TYPE groups revisions by the type prefix of
+	// their descriptions, eg. "feat", "fix", as used in Conventional
+	// Commits.
+	CommitMsgConfig_CONVENTIONAL_COMMIT_TYPE CommitMsgConfig_GroupBy = 1
+	// FILE_AREA groups revisions by the file_areas which they modify. This
+	// requires a Child which reports the files changed by each revision.
+	CommitMsgConfig_FILE_AREA CommitMsgConfig_GroupBy = 2
+)
+
+// Enum value maps for CommitMsgConfig_GroupBy.
+var (
+	CommitMsgConfig_GroupBy_name = map[int32]string{
+		0: "NONE",
+		1: "CONVENTIONAL_COMMIT_TYPE",
+		2: "FILE_AREA",
+	}
+	CommitMsgConfig_GroupBy_value = map[string]int32{
+		"NONE":                     0,
+		"CONVENTIONAL_COMMIT_TYPE": 1,
+		"FILE_AREA":                2,
+	}
+)
+
+func (x CommitMsgConfig_GroupBy) Enum() *CommitMsgConfig_GroupBy {
+	p := new(CommitMsgConfig_GroupBy)
+	*p = x
+	return p
+}
+
+func (x CommitMsgConfig_GroupBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommitMsgConfig_GroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_proto_enumTypes[4].Descriptor()
+}
+
+func (CommitMsgConfig_GroupBy) Type() protoreflect.EnumType {
+	return &file_config_proto_enumTypes[4]
+}
+
+func (x CommitMsgConfig_GroupBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommitMsgConfig_GroupBy.Descriptor instead.
+func (CommitMsgConfig_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{1, 1}
+}
+
 // Config lists the built-in Gerrit configs, named for the projects which
 // use them.
 type GerritConfig_Config int32
@@ -282,11 +388,11 @@ func (x GerritConfig_Config) String() string {
 }
 
 func (GerritConfig_Config) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[3].Descriptor()
+	return file_config_proto_enumTypes[5].Descriptor()
 }
 
 func (GerritConfig_Config) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[3]
+	return &file_config_proto_enumTypes[5]
 }
 
 func (x GerritConfig_Config) Number() protoreflect.EnumNumber {
@@ -295,7 +401,7 @@ func (x GerritConfig_Config) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GerritConfig_Config.Descriptor instead.
 func (GerritConfig_Config) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{2, 0}
+	return file_config_proto_rawDescGZIP(), []int{3, 0}
 }
 
 // LogLevel categorizes messages similarly to log severity.
@@ -338,11 +444,11 @@ func (x NotifierConfig_LogLevel) String() string {
 }
 
 func (NotifierConfig_LogLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[4].Descriptor()
+	return file_config_proto_enumTypes[6].Descriptor()
 }
 
 func (NotifierConfig_LogLevel) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[4]
+	return &file_config_proto_enumTypes[6]
 }
 
 func (x NotifierConfig_LogLevel) Number() protoreflect.EnumNumber {
@@ -351,7 +457,7 @@ func (x NotifierConfig_LogLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotifierConfig_LogLevel.Descriptor instead.
 func (NotifierConfig_LogLevel) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{32, 0}
+	return file_config_proto_rawDescGZIP(), []int{39, 0}
 }
 
 // MsgType categorizes notifications based on their type.
@@ -368,21 +474,23 @@ const (
 	NotifierConfig_STRATEGY_CHANGE             NotifierConfig_MsgType = 7
 	NotifierConfig_SUCCESS_THROTTLE            NotifierConfig_MsgType = 8
 	NotifierConfig_MANUAL_ROLL_CREATION_FAILED NotifierConfig_MsgType = 9
+	NotifierConfig_ROLL_REVERTED               NotifierConfig_MsgType = 10
 )
 
 // Enum value maps for NotifierConfig_MsgType.
 var (
 	NotifierConfig_MsgType_name = map[int32]string{
-		0: "ISSUE_UPDATE",
-		1: "LAST_N_FAILED",
-		2: "MODE_CHANGE",
-		3: "NEW_FAILURE",
-		4: "NEW_SUCCESS",
-		5: "ROLL_CREATION_FAILED",
-		6: "SAFETY_THROTTLE",
-		7: "STRATEGY_CHANGE",
-		8: "SUCCESS_THROTTLE",
-		9: "MANUAL_ROLL_CREATION_FAILED",
+		0:  "ISSUE_UPDATE",
+		1:  "LAST_N_FAILED",
+		2:  "MODE_CHANGE",
+		3:  "NEW_FAILURE",
+		4:  "NEW_SUCCESS",
+		5:  "ROLL_CREATION_FAILED",
+		6:  "SAFETY_THROTTLE",
+		7:  "STRATEGY_CHANGE",
+		8:  "SUCCESS_THROTTLE",
+		9:  "MANUAL_ROLL_CREATION_FAILED",
+		10: "ROLL_REVERTED",
 	}
 	NotifierConfig_MsgType_value = map[string]int32{
 		"ISSUE_UPDATE":                0,
@@ -395,6 +503,7 @@ var (
 		"STRATEGY_CHANGE":             7,
 		"SUCCESS_THROTTLE":            8,
 		"MANUAL_ROLL_CREATION_FAILED": 9,
+		"ROLL_REVERTED":               10,
 	}
 )
 
@@ -409,11 +518,11 @@ func (x NotifierConfig_MsgType) String() string {
 }
 
 func (NotifierConfig_MsgType) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[5].Descriptor()
+	return file_config_proto_enumTypes[7].Descriptor()
 }
 
 func (NotifierConfig_MsgType) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[5]
+	return &file_config_proto_enumTypes[7]
 }
 
 func (x NotifierConfig_MsgType) Number() protoreflect.EnumNumber {
@@ -422,7 +531,61 @@ func (x NotifierConfig_MsgType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotifierConfig_MsgType.Descriptor instead.
 func (NotifierConfig_MsgType) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{32, 1}
+	return file_config_proto_rawDescGZIP(), []int{39, 1}
+}
+
+// Format describes the format of the signature.
+type ArtifactSignatureConfig_Format int32
+
+const (
+	// DETACHED is a detached signature over the artifact, either raw or
+	// base64-encoded, an ASCII-armored OpenPGP signature, or an
+	// ASCII-armored SSH signature with namespace "file".
+	ArtifactSignatureConfig_DETACHED ArtifactSignatureConfig_Format = 0
+	// SIGSTORE_BUNDLE is a sigstore bundle in JSON format which contains a
+	// signature over the SHA-256 digest of the artifact. Only bundles
+	// signed with a key (as opposed to keyless signing) are supported,
+	// and transparency log entries are not verified.
+	ArtifactSignatureConfig_SIGSTORE_BUNDLE ArtifactSignatureConfig_Format = 1
+)
+
+// Enum value maps for ArtifactSignatureConfig_Format.
+var (
+	ArtifactSignatureConfig_Format_name = map[int32]string{
+		0: "DETACHED",
+		1: "SIGSTORE_BUNDLE",
+	}
+	ArtifactSignatureConfig_Format_value = map[string]int32{
+		"DETACHED":        0,
+		"SIGSTORE_BUNDLE": 1,
+	}
+)
+
+func (x ArtifactSignatureConfig_Format) Enum() *ArtifactSignatureConfig_Format {
+	p := new(ArtifactSignatureConfig_Format)
+	*p = x
+	return p
+}
+
+func (x ArtifactSignatureConfig_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArtifactSignatureConfig_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_proto_enumTypes[8].Descriptor()
+}
+
+func (ArtifactSignatureConfig_Format) Type() protoreflect.EnumType {
+	return &file_config_proto_enumTypes[8]
+}
+
+func (x ArtifactSignatureConfig_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArtifactSignatureConfig_Format.Descriptor instead.
+func (ArtifactSignatureConfig_Format) EnumDescriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{57, 0}
 }
 
 // Config provides configuration for one AutoRoller.
@@ -487,6 +650,7 @@ type Config struct {
 	//	*Config_Gerrit
 	//	*Config_Github
 	//	*Config_Google3
+	//	*Config_Gitlab
 	CodeReview isConfig_CodeReview `protobuf_oneof:"code_review"`
 	// kubernetes provides configuration for Kubernetes.
 	Kubernetes *KubernetesConfig `protobuf:"bytes,19,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
@@ -499,6 +663,7 @@ type Config struct {
 	//	*Config_CommandRepoManager
 	//	*Config_FreetypeRepoManager
 	//	*Config_Google3RepoManager
+	//	*Config_FanOutRepoManager
 	RepoManager isConfig_RepoManager `protobuf_oneof:"repo_manager"`
 	// notifiers configures any extra notifications used by this roller. Optional.
 	Notifiers []*NotifierConfig `protobuf:"bytes,26,rep,name=notifiers,proto3" json:"notifiers,omitempty"`
@@ -523,6 +688,12 @@ type Config struct {
 	// max_roll_cls_to_same_revision indicates the maximum number of roll CLs to
 	// the same revision before giving up. If not set, the default is 3.
 	MaxRollClsToSameRevision int32 `protobuf:"varint,34,opt,name=max_roll_cls_to_same_revision,json=maxRollClsToSameRevision,proto3" json:"max_roll_cls_to_same_revision,omitempty"`
+	// auto_revert configures automatic reverts of rolls which break the parent
+	// after landing. Optional.
+	AutoRevert *AutoRevertConfig `protobuf:"bytes,37,opt,name=auto_revert,json=autoRevert,proto3" json:"auto_revert,omitempty"`
+	// strategy_options provides parameters for the roll strategies which need
+	// them. Optional; defaults are used if not set.
+	StrategyOptions *StrategyOptions `protobuf:"bytes,38,opt,name=strategy_options,json=strategyOptions,proto3" json:"strategy_options,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -711,6 +882,13 @@ func (x *Config) GetGoogle3() *Google3Config {
 	return nil
 }
 
+func (x *Config) GetGitlab() *GitLabConfig {
+	if x, ok := x.GetCodeReview().(*Config_Gitlab); ok {
+		return x.Gitlab
+	}
+	return nil
+}
+
 func (x *Config) GetKubernetes() *KubernetesConfig {
 	if x != nil {
 		return x.Kubernetes
@@ -760,6 +938,13 @@ func (x *Config) GetGoogle3RepoManager() *Google3RepoManagerConfig {
 	return nil
 }
 
+func (x *Config) GetFanOutRepoManager() *FanOutRepoManagerConfig {
+	if x, ok := x.GetRepoManager().(*Config_FanOutRepoManager); ok {
+		return x.FanOutRepoManager
+	}
+	return nil
+}
+
 func (x *Config) GetNotifiers() []*NotifierConfig {
 	if x != nil {
 		return x.Notifiers
@@ -809,6 +994,20 @@ func (x *Config) GetMaxRollClsToSameRevision() int32 {
 	return 0
 }
 
+func (x *Config) GetAutoRevert() *AutoRevertConfig {
+	if x != nil {
+		return x.AutoRevert
+	}
+	return nil
+}
+
+func (x *Config) GetStrategyOptions() *StrategyOptions {
+	if x != nil {
+		return x.StrategyOptions
+	}
+	return nil
+}
+
 type isConfig_CodeReview interface {
 	isConfig_CodeReview()
 }
@@ -828,12 +1027,19 @@ type Config_Google3 struct {
 	Google3 *Google3Config `protobuf:"bytes,18,opt,name=google3,proto3,oneof"`
 }
 
+type Config_Gitlab struct {
+	// gitlab provides configuration for code review using GitLab.
+	Gitlab *GitLabConfig `protobuf:"bytes,36,opt,name=gitlab,proto3,oneof"`
+}
+
 func (*Config_Gerrit) isConfig_CodeReview() {}
 
 func (*Config_Github) isConfig_CodeReview() {}
 
 func (*Config_Google3) isConfig_CodeReview() {}
 
+func (*Config_Gitlab) isConfig_CodeReview() {}
+
 type isConfig_RepoManager interface {
 	isConfig_RepoManager()
 }
@@ -858,6 +1064,10 @@ type Config_Google3RepoManager struct {
 	Google3RepoManager *Google3RepoManagerConfig `protobuf:"bytes,25,opt,name=google3_repo_manager,json=google3RepoManager,proto3,oneof"`
 }
 
+type Config_FanOutRepoManager struct {
+	FanOutRepoManager *FanOutRepoManagerConfig `protobuf:"bytes,39,opt,name=fan_out_repo_manager,json=fanOutRepoManager,proto3,oneof"`
+}
+
 func (*Config_ParentChildRepoManager) isConfig_RepoManager() {}
 
 func (*Config_AndroidRepoManager) isConfig_RepoManager() {}
@@ -868,6 +1078,8 @@ func (*Config_FreetypeRepoManager) isConfig_RepoManager() {}
 
 func (*Config_Google3RepoManager) isConfig_RepoManager() {}
 
+func (*Config_FanOutRepoManager) isConfig_RepoManager() {}
+
 // CommitMsgConfig provides configuration for commit messages.
 type CommitMsgConfig struct {
 	state         protoimpl.MessageState
@@ -913,6 +1125,19 @@ type CommitMsgConfig struct {
 	// specify a built-in template and then override parts of it in the custom
 	// template.
 	Custom string `protobuf:"bytes,10,opt,name=custom,proto3" json:"custom,omitempty"`
+	// group_by indicates how the revisions in the log should be grouped. Only
+	// applies if include_log is set.
+	GroupBy CommitMsgConfig_GroupBy `protobuf:"varint,13,opt,name=group_by,json=groupBy,proto3,enum=autoroll.config.CommitMsgConfig_GroupBy" json:"group_by,omitempty"`
+	// file_areas are the areas used to group revisions when group_by is
+	// FILE_AREA. Revisions which modify no area are listed under "Other".
+	FileAreas []*CommitMsgConfig_FileArea `protobuf:"bytes,14,rep,name=file_areas,json=fileAreas,proto3" json:"file_areas,omitempty"`
+	// include_author_summary indicates whether the commit message should
+	// include a list of the authors of the revisions in the roll, with the
+	// number of revisions by each.
+	IncludeAuthorSummary bool `protobuf:"varint,15,opt,name=include_author_summary,json=includeAuthorSummary,proto3" json:"include_author_summary,omitempty"`
+	// release_notes indicates where to find release notes for the revisions
+	// in the roll, if any.
+	ReleaseNotes *ReleaseNotesConfig `protobuf:"bytes,16,opt,name=release_notes,json=releaseNotes,proto3" json:"release_notes,omitempty"`
 }
 
 func (x *CommitMsgConfig) Reset() {
@@ -1031,6 +1256,97 @@ func (x *CommitMsgConfig) GetCustom() string {
 	return ""
 }
 
+func (x *CommitMsgConfig) GetGroupBy() CommitMsgConfig_GroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return CommitMsgConfig_NONE
+}
+
+func (x *CommitMsgConfig) GetFileAreas() []*CommitMsgConfig_FileArea {
+	if x != nil {
+		return x.FileAreas
+	}
+	return nil
+}
+
+func (x *CommitMsgConfig) GetIncludeAuthorSummary() bool {
+	if x != nil {
+		return x.IncludeAuthorSummary
+	}
+	return false
+}
+
+func (x *CommitMsgConfig) GetReleaseNotes() *ReleaseNotesConfig {
+	if x != nil {
+		return x.ReleaseNotes
+	}
+	return nil
+}
+
+// ReleaseNotesConfig provides configuration for including the Child's release
+// notes in roll commit messages.
+type ReleaseNotesConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changelog_path is the path within the Child of a changelog file. Lines
+	// added to the file between the rolled-from and rolled-to revisions are
+	// included in the commit message.
+	ChangelogPath string `protobuf:"bytes,1,opt,name=changelog_path,json=changelogPath,proto3" json:"changelog_path,omitempty"`
+	// tag_annotations indicates whether the annotations of tags which point
+	// to revisions in the roll should be included in the commit message. This
+	// requires a Child which reports tag annotations, eg. git_checkout_child.
+	TagAnnotations bool `protobuf:"varint,2,opt,name=tag_annotations,json=tagAnnotations,proto3" json:"tag_annotations,omitempty"`
+}
+
+func (x *ReleaseNotesConfig) Reset() {
+	*x = ReleaseNotesConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleaseNotesConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNotesConfig) ProtoMessage() {}
+
+func (x *ReleaseNotesConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNotesConfig.ProtoReflect.Descriptor instead.
+func (*ReleaseNotesConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseNotesConfig) GetChangelogPath() string {
+	if x != nil {
+		return x.ChangelogPath
+	}
+	return ""
+}
+
+func (x *ReleaseNotesConfig) GetTagAnnotations() bool {
+	if x != nil {
+		return x.TagAnnotations
+	}
+	return false
+}
+
 // GerritConfig provides configuration for code review using Gerrit.
 type GerritConfig struct {
 	state         protoimpl.MessageState
@@ -1050,7 +1366,7 @@ type GerritConfig struct {
 func (x *GerritConfig) Reset() {
 	*x = GerritConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[2]
+		mi := &file_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1063,7 +1379,7 @@ func (x *GerritConfig) String() string {
 func (*GerritConfig) ProtoMessage() {}
 
 func (x *GerritConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[2]
+	mi := &file_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,7 +1392,7 @@ func (x *GerritConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GerritConfig.ProtoReflect.Descriptor instead.
 func (*GerritConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{2}
+	return file_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GerritConfig) GetUrl() string {
@@ -1127,7 +1443,7 @@ type GitHubConfig struct {
 func (x *GitHubConfig) Reset() {
 	*x = GitHubConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[3]
+		mi := &file_config_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1140,7 +1456,7 @@ func (x *GitHubConfig) String() string {
 func (*GitHubConfig) ProtoMessage() {}
 
 func (x *GitHubConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[3]
+	mi := &file_config_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1153,7 +1469,7 @@ func (x *GitHubConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitHubConfig.ProtoReflect.Descriptor instead.
 func (*GitHubConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{3}
+	return file_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GitHubConfig) GetRepoOwner() string {
@@ -1191,30 +1507,49 @@ func (x *GitHubConfig) GetSshKeySecret() string {
 	return ""
 }
 
-// Google3Config is an empty configuration object for Google3.
-type Google3Config struct {
+// GitLabConfig provides configuration for code review using GitLab merge
+// requests.
+type GitLabConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// url is the base URL of the GitLab instance, eg. "https://gitlab.com".
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// project is the ID or full path (eg. "my-group/my-project") of the GitLab
+	// project which receives the merge requests.
+	Project string `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	// labels are added to every merge request uploaded by the roller.
+	Labels []string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	// self_approve indicates whether the roller should approve its own merge
+	// requests. The project must allow authors to approve their own merge
+	// requests.
+	SelfApprove bool `protobuf:"varint,4,opt,name=self_approve,json=selfApprove,proto3" json:"self_approve,omitempty"`
+	// token_secret is the name of the secret containing the access token used
+	// to access the GitLab API.
+	TokenSecret string `protobuf:"bytes,5,opt,name=token_secret,json=tokenSecret,proto3" json:"token_secret,omitempty"`
+	// ssh_key_secret is the name of the secret containing the SSH key used to
+	// access GitLab repos.
+	SshKeySecret string `protobuf:"bytes,6,opt,name=ssh_key_secret,json=sshKeySecret,proto3" json:"ssh_key_secret,omitempty"`
 }
 
-func (x *Google3Config) Reset() {
-	*x = Google3Config{}
+func (x *GitLabConfig) Reset() {
+	*x = GitLabConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[4]
+		mi := &file_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Google3Config) String() string {
+func (x *GitLabConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Google3Config) ProtoMessage() {}
+func (*GitLabConfig) ProtoMessage() {}
 
-func (x *Google3Config) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[4]
+func (x *GitLabConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1225,45 +1560,126 @@ func (x *Google3Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Google3Config.ProtoReflect.Descriptor instead.
-func (*Google3Config) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{4}
-}
-
-// KubernetesConfig provides Kubernetes configuration for the autoroll backend
-// service for this roller.
-type KubernetesConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// cpu is the requested number of CPUs, eg. "250m".
-	Cpu string `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	// memory is the requested memory, eg. "2Gi".
-	Memory string `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	// readiness_failure_threshold indicates how many times the ready check may
-	// fail.
-	ReadinessFailureThreshold int32 `protobuf:"varint,3,opt,name=readiness_failure_threshold,json=readinessFailureThreshold,proto3" json:"readiness_failure_threshold,omitempty"`
-	// readiness_initial_delay_seconds indicates how long to wait before
-	// performing the ready check for the first time.
-	ReadinessInitialDelaySeconds int32 `protobuf:"varint,4,opt,name=readiness_initial_delay_seconds,json=readinessInitialDelaySeconds,proto3" json:"readiness_initial_delay_seconds,omitempty"`
-	// readiness_period_seconds indicates how often to perform the ready check.
-	ReadinessPeriodSeconds int32 `protobuf:"varint,5,opt,name=readiness_period_seconds,json=readinessPeriodSeconds,proto3" json:"readiness_period_seconds,omitempty"`
-	// disk indicates the size of the requested persistent disk.  If not
-	// specified, no persistent disk is used.
-	Disk string `protobuf:"bytes,6,opt,name=disk,proto3" json:"disk,omitempty"`
-	// image used for the autoroll backend container.
-	Image string `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
-	// extra_flags to pass to the autoroll-be binary.
-	ExtraFlags []string `protobuf:"bytes,9,rep,name=extra_flags,json=extraFlags,proto3" json:"extra_flags,omitempty"`
+// Deprecated: Use GitLabConfig.ProtoReflect.Descriptor instead.
+func (*GitLabConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *KubernetesConfig) Reset() {
-	*x = KubernetesConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GitLabConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GitLabConfig) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GitLabConfig) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *GitLabConfig) GetSelfApprove() bool {
+	if x != nil {
+		return x.SelfApprove
+	}
+	return false
+}
+
+func (x *GitLabConfig) GetTokenSecret() string {
+	if x != nil {
+		return x.TokenSecret
+	}
+	return ""
+}
+
+func (x *GitLabConfig) GetSshKeySecret() string {
+	if x != nil {
+		return x.SshKeySecret
+	}
+	return ""
+}
+
+// Google3Config is an empty configuration object for Google3.
+type Google3Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Google3Config) Reset() {
+	*x = Google3Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Google3Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Google3Config) ProtoMessage() {}
+
+func (x *Google3Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Google3Config.ProtoReflect.Descriptor instead.
+func (*Google3Config) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{6}
+}
+
+// KubernetesConfig provides Kubernetes configuration for the autoroll backend
+// service for this roller.
+type KubernetesConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cpu is the requested number of CPUs, eg. "250m".
+	Cpu string `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// memory is the requested memory, eg. "2Gi".
+	Memory string `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// readiness_failure_threshold indicates how many times the ready check may
+	// fail.
+	ReadinessFailureThreshold int32 `protobuf:"varint,3,opt,name=readiness_failure_threshold,json=readinessFailureThreshold,proto3" json:"readiness_failure_threshold,omitempty"`
+	// readiness_initial_delay_seconds indicates how long to wait before
+	// performing the ready check for the first time.
+	ReadinessInitialDelaySeconds int32 `protobuf:"varint,4,opt,name=readiness_initial_delay_seconds,json=readinessInitialDelaySeconds,proto3" json:"readiness_initial_delay_seconds,omitempty"`
+	// readiness_period_seconds indicates how often to perform the ready check.
+	ReadinessPeriodSeconds int32 `protobuf:"varint,5,opt,name=readiness_period_seconds,json=readinessPeriodSeconds,proto3" json:"readiness_period_seconds,omitempty"`
+	// disk indicates the size of the requested persistent disk.  If not
+	// specified, no persistent disk is used.
+	Disk string `protobuf:"bytes,6,opt,name=disk,proto3" json:"disk,omitempty"`
+	// image used for the autoroll backend container.
+	Image string `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
+	// extra_flags to pass to the autoroll-be binary.
+	ExtraFlags []string `protobuf:"bytes,9,rep,name=extra_flags,json=extraFlags,proto3" json:"extra_flags,omitempty"`
+}
+
+func (x *KubernetesConfig) Reset() {
+	*x = KubernetesConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
@@ -1274,7 +1690,7 @@ func (x *KubernetesConfig) String() string {
 func (*KubernetesConfig) ProtoMessage() {}
 
 func (x *KubernetesConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[5]
+	mi := &file_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,7 +1703,7 @@ func (x *KubernetesConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesConfig.ProtoReflect.Descriptor instead.
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{5}
+	return file_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *KubernetesConfig) GetCpu() string {
@@ -1393,7 +1809,7 @@ type AndroidRepoManagerConfig struct {
 func (x *AndroidRepoManagerConfig) Reset() {
 	*x = AndroidRepoManagerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[6]
+		mi := &file_config_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1406,7 +1822,7 @@ func (x *AndroidRepoManagerConfig) String() string {
 func (*AndroidRepoManagerConfig) ProtoMessage() {}
 
 func (x *AndroidRepoManagerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[6]
+	mi := &file_config_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,7 +1835,7 @@ func (x *AndroidRepoManagerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AndroidRepoManagerConfig.ProtoReflect.Descriptor instead.
 func (*AndroidRepoManagerConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{6}
+	return file_config_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AndroidRepoManagerConfig) GetChildRepoUrl() string {
@@ -1538,7 +1954,7 @@ type CommandRepoManagerConfig struct {
 func (x *CommandRepoManagerConfig) Reset() {
 	*x = CommandRepoManagerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[7]
+		mi := &file_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1551,7 +1967,7 @@ func (x *CommandRepoManagerConfig) String() string {
 func (*CommandRepoManagerConfig) ProtoMessage() {}
 
 func (x *CommandRepoManagerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[7]
+	mi := &file_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1564,7 +1980,7 @@ func (x *CommandRepoManagerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommandRepoManagerConfig.ProtoReflect.Descriptor instead.
 func (*CommandRepoManagerConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{7}
+	return file_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CommandRepoManagerConfig) GetGitCheckout() *GitCheckoutConfig {
@@ -1615,7 +2031,7 @@ type FreeTypeRepoManagerConfig struct {
 func (x *FreeTypeRepoManagerConfig) Reset() {
 	*x = FreeTypeRepoManagerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[8]
+		mi := &file_config_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1628,7 +2044,7 @@ func (x *FreeTypeRepoManagerConfig) String() string {
 func (*FreeTypeRepoManagerConfig) ProtoMessage() {}
 
 func (x *FreeTypeRepoManagerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[8]
+	mi := &file_config_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1641,7 +2057,7 @@ func (x *FreeTypeRepoManagerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreeTypeRepoManagerConfig.ProtoReflect.Descriptor instead.
 func (*FreeTypeRepoManagerConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{8}
+	return file_config_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FreeTypeRepoManagerConfig) GetParent() *FreeTypeParentConfig {
@@ -1673,7 +2089,7 @@ type Google3RepoManagerConfig struct {
 func (x *Google3RepoManagerConfig) Reset() {
 	*x = Google3RepoManagerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[9]
+		mi := &file_config_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1686,7 +2102,7 @@ func (x *Google3RepoManagerConfig) String() string {
 func (*Google3RepoManagerConfig) ProtoMessage() {}
 
 func (x *Google3RepoManagerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[9]
+	mi := &file_config_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,7 +2115,7 @@ func (x *Google3RepoManagerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Google3RepoManagerConfig.ProtoReflect.Descriptor instead.
 func (*Google3RepoManagerConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{9}
+	return file_config_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Google3RepoManagerConfig) GetChildBranch() string {
@@ -1716,6 +2132,62 @@ func (x *Google3RepoManagerConfig) GetChildRepo() string {
 	return ""
 }
 
+// FanOutRepoManagerConfig provides configuration for a roller which rolls the
+// same Child revision into multiple Parents at once. The CLs uploaded to each
+// Parent are tracked as a single roll, which succeeds only when all of the CLs
+// have landed. If any of the CLs fails, those which already landed are
+// reverted. All CLs are managed using the roller's code review config, so all
+// of the Parents must use the same code review host.
+type FanOutRepoManagerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// parent_child configures each of the Parents, paired with the Child. The
+	// entries must be identical apart from the Parent. The CL uploaded to the
+	// first Parent identifies the roll.
+	ParentChild []*ParentChildRepoManagerConfig `protobuf:"bytes,1,rep,name=parent_child,json=parentChild,proto3" json:"parent_child,omitempty"`
+}
+
+func (x *FanOutRepoManagerConfig) Reset() {
+	*x = FanOutRepoManagerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FanOutRepoManagerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FanOutRepoManagerConfig) ProtoMessage() {}
+
+func (x *FanOutRepoManagerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FanOutRepoManagerConfig.ProtoReflect.Descriptor instead.
+func (*FanOutRepoManagerConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FanOutRepoManagerConfig) GetParentChild() []*ParentChildRepoManagerConfig {
+	if x != nil {
+		return x.ParentChild
+	}
+	return nil
+}
+
 // ParentChildRepoManagerConfig provides configuration for a roller which
 // combines a pre-defined Parent and Child type.
 type ParentChildRepoManagerConfig struct {
@@ -1734,6 +2206,9 @@ type ParentChildRepoManagerConfig struct {
 	//	*ParentChildRepoManagerConfig_GitilesParent
 	//	*ParentChildRepoManagerConfig_GoModGerritParent
 	//	*ParentChildRepoManagerConfig_GitCheckoutGerritParent
+	//	*ParentChildRepoManagerConfig_GitCheckoutGitlabParent
+	//	*ParentChildRepoManagerConfig_BazelParent
+	//	*ParentChildRepoManagerConfig_PackageRegistryParent
 	Parent isParentChildRepoManagerConfig_Parent `protobuf_oneof:"parent"`
 	// child is the entity which is depended on by the parent and is rolled.
 	//
@@ -1746,18 +2221,20 @@ type ParentChildRepoManagerConfig struct {
 	//	*ParentChildRepoManagerConfig_GitilesChild
 	//	*ParentChildRepoManagerConfig_SemverGcsChild
 	//	*ParentChildRepoManagerConfig_DockerChild
+	//	*ParentChildRepoManagerConfig_PackageRegistryChild
 	Child isParentChildRepoManagerConfig_Child `protobuf_oneof:"child"`
 	// revision_filter filters out revisions of the child which should not be
 	// considered as roll candidates.
 	BuildbucketRevisionFilter []*BuildbucketRevisionFilterConfig `protobuf:"bytes,12,rep,name=buildbucket_revision_filter,json=buildbucketRevisionFilter,proto3" json:"buildbucket_revision_filter,omitempty"`
 	CipdRevisionFilter        []*CIPDRevisionFilterConfig        `protobuf:"bytes,13,rep,name=cipd_revision_filter,json=cipdRevisionFilter,proto3" json:"cipd_revision_filter,omitempty"`
 	ValidHttpRevisionFilter   []*ValidHttpRevisionFilterConfig   `protobuf:"bytes,15,rep,name=valid_http_revision_filter,json=validHttpRevisionFilter,proto3" json:"valid_http_revision_filter,omitempty"`
+	SignatureRevisionFilter   []*SignatureRevisionFilterConfig   `protobuf:"bytes,22,rep,name=signature_revision_filter,json=signatureRevisionFilter,proto3" json:"signature_revision_filter,omitempty"`
 }
 
 func (x *ParentChildRepoManagerConfig) Reset() {
 	*x = ParentChildRepoManagerConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[10]
+		mi := &file_config_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1770,7 +2247,7 @@ func (x *ParentChildRepoManagerConfig) String() string {
 func (*ParentChildRepoManagerConfig) ProtoMessage() {}
 
 func (x *ParentChildRepoManagerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[10]
+	mi := &file_config_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1783,7 +2260,7 @@ func (x *ParentChildRepoManagerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParentChildRepoManagerConfig.ProtoReflect.Descriptor instead.
 func (*ParentChildRepoManagerConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{10}
+	return file_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (m *ParentChildRepoManagerConfig) GetParent() isParentChildRepoManagerConfig_Parent {
@@ -1842,6 +2319,27 @@ func (x *ParentChildRepoManagerConfig) GetGitCheckoutGerritParent() *GitCheckout
 	return nil
 }
 
+func (x *ParentChildRepoManagerConfig) GetGitCheckoutGitlabParent() *GitCheckoutGitLabParentConfig {
+	if x, ok := x.GetParent().(*ParentChildRepoManagerConfig_GitCheckoutGitlabParent); ok {
+		return x.GitCheckoutGitlabParent
+	}
+	return nil
+}
+
+func (x *ParentChildRepoManagerConfig) GetBazelParent() *BazelParentConfig {
+	if x, ok := x.GetParent().(*ParentChildRepoManagerConfig_BazelParent); ok {
+		return x.BazelParent
+	}
+	return nil
+}
+
+func (x *ParentChildRepoManagerConfig) GetPackageRegistryParent() *PackageRegistryParentConfig {
+	if x, ok := x.GetParent().(*ParentChildRepoManagerConfig_PackageRegistryParent); ok {
+		return x.PackageRegistryParent
+	}
+	return nil
+}
+
 func (m *ParentChildRepoManagerConfig) GetChild() isParentChildRepoManagerConfig_Child {
 	if m != nil {
 		return m.Child
@@ -1898,6 +2396,13 @@ func (x *ParentChildRepoManagerConfig) GetDockerChild() *DockerChildConfig {
 	return nil
 }
 
+func (x *ParentChildRepoManagerConfig) GetPackageRegistryChild() *PackageRegistryChildConfig {
+	if x, ok := x.GetChild().(*ParentChildRepoManagerConfig_PackageRegistryChild); ok {
+		return x.PackageRegistryChild
+	}
+	return nil
+}
+
 func (x *ParentChildRepoManagerConfig) GetBuildbucketRevisionFilter() []*BuildbucketRevisionFilterConfig {
 	if x != nil {
 		return x.BuildbucketRevisionFilter
@@ -1919,6 +2424,13 @@ func (x *ParentChildRepoManagerConfig) GetValidHttpRevisionFilter() []*ValidHttp
 	return nil
 }
 
+func (x *ParentChildRepoManagerConfig) GetSignatureRevisionFilter() []*SignatureRevisionFilterConfig {
+	if x != nil {
+		return x.SignatureRevisionFilter
+	}
+	return nil
+}
+
 type isParentChildRepoManagerConfig_Parent interface {
 	isParentChildRepoManagerConfig_Parent()
 }
@@ -1951,6 +2463,18 @@ type ParentChildRepoManagerConfig_GitCheckoutGerritParent struct {
 	GitCheckoutGerritParent *GitCheckoutGerritParentConfig `protobuf:"bytes,17,opt,name=git_checkout_gerrit_parent,json=gitCheckoutGerritParent,proto3,oneof"`
 }
 
+type ParentChildRepoManagerConfig_GitCheckoutGitlabParent struct {
+	GitCheckoutGitlabParent *GitCheckoutGitLabParentConfig `protobuf:"bytes,18,opt,name=git_checkout_gitlab_parent,json=gitCheckoutGitlabParent,proto3,oneof"`
+}
+
+type ParentChildRepoManagerConfig_BazelParent struct {
+	BazelParent *BazelParentConfig `protobuf:"bytes,19,opt,name=bazel_parent,json=bazelParent,proto3,oneof"`
+}
+
+type ParentChildRepoManagerConfig_PackageRegistryParent struct {
+	PackageRegistryParent *PackageRegistryParentConfig `protobuf:"bytes,20,opt,name=package_registry_parent,json=packageRegistryParent,proto3,oneof"`
+}
+
 func (*ParentChildRepoManagerConfig_CopyParent) isParentChildRepoManagerConfig_Parent() {}
 
 func (*ParentChildRepoManagerConfig_DepsLocalGithubParent) isParentChildRepoManagerConfig_Parent() {}
@@ -1967,6 +2491,13 @@ func (*ParentChildRepoManagerConfig_GoModGerritParent) isParentChildRepoManagerC
 func (*ParentChildRepoManagerConfig_GitCheckoutGerritParent) isParentChildRepoManagerConfig_Parent() {
 }
 
+func (*ParentChildRepoManagerConfig_GitCheckoutGitlabParent) isParentChildRepoManagerConfig_Parent() {
+}
+
+func (*ParentChildRepoManagerConfig_BazelParent) isParentChildRepoManagerConfig_Parent() {}
+
+func (*ParentChildRepoManagerConfig_PackageRegistryParent) isParentChildRepoManagerConfig_Parent() {}
+
 type isParentChildRepoManagerConfig_Child interface {
 	isParentChildRepoManagerConfig_Child()
 }
@@ -1999,6 +2530,10 @@ type ParentChildRepoManagerConfig_DockerChild struct {
 	DockerChild *DockerChildConfig `protobuf:"bytes,16,opt,name=docker_child,json=dockerChild,proto3,oneof"`
 }
 
+type ParentChildRepoManagerConfig_PackageRegistryChild struct {
+	PackageRegistryChild *PackageRegistryChildConfig `protobuf:"bytes,21,opt,name=package_registry_child,json=packageRegistryChild,proto3,oneof"`
+}
+
 func (*ParentChildRepoManagerConfig_CipdChild) isParentChildRepoManagerConfig_Child() {}
 
 func (*ParentChildRepoManagerConfig_FuchsiaSdkChild) isParentChildRepoManagerConfig_Child() {}
@@ -2013,6 +2548,8 @@ func (*ParentChildRepoManagerConfig_SemverGcsChild) isParentChildRepoManagerConf
 
 func (*ParentChildRepoManagerConfig_DockerChild) isParentChildRepoManagerConfig_Child() {}
 
+func (*ParentChildRepoManagerConfig_PackageRegistryChild) isParentChildRepoManagerConfig_Child() {}
+
 // CopyParentConfig provides configuration for a Parent which copies the Child
 // into itself. It uses Gitiles and Gerrit instead of a local checkout.
 type CopyParentConfig struct {
@@ -2027,7 +2564,7 @@ type CopyParentConfig struct {
 func (x *CopyParentConfig) Reset() {
 	*x = CopyParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[11]
+		mi := &file_config_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2040,7 +2577,7 @@ func (x *CopyParentConfig) String() string {
 func (*CopyParentConfig) ProtoMessage() {}
 
 func (x *CopyParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[11]
+	mi := &file_config_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +2590,7 @@ func (x *CopyParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyParentConfig.ProtoReflect.Descriptor instead.
 func (*CopyParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{11}
+	return file_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CopyParentConfig) GetGitiles() *GitilesParentConfig {
@@ -2085,7 +2622,7 @@ type DEPSLocalGitHubParentConfig struct {
 func (x *DEPSLocalGitHubParentConfig) Reset() {
 	*x = DEPSLocalGitHubParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[12]
+		mi := &file_config_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2098,7 +2635,7 @@ func (x *DEPSLocalGitHubParentConfig) String() string {
 func (*DEPSLocalGitHubParentConfig) ProtoMessage() {}
 
 func (x *DEPSLocalGitHubParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[12]
+	mi := &file_config_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2111,7 +2648,7 @@ func (x *DEPSLocalGitHubParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DEPSLocalGitHubParentConfig.ProtoReflect.Descriptor instead.
 func (*DEPSLocalGitHubParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{12}
+	return file_config_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DEPSLocalGitHubParentConfig) GetDepsLocal() *DEPSLocalParentConfig {
@@ -2149,7 +2686,7 @@ type DEPSLocalGerritParentConfig struct {
 func (x *DEPSLocalGerritParentConfig) Reset() {
 	*x = DEPSLocalGerritParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[13]
+		mi := &file_config_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2162,7 +2699,7 @@ func (x *DEPSLocalGerritParentConfig) String() string {
 func (*DEPSLocalGerritParentConfig) ProtoMessage() {}
 
 func (x *DEPSLocalGerritParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[13]
+	mi := &file_config_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2175,7 +2712,7 @@ func (x *DEPSLocalGerritParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DEPSLocalGerritParentConfig.ProtoReflect.Descriptor instead.
 func (*DEPSLocalGerritParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{13}
+	return file_config_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DEPSLocalGerritParentConfig) GetDepsLocal() *DEPSLocalParentConfig {
@@ -2208,7 +2745,7 @@ type GitCheckoutGitHubParentConfig struct {
 func (x *GitCheckoutGitHubParentConfig) Reset() {
 	*x = GitCheckoutGitHubParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[14]
+		mi := &file_config_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2221,7 +2758,7 @@ func (x *GitCheckoutGitHubParentConfig) String() string {
 func (*GitCheckoutGitHubParentConfig) ProtoMessage() {}
 
 func (x *GitCheckoutGitHubParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[14]
+	mi := &file_config_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2234,7 +2771,7 @@ func (x *GitCheckoutGitHubParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitCheckoutGitHubParentConfig.ProtoReflect.Descriptor instead.
 func (*GitCheckoutGitHubParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{14}
+	return file_config_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GitCheckoutGitHubParentConfig) GetGitCheckout() *GitCheckoutParentConfig {
@@ -2264,7 +2801,7 @@ type GitCheckoutGerritParentConfig struct {
 func (x *GitCheckoutGerritParentConfig) Reset() {
 	*x = GitCheckoutGerritParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[15]
+		mi := &file_config_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2277,7 +2814,7 @@ func (x *GitCheckoutGerritParentConfig) String() string {
 func (*GitCheckoutGerritParentConfig) ProtoMessage() {}
 
 func (x *GitCheckoutGerritParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[15]
+	mi := &file_config_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2290,7 +2827,7 @@ func (x *GitCheckoutGerritParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitCheckoutGerritParentConfig.ProtoReflect.Descriptor instead.
 func (*GitCheckoutGerritParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{15}
+	return file_config_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GitCheckoutGerritParentConfig) GetGitCheckout() *GitCheckoutParentConfig {
@@ -2300,36 +2837,36 @@ func (x *GitCheckoutGerritParentConfig) GetGitCheckout() *GitCheckoutParentConfi
 	return nil
 }
 
-// GitCheckoutGitHubFileParentConfig provides configuration for a Parent which
-// uses a local Git checkout and uploads pull requests to GitHub.
-type GitCheckoutGitHubFileParentConfig struct {
+// GitCheckoutGitLabParentConfig provides configuration for a Parent which
+// uses a local Git checkout and uploads merge requests to GitLab.
+type GitCheckoutGitLabParentConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GitCheckout    *GitCheckoutGitHubParentConfig `protobuf:"bytes,1,opt,name=git_checkout,json=gitCheckout,proto3" json:"git_checkout,omitempty"`
-	PreUploadSteps []PreUploadStep                `protobuf:"varint,2,rep,packed,name=pre_upload_steps,json=preUploadSteps,proto3,enum=autoroll.config.PreUploadStep" json:"pre_upload_steps,omitempty"`
+	GitCheckout *GitCheckoutParentConfig `protobuf:"bytes,1,opt,name=git_checkout,json=gitCheckout,proto3" json:"git_checkout,omitempty"`
+	Gitlab      *GitLabConfig            `protobuf:"bytes,2,opt,name=gitlab,proto3" json:"gitlab,omitempty"`
 	// pre_upload describes command(s) to run before uploading roll CLs.
 	PreUploadCommands *PreUploadConfig `protobuf:"bytes,3,opt,name=pre_upload_commands,json=preUploadCommands,proto3" json:"pre_upload_commands,omitempty"`
 }
 
-func (x *GitCheckoutGitHubFileParentConfig) Reset() {
-	*x = GitCheckoutGitHubFileParentConfig{}
+func (x *GitCheckoutGitLabParentConfig) Reset() {
+	*x = GitCheckoutGitLabParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[16]
+		mi := &file_config_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GitCheckoutGitHubFileParentConfig) String() string {
+func (x *GitCheckoutGitLabParentConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitCheckoutGitHubFileParentConfig) ProtoMessage() {}
+func (*GitCheckoutGitLabParentConfig) ProtoMessage() {}
 
-func (x *GitCheckoutGitHubFileParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[16]
+func (x *GitCheckoutGitLabParentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,9 +2877,75 @@ func (x *GitCheckoutGitHubFileParentConfig) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitCheckoutGitHubFileParentConfig.ProtoReflect.Descriptor instead.
-func (*GitCheckoutGitHubFileParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GitCheckoutGitLabParentConfig.ProtoReflect.Descriptor instead.
+func (*GitCheckoutGitLabParentConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GitCheckoutGitLabParentConfig) GetGitCheckout() *GitCheckoutParentConfig {
+	if x != nil {
+		return x.GitCheckout
+	}
+	return nil
+}
+
+func (x *GitCheckoutGitLabParentConfig) GetGitlab() *GitLabConfig {
+	if x != nil {
+		return x.Gitlab
+	}
+	return nil
+}
+
+func (x *GitCheckoutGitLabParentConfig) GetPreUploadCommands() *PreUploadConfig {
+	if x != nil {
+		return x.PreUploadCommands
+	}
+	return nil
+}
+
+// GitCheckoutGitHubFileParentConfig provides configuration for a Parent which
+// uses a local Git checkout and uploads pull requests to GitHub.
+type GitCheckoutGitHubFileParentConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GitCheckout    *GitCheckoutGitHubParentConfig `protobuf:"bytes,1,opt,name=git_checkout,json=gitCheckout,proto3" json:"git_checkout,omitempty"`
+	PreUploadSteps []PreUploadStep                `protobuf:"varint,2,rep,packed,name=pre_upload_steps,json=preUploadSteps,proto3,enum=autoroll.config.PreUploadStep" json:"pre_upload_steps,omitempty"`
+	// pre_upload describes command(s) to run before uploading roll CLs.
+	PreUploadCommands *PreUploadConfig `protobuf:"bytes,3,opt,name=pre_upload_commands,json=preUploadCommands,proto3" json:"pre_upload_commands,omitempty"`
+}
+
+func (x *GitCheckoutGitHubFileParentConfig) Reset() {
+	*x = GitCheckoutGitHubFileParentConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitCheckoutGitHubFileParentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitCheckoutGitHubFileParentConfig) ProtoMessage() {}
+
+func (x *GitCheckoutGitHubFileParentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitCheckoutGitHubFileParentConfig.ProtoReflect.Descriptor instead.
+func (*GitCheckoutGitHubFileParentConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GitCheckoutGitHubFileParentConfig) GetGitCheckout() *GitCheckoutGitHubParentConfig {
@@ -2380,7 +2983,7 @@ type GitilesParentConfig struct {
 func (x *GitilesParentConfig) Reset() {
 	*x = GitilesParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[17]
+		mi := &file_config_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2393,7 +2996,7 @@ func (x *GitilesParentConfig) String() string {
 func (*GitilesParentConfig) ProtoMessage() {}
 
 func (x *GitilesParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[17]
+	mi := &file_config_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2406,7 +3009,7 @@ func (x *GitilesParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitilesParentConfig.ProtoReflect.Descriptor instead.
 func (*GitilesParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{17}
+	return file_config_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GitilesParentConfig) GetGitiles() *GitilesConfig {
@@ -2452,7 +3055,7 @@ type GitilesConfig struct {
 func (x *GitilesConfig) Reset() {
 	*x = GitilesConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[18]
+		mi := &file_config_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2465,7 +3068,7 @@ func (x *GitilesConfig) String() string {
 func (*GitilesConfig) ProtoMessage() {}
 
 func (x *GitilesConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[18]
+	mi := &file_config_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2478,7 +3081,7 @@ func (x *GitilesConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitilesConfig.ProtoReflect.Descriptor instead.
 func (*GitilesConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{18}
+	return file_config_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GitilesConfig) GetBranch() string {
@@ -2523,7 +3126,7 @@ type GoModGerritParentConfig struct {
 func (x *GoModGerritParentConfig) Reset() {
 	*x = GoModGerritParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[19]
+		mi := &file_config_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2536,7 +3139,7 @@ func (x *GoModGerritParentConfig) String() string {
 func (*GoModGerritParentConfig) ProtoMessage() {}
 
 func (x *GoModGerritParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[19]
+	mi := &file_config_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2549,7 +3152,7 @@ func (x *GoModGerritParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoModGerritParentConfig.ProtoReflect.Descriptor instead.
 func (*GoModGerritParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{19}
+	return file_config_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GoModGerritParentConfig) GetGoMod() *GoModParentConfig {
@@ -2591,7 +3194,7 @@ type GoModParentConfig struct {
 func (x *GoModParentConfig) Reset() {
 	*x = GoModParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[20]
+		mi := &file_config_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2604,7 +3207,7 @@ func (x *GoModParentConfig) String() string {
 func (*GoModParentConfig) ProtoMessage() {}
 
 func (x *GoModParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[20]
+	mi := &file_config_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2617,7 +3220,7 @@ func (x *GoModParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GoModParentConfig.ProtoReflect.Descriptor instead.
 func (*GoModParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{20}
+	return file_config_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GoModParentConfig) GetGitCheckout() *GitCheckoutConfig {
@@ -2692,7 +3295,7 @@ type DEPSLocalParentConfig struct {
 func (x *DEPSLocalParentConfig) Reset() {
 	*x = DEPSLocalParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[21]
+		mi := &file_config_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2705,7 +3308,7 @@ func (x *DEPSLocalParentConfig) String() string {
 func (*DEPSLocalParentConfig) ProtoMessage() {}
 
 func (x *DEPSLocalParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[21]
+	mi := &file_config_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2718,7 +3321,7 @@ func (x *DEPSLocalParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DEPSLocalParentConfig.ProtoReflect.Descriptor instead.
 func (*DEPSLocalParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{21}
+	return file_config_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *DEPSLocalParentConfig) GetGitCheckout() *GitCheckoutParentConfig {
@@ -2791,7 +3394,7 @@ type GitCheckoutParentConfig struct {
 func (x *GitCheckoutParentConfig) Reset() {
 	*x = GitCheckoutParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[22]
+		mi := &file_config_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2804,7 +3407,7 @@ func (x *GitCheckoutParentConfig) String() string {
 func (*GitCheckoutParentConfig) ProtoMessage() {}
 
 func (x *GitCheckoutParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[22]
+	mi := &file_config_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2817,7 +3420,7 @@ func (x *GitCheckoutParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitCheckoutParentConfig.ProtoReflect.Descriptor instead.
 func (*GitCheckoutParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{22}
+	return file_config_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GitCheckoutParentConfig) GetGitCheckout() *GitCheckoutConfig {
@@ -2846,7 +3449,7 @@ type FreeTypeParentConfig struct {
 func (x *FreeTypeParentConfig) Reset() {
 	*x = FreeTypeParentConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[23]
+		mi := &file_config_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2859,7 +3462,7 @@ func (x *FreeTypeParentConfig) String() string {
 func (*FreeTypeParentConfig) ProtoMessage() {}
 
 func (x *FreeTypeParentConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[23]
+	mi := &file_config_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2872,7 +3475,7 @@ func (x *FreeTypeParentConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreeTypeParentConfig.ProtoReflect.Descriptor instead.
 func (*FreeTypeParentConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{23}
+	return file_config_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *FreeTypeParentConfig) GetGitiles() *GitilesParentConfig {
@@ -2882,6 +3485,142 @@ func (x *FreeTypeParentConfig) GetGitiles() *GitilesParentConfig {
 	return nil
 }
 
+// BazelParentConfig provides configuration for a Parent which rolls a
+// dependency pinned in a Bazel file. The primary dependency's path must point
+// to a Bazel file, eg. MODULE.bazel or WORKSPACE, and its id identifies one of:
+//   - `bazel_dep` or `single_version_override` by module name.
+//   - `git_override` by module name; the child must provide commit hashes.
+//   - `archive_override` by module name, or `http_archive` or `http_file` by
+//     repository name. The version is taken from the archive URLs, and the
+//     sha256 or integrity is recomputed by downloading the new archive.
+//
+// If the primary dependency lives in MODULE.bazel and a MODULE.bazel.lock is
+// present next to it, the lockfile entries for the module are updated as well.
+type BazelParentConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gitiles *GitilesParentConfig `protobuf:"bytes,1,opt,name=gitiles,proto3" json:"gitiles,omitempty"`
+}
+
+func (x *BazelParentConfig) Reset() {
+	*x = BazelParentConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BazelParentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BazelParentConfig) ProtoMessage() {}
+
+func (x *BazelParentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BazelParentConfig.ProtoReflect.Descriptor instead.
+func (*BazelParentConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BazelParentConfig) GetGitiles() *GitilesParentConfig {
+	if x != nil {
+		return x.Gitiles
+	}
+	return nil
+}
+
+// PackageRegistryParentConfig provides configuration for a Parent which rolls
+// a package pinned in a package manifest. The primary dependency's id is the
+// name of the package and its path must point to one of:
+//   - `package.json`, for packages from npm.
+//   - A pip requirements file, eg. `requirements.txt`, for packages from PyPI.
+//     The package must be pinned using "==". If the requirement specifies
+//     hashes, they are replaced with the hashes of the new version.
+//   - `Cargo.toml`, for crates from crates.io.
+//
+// Operators in the version specifier, eg. the "^" in "^1.2.3", are retained.
+type PackageRegistryParentConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gitiles *GitilesParentConfig `protobuf:"bytes,1,opt,name=gitiles,proto3" json:"gitiles,omitempty"`
+	// registry_url overrides the default URL of the package registry, which is
+	// used to retrieve checksums of the new version. Optional.
+	RegistryUrl string `protobuf:"bytes,2,opt,name=registry_url,json=registryUrl,proto3" json:"registry_url,omitempty"`
+	// lockfile is the path within the repo of a lockfile which should be
+	// updated along with the manifest, ie. `package-lock.json` or `Cargo.lock`.
+	// Only the entries for the rolled package are updated. Optional.
+	Lockfile string `protobuf:"bytes,3,opt,name=lockfile,proto3" json:"lockfile,omitempty"`
+}
+
+func (x *PackageRegistryParentConfig) Reset() {
+	*x = PackageRegistryParentConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageRegistryParentConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageRegistryParentConfig) ProtoMessage() {}
+
+func (x *PackageRegistryParentConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageRegistryParentConfig.ProtoReflect.Descriptor instead.
+func (*PackageRegistryParentConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PackageRegistryParentConfig) GetGitiles() *GitilesParentConfig {
+	if x != nil {
+		return x.Gitiles
+	}
+	return nil
+}
+
+func (x *PackageRegistryParentConfig) GetRegistryUrl() string {
+	if x != nil {
+		return x.RegistryUrl
+	}
+	return ""
+}
+
+func (x *PackageRegistryParentConfig) GetLockfile() string {
+	if x != nil {
+		return x.Lockfile
+	}
+	return ""
+}
+
 // CIPDChildConfig provides configuration for a CIPD package Child.
 type CIPDChildConfig struct {
 	state         protoimpl.MessageState
@@ -2918,7 +3657,7 @@ type CIPDChildConfig struct {
 func (x *CIPDChildConfig) Reset() {
 	*x = CIPDChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[24]
+		mi := &file_config_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2931,7 +3670,7 @@ func (x *CIPDChildConfig) String() string {
 func (*CIPDChildConfig) ProtoMessage() {}
 
 func (x *CIPDChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[24]
+	mi := &file_config_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2944,7 +3683,7 @@ func (x *CIPDChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CIPDChildConfig.ProtoReflect.Descriptor instead.
 func (*CIPDChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{24}
+	return file_config_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CIPDChildConfig) GetName() string {
@@ -3017,7 +3756,7 @@ type FuchsiaSDKChildConfig struct {
 func (x *FuchsiaSDKChildConfig) Reset() {
 	*x = FuchsiaSDKChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[25]
+		mi := &file_config_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3030,7 +3769,7 @@ func (x *FuchsiaSDKChildConfig) String() string {
 func (*FuchsiaSDKChildConfig) ProtoMessage() {}
 
 func (x *FuchsiaSDKChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[25]
+	mi := &file_config_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3043,7 +3782,7 @@ func (x *FuchsiaSDKChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FuchsiaSDKChildConfig.ProtoReflect.Descriptor instead.
 func (*FuchsiaSDKChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{25}
+	return file_config_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FuchsiaSDKChildConfig) GetIncludeMacSdk() bool {
@@ -3102,7 +3841,7 @@ type SemVerGCSChildConfig struct {
 func (x *SemVerGCSChildConfig) Reset() {
 	*x = SemVerGCSChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[26]
+		mi := &file_config_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3115,7 +3854,7 @@ func (x *SemVerGCSChildConfig) String() string {
 func (*SemVerGCSChildConfig) ProtoMessage() {}
 
 func (x *SemVerGCSChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[26]
+	mi := &file_config_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3128,7 +3867,7 @@ func (x *SemVerGCSChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SemVerGCSChildConfig.ProtoReflect.Descriptor instead.
 func (*SemVerGCSChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{26}
+	return file_config_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *SemVerGCSChildConfig) GetGcs() *GCSChildConfig {
@@ -3170,7 +3909,7 @@ type GCSChildConfig struct {
 func (x *GCSChildConfig) Reset() {
 	*x = GCSChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[27]
+		mi := &file_config_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3183,7 +3922,7 @@ func (x *GCSChildConfig) String() string {
 func (*GCSChildConfig) ProtoMessage() {}
 
 func (x *GCSChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[27]
+	mi := &file_config_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3196,7 +3935,7 @@ func (x *GCSChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GCSChildConfig.ProtoReflect.Descriptor instead.
 func (*GCSChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{27}
+	return file_config_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GCSChildConfig) GetGcsBucket() string {
@@ -3233,7 +3972,7 @@ type GitCheckoutChildConfig struct {
 func (x *GitCheckoutChildConfig) Reset() {
 	*x = GitCheckoutChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[28]
+		mi := &file_config_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3246,7 +3985,7 @@ func (x *GitCheckoutChildConfig) String() string {
 func (*GitCheckoutChildConfig) ProtoMessage() {}
 
 func (x *GitCheckoutChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[28]
+	mi := &file_config_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3259,7 +3998,7 @@ func (x *GitCheckoutChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitCheckoutChildConfig.ProtoReflect.Descriptor instead.
 func (*GitCheckoutChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{28}
+	return file_config_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GitCheckoutChildConfig) GetGitCheckout() *GitCheckoutConfig {
@@ -3284,7 +4023,7 @@ type GitCheckoutGitHubChildConfig struct {
 func (x *GitCheckoutGitHubChildConfig) Reset() {
 	*x = GitCheckoutGitHubChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[29]
+		mi := &file_config_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3297,7 +4036,7 @@ func (x *GitCheckoutGitHubChildConfig) String() string {
 func (*GitCheckoutGitHubChildConfig) ProtoMessage() {}
 
 func (x *GitCheckoutGitHubChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[29]
+	mi := &file_config_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,7 +4049,7 @@ func (x *GitCheckoutGitHubChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitCheckoutGitHubChildConfig.ProtoReflect.Descriptor instead.
 func (*GitCheckoutGitHubChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{29}
+	return file_config_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GitCheckoutGitHubChildConfig) GetGitCheckout() *GitCheckoutChildConfig {
@@ -3351,7 +4090,7 @@ type GitilesChildConfig struct {
 func (x *GitilesChildConfig) Reset() {
 	*x = GitilesChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[30]
+		mi := &file_config_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3364,7 +4103,7 @@ func (x *GitilesChildConfig) String() string {
 func (*GitilesChildConfig) ProtoMessage() {}
 
 func (x *GitilesChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[30]
+	mi := &file_config_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3377,7 +4116,7 @@ func (x *GitilesChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitilesChildConfig.ProtoReflect.Descriptor instead.
 func (*GitilesChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{30}
+	return file_config_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GitilesChildConfig) GetGitiles() *GitilesConfig {
@@ -3411,7 +4150,7 @@ type DockerChildConfig struct {
 func (x *DockerChildConfig) Reset() {
 	*x = DockerChildConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[31]
+		mi := &file_config_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3424,7 +4163,7 @@ func (x *DockerChildConfig) String() string {
 func (*DockerChildConfig) ProtoMessage() {}
 
 func (x *DockerChildConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[31]
+	mi := &file_config_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,7 +4176,7 @@ func (x *DockerChildConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DockerChildConfig.ProtoReflect.Descriptor instead.
 func (*DockerChildConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{31}
+	return file_config_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *DockerChildConfig) GetRegistry() string {
@@ -3461,26 +4200,115 @@ func (x *DockerChildConfig) GetTag() string {
 	return ""
 }
 
-// NotifierConfig provides configuration for a notification system.
-type NotifierConfig struct {
+// PackageRegistryChildConfig provides configuration for a Child which tracks
+// the versions of a package published to a package registry.
+type PackageRegistryChildConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// log_level allows all messages at and above the given severity to be
-	// sent. Mutually exclusive with msg_type.
-	LogLevel NotifierConfig_LogLevel `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=autoroll.config.NotifierConfig_LogLevel" json:"log_level,omitempty"`
-	// msg_type limits the notifier to only send messages of the given
-	// types. Mutually exclusive with log_level.
-	MsgType []NotifierConfig_MsgType `protobuf:"varint,2,rep,packed,name=msg_type,json=msgType,proto3,enum=autoroll.config.NotifierConfig_MsgType" json:"msg_type,omitempty"`
-	// config provides configuration for the specific type of notifier.
-	//
-	// Types that are assignable to Config:
-	//
-	//	*NotifierConfig_Email
-	//	*NotifierConfig_Chat
-	//	*NotifierConfig_Monorail
-	//	*NotifierConfig_Pubsub
+	// registry which hosts the package.
+	Registry PackageRegistry `protobuf:"varint,1,opt,name=registry,proto3,enum=autoroll.config.PackageRegistry" json:"registry,omitempty"`
+	// registry_url overrides the default URL of the registry, eg. to use a
+	// mirror. Optional.
+	RegistryUrl string `protobuf:"bytes,2,opt,name=registry_url,json=registryUrl,proto3" json:"registry_url,omitempty"`
+	// package_name is the name of the package, eg. "@types/node" or "serde".
+	PackageName string `protobuf:"bytes,3,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
+	// version_constraint is a semantic version constraint, eg. "^1.2" or
+	// ">= 1.0, < 3", which limits the versions which may be rolled. Optional.
+	VersionConstraint string `protobuf:"bytes,4,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+	// include_prereleases indicates whether pre-release versions may be
+	// rolled.
+	IncludePrereleases bool `protobuf:"varint,5,opt,name=include_prereleases,json=includePrereleases,proto3" json:"include_prereleases,omitempty"`
+}
+
+func (x *PackageRegistryChildConfig) Reset() {
+	*x = PackageRegistryChildConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageRegistryChildConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageRegistryChildConfig) ProtoMessage() {}
+
+func (x *PackageRegistryChildConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageRegistryChildConfig.ProtoReflect.Descriptor instead.
+func (*PackageRegistryChildConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PackageRegistryChildConfig) GetRegistry() PackageRegistry {
+	if x != nil {
+		return x.Registry
+	}
+	return PackageRegistry_NPM
+}
+
+func (x *PackageRegistryChildConfig) GetRegistryUrl() string {
+	if x != nil {
+		return x.RegistryUrl
+	}
+	return ""
+}
+
+func (x *PackageRegistryChildConfig) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *PackageRegistryChildConfig) GetVersionConstraint() string {
+	if x != nil {
+		return x.VersionConstraint
+	}
+	return ""
+}
+
+func (x *PackageRegistryChildConfig) GetIncludePrereleases() bool {
+	if x != nil {
+		return x.IncludePrereleases
+	}
+	return false
+}
+
+// NotifierConfig provides configuration for a notification system.
+type NotifierConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// log_level allows all messages at and above the given severity to be
+	// sent. Mutually exclusive with msg_type.
+	LogLevel NotifierConfig_LogLevel `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=autoroll.config.NotifierConfig_LogLevel" json:"log_level,omitempty"`
+	// msg_type limits the notifier to only send messages of the given
+	// types. Mutually exclusive with log_level.
+	MsgType []NotifierConfig_MsgType `protobuf:"varint,2,rep,packed,name=msg_type,json=msgType,proto3,enum=autoroll.config.NotifierConfig_MsgType" json:"msg_type,omitempty"`
+	// config provides configuration for the specific type of notifier.
+	//
+	// Types that are assignable to Config:
+	//
+	//	*NotifierConfig_Email
+	//	*NotifierConfig_Chat
+	//	*NotifierConfig_Monorail
+	//	*NotifierConfig_Pubsub
 	Config isNotifierConfig_Config `protobuf_oneof:"config"`
 	// subject indicates a subject line which overrides the default subject line
 	// for every notification message, if provided.
@@ -3490,7 +4318,7 @@ type NotifierConfig struct {
 func (x *NotifierConfig) Reset() {
 	*x = NotifierConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[32]
+		mi := &file_config_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3503,7 +4331,7 @@ func (x *NotifierConfig) String() string {
 func (*NotifierConfig) ProtoMessage() {}
 
 func (x *NotifierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[32]
+	mi := &file_config_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3516,7 +4344,7 @@ func (x *NotifierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifierConfig.ProtoReflect.Descriptor instead.
 func (*NotifierConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{32}
+	return file_config_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *NotifierConfig) GetLogLevel() NotifierConfig_LogLevel {
@@ -3616,7 +4444,7 @@ type EmailNotifierConfig struct {
 func (x *EmailNotifierConfig) Reset() {
 	*x = EmailNotifierConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[33]
+		mi := &file_config_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3629,7 +4457,7 @@ func (x *EmailNotifierConfig) String() string {
 func (*EmailNotifierConfig) ProtoMessage() {}
 
 func (x *EmailNotifierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[33]
+	mi := &file_config_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3642,7 +4470,7 @@ func (x *EmailNotifierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmailNotifierConfig.ProtoReflect.Descriptor instead.
 func (*EmailNotifierConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{33}
+	return file_config_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *EmailNotifierConfig) GetEmails() []string {
@@ -3665,7 +4493,7 @@ type ChatNotifierConfig struct {
 func (x *ChatNotifierConfig) Reset() {
 	*x = ChatNotifierConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[34]
+		mi := &file_config_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3678,7 +4506,7 @@ func (x *ChatNotifierConfig) String() string {
 func (*ChatNotifierConfig) ProtoMessage() {}
 
 func (x *ChatNotifierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[34]
+	mi := &file_config_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3691,7 +4519,7 @@ func (x *ChatNotifierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatNotifierConfig.ProtoReflect.Descriptor instead.
 func (*ChatNotifierConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{34}
+	return file_config_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ChatNotifierConfig) GetRoomId() string {
@@ -3723,7 +4551,7 @@ type MonorailNotifierConfig struct {
 func (x *MonorailNotifierConfig) Reset() {
 	*x = MonorailNotifierConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[35]
+		mi := &file_config_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3736,7 +4564,7 @@ func (x *MonorailNotifierConfig) String() string {
 func (*MonorailNotifierConfig) ProtoMessage() {}
 
 func (x *MonorailNotifierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[35]
+	mi := &file_config_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3749,7 +4577,7 @@ func (x *MonorailNotifierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MonorailNotifierConfig.ProtoReflect.Descriptor instead.
 func (*MonorailNotifierConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{35}
+	return file_config_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *MonorailNotifierConfig) GetProject() string {
@@ -3800,7 +4628,7 @@ type PubSubNotifierConfig struct {
 func (x *PubSubNotifierConfig) Reset() {
 	*x = PubSubNotifierConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[36]
+		mi := &file_config_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3813,7 +4641,7 @@ func (x *PubSubNotifierConfig) String() string {
 func (*PubSubNotifierConfig) ProtoMessage() {}
 
 func (x *PubSubNotifierConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[36]
+	mi := &file_config_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3826,7 +4654,7 @@ func (x *PubSubNotifierConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PubSubNotifierConfig.ProtoReflect.Descriptor instead.
 func (*PubSubNotifierConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{36}
+	return file_config_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *PubSubNotifierConfig) GetTopic() string {
@@ -3853,7 +4681,7 @@ type ThrottleConfig struct {
 func (x *ThrottleConfig) Reset() {
 	*x = ThrottleConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[37]
+		mi := &file_config_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3866,7 +4694,7 @@ func (x *ThrottleConfig) String() string {
 func (*ThrottleConfig) ProtoMessage() {}
 
 func (x *ThrottleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[37]
+	mi := &file_config_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3879,7 +4707,7 @@ func (x *ThrottleConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ThrottleConfig.ProtoReflect.Descriptor instead.
 func (*ThrottleConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{37}
+	return file_config_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ThrottleConfig) GetAttemptCount() int32 {
@@ -3896,6 +4724,230 @@ func (x *ThrottleConfig) GetTimeWindow() string {
 	return ""
 }
 
+// StrategyOptions provides parameters for roll strategies.
+type StrategyOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_batch_interval is the minimum amount of time between successful
+	// rolls when using the "time_batch" strategy, eg. "6h".
+	TimeBatchInterval string `protobuf:"bytes,1,opt,name=time_batch_interval,json=timeBatchInterval,proto3" json:"time_batch_interval,omitempty"`
+	// max_files_changed is the maximum total number of files changed by the
+	// revisions in a single roll when using the "size_batch" strategy.
+	MaxFilesChanged int32 `protobuf:"varint,2,opt,name=max_files_changed,json=maxFilesChanged,proto3" json:"max_files_changed,omitempty"`
+	// max_lines_changed is the maximum total number of lines changed by the
+	// revisions in a single roll when using the "size_batch" strategy.
+	MaxLinesChanged int32 `protobuf:"varint,3,opt,name=max_lines_changed,json=maxLinesChanged,proto3" json:"max_lines_changed,omitempty"`
+}
+
+func (x *StrategyOptions) Reset() {
+	*x = StrategyOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StrategyOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyOptions) ProtoMessage() {}
+
+func (x *StrategyOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyOptions.ProtoReflect.Descriptor instead.
+func (*StrategyOptions) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StrategyOptions) GetTimeBatchInterval() string {
+	if x != nil {
+		return x.TimeBatchInterval
+	}
+	return ""
+}
+
+func (x *StrategyOptions) GetMaxFilesChanged() int32 {
+	if x != nil {
+		return x.MaxFilesChanged
+	}
+	return 0
+}
+
+func (x *StrategyOptions) GetMaxLinesChanged() int32 {
+	if x != nil {
+		return x.MaxLinesChanged
+	}
+	return 0
+}
+
+// AutoRevertConfig provides configuration for automatically reverting rolls
+// which break the parent. After a roll lands, the roller watches the configured
+// post-submit signals, and if any of them fails within the window, it uploads a
+// revert of the roll, stops itself, and sends a notification. At least one
+// signal is required. Only supported for rollers which use Gerrit.
+type AutoRevertConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the period of time after a roll lands during which failures
+	// are attributed to the roll, eg. "2h".
+	Window string `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	// tree_status_url is the URL of the parent's tree status, eg.
+	// "https://tree-status.skia.org/current". The roll is considered to have
+	// broken the parent if the tree was closed after the roll landed.
+	TreeStatusUrl string `protobuf:"bytes,2,opt,name=tree_status_url,json=treeStatusUrl,proto3" json:"tree_status_url,omitempty"`
+	// task_scheduler configures Task Scheduler jobs to watch.
+	TaskScheduler *AutoRevertTaskSchedulerConfig `protobuf:"bytes,3,opt,name=task_scheduler,json=taskScheduler,proto3" json:"task_scheduler,omitempty"`
+	// health_check_url lists URLs which are expected to respond with a
+	// successful status code while the parent is healthy.
+	HealthCheckUrl []string `protobuf:"bytes,4,rep,name=health_check_url,json=healthCheckUrl,proto3" json:"health_check_url,omitempty"`
+}
+
+func (x *AutoRevertConfig) Reset() {
+	*x = AutoRevertConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoRevertConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRevertConfig) ProtoMessage() {}
+
+func (x *AutoRevertConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoRevertConfig.ProtoReflect.Descriptor instead.
+func (*AutoRevertConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AutoRevertConfig) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *AutoRevertConfig) GetTreeStatusUrl() string {
+	if x != nil {
+		return x.TreeStatusUrl
+	}
+	return ""
+}
+
+func (x *AutoRevertConfig) GetTaskScheduler() *AutoRevertTaskSchedulerConfig {
+	if x != nil {
+		return x.TaskScheduler
+	}
+	return nil
+}
+
+func (x *AutoRevertConfig) GetHealthCheckUrl() []string {
+	if x != nil {
+		return x.HealthCheckUrl
+	}
+	return nil
+}
+
+// AutoRevertTaskSchedulerConfig provides configuration for using Task Scheduler
+// jobs as a post-submit signal. The roll is considered to have broken the parent
+// if the first run of any of the jobs after the roll landed failed.
+type AutoRevertTaskSchedulerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// host is the URL of the Task Scheduler, eg.
+	// "https://task-scheduler.skia.org".
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// repo is the URL of the parent repo.
+	Repo string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// jobs lists the names of the jobs to watch.
+	Jobs []string `protobuf:"bytes,3,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *AutoRevertTaskSchedulerConfig) Reset() {
+	*x = AutoRevertTaskSchedulerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoRevertTaskSchedulerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoRevertTaskSchedulerConfig) ProtoMessage() {}
+
+func (x *AutoRevertTaskSchedulerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoRevertTaskSchedulerConfig.ProtoReflect.Descriptor instead.
+func (*AutoRevertTaskSchedulerConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AutoRevertTaskSchedulerConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *AutoRevertTaskSchedulerConfig) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *AutoRevertTaskSchedulerConfig) GetJobs() []string {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
 // TransitiveDepConfig provides configuration for a dependency referenced by
 // both the parent and child, to be updated in the parent to match the revision
 // depended on by the child at the revision being rolled.
@@ -3916,7 +4968,7 @@ type TransitiveDepConfig struct {
 func (x *TransitiveDepConfig) Reset() {
 	*x = TransitiveDepConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[38]
+		mi := &file_config_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3929,7 +4981,7 @@ func (x *TransitiveDepConfig) String() string {
 func (*TransitiveDepConfig) ProtoMessage() {}
 
 func (x *TransitiveDepConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[38]
+	mi := &file_config_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3942,7 +4994,7 @@ func (x *TransitiveDepConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransitiveDepConfig.ProtoReflect.Descriptor instead.
 func (*TransitiveDepConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{38}
+	return file_config_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *TransitiveDepConfig) GetChild() *VersionFileConfig {
@@ -4001,6 +5053,8 @@ type VersionFileConfig struct {
 	//     },
 	//     }
 	//
+	//   - `package.json`, pip requirements files and `Cargo.toml`: the `id`
+	//     field is the name of the package, whose version we read and write.
 	//   - Otherwise, we assume that the file's sole contents are the revision
 	//     ID and we read or write the entirety of the file.
 	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
@@ -4012,7 +5066,7 @@ type VersionFileConfig struct {
 func (x *VersionFileConfig) Reset() {
 	*x = VersionFileConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[39]
+		mi := &file_config_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4025,7 +5079,7 @@ func (x *VersionFileConfig) String() string {
 func (*VersionFileConfig) ProtoMessage() {}
 
 func (x *VersionFileConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[39]
+	mi := &file_config_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4038,7 +5092,7 @@ func (x *VersionFileConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VersionFileConfig.ProtoReflect.Descriptor instead.
 func (*VersionFileConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{39}
+	return file_config_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *VersionFileConfig) GetId() string {
@@ -4082,7 +5136,7 @@ type DependencyConfig struct {
 func (x *DependencyConfig) Reset() {
 	*x = DependencyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[40]
+		mi := &file_config_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4095,7 +5149,7 @@ func (x *DependencyConfig) String() string {
 func (*DependencyConfig) ProtoMessage() {}
 
 func (x *DependencyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[40]
+	mi := &file_config_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4108,7 +5162,7 @@ func (x *DependencyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DependencyConfig.ProtoReflect.Descriptor instead.
 func (*DependencyConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{40}
+	return file_config_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *DependencyConfig) GetPrimary() *VersionFileConfig {
@@ -4158,7 +5212,7 @@ type GitCheckoutConfig struct {
 func (x *GitCheckoutConfig) Reset() {
 	*x = GitCheckoutConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[41]
+		mi := &file_config_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4171,7 +5225,7 @@ func (x *GitCheckoutConfig) String() string {
 func (*GitCheckoutConfig) ProtoMessage() {}
 
 func (x *GitCheckoutConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[41]
+	mi := &file_config_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4184,7 +5238,7 @@ func (x *GitCheckoutConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GitCheckoutConfig.ProtoReflect.Descriptor instead.
 func (*GitCheckoutConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{41}
+	return file_config_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GitCheckoutConfig) GetBranch() string {
@@ -4194,64 +5248,273 @@ func (x *GitCheckoutConfig) GetBranch() string {
 	return ""
 }
 
-func (x *GitCheckoutConfig) GetRepoUrl() string {
-	if x != nil {
-		return x.RepoUrl
+func (x *GitCheckoutConfig) GetRepoUrl() string {
+	if x != nil {
+		return x.RepoUrl
+	}
+	return ""
+}
+
+func (x *GitCheckoutConfig) GetRevLinkTmpl() string {
+	if x != nil {
+		return x.RevLinkTmpl
+	}
+	return ""
+}
+
+func (x *GitCheckoutConfig) GetDependencies() []*VersionFileConfig {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *GitCheckoutConfig) GetDefaultBugProject() string {
+	if x != nil {
+		return x.DefaultBugProject
+	}
+	return ""
+}
+
+// BuildbucketRevisionFilterConfig provides configuration for a revision filter
+// which uses Buildbucket.
+type BuildbucketRevisionFilterConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project            string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Bucket             string   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	BuildsetCommitTmpl string   `protobuf:"bytes,3,opt,name=buildset_commit_tmpl,json=buildsetCommitTmpl,proto3" json:"buildset_commit_tmpl,omitempty"`
+	Builder            []string `protobuf:"bytes,4,rep,name=builder,proto3" json:"builder,omitempty"`
+}
+
+func (x *BuildbucketRevisionFilterConfig) Reset() {
+	*x = BuildbucketRevisionFilterConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildbucketRevisionFilterConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildbucketRevisionFilterConfig) ProtoMessage() {}
+
+func (x *BuildbucketRevisionFilterConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildbucketRevisionFilterConfig.ProtoReflect.Descriptor instead.
+func (*BuildbucketRevisionFilterConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BuildbucketRevisionFilterConfig) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *BuildbucketRevisionFilterConfig) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *BuildbucketRevisionFilterConfig) GetBuildsetCommitTmpl() string {
+	if x != nil {
+		return x.BuildsetCommitTmpl
+	}
+	return ""
+}
+
+func (x *BuildbucketRevisionFilterConfig) GetBuilder() []string {
+	if x != nil {
+		return x.Builder
+	}
+	return nil
+}
+
+// CIPDRevisionFilterConfig provides configuration for a revision filter
+// which filters out revisions which don't exist on all of the configured
+// packages and platforms.
+type CIPDRevisionFilterConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package  []string `protobuf:"bytes,1,rep,name=package,proto3" json:"package,omitempty"`
+	Platform []string `protobuf:"bytes,2,rep,name=platform,proto3" json:"platform,omitempty"`
+	TagKey   string   `protobuf:"bytes,3,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+}
+
+func (x *CIPDRevisionFilterConfig) Reset() {
+	*x = CIPDRevisionFilterConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CIPDRevisionFilterConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CIPDRevisionFilterConfig) ProtoMessage() {}
+
+func (x *CIPDRevisionFilterConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CIPDRevisionFilterConfig.ProtoReflect.Descriptor instead.
+func (*CIPDRevisionFilterConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CIPDRevisionFilterConfig) GetPackage() []string {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *CIPDRevisionFilterConfig) GetPlatform() []string {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+func (x *CIPDRevisionFilterConfig) GetTagKey() string {
+	if x != nil {
+		return x.TagKey
+	}
+	return ""
+}
+
+// ValidHttpRevisionFilterConfig provides configuration for a
+// revision filter which obtains a single valid revision from a file which is
+// retrieved via HTTP.
+type ValidHttpRevisionFilterConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URL of the file containing the valid revision.
+	FileUrl string `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	// Optional regular expression used to extract the valid revision from the
+	// file after retrieval. If not provided, we assume that the entire contents
+	// of the file comprise the revision ID.
+	Regex string `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (x *ValidHttpRevisionFilterConfig) Reset() {
+	*x = ValidHttpRevisionFilterConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidHttpRevisionFilterConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidHttpRevisionFilterConfig) ProtoMessage() {}
+
+func (x *ValidHttpRevisionFilterConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GitCheckoutConfig) GetRevLinkTmpl() string {
-	if x != nil {
-		return x.RevLinkTmpl
-	}
-	return ""
+// Deprecated: Use ValidHttpRevisionFilterConfig.ProtoReflect.Descriptor instead.
+func (*ValidHttpRevisionFilterConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GitCheckoutConfig) GetDependencies() []*VersionFileConfig {
+func (x *ValidHttpRevisionFilterConfig) GetFileUrl() string {
 	if x != nil {
-		return x.Dependencies
+		return x.FileUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *GitCheckoutConfig) GetDefaultBugProject() string {
+func (x *ValidHttpRevisionFilterConfig) GetRegex() string {
 	if x != nil {
-		return x.DefaultBugProject
+		return x.Regex
 	}
 	return ""
 }
 
-// BuildbucketRevisionFilterConfig provides configuration for a revision filter
-// which uses Buildbucket.
-type BuildbucketRevisionFilterConfig struct {
+// SignatureRevisionFilterConfig provides configuration for a revision filter
+// which filters out revisions which are not signed by any of a set of trusted
+// public keys.
+type SignatureRevisionFilterConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Project            string   `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	Bucket             string   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	BuildsetCommitTmpl string   `protobuf:"bytes,3,opt,name=buildset_commit_tmpl,json=buildsetCommitTmpl,proto3" json:"buildset_commit_tmpl,omitempty"`
-	Builder            []string `protobuf:"bytes,4,rep,name=builder,proto3" json:"builder,omitempty"`
+	// public_key contains the trusted public keys. Each entry may be an
+	// ASCII-armored OpenPGP public key block, an SSH public key in
+	// authorized_keys format, or a PEM-encoded PKIX public key (ECDSA, RSA or
+	// Ed25519).
+	PublicKey []string `protobuf:"bytes,1,rep,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// source indicates where the signatures are found.
+	//
+	// Types that are assignable to Source:
+	//	*SignatureRevisionFilterConfig_Git
+	//	*SignatureRevisionFilterConfig_Artifact
+	Source isSignatureRevisionFilterConfig_Source `protobuf_oneof:"source"`
 }
 
-func (x *BuildbucketRevisionFilterConfig) Reset() {
-	*x = BuildbucketRevisionFilterConfig{}
+func (x *SignatureRevisionFilterConfig) Reset() {
+	*x = SignatureRevisionFilterConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[42]
+		mi := &file_config_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BuildbucketRevisionFilterConfig) String() string {
+func (x *SignatureRevisionFilterConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildbucketRevisionFilterConfig) ProtoMessage() {}
+func (*SignatureRevisionFilterConfig) ProtoMessage() {}
 
-func (x *BuildbucketRevisionFilterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[42]
+func (x *SignatureRevisionFilterConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4262,69 +5525,87 @@ func (x *BuildbucketRevisionFilterConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildbucketRevisionFilterConfig.ProtoReflect.Descriptor instead.
-func (*BuildbucketRevisionFilterConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use SignatureRevisionFilterConfig.ProtoReflect.Descriptor instead.
+func (*SignatureRevisionFilterConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *BuildbucketRevisionFilterConfig) GetProject() string {
+func (x *SignatureRevisionFilterConfig) GetPublicKey() []string {
 	if x != nil {
-		return x.Project
+		return x.PublicKey
 	}
-	return ""
+	return nil
 }
 
-func (x *BuildbucketRevisionFilterConfig) GetBucket() string {
-	if x != nil {
-		return x.Bucket
+func (m *SignatureRevisionFilterConfig) GetSource() isSignatureRevisionFilterConfig_Source {
+	if m != nil {
+		return m.Source
 	}
-	return ""
+	return nil
 }
 
-func (x *BuildbucketRevisionFilterConfig) GetBuildsetCommitTmpl() string {
-	if x != nil {
-		return x.BuildsetCommitTmpl
+func (x *SignatureRevisionFilterConfig) GetGit() *GitSignatureConfig {
+	if x, ok := x.GetSource().(*SignatureRevisionFilterConfig_Git); ok {
+		return x.Git
 	}
-	return ""
+	return nil
 }
 
-func (x *BuildbucketRevisionFilterConfig) GetBuilder() []string {
-	if x != nil {
-		return x.Builder
+func (x *SignatureRevisionFilterConfig) GetArtifact() *ArtifactSignatureConfig {
+	if x, ok := x.GetSource().(*SignatureRevisionFilterConfig_Artifact); ok {
+		return x.Artifact
 	}
 	return nil
 }
 
-// CIPDRevisionFilterConfig provides configuration for a revision filter
-// which filters out revisions which don't exist on all of the configured
-// packages and platforms.
-type CIPDRevisionFilterConfig struct {
+type isSignatureRevisionFilterConfig_Source interface {
+	isSignatureRevisionFilterConfig_Source()
+}
+
+type SignatureRevisionFilterConfig_Git struct {
+	Git *GitSignatureConfig `protobuf:"bytes,2,opt,name=git,proto3,oneof"`
+}
+
+type SignatureRevisionFilterConfig_Artifact struct {
+	Artifact *ArtifactSignatureConfig `protobuf:"bytes,3,opt,name=artifact,proto3,oneof"`
+}
+
+func (*SignatureRevisionFilterConfig_Git) isSignatureRevisionFilterConfig_Source() {}
+
+func (*SignatureRevisionFilterConfig_Artifact) isSignatureRevisionFilterConfig_Source() {}
+
+// GitSignatureConfig provides configuration for verifying OpenPGP or SSH
+// signatures on git commits or tags.
+type GitSignatureConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Package  []string `protobuf:"bytes,1,rep,name=package,proto3" json:"package,omitempty"`
-	Platform []string `protobuf:"bytes,2,rep,name=platform,proto3" json:"platform,omitempty"`
-	TagKey   string   `protobuf:"bytes,3,opt,name=tag_key,json=tagKey,proto3" json:"tag_key,omitempty"`
+	// repo_url is the URL of the git repo which contains the revisions.
+	RepoUrl string `protobuf:"bytes,1,opt,name=repo_url,json=repoUrl,proto3" json:"repo_url,omitempty"`
+	// tag_regex is optional. If set, a revision is only valid if it is pointed
+	// to by a signed tag whose name matches the regular expression. Otherwise,
+	// the commit itself must be signed.
+	TagRegex string `protobuf:"bytes,2,opt,name=tag_regex,json=tagRegex,proto3" json:"tag_regex,omitempty"`
 }
 
-func (x *CIPDRevisionFilterConfig) Reset() {
-	*x = CIPDRevisionFilterConfig{}
+func (x *GitSignatureConfig) Reset() {
+	*x = GitSignatureConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[43]
+		mi := &file_config_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CIPDRevisionFilterConfig) String() string {
+func (x *GitSignatureConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CIPDRevisionFilterConfig) ProtoMessage() {}
+func (*GitSignatureConfig) ProtoMessage() {}
 
-func (x *CIPDRevisionFilterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[43]
+func (x *GitSignatureConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4335,65 +5616,64 @@ func (x *CIPDRevisionFilterConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CIPDRevisionFilterConfig.ProtoReflect.Descriptor instead.
-func (*CIPDRevisionFilterConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *CIPDRevisionFilterConfig) GetPackage() []string {
-	if x != nil {
-		return x.Package
-	}
-	return nil
+// Deprecated: Use GitSignatureConfig.ProtoReflect.Descriptor instead.
+func (*GitSignatureConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CIPDRevisionFilterConfig) GetPlatform() []string {
+func (x *GitSignatureConfig) GetRepoUrl() string {
 	if x != nil {
-		return x.Platform
+		return x.RepoUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *CIPDRevisionFilterConfig) GetTagKey() string {
+func (x *GitSignatureConfig) GetTagRegex() string {
 	if x != nil {
-		return x.TagKey
+		return x.TagRegex
 	}
 	return ""
 }
 
-// ValidHttpRevisionFilterConfig provides configuration for a
-// revision filter which obtains a single valid revision from a file which is
-// retrieved via HTTP.
-type ValidHttpRevisionFilterConfig struct {
+// ArtifactSignatureConfig provides configuration for verifying signatures of
+// artifacts, eg. GCS files, CIPD packages or Docker images.
+type ArtifactSignatureConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// URL of the file containing the valid revision.
-	FileUrl string `protobuf:"bytes,1,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
-	// Optional regular expression used to extract the valid revision from the
-	// file after retrieval. If not provided, we assume that the entire contents
-	// of the file comprise the revision ID.
-	Regex string `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
-}
-
-func (x *ValidHttpRevisionFilterConfig) Reset() {
-	*x = ValidHttpRevisionFilterConfig{}
+	Format ArtifactSignatureConfig_Format `protobuf:"varint,1,opt,name=format,proto3,enum=autoroll.config.ArtifactSignatureConfig_Format" json:"format,omitempty"`
+	// signature_url_tmpl is the URL of the signature for a given revision. It
+	// should contain a single "%s" which is replaced with the revision ID.
+	SignatureUrlTmpl string `protobuf:"bytes,2,opt,name=signature_url_tmpl,json=signatureUrlTmpl,proto3" json:"signature_url_tmpl,omitempty"`
+	// artifact_url_tmpl is the URL of the artifact for a given revision. It
+	// should contain a single "%s" which is replaced with the revision ID.
+	// Optional; if not set, the SHA-256 checksum of the revision is used as
+	// the digest of the artifact if available (eg. for CIPD and Docker), and
+	// otherwise the artifact is downloaded from the URL of the revision (eg.
+	// for GCS). Note that signatures made with Ed25519, OpenPGP and SSH keys
+	// cover the full contents of the artifact and therefore require the
+	// artifact to be downloaded.
+	ArtifactUrlTmpl string `protobuf:"bytes,3,opt,name=artifact_url_tmpl,json=artifactUrlTmpl,proto3" json:"artifact_url_tmpl,omitempty"`
+}
+
+func (x *ArtifactSignatureConfig) Reset() {
+	*x = ArtifactSignatureConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[44]
+		mi := &file_config_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ValidHttpRevisionFilterConfig) String() string {
+func (x *ArtifactSignatureConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidHttpRevisionFilterConfig) ProtoMessage() {}
+func (*ArtifactSignatureConfig) ProtoMessage() {}
 
-func (x *ValidHttpRevisionFilterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[44]
+func (x *ArtifactSignatureConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4404,21 +5684,28 @@ func (x *ValidHttpRevisionFilterConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidHttpRevisionFilterConfig.ProtoReflect.Descriptor instead.
-func (*ValidHttpRevisionFilterConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ArtifactSignatureConfig.ProtoReflect.Descriptor instead.
+func (*ArtifactSignatureConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ValidHttpRevisionFilterConfig) GetFileUrl() string {
+func (x *ArtifactSignatureConfig) GetFormat() ArtifactSignatureConfig_Format {
 	if x != nil {
-		return x.FileUrl
+		return x.Format
+	}
+	return ArtifactSignatureConfig_DETACHED
+}
+
+func (x *ArtifactSignatureConfig) GetSignatureUrlTmpl() string {
+	if x != nil {
+		return x.SignatureUrlTmpl
 	}
 	return ""
 }
 
-func (x *ValidHttpRevisionFilterConfig) GetRegex() string {
+func (x *ArtifactSignatureConfig) GetArtifactUrlTmpl() string {
 	if x != nil {
-		return x.Regex
+		return x.ArtifactUrlTmpl
 	}
 	return ""
 }
@@ -4440,7 +5727,7 @@ type PreUploadConfig struct {
 func (x *PreUploadConfig) Reset() {
 	*x = PreUploadConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[45]
+		mi := &file_config_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4453,7 +5740,7 @@ func (x *PreUploadConfig) String() string {
 func (*PreUploadConfig) ProtoMessage() {}
 
 func (x *PreUploadConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[45]
+	mi := &file_config_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4466,7 +5753,7 @@ func (x *PreUploadConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreUploadConfig.ProtoReflect.Descriptor instead.
 func (*PreUploadConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{45}
+	return file_config_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *PreUploadConfig) GetCipdPackage() []*PreUploadCIPDPackageConfig {
@@ -4502,7 +5789,7 @@ type PreUploadCommandConfig struct {
 func (x *PreUploadCommandConfig) Reset() {
 	*x = PreUploadCommandConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[46]
+		mi := &file_config_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4515,7 +5802,7 @@ func (x *PreUploadCommandConfig) String() string {
 func (*PreUploadCommandConfig) ProtoMessage() {}
 
 func (x *PreUploadCommandConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[46]
+	mi := &file_config_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4528,7 +5815,7 @@ func (x *PreUploadCommandConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreUploadCommandConfig.ProtoReflect.Descriptor instead.
 func (*PreUploadCommandConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{46}
+	return file_config_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *PreUploadCommandConfig) GetCommand() string {
@@ -4576,7 +5863,7 @@ type PreUploadCIPDPackageConfig struct {
 func (x *PreUploadCIPDPackageConfig) Reset() {
 	*x = PreUploadCIPDPackageConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[47]
+		mi := &file_config_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4589,7 +5876,7 @@ func (x *PreUploadCIPDPackageConfig) String() string {
 func (*PreUploadCIPDPackageConfig) ProtoMessage() {}
 
 func (x *PreUploadCIPDPackageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[47]
+	mi := &file_config_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4602,7 +5889,7 @@ func (x *PreUploadCIPDPackageConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PreUploadCIPDPackageConfig.ProtoReflect.Descriptor instead.
 func (*PreUploadCIPDPackageConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{47}
+	return file_config_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *PreUploadCIPDPackageConfig) GetName() string {
@@ -4638,7 +5925,7 @@ type Configs struct {
 func (x *Configs) Reset() {
 	*x = Configs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[48]
+		mi := &file_config_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4651,7 +5938,7 @@ func (x *Configs) String() string {
 func (*Configs) ProtoMessage() {}
 
 func (x *Configs) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[48]
+	mi := &file_config_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4664,7 +5951,7 @@ func (x *Configs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Configs.ProtoReflect.Descriptor instead.
 func (*Configs) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{48}
+	return file_config_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *Configs) GetConfig() []*Config {
@@ -4674,6 +5961,65 @@ func (x *Configs) GetConfig() []*Config {
 	return nil
 }
 
+// FileArea is a named set of paths within the Child.
+type CommitMsgConfig_FileArea struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the area, used as the heading for its revisions.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// paths within the Child which make up this area. A revision belongs
+	// to the area if it modifies any file under any of these paths.
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *CommitMsgConfig_FileArea) Reset() {
+	*x = CommitMsgConfig_FileArea{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommitMsgConfig_FileArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitMsgConfig_FileArea) ProtoMessage() {}
+
+func (x *CommitMsgConfig_FileArea) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitMsgConfig_FileArea.ProtoReflect.Descriptor instead.
+func (*CommitMsgConfig_FileArea) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *CommitMsgConfig_FileArea) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CommitMsgConfig_FileArea) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
 // ProjectMetadataFileConfig provides configuration for METADATA files in
 // the Android repo.
 type AndroidRepoManagerConfig_ProjectMetadataFileConfig struct {
@@ -4698,7 +6044,7 @@ type AndroidRepoManagerConfig_ProjectMetadataFileConfig struct {
 func (x *AndroidRepoManagerConfig_ProjectMetadataFileConfig) Reset() {
 	*x = AndroidRepoManagerConfig_ProjectMetadataFileConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[49]
+		mi := &file_config_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4711,7 +6057,7 @@ func (x *AndroidRepoManagerConfig_ProjectMetadataFileConfig) String() string {
 func (*AndroidRepoManagerConfig_ProjectMetadataFileConfig) ProtoMessage() {}
 
 func (x *AndroidRepoManagerConfig_ProjectMetadataFileConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[49]
+	mi := &file_config_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4724,7 +6070,7 @@ func (x *AndroidRepoManagerConfig_ProjectMetadataFileConfig) ProtoReflect() prot
 
 // Deprecated: Use AndroidRepoManagerConfig_ProjectMetadataFileConfig.ProtoReflect.Descriptor instead.
 func (*AndroidRepoManagerConfig_ProjectMetadataFileConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{6, 0}
+	return file_config_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *AndroidRepoManagerConfig_ProjectMetadataFileConfig) GetFilePath() string {
@@ -4788,7 +6134,7 @@ type CommandRepoManagerConfig_CommandConfig struct {
 func (x *CommandRepoManagerConfig_CommandConfig) Reset() {
 	*x = CommandRepoManagerConfig_CommandConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[50]
+		mi := &file_config_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4801,7 +6147,7 @@ func (x *CommandRepoManagerConfig_CommandConfig) String() string {
 func (*CommandRepoManagerConfig_CommandConfig) ProtoMessage() {}
 
 func (x *CommandRepoManagerConfig_CommandConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[50]
+	mi := &file_config_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4814,7 +6160,7 @@ func (x *CommandRepoManagerConfig_CommandConfig) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use CommandRepoManagerConfig_CommandConfig.ProtoReflect.Descriptor instead.
 func (*CommandRepoManagerConfig_CommandConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{7, 0}
+	return file_config_proto_rawDescGZIP(), []int{9, 0}
 }
 
 func (x *CommandRepoManagerConfig_CommandConfig) GetCommand() []string {
@@ -4852,7 +6198,7 @@ type CopyParentConfig_CopyEntry struct {
 func (x *CopyParentConfig_CopyEntry) Reset() {
 	*x = CopyParentConfig_CopyEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[51]
+		mi := &file_config_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4865,7 +6211,7 @@ func (x *CopyParentConfig_CopyEntry) String() string {
 func (*CopyParentConfig_CopyEntry) ProtoMessage() {}
 
 func (x *CopyParentConfig_CopyEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[51]
+	mi := &file_config_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4878,7 +6224,7 @@ func (x *CopyParentConfig_CopyEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CopyParentConfig_CopyEntry.ProtoReflect.Descriptor instead.
 func (*CopyParentConfig_CopyEntry) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{11, 0}
+	return file_config_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *CopyParentConfig_CopyEntry) GetSrcRelPath() string {
@@ -4900,7 +6246,7 @@ var File_config_proto protoreflect.FileDescriptor
 var file_config_proto_rawDesc = []byte{
 	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
 	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
-	0x8f, 0x0f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f,
+	0xb6, 0x11, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f,
 	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0a, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63,
 	0x68, 0x69, 0x6c, 0x64, 0x5f, 0x62, 0x75, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x1d, 0x20,
@@ -4956,804 +6302,1016 @@ var file_config_proto_rawDesc = []byte{
 	0x75, 0x62, 0x12, 0x3a, 0x0a, 0x07, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x18, 0x12, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x12, 0x41,
-	0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
-	0x73, 0x12, 0x6a, 0x0a, 0x19, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69,
-	0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x16, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69,
-	0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5d, 0x0a,
-	0x14, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x6e,
-	0x64, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x12, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69,
-	0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x14,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x15, 0x66,
-	0x72, 0x65, 0x65, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x72, 0x65,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x13, 0x66, 0x72, 0x65, 0x65, 0x74, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5d, 0x0a,
-	0x14, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x33, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x33, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x09,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x09, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x73,
-	0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x1b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x54, 0x68, 0x72,
-	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x07, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x12, 0x37,
+	0x0a, 0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
 	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x44, 0x65, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x73, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x1f, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x75, 0x73, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x2e, 0x47, 0x69, 0x74, 0x4c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x06, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x12, 0x41, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4b, 0x75,
+	0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x19, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x71, 0x5f,
-	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
-	0x6d, 0x61, 0x78, 0x52, 0x6f, 0x6c, 0x6c, 0x43, 0x71, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x73, 0x12, 0x3f, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x6c,
-	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x6c,
-	0x6c, 0x43, 0x6c, 0x73, 0x54, 0x6f, 0x53, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x42, 0x0e, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x22, 0xca, 0x04, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x67, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
-	0x6c, 0x6f, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4c, 0x6f, 0x67, 0x55, 0x72, 0x6c, 0x54,
-	0x6d, 0x70, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x71, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
-	0x74, 0x72, 0x79, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63,
-	0x71, 0x45, 0x78, 0x74, 0x72, 0x61, 0x54, 0x72, 0x79, 0x62, 0x6f, 0x74, 0x73, 0x12, 0x36, 0x0a,
-	0x18, 0x63, 0x71, 0x5f, 0x64, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x5f, 0x74, 0x72, 0x79, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x14, 0x63, 0x71, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72,
-	0x79, 0x62, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x62, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54,
-	0x62, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x73, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x64, 0x57, 0x72, 0x61, 0x70, 0x12, 0x43, 0x0a,
-	0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74,
-	0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x22, 0x42, 0x0a, 0x07, 0x42, 0x75,
-	0x69, 0x6c, 0x74, 0x49, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x10, 0x01, 0x12,
-	0x11, 0x0a, 0x0d, 0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52,
-	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x03, 0x22, 0xc7,
-	0x02, 0x0a, 0x0c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x65,
-	0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xcc, 0x01, 0x0a, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x10,
-	0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
-	0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48,
-	0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x51, 0x10, 0x03, 0x12, 0x10,
-	0x0a, 0x0c, 0x4c, 0x49, 0x42, 0x41, 0x53, 0x53, 0x49, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x10, 0x04,
-	0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x42, 0x4f, 0x54,
-	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x05, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x48, 0x52,
-	0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x42, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54,
-	0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x51, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x4e, 0x44, 0x52,
-	0x4f, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x10, 0x07, 0x12, 0x17, 0x0a, 0x13, 0x41,
-	0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x5f, 0x4e, 0x4f, 0x5f,
-	0x50, 0x52, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d,
-	0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x10, 0x09, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x47, 0x69, 0x74,
-	0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70,
-	0x6f, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x70, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70,
-	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f,
-	0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x12, 0x24, 0x0a, 0x0e, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x73, 0x68, 0x4b, 0x65, 0x79,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x33, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc8, 0x02, 0x0a, 0x10, 0x4b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x1b, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
-	0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x72, 0x65, 0x61,
-	0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e,
-	0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6c, 0x61,
-	0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x1c, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x38, 0x0a,
-	0x18, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
-	0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x16, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x46, 0x6c, 0x61,
-	0x67, 0x73, 0x22, 0x8b, 0x07, 0x0a, 0x18, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x65,
-	0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x24, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65,
-	0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x62,
-	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12,
-	0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x72,
-	0x61, 0x6e, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65,
-	0x76, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x10, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x76, 0x4c, 0x69, 0x6e, 0x6b, 0x54,
-	0x6d, 0x70, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x75, 0x62,
-	0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x53, 0x75, 0x62, 0x64, 0x69, 0x72, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70,
-	0x52, 0x0e, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73,
-	0x12, 0x5f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x43, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6f,
-	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x3f, 0x0a, 0x1c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
-	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x41, 0x73, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
-	0x72, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x75, 0x67, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0xc7, 0x01, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x6f, 0x6d, 0x65, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x6d, 0x65,
-	0x50, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a,
-	0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0xef, 0x03, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f,
-	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a,
-	0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65,
-	0x76, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x57, 0x0a, 0x0b,
-	0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x16,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x14, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69,
+	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x65,
+	0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x01, 0x52, 0x12, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70,
+	0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01,
+	0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x12, 0x60, 0x0a, 0x15, 0x66, 0x72, 0x65, 0x65, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x01, 0x52, 0x13, 0x66, 0x72, 0x65, 0x65, 0x74, 0x79, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5d, 0x0a, 0x14, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x33, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x52, 0x65,
+	0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x01, 0x52, 0x12, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x14, 0x66, 0x61, 0x6e, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x27, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52,
+	0x11, 0x66, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x12, 0x3d, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18,
+	0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x12, 0x48, 0x0a, 0x0f, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x61, 0x66,
+	0x65, 0x74, 0x79, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x73, 0x18, 0x1c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x73,
+	0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x75, 0x73, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x36,
+	0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x20, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x5f, 0x63, 0x71, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x52, 0x6f, 0x6c, 0x6c, 0x43, 0x71, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x5f, 0x63, 0x6c, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x61, 0x6d, 0x65, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x22, 0x20, 0x01, 0x28, 0x05, 0x52, 0x18,
+	0x6d, 0x61, 0x78, 0x52, 0x6f, 0x6c, 0x6c, 0x43, 0x6c, 0x73, 0x54, 0x6f, 0x53, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x12, 0x4b, 0x0a, 0x10,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x63, 0x6f, 0x64,
+	0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x0e, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x22, 0xd1, 0x07, 0x0a, 0x0f, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x0a,
+	0x12, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74,
+	0x6d, 0x70, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x4c, 0x6f, 0x67, 0x55, 0x72, 0x6c, 0x54, 0x6d, 0x70, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x71,
+	0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x74, 0x72, 0x79, 0x62, 0x6f, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x71, 0x45, 0x78, 0x74, 0x72, 0x61, 0x54, 0x72, 0x79,
+	0x62, 0x6f, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x18, 0x63, 0x71, 0x5f, 0x64, 0x6f, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x72, 0x79, 0x62, 0x6f, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63, 0x71, 0x44, 0x6f, 0x4e, 0x6f, 0x74, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x79, 0x62, 0x6f, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x67, 0x12, 0x34, 0x0a,
+	0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74,
+	0x62, 0x72, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x62, 0x72, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x6f, 0x6f, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x46, 0x6f, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x5f,
+	0x77, 0x72, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x64,
+	0x57, 0x72, 0x61, 0x70, 0x12, 0x43, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x69, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d,
+	0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x49, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x12, 0x43, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61,
+	0x72, 0x65, 0x61, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x4d, 0x73, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x41, 0x72, 0x65, 0x61, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x72, 0x65, 0x61, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x1a, 0x34, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x72, 0x65, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x42, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x49,
+	0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x43, 0x41, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x03, 0x22, 0x40, 0x0a, 0x07, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x56, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x41, 0x52, 0x45, 0x41, 0x10, 0x02, 0x22, 0x64, 0x0a, 0x12,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x67, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x6c, 0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x61, 0x67,
+	0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x74, 0x61, 0x67, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xc7, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xcc, 0x01,
+	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e, 0x44, 0x52,
+	0x4f, 0x49, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x51,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x49, 0x42, 0x41, 0x53, 0x53, 0x49, 0x53, 0x54, 0x41,
+	0x4e, 0x54, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d,
+	0x5f, 0x42, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x10, 0x05, 0x12, 0x1d, 0x0a,
+	0x19, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x42, 0x4f, 0x54, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x51, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d,
+	0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x10, 0x07, 0x12,
+	0x17, 0x0a, 0x13, 0x41, 0x4e, 0x44, 0x52, 0x4f, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52,
+	0x5f, 0x4e, 0x4f, 0x5f, 0x50, 0x52, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x52, 0x4f,
+	0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x10, 0x09, 0x22, 0xbb, 0x01, 0x0a,
+	0x0c, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x73,
+	0x68, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x0c, 0x47,
+	0x69, 0x74, 0x4c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x66, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x73, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x0f, 0x0a, 0x0d, 0x47,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xc8, 0x02, 0x0a,
+	0x10, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x70, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x1b, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x19, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x45, 0x0a, 0x1f, 0x72,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x1c, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x69, 0x73, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x73, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x8b, 0x07, 0x0a, 0x18, 0x41, 0x6e, 0x64, 0x72,
+	0x6f, 0x69, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x55, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x13, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6d, 0x70, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x76,
+	0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x6d, 0x70, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x64, 0x69, 0x72, 0x12, 0x48, 0x0a, 0x10, 0x70,
+	0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x5f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x1c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x73, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x41, 0x73, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x5f, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x75, 0x74,
+	0x6f, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x42, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0xc7, 0x01, 0x0a, 0x19,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x46,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x6d, 0x65, 0x50, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x55,
+	0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xef, 0x03, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x45, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x52, 0x65, 0x67, 0x65,
+	0x78, 0x12, 0x57, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x76,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x09, 0x67, 0x65, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x12, 0x5d, 0x0a, 0x0e, 0x67, 0x65,
+	0x74, 0x5f, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x67, 0x65, 0x74,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x12, 0x5d, 0x0a, 0x0e, 0x73, 0x65, 0x74,
+	0x5f, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x37, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d,
 	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x67, 0x65, 0x74, 0x54,
-	0x69, 0x70, 0x52, 0x65, 0x76, 0x12, 0x5d, 0x0a, 0x0e, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x67, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65,
-	0x64, 0x52, 0x65, 0x76, 0x12, 0x5d, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x52, 0x65, 0x76, 0x1a, 0x4d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10,
-	0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72,
-	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65,
-	0x6e, 0x76, 0x22, 0x95, 0x01, 0x0a, 0x19, 0x46, 0x72, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x39, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x22, 0x5c, 0x0a, 0x18, 0x47, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x33, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
-	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x22, 0xe2, 0x0c, 0x0a, 0x1c, 0x50, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x70,
-	0x79, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
-	0x67, 0x0a, 0x18, 0x64, 0x65, 0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x69, 0x74,
-	0x48, 0x75, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
-	0x00, 0x52, 0x15, 0x64, 0x65, 0x70, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x18, 0x64, 0x65, 0x70, 0x73,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x45, 0x50,
-	0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x70, 0x73,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x12, 0x7a, 0x0a, 0x1f, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
-	0x74, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x46, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
-	0x52, 0x1b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a,
-	0x0e, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0d, 0x67,
-	0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x5b, 0x0a, 0x14,
-	0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x5f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f, 0x4d,
-	0x6f, 0x64, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x11, 0x67, 0x6f, 0x4d, 0x6f, 0x64, 0x47, 0x65, 0x72,
-	0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x6d, 0x0a, 0x1a, 0x67, 0x69, 0x74,
-	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74,
-	0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x72, 0x72, 0x69,
-	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
-	0x17, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x72, 0x72,
-	0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x69, 0x70, 0x64,
-	0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
-	0x49, 0x50, 0x44, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01,
-	0x52, 0x09, 0x63, 0x69, 0x70, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x54, 0x0a, 0x11, 0x66,
-	0x75, 0x63, 0x68, 0x73, 0x69, 0x61, 0x5f, 0x73, 0x64, 0x6b, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
-	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x75, 0x63, 0x68, 0x73, 0x69, 0x61,
-	0x53, 0x44, 0x4b, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01,
-	0x52, 0x0f, 0x66, 0x75, 0x63, 0x68, 0x73, 0x69, 0x61, 0x53, 0x64, 0x6b, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x12, 0x57, 0x0a, 0x12, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
-	0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x10, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x6a, 0x0a, 0x19, 0x67, 0x69,
-	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75,
-	0x62, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x16,
-	0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x48, 0x01, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x69,
-	0x6c, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x67, 0x63, 0x73,
-	0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53,
-	0x65, 0x6d, 0x56, 0x65, 0x72, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x0e, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x47, 0x63, 0x73,
-	0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x5f,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x6f,
-	0x63, 0x6b, 0x65, 0x72, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
-	0x01, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x70,
-	0x0a, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x19, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x5b, 0x0a, 0x14, 0x63, 0x69, 0x70, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x50,
+	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x1a, 0x4d, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x22, 0x95, 0x01, 0x0a, 0x19, 0x46, 0x72, 0x65, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x22,
+	0x5c, 0x0a, 0x18, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x33, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x6b, 0x0a,
+	0x17, 0x46, 0x61, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x50, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
 	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x43, 0x49, 0x50, 0x44, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x12, 0x63, 0x69, 0x70, 0x64, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x6b, 0x0a,
-	0x1a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x22, 0xe8, 0x01,
-	0x0a, 0x10, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x3e, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c,
-	0x65, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x06, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x1a, 0x4f, 0x0a, 0x09, 0x43, 0x6f, 0x70, 0x79, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f, 0x72, 0x65, 0x6c, 0x5f,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x52,
-	0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x73, 0x74, 0x5f, 0x72, 0x65,
-	0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x73,
-	0x74, 0x52, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0xbf, 0x01, 0x0a, 0x1b, 0x44, 0x45, 0x50,
-	0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x73,
-	0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61,
+	0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x22, 0xd3, 0x10, 0x0a, 0x1c, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x0b, 0x63,
+	0x6f, 0x70, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x12, 0x67, 0x0a, 0x18, 0x64, 0x65, 0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47,
+	0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x70, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x18, 0x64, 0x65,
+	0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61,
 	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
-	0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12,
-	0x35, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x6b, 0x5f, 0x72,
-	0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66,
-	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x44,
 	0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0a, 0x64, 0x65,
-	0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x73, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1d, 0x47, 0x69, 0x74,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x0c, 0x67, 0x69,
-	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x6b, 0x5f,
-	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x66, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x6c, 0x0a, 0x1d, 0x47,
-	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x0c,
-	0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69,
-	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x92, 0x02, 0x0a, 0x21, 0x47, 0x69,
-	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x51, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f,
-	0x75, 0x74, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50,
-	0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70, 0x72,
-	0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x13,
-	0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65,
-	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xbb,
-	0x01, 0x0a, 0x13, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65,
+	0x70, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x7a, 0x0a, 0x1f, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x5f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47,
+	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62,
+	0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x00, 0x52, 0x1b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x4d, 0x0a, 0x0e, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
 	0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73,
-	0x12, 0x33, 0x0a, 0x03, 0x64, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
+	0x0d, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x5b,
+	0x0a, 0x14, 0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x5f, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x5f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47,
+	0x6f, 0x4d, 0x6f, 0x64, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x11, 0x67, 0x6f, 0x4d, 0x6f, 0x64, 0x47,
+	0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x6d, 0x0a, 0x1a, 0x67,
+	0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x67, 0x65, 0x72, 0x72,
+	0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x17, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x6d, 0x0a, 0x1a, 0x67, 0x69,
+	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x67, 0x69, 0x74, 0x6c, 0x61,
+	0x62, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x4c,
+	0x61, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x17, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74,
+	0x6c, 0x61, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x62, 0x61, 0x7a,
+	0x65, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x42, 0x61, 0x7a, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x7a, 0x65, 0x6c, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x12, 0x66, 0x0a, 0x17, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x15, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x69,
+	0x70, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x43, 0x49, 0x50, 0x44, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x01, 0x52, 0x09, 0x63, 0x69, 0x70, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x54, 0x0a,
+	0x11, 0x66, 0x75, 0x63, 0x68, 0x73, 0x69, 0x61, 0x5f, 0x73, 0x64, 0x6b, 0x5f, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x75, 0x63, 0x68, 0x73,
+	0x69, 0x61, 0x53, 0x44, 0x4b, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x01, 0x52, 0x0f, 0x66, 0x75, 0x63, 0x68, 0x73, 0x69, 0x61, 0x53, 0x64, 0x6b, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x12, 0x57, 0x0a, 0x12, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x10, 0x67, 0x69, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x6a, 0x0a, 0x19,
+	0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74,
+	0x48, 0x75, 0x62, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01,
+	0x52, 0x16, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x4a, 0x0a, 0x0d, 0x67, 0x69, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x0c, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x5f, 0x67,
+	0x63, 0x73, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x53, 0x65, 0x6d, 0x56, 0x65, 0x72, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x0e, 0x73, 0x65, 0x6d, 0x76, 0x65, 0x72, 0x47,
+	0x63, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x03, 0x64, 0x65, 0x70, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x22, 0xba, 0x01, 0x0a,
-	0x0d, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
-	0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72,
-	0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x64, 0x65, 0x70,
-	0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42,
-	0x75, 0x67, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x47, 0x6f,
-	0x4d, 0x6f, 0x64, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x06, 0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x6f, 0x4d, 0x6f, 0x64, 0x50, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x67, 0x6f, 0x4d, 0x6f, 0x64,
-	0x12, 0x35, 0x0a, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x22, 0xd8, 0x02, 0x0a, 0x11, 0x47, 0x6f, 0x4d, 0x6f,
-	0x64, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a,
+	0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x12, 0x63, 0x0a, 0x16, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52,
+	0x14, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x70, 0x0a, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x19, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x14, 0x63, 0x69, 0x70, 0x64, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x49, 0x50, 0x44, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x12, 0x63, 0x69, 0x70, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x6b, 0x0a, 0x1a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x68, 0x74,
+	0x74, 0x70, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x48,
+	0x74, 0x74, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x6a, 0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x16,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x17, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x22, 0xe8, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x06, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x1a, 0x4f, 0x0a, 0x09, 0x43, 0x6f,
+	0x70, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x72, 0x63, 0x5f, 0x72,
+	0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x72, 0x63, 0x52, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x73, 0x74,
+	0x5f, 0x72, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x73, 0x74, 0x52, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0xbf, 0x01, 0x0a, 0x1b,
+	0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0a, 0x64,
+	0x65, 0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x73, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f, 0x72,
+	0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x9b, 0x01,
+	0x0a, 0x1b, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x65, 0x72, 0x72, 0x69,
+	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a,
+	0x0a, 0x64, 0x65, 0x70, 0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x65, 0x70, 0x73, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1d,
+	0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75,
+	0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a,
 	0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x61, 0x6e,
-	0x64, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0e, 0x66, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12,
-	0x48, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74,
-	0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65,
-	0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
-	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x67,
-	0x6f, 0x5f, 0x63, 0x6d, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x6f, 0x43,
-	0x6d, 0x64, 0x22, 0xa7, 0x03, 0x0a, 0x15, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x0c,
-	0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69,
-	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x5f, 0x73, 0x75, 0x62, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x75, 0x62, 0x64, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70,
-	0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a,
-	0x09, 0x72, 0x75, 0x6e, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x72, 0x75, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72,
-	0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67,
+	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x6f,
+	0x72, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x22, 0x6c,
+	0x0a, 0x1d, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x72,
+	0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x4b, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0xf5, 0x01, 0x0a,
+	0x1d, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x4c,
+	0x61, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b,
+	0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b,
+	0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69,
+	0x74, 0x4c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x67, 0x69, 0x74, 0x6c,
+	0x61, 0x62, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x22, 0x92, 0x02, 0x0a, 0x21, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x0c, 0x67, 0x69,
+	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69,
+	0x74, 0x48, 0x75, 0x62, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x48, 0x0a,
+	0x10, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
 	0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x95, 0x01, 0x0a,
-	0x17, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x65,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x5f, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x47, 0x69,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x38, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x03, 0x64,
+	0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x64, 0x65, 0x70,
+	0x12, 0x35, 0x0a, 0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x47, 0x65, 0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x67, 0x65, 0x72, 0x72, 0x69, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x0d, 0x47, 0x69, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x46, 0x0a, 0x0c,
+	0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x62, 0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x75, 0x67, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x47, 0x6f, 0x4d, 0x6f, 0x64, 0x47, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x39, 0x0a, 0x06, 0x67, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x47, 0x6f, 0x4d, 0x6f, 0x64, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x67, 0x6f, 0x4d, 0x6f, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x67,
+	0x65, 0x72, 0x72, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x65,
+	0x72, 0x72, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x67, 0x65, 0x72, 0x72,
+	0x69, 0x74, 0x22, 0xd8, 0x02, 0x0a, 0x11, 0x47, 0x6f, 0x4d, 0x6f, 0x64, 0x50, 0x61, 0x72, 0x65,
 	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f,
 	0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
 	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12,
-	0x33, 0x0a, 0x03, 0x64, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44,
-	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x03, 0x64, 0x65, 0x70, 0x22, 0x56, 0x0a, 0x14, 0x46, 0x72, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x07,
-	0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x64,
+	0x41, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x70, 0x72,
+	0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x67, 0x6f, 0x5f, 0x63, 0x6d, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x6f, 0x43, 0x6d, 0x64, 0x22, 0xa7, 0x03,
+	0x0a, 0x15, 0x44, 0x45, 0x50, 0x53, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4b, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xfd, 0x01, 0x0a,
-	0x0f, 0x43, 0x49, 0x50, 0x44, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65,
-	0x73, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x69,
-	0x74, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x54, 0x61,
-	0x67, 0x12, 0x38, 0x0a, 0x19, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x5f, 0x74, 0x61, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x54, 0x61, 0x67, 0x53, 0x74, 0x72, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x22, 0xe9, 0x01, 0x0a,
-	0x15, 0x46, 0x75, 0x63, 0x68, 0x73, 0x69, 0x61, 0x53, 0x44, 0x4b, 0x43, 0x68, 0x69, 0x6c, 0x64,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x73, 0x64, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x63, 0x53, 0x64, 0x6b, 0x12, 0x1d,
-	0x0a, 0x0a, 0x67, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x67, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2a, 0x0a,
-	0x11, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
-	0x4c, 0x69, 0x6e, 0x75, 0x78, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x74,
-	0x65, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x72, 0x62, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x6e,
-	0x75, 0x78, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x14, 0x74, 0x61, 0x72, 0x62, 0x61, 0x6c, 0x6c, 0x4c, 0x69, 0x6e, 0x75, 0x78,
-	0x50, 0x61, 0x74, 0x68, 0x54, 0x6d, 0x70, 0x6c, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6d,
-	0x56, 0x65, 0x72, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x31, 0x0a, 0x03, 0x67, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x03, 0x67, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65,
-	0x76, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x65,
-	0x78, 0x22, 0x76, 0x0a, 0x0e, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a,
-	0x11, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x67,
-	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x52, 0x65, 0x67, 0x65, 0x78, 0x22, 0x5f, 0x0a, 0x16, 0x47, 0x69, 0x74,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e,
+	0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x75, 0x62,
+	0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x53, 0x75, 0x62, 0x64, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x67, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x48,
+	0x0a, 0x10, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x75, 0x6e, 0x5f,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x75, 0x6e,
+	0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x70, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x17, 0x47, 0x69, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
 	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f,
 	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43,
 	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67,
-	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x1c, 0x47,
-	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62,
-	0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x0c, 0x67,
-	0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43,
-	0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f,
-	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70,
-	0x6f, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e,
-	0x61, 0x6d, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68,
-	0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x07, 0x67, 0x69, 0x74,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74,
-	0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69,
-	0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x61, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x6b, 0x65,
-	0x72, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0xe4, 0x05, 0x0a, 0x0e, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a,
-	0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
-	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x63, 0x68, 0x61,
-	0x74, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x08,
-	0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x73,
-	0x75, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
-	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75,
-	0x62, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
-	0x00, 0x52, 0x06, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x22, 0x43, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e,
-	0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x09, 0x0a,
-	0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x04, 0x22, 0xdc, 0x01, 0x0a, 0x07, 0x4d, 0x73, 0x67,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4e,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44,
-	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x45,
-	0x57, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x4e,
-	0x45, 0x57, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
-	0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41,
-	0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x41, 0x46, 0x45, 0x54, 0x59,
-	0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x53,
-	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x07,
-	0x12, 0x14, 0x0a, 0x10, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x52, 0x4f,
-	0x54, 0x54, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c,
-	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x2d, 0x0a, 0x13, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73,
-	0x22, 0x2d, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x22,
-	0x90, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x63,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x63, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
-	0x22, 0x56, 0x0a, 0x0e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xad, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x38, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74,
-	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x75, 0x72,
-	0x6c, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
-	0x67, 0x55, 0x72, 0x6c, 0x54, 0x6d, 0x70, 0x6c, 0x22, 0x4d, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x70, 0x65,
-	0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x07,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x33, 0x0a, 0x03, 0x64, 0x65,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03, 0x64, 0x65, 0x70, 0x22,
+	0x56, 0x0a, 0x14, 0x46, 0x72, 0x65, 0x65, 0x54, 0x79, 0x70, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07,
+	0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x11, 0x42, 0x61, 0x7a, 0x65, 0x6c,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x07,
+	0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x9c, 0x01, 0x0a,
+	0x1b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x07,
+	0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x0f,
+	0x43, 0x49, 0x50, 0x44, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x69, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x54, 0x61, 0x67,
+	0x12, 0x38, 0x0a, 0x19, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f,
+	0x74, 0x61, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x15, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x54,
+	0x61, 0x67, 0x53, 0x74, 0x72, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x15,
+	0x46, 0x75, 0x63, 0x68, 0x73, 0x69, 0x61, 0x53, 0x44, 0x4b, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x73, 0x64, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x61, 0x63, 0x53, 0x64, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x67, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x67, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4c,
+	0x69, 0x6e, 0x75, 0x78, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x63, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x35, 0x0a, 0x17, 0x74, 0x61, 0x72, 0x62, 0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x74, 0x61, 0x72, 0x62, 0x61, 0x6c, 0x6c, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x50,
+	0x61, 0x74, 0x68, 0x54, 0x6d, 0x70, 0x6c, 0x22, 0x96, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x6d, 0x56,
+	0x65, 0x72, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x31, 0x0a, 0x03, 0x67, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x03,
+	0x67, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x76,
+	0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x76, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x65, 0x78,
+	0x22, 0x76, 0x0a, 0x0e, 0x47, 0x43, 0x53, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x63, 0x73, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x63, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x63, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x63, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x52, 0x65, 0x67, 0x65, 0x78, 0x22, 0x5f, 0x0a, 0x16, 0x47, 0x69, 0x74, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x45, 0x0a, 0x0c, 0x67, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x1c, 0x47, 0x69,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x0c, 0x67, 0x69,
+	0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x69, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x67, 0x69, 0x74, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x61, 0x0a, 0x11, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x80, 0x02, 0x0a, 0x1a, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x50, 0x72, 0x65, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0xf7, 0x05, 0x0a,
+	0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x45, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x08, 0x6c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x42, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x04, 0x63,
+	0x68, 0x61, 0x74, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00,
+	0x52, 0x08, 0x6d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69, 0x6c, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x75,
+	0x62, 0x73, 0x75, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x75, 0x62,
+	0x53, 0x75, 0x62, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x48, 0x00, 0x52, 0x06, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x43, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a,
+	0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12,
+	0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x04, 0x22, 0xef, 0x01, 0x0a, 0x07, 0x4d,
+	0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x41, 0x53, 0x54,
+	0x5f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4e, 0x45, 0x57, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4e, 0x45, 0x57, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x04, 0x12, 0x18,
+	0x0a, 0x14, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x41, 0x46, 0x45,
+	0x54, 0x59, 0x5f, 0x54, 0x48, 0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a,
+	0x0f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48,
+	0x52, 0x4f, 0x54, 0x54, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x41, 0x4e, 0x55,
+	0x41, 0x4c, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x4f, 0x4c,
+	0x4c, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x54, 0x45, 0x44, 0x10, 0x0a, 0x42, 0x08, 0x0a, 0x06,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2d, 0x0a, 0x13, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2d, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x72,
+	0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f,
+	0x6f, 0x6d, 0x49, 0x64, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x4d, 0x6f, 0x6e, 0x6f, 0x72, 0x61, 0x69,
+	0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x63, 0x63, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x63, 0x63, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x70, 0x69, 0x63, 0x22, 0x56, 0x0a, 0x0e, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x99, 0x01,
+	0x0a, 0x0f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x74, 0x69, 0x6d, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4c, 0x69, 0x6e,
+	0x65, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x10, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x65, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x55,
+	0x0a, 0x0e, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x22,
+	0x5b, 0x0a, 0x1d, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xad, 0x01, 0x0a,
+	0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x38, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x3a,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x6f,
+	0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x55, 0x72, 0x6c, 0x54, 0x6d, 0x70, 0x6c, 0x22, 0x4d, 0x0a, 0x11,
 	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0xc0, 0x01, 0x0a, 0x10,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x3c, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x44,
+	0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x65, 0x70, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x61, 0x6e, 0x64,
+	0x5f, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x69, 0x6e, 0x64, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0xe2,
+	0x01, 0x0a, 0x11, 0x47, 0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x5f, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x76, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x6d, 0x70, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62,
+	0x75, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x1f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x6d, 0x70,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x54, 0x6d, 0x70, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x22, 0x69, 0x0a, 0x18, 0x43, 0x49, 0x50, 0x44, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79,
+	0x22, 0x50, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x22, 0xc9, 0x01, 0x0a, 0x1d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x03, 0x67, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x03, 0x67, 0x69, 0x74, 0x12, 0x46, 0x0a, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
 	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x70, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6e, 0x64, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x64,
-	0x41, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x11, 0x47,
-	0x69, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f,
-	0x55, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
-	0x74, 0x6d, 0x70, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x4c,
-	0x69, 0x6e, 0x6b, 0x54, 0x6d, 0x70, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x4c,
+	0x0a, 0x12, 0x47, 0x69, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x55, 0x72, 0x6c, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x22, 0xe9, 0x01, 0x0a,
+	0x17, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x47, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x55, 0x72, 0x6c, 0x54, 0x6d, 0x70, 0x6c, 0x12,
+	0x2a, 0x0a, 0x11, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x5f,
+	0x74, 0x6d, 0x70, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x72, 0x74, 0x69,
+	0x66, 0x61, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x54, 0x6d, 0x70, 0x6c, 0x22, 0x2b, 0x0a, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x54, 0x41, 0x43, 0x48, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x49, 0x47, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f,
+	0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x10, 0x01, 0x22, 0xa4, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4e, 0x0a, 0x0c,
+	0x63, 0x69, 0x70, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x49,
+	0x50, 0x44, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0b, 0x63, 0x69, 0x70, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12,
-	0x2e, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x62, 0x75, 0x67, 0x5f, 0x70,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x75, 0x67, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22,
-	0x9f, 0x01, 0x0a, 0x1f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x65,
-	0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x6d, 0x70, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x54, 0x6d, 0x70, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65,
-	0x72, 0x22, 0x69, 0x0a, 0x18, 0x43, 0x49, 0x50, 0x44, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
-	0x6f, 0x72, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x22, 0x50, 0x0a, 0x1d,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x19, 0x0a,
-	0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x22, 0xa4,
-	0x01, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x4e, 0x0a, 0x0c, 0x63, 0x69, 0x70, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72,
-	0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x49, 0x50, 0x44, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x63, 0x69, 0x70, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x7d, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65,
-	0x6e, 0x76, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x25, 0x0a,
-	0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x46, 0x61, 0x69,
-	0x6c, 0x75, 0x72, 0x65, 0x22, 0x5e, 0x0a, 0x1a, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x43, 0x49, 0x50, 0x44, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
-	0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2a, 0xfa, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74,
-	0x65, 0x70, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x47, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x50, 0x10,
-	0x01, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f,
-	0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x4f,
-	0x5f, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x49, 0x50, 0x44, 0x10, 0x03,
-	0x12, 0x1b, 0x0a, 0x17, 0x46, 0x4c, 0x55, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45,
-	0x4e, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x53, 0x10, 0x04, 0x12, 0x24, 0x0a,
-	0x20, 0x46, 0x4c, 0x55, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45,
-	0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44, 0x41, 0x52,
-	0x54, 0x10, 0x05, 0x12, 0x27, 0x0a, 0x23, 0x46, 0x4c, 0x55, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x4c,
-	0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x46,
-	0x4f, 0x52, 0x5f, 0x46, 0x55, 0x43, 0x48, 0x53, 0x49, 0x41, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d,
-	0x53, 0x4b, 0x49, 0x41, 0x5f, 0x47, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x50, 0x10, 0x07, 0x12,
-	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x10, 0x08,
-	0x12, 0x20, 0x0a, 0x1c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x54, 0x54,
-	0x45, 0x52, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44, 0x41, 0x52, 0x54,
-	0x10, 0x09, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x55, 0x4c, 0x4b, 0x41, 0x4e, 0x5f, 0x44, 0x45, 0x50,
-	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f,
-	0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x5f, 0x42, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x53, 0x53, 0x4c, 0x10, 0x0b, 0x12,
-	0x1c, 0x0a, 0x18, 0x43, 0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x4c,
-	0x5f, 0x57, 0x45, 0x42, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x54, 0x53, 0x10, 0x0c, 0x2a, 0x3a, 0x0a,
-	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x0b, 0x0a, 0x07, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
-	0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x6f, 0x2e,
-	0x73, 0x6b, 0x69, 0x61, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22,
+	0x7d, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x77, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x63, 0x77, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x5e,
+	0x0a, 0x1a, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x49, 0x50, 0x44, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a,
+	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2a, 0x30, 0x0a, 0x0f, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x07, 0x0a,
+	0x03, 0x4e, 0x50, 0x4d, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x59, 0x50, 0x49, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x41, 0x54, 0x45, 0x53, 0x10, 0x02, 0x2a, 0xfa, 0x02, 0x0a,
+	0x0d, 0x50, 0x72, 0x65, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x65, 0x70, 0x12, 0x19,
+	0x0a, 0x15, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4e, 0x47,
+	0x4c, 0x45, 0x5f, 0x47, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x50, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x43, 0x48, 0x52, 0x4f,
+	0x4d, 0x49, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x4f, 0x5f, 0x47, 0x45, 0x4e,
+	0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x49, 0x50, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
+	0x46, 0x4c, 0x55, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f,
+	0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x53, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x46, 0x4c, 0x55,
+	0x54, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x52,
+	0x49, 0x50, 0x54, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44, 0x41, 0x52, 0x54, 0x10, 0x05, 0x12,
+	0x27, 0x0a, 0x23, 0x46, 0x4c, 0x55, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x4c, 0x49, 0x43, 0x45, 0x4e,
+	0x53, 0x45, 0x5f, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x46,
+	0x55, 0x43, 0x48, 0x53, 0x49, 0x41, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4b, 0x49, 0x41,
+	0x5f, 0x47, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x50, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54,
+	0x52, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x4e, 0x46, 0x52, 0x41, 0x10, 0x08, 0x12, 0x20, 0x0a, 0x1c,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x54, 0x54, 0x45, 0x52, 0x5f, 0x44,
+	0x45, 0x50, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x44, 0x41, 0x52, 0x54, 0x10, 0x09, 0x12, 0x25,
+	0x0a, 0x21, 0x56, 0x55, 0x4c, 0x4b, 0x41, 0x4e, 0x5f, 0x44, 0x45, 0x50, 0x53, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x42, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x53, 0x53, 0x4c, 0x10, 0x0b, 0x12, 0x1c, 0x0a, 0x18, 0x43,
+	0x48, 0x52, 0x4f, 0x4d, 0x49, 0x55, 0x4d, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x57, 0x45, 0x42,
+	0x47, 0x50, 0x55, 0x5f, 0x43, 0x54, 0x53, 0x10, 0x0c, 0x2a, 0x3a, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x03, 0x42, 0x26, 0x5a, 0x24, 0x67, 0x6f, 0x2e, 0x73, 0x6b, 0x69, 0x61,
+	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x72,
+	0x6f, 0x6c, 0x6c, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5768,161 +7326,201 @@ func file_config_proto_rawDescGZIP() []byte {
 	return file_config_proto_rawDescData
 }
 
-var file_config_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_config_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_config_proto_goTypes = []interface{}{
-	(PreUploadStep)(0),                                         // 0: autoroll.config.PreUploadStep
-	(Mode)(0),                                                  // 1: autoroll.config.Mode
-	(CommitMsgConfig_BuiltIn)(0),                               // 2: autoroll.config.CommitMsgConfig.BuiltIn
-	(GerritConfig_Config)(0),                                   // 3: autoroll.config.GerritConfig.Config
-	(NotifierConfig_LogLevel)(0),                               // 4: autoroll.config.NotifierConfig.LogLevel
-	(NotifierConfig_MsgType)(0),                                // 5: autoroll.config.NotifierConfig.MsgType
-	(*Config)(nil),                                             // 6: autoroll.config.Config
-	(*CommitMsgConfig)(nil),                                    // 7: autoroll.config.CommitMsgConfig
-	(*GerritConfig)(nil),                                       // 8: autoroll.config.GerritConfig
-	(*GitHubConfig)(nil),                                       // 9: autoroll.config.GitHubConfig
-	(*Google3Config)(nil),                                      // 10: autoroll.config.Google3Config
-	(*KubernetesConfig)(nil),                                   // 11: autoroll.config.KubernetesConfig
-	(*AndroidRepoManagerConfig)(nil),                           // 12: autoroll.config.AndroidRepoManagerConfig
-	(*CommandRepoManagerConfig)(nil),                           // 13: autoroll.config.CommandRepoManagerConfig
-	(*FreeTypeRepoManagerConfig)(nil),                          // 14: autoroll.config.FreeTypeRepoManagerConfig
-	(*Google3RepoManagerConfig)(nil),                           // 15: autoroll.config.Google3RepoManagerConfig
-	(*ParentChildRepoManagerConfig)(nil),                       // 16: autoroll.config.ParentChildRepoManagerConfig
-	(*CopyParentConfig)(nil),                                   // 17: autoroll.config.CopyParentConfig
-	(*DEPSLocalGitHubParentConfig)(nil),                        // 18: autoroll.config.DEPSLocalGitHubParentConfig
-	(*DEPSLocalGerritParentConfig)(nil),                        // 19: autoroll.config.DEPSLocalGerritParentConfig
-	(*GitCheckoutGitHubParentConfig)(nil),                      // 20: autoroll.config.GitCheckoutGitHubParentConfig
-	(*GitCheckoutGerritParentConfig)(nil),                      // 21: autoroll.config.GitCheckoutGerritParentConfig
-	(*GitCheckoutGitHubFileParentConfig)(nil),                  // 22: autoroll.config.GitCheckoutGitHubFileParentConfig
-	(*GitilesParentConfig)(nil),                                // 23: autoroll.config.GitilesParentConfig
-	(*GitilesConfig)(nil),                                      // 24: autoroll.config.GitilesConfig
-	(*GoModGerritParentConfig)(nil),                            // 25: autoroll.config.GoModGerritParentConfig
-	(*GoModParentConfig)(nil),                                  // 26: autoroll.config.GoModParentConfig
-	(*DEPSLocalParentConfig)(nil),                              // 27: autoroll.config.DEPSLocalParentConfig
-	(*GitCheckoutParentConfig)(nil),                            // 28: autoroll.config.GitCheckoutParentConfig
-	(*FreeTypeParentConfig)(nil),                               // 29: autoroll.config.FreeTypeParentConfig
-	(*CIPDChildConfig)(nil),                                    // 30: autoroll.config.CIPDChildConfig
-	(*FuchsiaSDKChildConfig)(nil),                              // 31: autoroll.config.FuchsiaSDKChildConfig
-	(*SemVerGCSChildConfig)(nil),                               // 32: autoroll.config.SemVerGCSChildConfig
-	(*GCSChildConfig)(nil),                                     // 33: autoroll.config.GCSChildConfig
-	(*GitCheckoutChildConfig)(nil),                             // 34: autoroll.config.GitCheckoutChildConfig
-	(*GitCheckoutGitHubChildConfig)(nil),                       // 35: autoroll.config.GitCheckoutGitHubChildConfig
-	(*GitilesChildConfig)(nil),                                 // 36: autoroll.config.GitilesChildConfig
-	(*DockerChildConfig)(nil),                                  // 37: autoroll.config.DockerChildConfig
-	(*NotifierConfig)(nil),                                     // 38: autoroll.config.NotifierConfig
-	(*EmailNotifierConfig)(nil),                                // 39: autoroll.config.EmailNotifierConfig
-	(*ChatNotifierConfig)(nil),                                 // 40: autoroll.config.ChatNotifierConfig
-	(*MonorailNotifierConfig)(nil),                             // 41: autoroll.config.MonorailNotifierConfig
-	(*PubSubNotifierConfig)(nil),                               // 42: autoroll.config.PubSubNotifierConfig
-	(*ThrottleConfig)(nil),                                     // 43: autoroll.config.ThrottleConfig
-	(*TransitiveDepConfig)(nil),                                // 44: autoroll.config.TransitiveDepConfig
-	(*VersionFileConfig)(nil),                                  // 45: autoroll.config.VersionFileConfig
-	(*DependencyConfig)(nil),                                   // 46: autoroll.config.DependencyConfig
-	(*GitCheckoutConfig)(nil),                                  // 47: autoroll.config.GitCheckoutConfig
-	(*BuildbucketRevisionFilterConfig)(nil),                    // 48: autoroll.config.BuildbucketRevisionFilterConfig
-	(*CIPDRevisionFilterConfig)(nil),                           // 49: autoroll.config.CIPDRevisionFilterConfig
-	(*ValidHttpRevisionFilterConfig)(nil),                      // 50: autoroll.config.ValidHttpRevisionFilterConfig
-	(*PreUploadConfig)(nil),                                    // 51: autoroll.config.PreUploadConfig
-	(*PreUploadCommandConfig)(nil),                             // 52: autoroll.config.PreUploadCommandConfig
-	(*PreUploadCIPDPackageConfig)(nil),                         // 53: autoroll.config.PreUploadCIPDPackageConfig
-	(*Configs)(nil),                                            // 54: autoroll.config.Configs
-	(*AndroidRepoManagerConfig_ProjectMetadataFileConfig)(nil), // 55: autoroll.config.AndroidRepoManagerConfig.ProjectMetadataFileConfig
-	(*CommandRepoManagerConfig_CommandConfig)(nil),             // 56: autoroll.config.CommandRepoManagerConfig.CommandConfig
-	(*CopyParentConfig_CopyEntry)(nil),                         // 57: autoroll.config.CopyParentConfig.CopyEntry
+	(PackageRegistry)(0),                                       // 0: autoroll.config.PackageRegistry
+	(PreUploadStep)(0),                                         // 1: autoroll.config.PreUploadStep
+	(Mode)(0),                                                  // 2: autoroll.config.Mode
+	(CommitMsgConfig_BuiltIn)(0),                               // 3: autoroll.config.CommitMsgConfig.BuiltIn
+	(CommitMsgConfig_GroupBy)(0),                               // 4: autoroll.config.CommitMsgConfig.GroupBy
+	(GerritConfig_Config)(0),                                   // 5: autoroll.config.GerritConfig.Config
+	(NotifierConfig_LogLevel)(0),                               // 6: autoroll.config.NotifierConfig.LogLevel
+	(NotifierConfig_MsgType)(0),                                // 7: autoroll.config.NotifierConfig.MsgType
+	(ArtifactSignatureConfig_Format)(0),                        // 8: autoroll.config.ArtifactSignatureConfig.Format
+	(*Config)(nil),                                             // 9: autoroll.config.Config
+	(*CommitMsgConfig)(nil),                                    // 10: autoroll.config.CommitMsgConfig
+	(*ReleaseNotesConfig)(nil),                                 // 11: autoroll.config.ReleaseNotesConfig
+	(*GerritConfig)(nil),                                       // 12: autoroll.config.GerritConfig
+	(*GitHubConfig)(nil),                                       // 13: autoroll.config.GitHubConfig
+	(*GitLabConfig)(nil),                                       // 14: autoroll.config.GitLabConfig
+	(*Google3Config)(nil),                                      // 15: autoroll.config.Google3Config
+	(*KubernetesConfig)(nil),                                   // 16: autoroll.config.KubernetesConfig
+	(*AndroidRepoManagerConfig)(nil),                           // 17: autoroll.config.AndroidRepoManagerConfig
+	(*CommandRepoManagerConfig)(nil),                           // 18: autoroll.config.CommandRepoManagerConfig
+	(*FreeTypeRepoManagerConfig)(nil),                          // 19: autoroll.config.FreeTypeRepoManagerConfig
+	(*Google3RepoManagerConfig)(nil),                           // 20: autoroll.config.Google3RepoManagerConfig
+	(*FanOutRepoManagerConfig)(nil),                            // 21: autoroll.config.FanOutRepoManagerConfig
+	(*ParentChildRepoManagerConfig)(nil),                       // 22: autoroll.config.ParentChildRepoManagerConfig
+	(*CopyParentConfig)(nil),                                   // 23: autoroll.config.CopyParentConfig
+	(*DEPSLocalGitHubParentConfig)(nil),                        // 24: autoroll.config.DEPSLocalGitHubParentConfig
+	(*DEPSLocalGerritParentConfig)(nil),                        // 25: autoroll.config.DEPSLocalGerritParentConfig
+	(*GitCheckoutGitHubParentConfig)(nil),                      // 26: autoroll.config.GitCheckoutGitHubParentConfig
+	(*GitCheckoutGerritParentConfig)(nil),                      // 27: autoroll.config.GitCheckoutGerritParentConfig
+	(*GitCheckoutGitLabParentConfig)(nil),                      // 28: autoroll.config.GitCheckoutGitLabParentConfig
+	(*GitCheckoutGitHubFileParentConfig)(nil),                  // 29: autoroll.config.GitCheckoutGitHubFileParentConfig
+	(*GitilesParentConfig)(nil),                                // 30: autoroll.config.GitilesParentConfig
+	(*GitilesConfig)(nil),                                      // 31: autoroll.config.GitilesConfig
+	(*GoModGerritParentConfig)(nil),                            // 32: autoroll.config.GoModGerritParentConfig
+	(*GoModParentConfig)(nil),                                  // 33: autoroll.config.GoModParentConfig
+	(*DEPSLocalParentConfig)(nil),                              // 34: autoroll.config.DEPSLocalParentConfig
+	(*GitCheckoutParentConfig)(nil),                            // 35: autoroll.config.GitCheckoutParentConfig
+	(*FreeTypeParentConfig)(nil),                               // 36: autoroll.config.FreeTypeParentConfig
+	(*BazelParentConfig)(nil),                                  // 37: autoroll.config.BazelParentConfig
+	(*PackageRegistryParentConfig)(nil),                        // 38: autoroll.config.PackageRegistryParentConfig
+	(*CIPDChildConfig)(nil),                                    // 39: autoroll.config.CIPDChildConfig
+	(*FuchsiaSDKChildConfig)(nil),                              // 40: autoroll.config.FuchsiaSDKChildConfig
+	(*SemVerGCSChildConfig)(nil),                               // 41: autoroll.config.SemVerGCSChildConfig
+	(*GCSChildConfig)(nil),                                     // 42: autoroll.config.GCSChildConfig
+	(*GitCheckoutChildConfig)(nil),                             // 43: autoroll.config.GitCheckoutChildConfig
+	(*GitCheckoutGitHubChildConfig)(nil),                       // 44: autoroll.config.GitCheckoutGitHubChildConfig
+	(*GitilesChildConfig)(nil),                                 // 45: autoroll.config.GitilesChildConfig
+	(*DockerChildConfig)(nil),                                  // 46: autoroll.config.DockerChildConfig
+	(*PackageRegistryChildConfig)(nil),                         // 47: autoroll.config.PackageRegistryChildConfig
+	(*NotifierConfig)(nil),                                     // 48: autoroll.config.NotifierConfig
+	(*EmailNotifierConfig)(nil),                                // 49: autoroll.config.EmailNotifierConfig
+	(*ChatNotifierConfig)(nil),                                 // 50: autoroll.config.ChatNotifierConfig
+	(*MonorailNotifierConfig)(nil),                             // 51: autoroll.config.MonorailNotifierConfig
+	(*PubSubNotifierConfig)(nil),                               // 52: autoroll.config.PubSubNotifierConfig
+	(*ThrottleConfig)(nil),                                     // 53: autoroll.config.ThrottleConfig
+	(*StrategyOptions)(nil),                                    // 54: autoroll.config.StrategyOptions
+	(*AutoRevertConfig)(nil),                                   // 55: autoroll.config.AutoRevertConfig
+	(*AutoRevertTaskSchedulerConfig)(nil),                      // 56: autoroll.config.AutoRevertTaskSchedulerConfig
+	(*TransitiveDepConfig)(nil),                                // 57: autoroll.config.TransitiveDepConfig
+	(*VersionFileConfig)(nil),                                  // 58: autoroll.config.VersionFileConfig
+	(*DependencyConfig)(nil),                                   // 59: autoroll.config.DependencyConfig
+	(*GitCheckoutConfig)(nil),                                  // 60: autoroll.config.GitCheckoutConfig
+	(*BuildbucketRevisionFilterConfig)(nil),                    // 61: autoroll.config.BuildbucketRevisionFilterConfig
+	(*CIPDRevisionFilterConfig)(nil),                           // 62: autoroll.config.CIPDRevisionFilterConfig
+	(*ValidHttpRevisionFilterConfig)(nil),                      // 63: autoroll.config.ValidHttpRevisionFilterConfig
+	(*SignatureRevisionFilterConfig)(nil),                      // 64: autoroll.config.SignatureRevisionFilterConfig
+	(*GitSignatureConfig)(nil),                                 // 65: autoroll.config.GitSignatureConfig
+	(*ArtifactSignatureConfig)(nil),                            // 66: autoroll.config.ArtifactSignatureConfig
+	(*PreUploadConfig)(nil),                                    // 67: autoroll.config.PreUploadConfig
+	(*PreUploadCommandConfig)(nil),                             // 68: autoroll.config.PreUploadCommandConfig
+	(*PreUploadCIPDPackageConfig)(nil),                         // 69: autoroll.config.PreUploadCIPDPackageConfig
+	(*Configs)(nil),                                            // 70: autoroll.config.Configs
+	(*CommitMsgConfig_FileArea)(nil),                           // 71: autoroll.config.CommitMsgConfig.FileArea
+	(*AndroidRepoManagerConfig_ProjectMetadataFileConfig)(nil), // 72: autoroll.config.AndroidRepoManagerConfig.ProjectMetadataFileConfig
+	(*CommandRepoManagerConfig_CommandConfig)(nil),             // 73: autoroll.config.CommandRepoManagerConfig.CommandConfig
+	(*CopyParentConfig_CopyEntry)(nil),                         // 74: autoroll.config.CopyParentConfig.CopyEntry
 }
 var file_config_proto_depIdxs = []int32{
-	7,  // 0: autoroll.config.Config.commit_msg:type_name -> autoroll.config.CommitMsgConfig
-	8,  // 1: autoroll.config.Config.gerrit:type_name -> autoroll.config.GerritConfig
-	9,  // 2: autoroll.config.Config.github:type_name -> autoroll.config.GitHubConfig
-	10, // 3: autoroll.config.Config.google3:type_name -> autoroll.config.Google3Config
-	11, // 4: autoroll.config.Config.kubernetes:type_name -> autoroll.config.KubernetesConfig
-	16, // 5: autoroll.config.Config.parent_child_repo_manager:type_name -> autoroll.config.ParentChildRepoManagerConfig
-	12, // 6: autoroll.config.Config.android_repo_manager:type_name -> autoroll.config.AndroidRepoManagerConfig
-	13, // 7: autoroll.config.Config.command_repo_manager:type_name -> autoroll.config.CommandRepoManagerConfig
-	14, // 8: autoroll.config.Config.freetype_repo_manager:type_name -> autoroll.config.FreeTypeRepoManagerConfig
-	15, // 9: autoroll.config.Config.google3_repo_manager:type_name -> autoroll.config.Google3RepoManagerConfig
-	38, // 10: autoroll.config.Config.notifiers:type_name -> autoroll.config.NotifierConfig
-	43, // 11: autoroll.config.Config.safety_throttle:type_name -> autoroll.config.ThrottleConfig
-	44, // 12: autoroll.config.Config.transitive_deps:type_name -> autoroll.config.TransitiveDepConfig
-	1,  // 13: autoroll.config.Config.valid_modes:type_name -> autoroll.config.Mode
-	2,  // 14: autoroll.config.CommitMsgConfig.built_in:type_name -> autoroll.config.CommitMsgConfig.BuiltIn
-	3,  // 15: autoroll.config.GerritConfig.config:type_name -> autoroll.config.GerritConfig.Config
-	0,  // 16: autoroll.config.AndroidRepoManagerConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
-	55, // 17: autoroll.config.AndroidRepoManagerConfig.metadata:type_name -> autoroll.config.AndroidRepoManagerConfig.ProjectMetadataFileConfig
-	51, // 18: autoroll.config.AndroidRepoManagerConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
-	47, // 19: autoroll.config.CommandRepoManagerConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
-	56, // 20: autoroll.config.CommandRepoManagerConfig.get_tip_rev:type_name -> autoroll.config.CommandRepoManagerConfig.CommandConfig
-	56, // 21: autoroll.config.CommandRepoManagerConfig.get_pinned_rev:type_name -> autoroll.config.CommandRepoManagerConfig.CommandConfig
-	56, // 22: autoroll.config.CommandRepoManagerConfig.set_pinned_rev:type_name -> autoroll.config.CommandRepoManagerConfig.CommandConfig
-	29, // 23: autoroll.config.FreeTypeRepoManagerConfig.parent:type_name -> autoroll.config.FreeTypeParentConfig
-	36, // 24: autoroll.config.FreeTypeRepoManagerConfig.child:type_name -> autoroll.config.GitilesChildConfig
-	17, // 25: autoroll.config.ParentChildRepoManagerConfig.copy_parent:type_name -> autoroll.config.CopyParentConfig
-	18, // 26: autoroll.config.ParentChildRepoManagerConfig.deps_local_github_parent:type_name -> autoroll.config.DEPSLocalGitHubParentConfig
-	19, // 27: autoroll.config.ParentChildRepoManagerConfig.deps_local_gerrit_parent:type_name -> autoroll.config.DEPSLocalGerritParentConfig
-	22, // 28: autoroll.config.ParentChildRepoManagerConfig.git_checkout_github_file_parent:type_name -> autoroll.config.GitCheckoutGitHubFileParentConfig
-	23, // 29: autoroll.config.ParentChildRepoManagerConfig.gitiles_parent:type_name -> autoroll.config.GitilesParentConfig
-	25, // 30: autoroll.config.ParentChildRepoManagerConfig.go_mod_gerrit_parent:type_name -> autoroll.config.GoModGerritParentConfig
-	21, // 31: autoroll.config.ParentChildRepoManagerConfig.git_checkout_gerrit_parent:type_name -> autoroll.config.GitCheckoutGerritParentConfig
-	30, // 32: autoroll.config.ParentChildRepoManagerConfig.cipd_child:type_name -> autoroll.config.CIPDChildConfig
-	31, // 33: autoroll.config.ParentChildRepoManagerConfig.fuchsia_sdk_child:type_name -> autoroll.config.FuchsiaSDKChildConfig
-	34, // 34: autoroll.config.ParentChildRepoManagerConfig.git_checkout_child:type_name -> autoroll.config.GitCheckoutChildConfig
-	35, // 35: autoroll.config.ParentChildRepoManagerConfig.git_checkout_github_child:type_name -> autoroll.config.GitCheckoutGitHubChildConfig
-	36, // 36: autoroll.config.ParentChildRepoManagerConfig.gitiles_child:type_name -> autoroll.config.GitilesChildConfig
-	32, // 37: autoroll.config.ParentChildRepoManagerConfig.semver_gcs_child:type_name -> autoroll.config.SemVerGCSChildConfig
-	37, // 38: autoroll.config.ParentChildRepoManagerConfig.docker_child:type_name -> autoroll.config.DockerChildConfig
-	48, // 39: autoroll.config.ParentChildRepoManagerConfig.buildbucket_revision_filter:type_name -> autoroll.config.BuildbucketRevisionFilterConfig
-	49, // 40: autoroll.config.ParentChildRepoManagerConfig.cipd_revision_filter:type_name -> autoroll.config.CIPDRevisionFilterConfig
-	50, // 41: autoroll.config.ParentChildRepoManagerConfig.valid_http_revision_filter:type_name -> autoroll.config.ValidHttpRevisionFilterConfig
-	23, // 42: autoroll.config.CopyParentConfig.gitiles:type_name -> autoroll.config.GitilesParentConfig
-	57, // 43: autoroll.config.CopyParentConfig.copies:type_name -> autoroll.config.CopyParentConfig.CopyEntry
-	27, // 44: autoroll.config.DEPSLocalGitHubParentConfig.deps_local:type_name -> autoroll.config.DEPSLocalParentConfig
-	9,  // 45: autoroll.config.DEPSLocalGitHubParentConfig.github:type_name -> autoroll.config.GitHubConfig
-	27, // 46: autoroll.config.DEPSLocalGerritParentConfig.deps_local:type_name -> autoroll.config.DEPSLocalParentConfig
-	8,  // 47: autoroll.config.DEPSLocalGerritParentConfig.gerrit:type_name -> autoroll.config.GerritConfig
-	28, // 48: autoroll.config.GitCheckoutGitHubParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
-	28, // 49: autoroll.config.GitCheckoutGerritParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
-	20, // 50: autoroll.config.GitCheckoutGitHubFileParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutGitHubParentConfig
-	0,  // 51: autoroll.config.GitCheckoutGitHubFileParentConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
-	51, // 52: autoroll.config.GitCheckoutGitHubFileParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
-	24, // 53: autoroll.config.GitilesParentConfig.gitiles:type_name -> autoroll.config.GitilesConfig
-	46, // 54: autoroll.config.GitilesParentConfig.dep:type_name -> autoroll.config.DependencyConfig
-	8,  // 55: autoroll.config.GitilesParentConfig.gerrit:type_name -> autoroll.config.GerritConfig
-	45, // 56: autoroll.config.GitilesConfig.dependencies:type_name -> autoroll.config.VersionFileConfig
-	26, // 57: autoroll.config.GoModGerritParentConfig.go_mod:type_name -> autoroll.config.GoModParentConfig
-	8,  // 58: autoroll.config.GoModGerritParentConfig.gerrit:type_name -> autoroll.config.GerritConfig
-	47, // 59: autoroll.config.GoModParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
-	0,  // 60: autoroll.config.GoModParentConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
-	51, // 61: autoroll.config.GoModParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
-	28, // 62: autoroll.config.DEPSLocalParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
-	0,  // 63: autoroll.config.DEPSLocalParentConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
-	51, // 64: autoroll.config.DEPSLocalParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
-	47, // 65: autoroll.config.GitCheckoutParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
-	46, // 66: autoroll.config.GitCheckoutParentConfig.dep:type_name -> autoroll.config.DependencyConfig
-	23, // 67: autoroll.config.FreeTypeParentConfig.gitiles:type_name -> autoroll.config.GitilesParentConfig
-	24, // 68: autoroll.config.CIPDChildConfig.source_repo:type_name -> autoroll.config.GitilesConfig
-	33, // 69: autoroll.config.SemVerGCSChildConfig.gcs:type_name -> autoroll.config.GCSChildConfig
-	47, // 70: autoroll.config.GitCheckoutChildConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
-	34, // 71: autoroll.config.GitCheckoutGitHubChildConfig.git_checkout:type_name -> autoroll.config.GitCheckoutChildConfig
-	24, // 72: autoroll.config.GitilesChildConfig.gitiles:type_name -> autoroll.config.GitilesConfig
-	4,  // 73: autoroll.config.NotifierConfig.log_level:type_name -> autoroll.config.NotifierConfig.LogLevel
-	5,  // 74: autoroll.config.NotifierConfig.msg_type:type_name -> autoroll.config.NotifierConfig.MsgType
-	39, // 75: autoroll.config.NotifierConfig.email:type_name -> autoroll.config.EmailNotifierConfig
-	40, // 76: autoroll.config.NotifierConfig.chat:type_name -> autoroll.config.ChatNotifierConfig
-	41, // 77: autoroll.config.NotifierConfig.monorail:type_name -> autoroll.config.MonorailNotifierConfig
-	42, // 78: autoroll.config.NotifierConfig.pubsub:type_name -> autoroll.config.PubSubNotifierConfig
-	45, // 79: autoroll.config.TransitiveDepConfig.child:type_name -> autoroll.config.VersionFileConfig
-	45, // 80: autoroll.config.TransitiveDepConfig.parent:type_name -> autoroll.config.VersionFileConfig
-	45, // 81: autoroll.config.DependencyConfig.primary:type_name -> autoroll.config.VersionFileConfig
-	44, // 82: autoroll.config.DependencyConfig.transitive:type_name -> autoroll.config.TransitiveDepConfig
-	45, // 83: autoroll.config.GitCheckoutConfig.dependencies:type_name -> autoroll.config.VersionFileConfig
-	53, // 84: autoroll.config.PreUploadConfig.cipd_package:type_name -> autoroll.config.PreUploadCIPDPackageConfig
-	52, // 85: autoroll.config.PreUploadConfig.command:type_name -> autoroll.config.PreUploadCommandConfig
-	6,  // 86: autoroll.config.Configs.config:type_name -> autoroll.config.Config
-	87, // [87:87] is the sub-list for method output_type
-	87, // [87:87] is the sub-list for method input_type
-	87, // [87:87] is the sub-list for extension type_name
-	87, // [87:87] is the sub-list for extension extendee
-	0,  // [0:87] is the sub-list for field type_name
+	10,  // 0: autoroll.config.Config.commit_msg:type_name -> autoroll.config.CommitMsgConfig
+	12,  // 1: autoroll.config.Config.gerrit:type_name -> autoroll.config.GerritConfig
+	13,  // 2: autoroll.config.Config.github:type_name -> autoroll.config.GitHubConfig
+	15,  // 3: autoroll.config.Config.google3:type_name -> autoroll.config.Google3Config
+	14,  // 4: autoroll.config.Config.gitlab:type_name -> autoroll.config.GitLabConfig
+	16,  // 5: autoroll.config.Config.kubernetes:type_name -> autoroll.config.KubernetesConfig
+	22,  // 6: autoroll.config.Config.parent_child_repo_manager:type_name -> autoroll.config.ParentChildRepoManagerConfig
+	17,  // 7: autoroll.config.Config.android_repo_manager:type_name -> autoroll.config.AndroidRepoManagerConfig
+	18,  // 8: autoroll.config.Config.command_repo_manager:type_name -> autoroll.config.CommandRepoManagerConfig
+	19,  // 9: autoroll.config.Config.freetype_repo_manager:type_name -> autoroll.config.FreeTypeRepoManagerConfig
+	20,  // 10: autoroll.config.Config.google3_repo_manager:type_name -> autoroll.config.Google3RepoManagerConfig
+	21,  // 11: autoroll.config.Config.fan_out_repo_manager:type_name -> autoroll.config.FanOutRepoManagerConfig
+	48,  // 12: autoroll.config.Config.notifiers:type_name -> autoroll.config.NotifierConfig
+	53,  // 13: autoroll.config.Config.safety_throttle:type_name -> autoroll.config.ThrottleConfig
+	57,  // 14: autoroll.config.Config.transitive_deps:type_name -> autoroll.config.TransitiveDepConfig
+	2,   // 15: autoroll.config.Config.valid_modes:type_name -> autoroll.config.Mode
+	55,  // 16: autoroll.config.Config.auto_revert:type_name -> autoroll.config.AutoRevertConfig
+	54,  // 17: autoroll.config.Config.strategy_options:type_name -> autoroll.config.StrategyOptions
+	3,   // 18: autoroll.config.CommitMsgConfig.built_in:type_name -> autoroll.config.CommitMsgConfig.BuiltIn
+	4,   // 19: autoroll.config.CommitMsgConfig.group_by:type_name -> autoroll.config.CommitMsgConfig.GroupBy
+	71,  // 20: autoroll.config.CommitMsgConfig.file_areas:type_name -> autoroll.config.CommitMsgConfig.FileArea
+	11,  // 21: autoroll.config.CommitMsgConfig.release_notes:type_name -> autoroll.config.ReleaseNotesConfig
+	5,   // 22: autoroll.config.GerritConfig.config:type_name -> autoroll.config.GerritConfig.Config
+	1,   // 23: autoroll.config.AndroidRepoManagerConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
+	72,  // 24: autoroll.config.AndroidRepoManagerConfig.metadata:type_name -> autoroll.config.AndroidRepoManagerConfig.ProjectMetadataFileConfig
+	67,  // 25: autoroll.config.AndroidRepoManagerConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
+	60,  // 26: autoroll.config.CommandRepoManagerConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
+	73,  // 27: autoroll.config.CommandRepoManagerConfig.get_tip_rev:type_name -> autoroll.config.CommandRepoManagerConfig.CommandConfig
+	73,  // 28: autoroll.config.CommandRepoManagerConfig.get_pinned_rev:type_name -> autoroll.config.CommandRepoManagerConfig.CommandConfig
+	73,  // 29: autoroll.config.CommandRepoManagerConfig.set_pinned_rev:type_name -> autoroll.config.CommandRepoManagerConfig.CommandConfig
+	36,  // 30: autoroll.config.FreeTypeRepoManagerConfig.parent:type_name -> autoroll.config.FreeTypeParentConfig
+	45,  // 31: autoroll.config.FreeTypeRepoManagerConfig.child:type_name -> autoroll.config.GitilesChildConfig
+	22,  // 32: autoroll.config.FanOutRepoManagerConfig.parent_child:type_name -> autoroll.config.ParentChildRepoManagerConfig
+	23,  // 33: autoroll.config.ParentChildRepoManagerConfig.copy_parent:type_name -> autoroll.config.CopyParentConfig
+	24,  // 34: autoroll.config.ParentChildRepoManagerConfig.deps_local_github_parent:type_name -> autoroll.config.DEPSLocalGitHubParentConfig
+	25,  // 35: autoroll.config.ParentChildRepoManagerConfig.deps_local_gerrit_parent:type_name -> autoroll.config.DEPSLocalGerritParentConfig
+	29,  // 36: autoroll.config.ParentChildRepoManagerConfig.git_checkout_github_file_parent:type_name -> autoroll.config.GitCheckoutGitHubFileParentConfig
+	30,  // 37: autoroll.config.ParentChildRepoManagerConfig.gitiles_parent:type_name -> autoroll.config.GitilesParentConfig
+	32,  // 38: autoroll.config.ParentChildRepoManagerConfig.go_mod_gerrit_parent:type_name -> autoroll.config.GoModGerritParentConfig
+	27,  // 39: autoroll.config.ParentChildRepoManagerConfig.git_checkout_gerrit_parent:type_name -> autoroll.config.GitCheckoutGerritParentConfig
+	28,  // 40: autoroll.config.ParentChildRepoManagerConfig.git_checkout_gitlab_parent:type_name -> autoroll.config.GitCheckoutGitLabParentConfig
+	37,  // 41: autoroll.config.ParentChildRepoManagerConfig.bazel_parent:type_name -> autoroll.config.BazelParentConfig
+	38,  // 42: autoroll.config.ParentChildRepoManagerConfig.package_registry_parent:type_name -> autoroll.config.PackageRegistryParentConfig
+	39,  // 43: autoroll.config.ParentChildRepoManagerConfig.cipd_child:type_name -> autoroll.config.CIPDChildConfig
+	40,  // 44: autoroll.config.ParentChildRepoManagerConfig.fuchsia_sdk_child:type_name -> autoroll.config.FuchsiaSDKChildConfig
+	43,  // 45: autoroll.config.ParentChildRepoManagerConfig.git_checkout_child:type_name -> autoroll.config.GitCheckoutChildConfig
+	44,  // 46: autoroll.config.ParentChildRepoManagerConfig.git_checkout_github_child:type_name -> autoroll.config.GitCheckoutGitHubChildConfig
+	45,  // 47: autoroll.config.ParentChildRepoManagerConfig.gitiles_child:type_name -> autoroll.config.GitilesChildConfig
+	41,  // 48: autoroll.config.ParentChildRepoManagerConfig.semver_gcs_child:type_name -> autoroll.config.SemVerGCSChildConfig
+	46,  // 49: autoroll.config.ParentChildRepoManagerConfig.docker_child:type_name -> autoroll.config.DockerChildConfig
+	47,  // 50: autoroll.config.ParentChildRepoManagerConfig.package_registry_child:type_name -> autoroll.config.PackageRegistryChildConfig
+	61,  // 51: autoroll.config.ParentChildRepoManagerConfig.buildbucket_revision_filter:type_name -> autoroll.config.BuildbucketRevisionFilterConfig
+	62,  // 52: autoroll.config.ParentChildRepoManagerConfig.cipd_revision_filter:type_name -> autoroll.config.CIPDRevisionFilterConfig
+	63,  // 53: autoroll.config.ParentChildRepoManagerConfig.valid_http_revision_filter:type_name -> autoroll.config.ValidHttpRevisionFilterConfig
+	64,  // 54: autoroll.config.ParentChildRepoManagerConfig.signature_revision_filter:type_name -> autoroll.config.SignatureRevisionFilterConfig
+	30,  // 55: autoroll.config.CopyParentConfig.gitiles:type_name -> autoroll.config.GitilesParentConfig
+	74,  // 56: autoroll.config.CopyParentConfig.copies:type_name -> autoroll.config.CopyParentConfig.CopyEntry
+	34,  // 57: autoroll.config.DEPSLocalGitHubParentConfig.deps_local:type_name -> autoroll.config.DEPSLocalParentConfig
+	13,  // 58: autoroll.config.DEPSLocalGitHubParentConfig.github:type_name -> autoroll.config.GitHubConfig
+	34,  // 59: autoroll.config.DEPSLocalGerritParentConfig.deps_local:type_name -> autoroll.config.DEPSLocalParentConfig
+	12,  // 60: autoroll.config.DEPSLocalGerritParentConfig.gerrit:type_name -> autoroll.config.GerritConfig
+	35,  // 61: autoroll.config.GitCheckoutGitHubParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
+	35,  // 62: autoroll.config.GitCheckoutGerritParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
+	35,  // 63: autoroll.config.GitCheckoutGitLabParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
+	14,  // 64: autoroll.config.GitCheckoutGitLabParentConfig.gitlab:type_name -> autoroll.config.GitLabConfig
+	67,  // 65: autoroll.config.GitCheckoutGitLabParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
+	26,  // 66: autoroll.config.GitCheckoutGitHubFileParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutGitHubParentConfig
+	1,   // 67: autoroll.config.GitCheckoutGitHubFileParentConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
+	67,  // 68: autoroll.config.GitCheckoutGitHubFileParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
+	31,  // 69: autoroll.config.GitilesParentConfig.gitiles:type_name -> autoroll.config.GitilesConfig
+	59,  // 70: autoroll.config.GitilesParentConfig.dep:type_name -> autoroll.config.DependencyConfig
+	12,  // 71: autoroll.config.GitilesParentConfig.gerrit:type_name -> autoroll.config.GerritConfig
+	58,  // 72: autoroll.config.GitilesConfig.dependencies:type_name -> autoroll.config.VersionFileConfig
+	33,  // 73: autoroll.config.GoModGerritParentConfig.go_mod:type_name -> autoroll.config.GoModParentConfig
+	12,  // 74: autoroll.config.GoModGerritParentConfig.gerrit:type_name -> autoroll.config.GerritConfig
+	60,  // 75: autoroll.config.GoModParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
+	1,   // 76: autoroll.config.GoModParentConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
+	67,  // 77: autoroll.config.GoModParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
+	35,  // 78: autoroll.config.DEPSLocalParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutParentConfig
+	1,   // 79: autoroll.config.DEPSLocalParentConfig.pre_upload_steps:type_name -> autoroll.config.PreUploadStep
+	67,  // 80: autoroll.config.DEPSLocalParentConfig.pre_upload_commands:type_name -> autoroll.config.PreUploadConfig
+	60,  // 81: autoroll.config.GitCheckoutParentConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
+	59,  // 82: autoroll.config.GitCheckoutParentConfig.dep:type_name -> autoroll.config.DependencyConfig
+	30,  // 83: autoroll.config.FreeTypeParentConfig.gitiles:type_name -> autoroll.config.GitilesParentConfig
+	30,  // 84: autoroll.config.BazelParentConfig.gitiles:type_name -> autoroll.config.GitilesParentConfig
+	30,  // 85: autoroll.config.PackageRegistryParentConfig.gitiles:type_name -> autoroll.config.GitilesParentConfig
+	31,  // 86: autoroll.config.CIPDChildConfig.source_repo:type_name -> autoroll.config.GitilesConfig
+	42,  // 87: autoroll.config.SemVerGCSChildConfig.gcs:type_name -> autoroll.config.GCSChildConfig
+	60,  // 88: autoroll.config.GitCheckoutChildConfig.git_checkout:type_name -> autoroll.config.GitCheckoutConfig
+	43,  // 89: autoroll.config.GitCheckoutGitHubChildConfig.git_checkout:type_name -> autoroll.config.GitCheckoutChildConfig
+	31,  // 90: autoroll.config.GitilesChildConfig.gitiles:type_name -> autoroll.config.GitilesConfig
+	0,   // 91: autoroll.config.PackageRegistryChildConfig.registry:type_name -> autoroll.config.PackageRegistry
+	6,   // 92: autoroll.config.NotifierConfig.log_level:type_name -> autoroll.config.NotifierConfig.LogLevel
+	7,   // 93: autoroll.config.NotifierConfig.msg_type:type_name -> autoroll.config.NotifierConfig.MsgType
+	49,  // 94: autoroll.config.NotifierConfig.email:type_name -> autoroll.config.EmailNotifierConfig
+	50,  // 95: autoroll.config.NotifierConfig.chat:type_name -> autoroll.config.ChatNotifierConfig
+	51,  // 96: autoroll.config.NotifierConfig.monorail:type_name -> autoroll.config.MonorailNotifierConfig
+	52,  // 97: autoroll.config.NotifierConfig.pubsub:type_name -> autoroll.config.PubSubNotifierConfig
+	56,  // 98: autoroll.config.AutoRevertConfig.task_scheduler:type_name -> autoroll.config.AutoRevertTaskSchedulerConfig
+	58,  // 99: autoroll.config.TransitiveDepConfig.child:type_name -> autoroll.config.VersionFileConfig
+	58,  // 100: autoroll.config.TransitiveDepConfig.parent:type_name -> autoroll.config.VersionFileConfig
+	58,  // 101: autoroll.config.DependencyConfig.primary:type_name -> autoroll.config.VersionFileConfig
+	57,  // 102: autoroll.config.DependencyConfig.transitive:type_name -> autoroll.config.TransitiveDepConfig
+	58,  // 103: autoroll.config.GitCheckoutConfig.dependencies:type_name -> autoroll.config.VersionFileConfig
+	65,  // 104: autoroll.config.SignatureRevisionFilterConfig.git:type_name -> autoroll.config.GitSignatureConfig
+	66,  // 105: autoroll.config.SignatureRevisionFilterConfig.artifact:type_name -> autoroll.config.ArtifactSignatureConfig
+	8,   // 106: autoroll.config.ArtifactSignatureConfig.format:type_name -> autoroll.config.ArtifactSignatureConfig.Format
+	69,  // 107: autoroll.config.PreUploadConfig.cipd_package:type_name -> autoroll.config.PreUploadCIPDPackageConfig
+	68,  // 108: autoroll.config.PreUploadConfig.command:type_name -> autoroll.config.PreUploadCommandConfig
+	9,   // 109: autoroll.config.Configs.config:type_name -> autoroll.config.Config
+	110, // [110:110] is the sub-list for method output_type
+	110, // [110:110] is the sub-list for method input_type
+	110, // [110:110] is the sub-list for extension type_name
+	110, // [110:110] is the sub-list for extension extendee
+	0,   // [0:110] is the sub-list for field type_name
 }
 
 func init() { file_config_proto_init() }
@@ -5956,7 +7554,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GerritConfig); i {
+			switch v := v.(*ReleaseNotesConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5968,7 +7566,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitHubConfig); i {
+			switch v := v.(*GerritConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5980,7 +7578,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Google3Config); i {
+			switch v := v.(*GitHubConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5992,7 +7590,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KubernetesConfig); i {
+			switch v := v.(*GitLabConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6004,7 +7602,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AndroidRepoManagerConfig); i {
+			switch v := v.(*Google3Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6016,7 +7614,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandRepoManagerConfig); i {
+			switch v := v.(*KubernetesConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6028,7 +7626,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FreeTypeRepoManagerConfig); i {
+			switch v := v.(*AndroidRepoManagerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6040,7 +7638,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Google3RepoManagerConfig); i {
+			switch v := v.(*CommandRepoManagerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6052,7 +7650,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParentChildRepoManagerConfig); i {
+			switch v := v.(*FreeTypeRepoManagerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6064,7 +7662,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyParentConfig); i {
+			switch v := v.(*Google3RepoManagerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6076,7 +7674,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DEPSLocalGitHubParentConfig); i {
+			switch v := v.(*FanOutRepoManagerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6088,7 +7686,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DEPSLocalGerritParentConfig); i {
+			switch v := v.(*ParentChildRepoManagerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6100,7 +7698,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutGitHubParentConfig); i {
+			switch v := v.(*CopyParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6112,7 +7710,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutGerritParentConfig); i {
+			switch v := v.(*DEPSLocalGitHubParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6124,7 +7722,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutGitHubFileParentConfig); i {
+			switch v := v.(*DEPSLocalGerritParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6136,7 +7734,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitilesParentConfig); i {
+			switch v := v.(*GitCheckoutGitHubParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6148,7 +7746,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitilesConfig); i {
+			switch v := v.(*GitCheckoutGerritParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6160,7 +7758,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GoModGerritParentConfig); i {
+			switch v := v.(*GitCheckoutGitLabParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6172,7 +7770,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GoModParentConfig); i {
+			switch v := v.(*GitCheckoutGitHubFileParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6184,7 +7782,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DEPSLocalParentConfig); i {
+			switch v := v.(*GitilesParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6196,7 +7794,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutParentConfig); i {
+			switch v := v.(*GitilesConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6208,7 +7806,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FreeTypeParentConfig); i {
+			switch v := v.(*GoModGerritParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6220,7 +7818,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CIPDChildConfig); i {
+			switch v := v.(*GoModParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6232,7 +7830,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FuchsiaSDKChildConfig); i {
+			switch v := v.(*DEPSLocalParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6244,7 +7842,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SemVerGCSChildConfig); i {
+			switch v := v.(*GitCheckoutParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6256,7 +7854,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCSChildConfig); i {
+			switch v := v.(*FreeTypeParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6268,7 +7866,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutChildConfig); i {
+			switch v := v.(*BazelParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6280,7 +7878,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutGitHubChildConfig); i {
+			switch v := v.(*PackageRegistryParentConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6292,7 +7890,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitilesChildConfig); i {
+			switch v := v.(*CIPDChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6304,7 +7902,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DockerChildConfig); i {
+			switch v := v.(*FuchsiaSDKChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6316,7 +7914,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifierConfig); i {
+			switch v := v.(*SemVerGCSChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6328,7 +7926,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmailNotifierConfig); i {
+			switch v := v.(*GCSChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6340,7 +7938,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChatNotifierConfig); i {
+			switch v := v.(*GitCheckoutChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6352,7 +7950,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MonorailNotifierConfig); i {
+			switch v := v.(*GitCheckoutGitHubChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6364,7 +7962,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PubSubNotifierConfig); i {
+			switch v := v.(*GitilesChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6376,7 +7974,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThrottleConfig); i {
+			switch v := v.(*DockerChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6388,7 +7986,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransitiveDepConfig); i {
+			switch v := v.(*PackageRegistryChildConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6400,7 +7998,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionFileConfig); i {
+			switch v := v.(*NotifierConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6412,7 +8010,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DependencyConfig); i {
+			switch v := v.(*EmailNotifierConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6424,7 +8022,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GitCheckoutConfig); i {
+			switch v := v.(*ChatNotifierConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6436,7 +8034,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BuildbucketRevisionFilterConfig); i {
+			switch v := v.(*MonorailNotifierConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6448,7 +8046,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CIPDRevisionFilterConfig); i {
+			switch v := v.(*PubSubNotifierConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6460,7 +8058,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidHttpRevisionFilterConfig); i {
+			switch v := v.(*ThrottleConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6472,7 +8070,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreUploadConfig); i {
+			switch v := v.(*StrategyOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6484,7 +8082,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreUploadCommandConfig); i {
+			switch v := v.(*AutoRevertConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6496,7 +8094,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PreUploadCIPDPackageConfig); i {
+			switch v := v.(*AutoRevertTaskSchedulerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6508,7 +8106,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Configs); i {
+			switch v := v.(*TransitiveDepConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6520,7 +8118,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AndroidRepoManagerConfig_ProjectMetadataFileConfig); i {
+			switch v := v.(*VersionFileConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6532,7 +8130,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommandRepoManagerConfig_CommandConfig); i {
+			switch v := v.(*DependencyConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6544,6 +8142,174 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitCheckoutConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildbucketRevisionFilterConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CIPDRevisionFilterConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidHttpRevisionFilterConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignatureRevisionFilterConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitSignatureConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactSignatureConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreUploadConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreUploadCommandConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreUploadCIPDPackageConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Configs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommitMsgConfig_FileArea); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AndroidRepoManagerConfig_ProjectMetadataFileConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandRepoManagerConfig_CommandConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CopyParentConfig_CopyEntry); i {
 			case 0:
 				return &v.state
@@ -6560,13 +8326,15 @@ func file_config_proto_init() {
 		(*Config_Gerrit)(nil),
 		(*Config_Github)(nil),
 		(*Config_Google3)(nil),
+		(*Config_Gitlab)(nil),
 		(*Config_ParentChildRepoManager)(nil),
 		(*Config_AndroidRepoManager)(nil),
 		(*Config_CommandRepoManager)(nil),
 		(*Config_FreetypeRepoManager)(nil),
 		(*Config_Google3RepoManager)(nil),
+		(*Config_FanOutRepoManager)(nil),
 	}
-	file_config_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_config_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*ParentChildRepoManagerConfig_CopyParent)(nil),
 		(*ParentChildRepoManagerConfig_DepsLocalGithubParent)(nil),
 		(*ParentChildRepoManagerConfig_DepsLocalGerritParent)(nil),
@@ -6574,6 +8342,9 @@ func file_config_proto_init() {
 		(*ParentChildRepoManagerConfig_GitilesParent)(nil),
 		(*ParentChildRepoManagerConfig_GoModGerritParent)(nil),
 		(*ParentChildRepoManagerConfig_GitCheckoutGerritParent)(nil),
+		(*ParentChildRepoManagerConfig_GitCheckoutGitlabParent)(nil),
+		(*ParentChildRepoManagerConfig_BazelParent)(nil),
+		(*ParentChildRepoManagerConfig_PackageRegistryParent)(nil),
 		(*ParentChildRepoManagerConfig_CipdChild)(nil),
 		(*ParentChildRepoManagerConfig_FuchsiaSdkChild)(nil),
 		(*ParentChildRepoManagerConfig_GitCheckoutChild)(nil),
@@ -6581,20 +8352,25 @@ func file_config_proto_init() {
 		(*ParentChildRepoManagerConfig_GitilesChild)(nil),
 		(*ParentChildRepoManagerConfig_SemverGcsChild)(nil),
 		(*ParentChildRepoManagerConfig_DockerChild)(nil),
+		(*ParentChildRepoManagerConfig_PackageRegistryChild)(nil),
 	}
-	file_config_proto_msgTypes[32].OneofWrappers = []interface{}{
+	file_config_proto_msgTypes[39].OneofWrappers = []interface{}{
 		(*NotifierConfig_Email)(nil),
 		(*NotifierConfig_Chat)(nil),
 		(*NotifierConfig_Monorail)(nil),
 		(*NotifierConfig_Pubsub)(nil),
 	}
+	file_config_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*SignatureRevisionFilterConfig_Git)(nil),
+		(*SignatureRevisionFilterConfig_Artifact)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   52,
+			NumEnums:      9,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
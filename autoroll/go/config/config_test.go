@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 )
 
 func makeConfig() *Config {
@@ -134,3 +135,302 @@ func TestValidation_TransitiveDeps(t *testing.T) {
 		require.ErrorContains(t, cfg.Validate(), "top level transitive dependency differs from transitive dependency set on parent")
 	})
 }
+
+func TestValidation_AutoRevert(t *testing.T) {
+	makeAutoRevertConfig := func() *Config {
+		cfg := makeConfig()
+		cfg.AutoRevert = &AutoRevertConfig{
+			Window:        "2h",
+			TreeStatusUrl: "https://tree-status/current",
+			TaskScheduler: &AutoRevertTaskSchedulerConfig{
+				Host: "https://task-scheduler",
+				Repo: "https://parent.repo.git",
+				Jobs: []string{"Build-Linux"},
+			},
+		}
+		return cfg
+	}
+	t.Run("baseline", func(t *testing.T) {
+		require.NoError(t, makeAutoRevertConfig().Validate())
+	})
+	t.Run("missing window", func(t *testing.T) {
+		cfg := makeAutoRevertConfig()
+		cfg.AutoRevert.Window = ""
+		require.ErrorContains(t, cfg.Validate(), "Window is required")
+	})
+	t.Run("invalid window", func(t *testing.T) {
+		cfg := makeAutoRevertConfig()
+		cfg.AutoRevert.Window = "two hours"
+		require.ErrorContains(t, cfg.Validate(), "invalid Window")
+	})
+	t.Run("no signals", func(t *testing.T) {
+		cfg := makeAutoRevertConfig()
+		cfg.AutoRevert.TreeStatusUrl = ""
+		cfg.AutoRevert.TaskScheduler = nil
+		require.ErrorContains(t, cfg.Validate(), "At least one of TreeStatusUrl, TaskScheduler, or HealthCheckUrl is required")
+	})
+	t.Run("no jobs", func(t *testing.T) {
+		cfg := makeAutoRevertConfig()
+		cfg.AutoRevert.TaskScheduler.Jobs = nil
+		require.ErrorContains(t, cfg.Validate(), "At least one job is required")
+	})
+	t.Run("not gerrit", func(t *testing.T) {
+		cfg := makeAutoRevertConfig()
+		cfg.CodeReview = &Config_Github{
+			Github: &GitHubConfig{
+				RepoOwner:    "me",
+				RepoName:     "my-repo",
+				TokenSecret:  "token",
+				SshKeySecret: "ssh-key",
+			},
+		}
+		require.ErrorContains(t, cfg.Validate(), "AutoRevert is only supported for rollers which use Gerrit")
+	})
+}
+
+func TestValidation_FanOut(t *testing.T) {
+	makeFanOutConfig := func() *Config {
+		cfg := makeConfig()
+		first := cfg.GetParentChildRepoManager()
+		second := proto.Clone(first).(*ParentChildRepoManagerConfig)
+		second.GetDepsLocalGerritParent().DepsLocal.GitCheckout.GitCheckout.RepoUrl = "https://other-parent.repo.git"
+		cfg.RepoManager = &Config_FanOutRepoManager{
+			FanOutRepoManager: &FanOutRepoManagerConfig{
+				ParentChild: []*ParentChildRepoManagerConfig{first, second},
+			},
+		}
+		return cfg
+	}
+	t.Run("baseline", func(t *testing.T) {
+		require.NoError(t, makeFanOutConfig().Validate())
+	})
+	t.Run("single parent", func(t *testing.T) {
+		cfg := makeFanOutConfig()
+		cfg.GetFanOutRepoManager().ParentChild = cfg.GetFanOutRepoManager().ParentChild[:1]
+		require.ErrorContains(t, cfg.Validate(), "At least two ParentChild are required")
+	})
+	t.Run("different child", func(t *testing.T) {
+		cfg := makeFanOutConfig()
+		cfg.GetFanOutRepoManager().ParentChild[1].GetGitCheckoutChild().GitCheckout.Branch = "other"
+		require.ErrorContains(t, cfg.Validate(), "ParentChild 1 differs from ParentChild 0 in more than the Parent")
+	})
+	t.Run("invalid parent", func(t *testing.T) {
+		cfg := makeFanOutConfig()
+		cfg.GetFanOutRepoManager().ParentChild[1].GetDepsLocalGerritParent().DepsLocal.GitCheckout.GitCheckout.RepoUrl = ""
+		require.ErrorContains(t, cfg.Validate(), "ParentChild 1 failed validation")
+	})
+	t.Run("not gerrit", func(t *testing.T) {
+		cfg := makeFanOutConfig()
+		cfg.CodeReview = &Config_Github{
+			Github: &GitHubConfig{
+				RepoOwner:    "me",
+				RepoName:     "my-repo",
+				TokenSecret:  "token",
+				SshKeySecret: "ssh-key",
+			},
+		}
+		require.ErrorContains(t, cfg.Validate(), "FanOutRepoManager is only supported for rollers which use Gerrit")
+	})
+}
+
+func TestValidation_GitLab(t *testing.T) {
+	makeGitLabConfig := func() *Config {
+		cfg := makeConfig()
+		gitlabConfig := &GitLabConfig{
+			Url:          "https://gitlab.com",
+			Project:      "my-group/my-project",
+			TokenSecret:  "token",
+			SshKeySecret: "ssh-key",
+		}
+		cfg.CodeReview = &Config_Gitlab{
+			Gitlab: gitlabConfig,
+		}
+		pc := cfg.GetParentChildRepoManager()
+		pc.Parent = &ParentChildRepoManagerConfig_GitCheckoutGitlabParent{
+			GitCheckoutGitlabParent: &GitCheckoutGitLabParentConfig{
+				GitCheckout: pc.GetDepsLocalGerritParent().DepsLocal.GitCheckout,
+				Gitlab:      proto.Clone(gitlabConfig).(*GitLabConfig),
+			},
+		}
+		return cfg
+	}
+	t.Run("baseline", func(t *testing.T) {
+		require.NoError(t, makeGitLabConfig().Validate())
+	})
+	t.Run("mismatched project", func(t *testing.T) {
+		cfg := makeGitLabConfig()
+		cfg.GetParentChildRepoManager().GetGitCheckoutGitlabParent().Gitlab.Project = "other-group/other-project"
+		require.ErrorContains(t, cfg.Validate(), "top level GitLab config differs from GitLab config set on parent")
+	})
+	t.Run("not gitlab", func(t *testing.T) {
+		cfg := makeGitLabConfig()
+		cfg.CodeReview = &Config_Gerrit{
+			Gerrit: &GerritConfig{
+				Url:     "fake.gerrit.url",
+				Project: "fake-project",
+				Config:  GerritConfig_CHROMIUM,
+			},
+		}
+		require.ErrorContains(t, cfg.Validate(), "GitCheckoutGitLabParent is only supported for rollers which use GitLab")
+	})
+}
+
+func TestValidation_CommitMsgDigest(t *testing.T) {
+	makeDigestConfig := func() *Config {
+		cfg := makeConfig()
+		cfg.CommitMsg.GroupBy = CommitMsgConfig_FILE_AREA
+		cfg.CommitMsg.FileAreas = []*CommitMsgConfig_FileArea{
+			{
+				Name:  "Docs",
+				Paths: []string{"docs"},
+			},
+		}
+		cfg.CommitMsg.IncludeAuthorSummary = true
+		cfg.CommitMsg.ReleaseNotes = &ReleaseNotesConfig{
+			ChangelogPath:  "CHANGELOG.md",
+			TagAnnotations: true,
+		}
+		return cfg
+	}
+	t.Run("baseline", func(t *testing.T) {
+		require.NoError(t, makeDigestConfig().Validate())
+	})
+	t.Run("missing file areas", func(t *testing.T) {
+		cfg := makeDigestConfig()
+		cfg.CommitMsg.FileAreas = nil
+		require.ErrorContains(t, cfg.Validate(), "FileAreas are required when GroupBy is FILE_AREA")
+	})
+	t.Run("file area missing name", func(t *testing.T) {
+		cfg := makeDigestConfig()
+		cfg.CommitMsg.FileAreas[0].Name = ""
+		require.ErrorContains(t, cfg.Validate(), "Name is required")
+	})
+	t.Run("file area missing paths", func(t *testing.T) {
+		cfg := makeDigestConfig()
+		cfg.CommitMsg.FileAreas[0].Paths = nil
+		require.ErrorContains(t, cfg.Validate(), "At least one path is required for FileArea \"Docs\"")
+	})
+	t.Run("gitiles child", func(t *testing.T) {
+		cfg := makeDigestConfig()
+		cfg.GetParentChildRepoManager().Child = &ParentChildRepoManagerConfig_GitilesChild{
+			GitilesChild: &GitilesChildConfig{
+				Gitiles: &GitilesConfig{
+					Branch:       "main",
+					RepoUrl:      "https://child.repo.git",
+					Dependencies: cfg.GetParentChildRepoManager().GetGitCheckoutChild().GitCheckout.Dependencies,
+				},
+			},
+		}
+		require.ErrorContains(t, cfg.Validate(), "GroupBy FILE_AREA and ReleaseNotes.TagAnnotations are only supported for Children which use a local git checkout")
+		cfg.CommitMsg.GroupBy = CommitMsgConfig_CONVENTIONAL_COMMIT_TYPE
+		require.ErrorContains(t, cfg.Validate(), "GroupBy FILE_AREA and ReleaseNotes.TagAnnotations are only supported for Children which use a local git checkout")
+		cfg.CommitMsg.ReleaseNotes.TagAnnotations = false
+		require.NoError(t, cfg.Validate())
+	})
+	t.Run("unknown group by", func(t *testing.T) {
+		cfg := makeDigestConfig()
+		cfg.CommitMsg.GroupBy = 42
+		require.ErrorContains(t, cfg.Validate(), "Unknown GroupBy")
+	})
+}
+
+func TestValidation_StrategyOptions(t *testing.T) {
+	makeStrategyOptionsConfig := func() *Config {
+		cfg := makeConfig()
+		cfg.StrategyOptions = &StrategyOptions{
+			TimeBatchInterval: "6h",
+			MaxFilesChanged:   100,
+			MaxLinesChanged:   2000,
+		}
+		return cfg
+	}
+	t.Run("baseline", func(t *testing.T) {
+		require.NoError(t, makeStrategyOptionsConfig().Validate())
+	})
+	t.Run("defaults", func(t *testing.T) {
+		cfg := makeStrategyOptionsConfig()
+		cfg.StrategyOptions = &StrategyOptions{}
+		require.NoError(t, cfg.Validate())
+	})
+	t.Run("invalid interval", func(t *testing.T) {
+		cfg := makeStrategyOptionsConfig()
+		cfg.StrategyOptions.TimeBatchInterval = "six hours"
+		require.ErrorContains(t, cfg.Validate(), "invalid TimeBatchInterval")
+	})
+	t.Run("negative max files", func(t *testing.T) {
+		cfg := makeStrategyOptionsConfig()
+		cfg.StrategyOptions.MaxFilesChanged = -1
+		require.ErrorContains(t, cfg.Validate(), "MaxFilesChanged must not be negative")
+	})
+	t.Run("negative max lines", func(t *testing.T) {
+		cfg := makeStrategyOptionsConfig()
+		cfg.StrategyOptions.MaxLinesChanged = -1
+		require.ErrorContains(t, cfg.Validate(), "MaxLinesChanged must not be negative")
+	})
+}
+
+func TestValidation_SignatureRevisionFilter(t *testing.T) {
+	makeGitConfig := func() *SignatureRevisionFilterConfig {
+		return &SignatureRevisionFilterConfig{
+			PublicKey: []string{"ssh-ed25519 AAAA"},
+			Source: &SignatureRevisionFilterConfig_Git{
+				Git: &GitSignatureConfig{
+					RepoUrl:  "https://my-repo.git",
+					TagRegex: `^v\d+`,
+				},
+			},
+		}
+	}
+	makeArtifactConfig := func() *SignatureRevisionFilterConfig {
+		return &SignatureRevisionFilterConfig{
+			PublicKey: []string{"ssh-ed25519 AAAA"},
+			Source: &SignatureRevisionFilterConfig_Artifact{
+				Artifact: &ArtifactSignatureConfig{
+					Format:           ArtifactSignatureConfig_SIGSTORE_BUNDLE,
+					SignatureUrlTmpl: "https://signatures/%s.bundle",
+				},
+			},
+		}
+	}
+	t.Run("git", func(t *testing.T) {
+		require.NoError(t, makeGitConfig().Validate())
+	})
+	t.Run("artifact", func(t *testing.T) {
+		require.NoError(t, makeArtifactConfig().Validate())
+	})
+	t.Run("no keys", func(t *testing.T) {
+		cfg := makeGitConfig()
+		cfg.PublicKey = nil
+		require.ErrorContains(t, cfg.Validate(), "At least one PublicKey is required")
+	})
+	t.Run("no source", func(t *testing.T) {
+		cfg := makeGitConfig()
+		cfg.Source = nil
+		require.ErrorContains(t, cfg.Validate(), "One of Git or Artifact is required")
+	})
+	t.Run("no repo", func(t *testing.T) {
+		cfg := makeGitConfig()
+		cfg.GetGit().RepoUrl = ""
+		require.ErrorContains(t, cfg.Validate(), "RepoUrl is required")
+	})
+	t.Run("invalid tag regex", func(t *testing.T) {
+		cfg := makeGitConfig()
+		cfg.GetGit().TagRegex = "v("
+		require.ErrorContains(t, cfg.Validate(), "invalid TagRegex")
+	})
+	t.Run("no signature URL", func(t *testing.T) {
+		cfg := makeArtifactConfig()
+		cfg.GetArtifact().SignatureUrlTmpl = ""
+		require.ErrorContains(t, cfg.Validate(), "SignatureUrlTmpl is required")
+	})
+	t.Run("signature URL without placeholder", func(t *testing.T) {
+		cfg := makeArtifactConfig()
+		cfg.GetArtifact().SignatureUrlTmpl = "https://signatures/latest.bundle"
+		require.ErrorContains(t, cfg.Validate(), "SignatureUrlTmpl must contain exactly one %s")
+	})
+	t.Run("artifact URL without placeholder", func(t *testing.T) {
+		cfg := makeArtifactConfig()
+		cfg.GetArtifact().ArtifactUrlTmpl = "https://artifacts/latest.tar.gz"
+		require.ErrorContains(t, cfg.Validate(), "ArtifactUrlTmpl must contain exactly one %s")
+	})
+}
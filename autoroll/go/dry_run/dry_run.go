@@ -0,0 +1,338 @@
+package dry_run
+
+/*
+   Package dry_run simulates a roll offline, using local git repos in place of
+   the configured Parent and Child repos, without talking to a code review
+   server.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.skia.org/infra/autoroll/go/codereview"
+	"go.skia.org/infra/autoroll/go/commit_msg"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/repo_manager/child"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
+	"go.skia.org/infra/autoroll/go/repo_manager/parent"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/autoroll/go/roller"
+	"go.skia.org/infra/autoroll/go/strategy"
+	"go.skia.org/infra/go/git"
+	"go.skia.org/infra/go/skerr"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// dryRunUserName is the git user name used to create roll commits.
+	dryRunUserName = "autoroll-dry-run"
+	// dryRunUserEmail is the git user email used to create roll commits.
+	dryRunUserEmail = "autoroll-dry-run@localhost"
+)
+
+// Options provides the local replacements for the remote resources used by
+// a roller.
+type Options struct {
+	// ParentRepo is the location of a git repo, typically a local checkout,
+	// which is used in place of the configured Parent repo. Required.
+	ParentRepo string
+	// ChildRepo is the location of a git repo, typically a local checkout,
+	// which is used in place of the configured Child repo. Required for
+	// rollers whose Child is a git repo, unless ChildRevisions is provided.
+	ChildRepo string
+	// ChildRevisions, if provided, is used as a fake registry of Child
+	// revisions in place of the configured Child. Revisions must be in
+	// reverse chronological order. This allows dry runs of rollers whose
+	// Child is a package registry, eg. CIPD, GCS or Docker.
+	ChildRevisions []*revision.Revision
+	// History provides the roller's recent rolls. Required by the
+	// strategies which depend on them, ie. strategy.ROLL_STRATEGY_TIME_BATCH
+	// and strategy.ROLL_STRATEGY_BISECT, unless RollTo is provided.
+	History strategy.RollHistory
+	// RollTo is the ID of the revision to roll to. If not provided, the
+	// revision is chosen using Strategy.
+	RollTo string
+	// Strategy is the roll strategy used to choose the next revision to roll.
+	// Defaults to strategy.ROLL_STRATEGY_BATCH.
+	Strategy string
+	// Workdir is the directory in which to create the local checkouts.
+	Workdir string
+}
+
+// Result describes the roll which would be uploaded.
+type Result struct {
+	LastRollRev   *revision.Revision
+	TipRev        *revision.Revision
+	NotRolledRevs []*revision.Revision
+	// NextRollRev is nil if there is nothing to roll, in which case CommitMsg
+	// and Diff are empty.
+	NextRollRev *revision.Revision
+	CommitMsg   string
+	Diff        string
+}
+
+// Run simulates a roll using the given config. Revision filters and
+// pre-upload steps are not run, since they require access to external
+// services.
+func Run(ctx context.Context, cfg *config.Config, reg *config_vars.Registry, opts Options) (*Result, error) {
+	rmc := cfg.GetParentChildRepoManager()
+	if rmc == nil {
+		return nil, skerr.Fmt("dry runs are only supported for parent/child rollers")
+	}
+	if opts.ParentRepo == "" {
+		return nil, skerr.Fmt("ParentRepo is required")
+	}
+	strategyName := opts.Strategy
+	if strategyName == "" {
+		strategyName = strategy.ROLL_STRATEGY_BATCH
+	}
+	if opts.RollTo == "" && opts.History == nil && (strategyName == strategy.ROLL_STRATEGY_TIME_BATCH || strategyName == strategy.ROLL_STRATEGY_BISECT) {
+		return nil, skerr.Fmt("the %q strategy depends on the roller's recent rolls, which are not available to dry runs; use a different strategy or choose the revision with RollTo", strategyName)
+	}
+	cr := &localUser{}
+
+	// Create the Parent. The roll is committed in a local checkout and the
+	// diff is recorded instead of being uploaded.
+	parentCfg, err := localParentConfig(rmc, opts.ParentRepo)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	var diff string
+	uploadRoll := func(ctx context.Context, co *git.Checkout, upstreamBranch, hash string, _ []string, _ bool, _ string) (int64, error) {
+		out, err := co.Git(ctx, "diff", "--binary", upstreamBranch, hash)
+		if err != nil {
+			return 0, skerr.Wrap(err)
+		}
+		diff = out
+		return 0, nil
+	}
+	parentWorkdir := filepath.Join(opts.Workdir, "parent")
+	if err := os.MkdirAll(parentWorkdir, os.ModePerm); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	parentRM, err := parent.NewGitCheckout(ctx, parentCfg, reg, parentWorkdir, cr, nil, parent.GitCheckoutFileCreateRollFunc(parentCfg.Dep), uploadRoll)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	// Create the Child.
+	var childRM child.Child
+	if len(opts.ChildRevisions) > 0 {
+		childRM = &fakeChild{revisions: opts.ChildRevisions}
+	} else {
+		childCfg, err := localChildConfig(rmc, opts.ChildRepo)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		childWorkdir := filepath.Join(opts.Workdir, "child")
+		if err := os.MkdirAll(childWorkdir, os.ModePerm); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		gitChild, err := child.NewGitCheckout(ctx, childCfg, reg, childWorkdir, cr, nil)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		gitChild.SetLogOptions(git_common.LogOptionsForCommitMsg(cfg.CommitMsg))
+		childRM = gitChild
+	}
+
+	// Find the not-rolled revisions.
+	lastRollRevID, err := parentRM.Update(ctx)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to update Parent")
+	}
+	lastRollRev, err := childRM.GetRevision(ctx, lastRollRevID)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to retrieve last-rolled revision %q", lastRollRevID)
+	}
+	tipRev, notRolledRevs, err := childRM.Update(ctx, lastRollRev)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to update Child")
+	}
+	rv := &Result{
+		LastRollRev:   lastRollRev,
+		TipRev:        tipRev,
+		NotRolledRevs: notRolledRevs,
+	}
+
+	// Choose the next revision to roll.
+	if opts.RollTo != "" {
+		for _, rev := range notRolledRevs {
+			if rev.Id == opts.RollTo {
+				rv.NextRollRev = rev
+				break
+			}
+		}
+		if rv.NextRollRev == nil {
+			return nil, skerr.Fmt("revision %q is not in the list of not-yet-rolled revisions", opts.RollTo)
+		}
+	} else {
+		s, err := strategy.GetNextRollStrategy(strategyName, strategy.Options{
+			History: opts.History,
+		})
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		rv.NextRollRev = s.GetNextRollRev(notRolledRevs)
+	}
+	if rv.NextRollRev == nil {
+		return rv, nil
+	}
+	var rolling []*revision.Revision
+	found := false
+	for _, rev := range notRolledRevs {
+		if rev.Id == rv.NextRollRev.Id {
+			found = true
+		}
+		if found {
+			rolling = append(rolling, rev)
+		}
+	}
+
+	// Build the commit message and create the roll.
+	serverURL := roller.AutorollURLPublic + "/r/" + cfg.RollerName
+	if cfg.IsInternal {
+		serverURL = roller.AutorollURLPrivate + "/r/" + cfg.RollerName
+	}
+	builder, err := commit_msg.NewBuilder(cfg.CommitMsg, reg, cfg.ChildDisplayName, cfg.ParentDisplayName, serverURL, cfg.ChildBugLink, cfg.ParentBugLink, cfg.TransitiveDeps)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	builder.SetFileReader(commit_msg.VFSFileReader(childRM.VFS))
+	emails := reviewerEmails(cfg)
+	commitMsg, err := builder.Build(ctx, lastRollRev, rv.NextRollRev, rolling, emails, cfg.Contacts, false, "")
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if _, err := parentRM.CreateNewRoll(ctx, lastRollRev, rv.NextRollRev, rolling, emails, false, commitMsg); err != nil {
+		return nil, skerr.Wrapf(err, "failed to create roll")
+	}
+	rv.CommitMsg = commitMsg
+	rv.Diff = diff
+	return rv, nil
+}
+
+// localParentConfig returns a GitCheckoutParentConfig which is equivalent to
+// the Parent in the given config but uses the given repo.
+func localParentConfig(c *config.ParentChildRepoManagerConfig, repo string) (*config.GitCheckoutParentConfig, error) {
+	var rv *config.GitCheckoutParentConfig
+	if p := c.GetGitilesParent(); p != nil {
+		rv = &config.GitCheckoutParentConfig{
+			GitCheckout: &config.GitCheckoutConfig{
+				Branch:            p.Gitiles.Branch,
+				RepoUrl:           p.Gitiles.RepoUrl,
+				DefaultBugProject: p.Gitiles.DefaultBugProject,
+			},
+			Dep: p.Dep,
+		}
+	} else if p := c.GetGitCheckoutGerritParent(); p != nil {
+		rv = p.GitCheckout
+	} else if p := c.GetGitCheckoutGithubFileParent(); p != nil {
+		rv = p.GitCheckout.GitCheckout
+	} else if p := c.GetGitCheckoutGitlabParent(); p != nil {
+		rv = p.GitCheckout
+	} else {
+		return nil, skerr.Fmt("dry runs are not supported for this type of Parent")
+	}
+	// Don't modify the passed-in config.
+	rv = proto.Clone(rv).(*config.GitCheckoutParentConfig)
+	rv.GitCheckout.RepoUrl = repo
+	// These are filled in by the Parent.
+	rv.GitCheckout.Dependencies = nil
+	return rv, nil
+}
+
+// localChildConfig returns a GitCheckoutChildConfig which is equivalent to
+// the Child in the given config but uses the given repo.
+func localChildConfig(c *config.ParentChildRepoManagerConfig, repo string) (*config.GitCheckoutChildConfig, error) {
+	var rv *config.GitCheckoutChildConfig
+	if ch := c.GetGitilesChild(); ch != nil {
+		if ch.Path != "" {
+			return nil, skerr.Fmt("dry runs are not supported for Gitiles children which roll a subdirectory; provide ChildRevisions instead")
+		}
+		rv = &config.GitCheckoutChildConfig{
+			GitCheckout: &config.GitCheckoutConfig{
+				Branch:            ch.Gitiles.Branch,
+				RepoUrl:           ch.Gitiles.RepoUrl,
+				Dependencies:      ch.Gitiles.Dependencies,
+				DefaultBugProject: ch.Gitiles.DefaultBugProject,
+			},
+		}
+	} else if ch := c.GetGitCheckoutChild(); ch != nil {
+		rv = ch
+	} else if ch := c.GetGitCheckoutGithubChild(); ch != nil {
+		rv = ch.GitCheckout
+	} else {
+		return nil, skerr.Fmt("dry runs require ChildRevisions for this type of Child")
+	}
+	if repo == "" {
+		return nil, skerr.Fmt("ChildRepo is required for git-based children")
+	}
+	// Don't modify the passed-in config.
+	rv = proto.Clone(rv).(*config.GitCheckoutChildConfig)
+	rv.GitCheckout.RepoUrl = repo
+	return rv, nil
+}
+
+// reviewerEmails returns the reviewers which are given as literal email
+// addresses in the config, since retrieving rotations requires network
+// access. Falls back to the backup reviewers if there are none.
+func reviewerEmails(cfg *config.Config) []string {
+	var rv []string
+	for _, r := range cfg.Reviewer {
+		if !strings.HasPrefix(r, "http") {
+			rv = append(rv, r)
+		}
+	}
+	if len(rv) == 0 {
+		rv = cfg.ReviewerBackup
+	}
+	return rv
+}
+
+// localUser is a codereview.CodeReview which only provides the user name and
+// email used to create commits in local checkouts.
+type localUser struct {
+	codereview.CodeReview
+}
+
+// UserName implements codereview.CodeReview.
+func (u *localUser) UserName() string {
+	return dryRunUserName
+}
+
+// UserEmail implements codereview.CodeReview.
+func (u *localUser) UserEmail() string {
+	return dryRunUserEmail
+}
+
+// String returns a human-readable summary of the Result.
+func (r *Result) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Last rolled revision: %s\n", r.LastRollRev.Id)
+	fmt.Fprintf(&b, "Tip revision: %s\n", r.TipRev.Id)
+	fmt.Fprintf(&b, "Not-yet-rolled revisions (%d):\n", len(r.NotRolledRevs))
+	for _, rev := range r.NotRolledRevs {
+		line := fmt.Sprintf("  %s", rev.Id)
+		if rev.Description != "" {
+			line += " " + rev.Description
+		}
+		if rev.InvalidReason != "" {
+			line += fmt.Sprintf(" (invalid: %s)", rev.InvalidReason)
+		}
+		fmt.Fprintln(&b, line)
+	}
+	if r.NextRollRev == nil {
+		fmt.Fprintln(&b, "Nothing to roll.")
+		return b.String()
+	}
+	fmt.Fprintf(&b, "Next roll revision: %s\n\n", r.NextRollRev.Id)
+	fmt.Fprintf(&b, "Commit message:\n%s\n\n", r.CommitMsg)
+	fmt.Fprintf(&b, "Diff:\n%s", r.Diff)
+	return b.String()
+}
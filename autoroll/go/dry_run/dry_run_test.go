@@ -0,0 +1,183 @@
+package dry_run
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/revision"
+	cipd_git "go.skia.org/infra/bazel/external/cipd/git"
+	"go.skia.org/infra/go/chrome_branch/mocks"
+	"go.skia.org/infra/go/git"
+	git_testutils "go.skia.org/infra/go/git/testutils"
+)
+
+const versionFile = "CHILD_VERSION"
+
+func setup(t *testing.T) (context.Context, *config.Config, *config_vars.Registry) {
+	ctx := cipd_git.UseGitFinder(context.Background())
+	cfg := &config.Config{
+		RollerName:        "child-parent",
+		ChildDisplayName:  "Child",
+		ParentDisplayName: "Parent",
+		Reviewer:          []string{"reviewer@google.com", "https://rotation"},
+		CommitMsg: &config.CommitMsgConfig{
+			BuiltIn: config.CommitMsgConfig_DEFAULT,
+		},
+		RepoManager: &config.Config_ParentChildRepoManager{
+			ParentChildRepoManager: &config.ParentChildRepoManagerConfig{
+				Parent: &config.ParentChildRepoManagerConfig_GitilesParent{
+					GitilesParent: &config.GitilesParentConfig{
+						Gitiles: &config.GitilesConfig{
+							Branch:  git.MainBranch,
+							RepoUrl: "https://fake-parent.googlesource.com/parent.git",
+						},
+						Dep: &config.DependencyConfig{
+							Primary: &config.VersionFileConfig{
+								Id:   "child",
+								Path: versionFile,
+							},
+						},
+						Gerrit: &config.GerritConfig{
+							Url:     "https://fake-parent-review.googlesource.com",
+							Project: "parent",
+							Config:  config.GerritConfig_CHROMIUM,
+						},
+					},
+				},
+			},
+		},
+	}
+	v := config_vars.FakeVars()
+	cbc := &mocks.Client{}
+	cbc.On("Get", ctx).Return(v.Branches.Chromium, v.Branches.ActiveMilestones, nil)
+	reg, err := config_vars.NewRegistry(ctx, cbc)
+	require.NoError(t, err)
+	return ctx, cfg, reg
+}
+
+func TestRun_GitChild(t *testing.T) {
+	ctx, cfg, reg := setup(t)
+	cfg.GetParentChildRepoManager().Child = &config.ParentChildRepoManagerConfig_GitilesChild{
+		GitilesChild: &config.GitilesChildConfig{
+			Gitiles: &config.GitilesConfig{
+				Branch:  git.MainBranch,
+				RepoUrl: "https://fake-child.googlesource.com/child.git",
+			},
+		},
+	}
+
+	child := git_testutils.GitInit(t, ctx)
+	defer child.Cleanup()
+	c0 := child.CommitGen(ctx, "a.txt")
+	c1 := child.CommitGen(ctx, "a.txt")
+	c2 := child.CommitGen(ctx, "a.txt")
+
+	parent := git_testutils.GitInit(t, ctx)
+	defer parent.Cleanup()
+	parent.Add(ctx, versionFile, c0+"\n")
+	parent.CommitMsg(ctx, "Initial commit")
+	parentHead := parent.Git(ctx, "rev-parse", "HEAD")
+
+	result, err := Run(ctx, cfg, reg, Options{
+		ParentRepo: parent.Dir(),
+		ChildRepo:  child.Dir(),
+		Workdir:    t.TempDir(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, c0, result.LastRollRev.Id)
+	require.Equal(t, c2, result.TipRev.Id)
+	require.Len(t, result.NotRolledRevs, 2)
+	require.Equal(t, c2, result.NextRollRev.Id)
+	require.Contains(t, result.CommitMsg, fmt.Sprintf("Roll Child from %s to %s\n", c0[:12], c2[:12]))
+	require.Contains(t, result.CommitMsg, "reviewer@google.com")
+	require.NotContains(t, result.CommitMsg, "https://rotation")
+	require.Contains(t, result.Diff, fmt.Sprintf("-%s\n+%s\n", c0, c2))
+
+	// The source repo must not be modified.
+	require.Equal(t, parentHead, parent.Git(ctx, "rev-parse", "HEAD"))
+
+	// Roll to a specific revision.
+	result, err = Run(ctx, cfg, reg, Options{
+		ParentRepo: parent.Dir(),
+		ChildRepo:  child.Dir(),
+		RollTo:     c1,
+		Workdir:    t.TempDir(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, c1, result.NextRollRev.Id)
+	require.Contains(t, result.Diff, fmt.Sprintf("-%s\n+%s\n", c0, c1))
+}
+
+func TestRun_FakeChild(t *testing.T) {
+	ctx, cfg, reg := setup(t)
+	cfg.GetParentChildRepoManager().Child = &config.ParentChildRepoManagerConfig_CipdChild{
+		CipdChild: &config.CIPDChildConfig{
+			Name: "some/package",
+			Tag:  "latest",
+		},
+	}
+
+	parent := git_testutils.GitInit(t, ctx)
+	defer parent.Cleanup()
+	parent.Add(ctx, versionFile, "version:1\n")
+	parent.CommitMsg(ctx, "Initial commit")
+
+	revs := []*revision.Revision{
+		{Id: "version:3", Display: "version:3"},
+		{Id: "version:2", Display: "version:2", InvalidReason: "broken"},
+		{Id: "version:1", Display: "version:1"},
+	}
+	result, err := Run(ctx, cfg, reg, Options{
+		ParentRepo:     parent.Dir(),
+		ChildRevisions: revs,
+		Strategy:       "single",
+		Workdir:        t.TempDir(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "version:1", result.LastRollRev.Id)
+	require.Len(t, result.NotRolledRevs, 2)
+	require.Equal(t, "version:3", result.NextRollRev.Id)
+	require.Contains(t, result.Diff, "-version:1\n+version:3\n")
+
+	// Git children require a repo.
+	_, err = Run(ctx, cfg, reg, Options{
+		ParentRepo: parent.Dir(),
+		Workdir:    t.TempDir(),
+	})
+	require.ErrorContains(t, err, "dry runs require ChildRevisions for this type of Child")
+}
+
+func TestRun_StrategyRequiresHistory(t *testing.T) {
+	ctx, cfg, reg := setup(t)
+	for _, s := range []string{"time_batch", "bisect"} {
+		_, err := Run(ctx, cfg, reg, Options{
+			ParentRepo:     "unused",
+			ChildRevisions: []*revision.Revision{{Id: "version:1"}},
+			Strategy:       s,
+			Workdir:        t.TempDir(),
+		})
+		require.ErrorContains(t, err, fmt.Sprintf("the %q strategy depends on the roller's recent rolls", s))
+	}
+}
+
+func TestRun_UpToDate(t *testing.T) {
+	ctx, cfg, reg := setup(t)
+	parent := git_testutils.GitInit(t, ctx)
+	defer parent.Cleanup()
+	parent.Add(ctx, versionFile, "version:1\n")
+	parent.CommitMsg(ctx, "Initial commit")
+
+	result, err := Run(ctx, cfg, reg, Options{
+		ParentRepo:     parent.Dir(),
+		ChildRevisions: []*revision.Revision{{Id: "version:1"}},
+		Workdir:        t.TempDir(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, result.NextRollRev)
+	require.Empty(t, result.Diff)
+	require.Contains(t, result.String(), "Nothing to roll.")
+}
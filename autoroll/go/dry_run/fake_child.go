@@ -0,0 +1,62 @@
+package dry_run
+
+import (
+	"context"
+
+	"go.skia.org/infra/autoroll/go/repo_manager/child"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/vfs"
+)
+
+// fakeChild is a Child implementation which serves a fixed list of revisions,
+// in reverse chronological order, in place of a package registry.
+type fakeChild struct {
+	revisions []*revision.Revision
+}
+
+// Update implements child.Child.
+func (c *fakeChild) Update(ctx context.Context, lastRollRev *revision.Revision) (*revision.Revision, []*revision.Revision, error) {
+	tipRev := c.revisions[0]
+	notRolledRevs, err := c.LogRevisions(ctx, lastRollRev, tipRev)
+	if err != nil {
+		return nil, nil, skerr.Wrap(err)
+	}
+	return tipRev.Copy(), notRolledRevs, nil
+}
+
+// GetRevision implements child.Child.
+func (c *fakeChild) GetRevision(_ context.Context, id string) (*revision.Revision, error) {
+	for _, rev := range c.revisions {
+		if rev.Id == id {
+			return rev.Copy(), nil
+		}
+	}
+	return nil, skerr.Fmt("unknown revision %q", id)
+}
+
+// LogRevisions implements child.Child.
+func (c *fakeChild) LogRevisions(_ context.Context, from, to *revision.Revision) ([]*revision.Revision, error) {
+	var rv []*revision.Revision
+	found := false
+	for _, rev := range c.revisions {
+		if rev.Id == from.Id {
+			return rv, nil
+		}
+		if rev.Id == to.Id {
+			found = true
+		}
+		if found {
+			rv = append(rv, rev.Copy())
+		}
+	}
+	return nil, skerr.Fmt("revision %q does not precede revision %q", from.Id, to.Id)
+}
+
+// VFS implements child.Child.
+func (c *fakeChild) VFS(_ context.Context, rev *revision.Revision) (vfs.FS, error) {
+	return nil, skerr.Fmt("VFS is not supported for fake children")
+}
+
+// fakeChild implements child.Child.
+var _ child.Child = &fakeChild{}
@@ -0,0 +1,150 @@
+package invalid_revisions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"go.skia.org/infra/autoroll/go/repo_manager/child/revision_filter"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/ds"
+	"go.skia.org/infra/go/skerr"
+)
+
+// InvalidRevision records that the roller itself found a Revision to be
+// invalid, eg. because bisection found that rolling it fails the commit queue.
+type InvalidRevision struct {
+	// Issue is the most recent failed roll which included the Revision.
+	Issue    int64     `datastore:"issue,noindex"`
+	Reason   string    `datastore:"reason,noindex"`
+	Revision string    `datastore:"revision,noindex"`
+	Roller   string    `datastore:"roller"`
+	Time     time.Time `datastore:"time,noindex"`
+}
+
+// DB stores InvalidRevisions.
+type DB interface {
+	// Put inserts or replaces the InvalidRevision for its roller and
+	// Revision.
+	Put(ctx context.Context, rev *InvalidRevision) error
+	// GetAll returns all of the InvalidRevisions for the given roller.
+	GetAll(ctx context.Context, roller string) ([]*InvalidRevision, error)
+}
+
+// Fake ancestor we supply for all InvalidRevisions, to force consistency.
+// We lose some performance this way but it keeps our tests from
+// flaking.
+func fakeAncestor() *datastore.Key {
+	rv := ds.NewKey(ds.KIND_AUTOROLL_INVALID_REVISION_ANCESTOR)
+	rv.ID = 13 // Bogus ID.
+	return rv
+}
+
+// Return a datastore key for the given roller and Revision.
+func key(roller, rev string) *datastore.Key {
+	k := ds.NewKey(ds.KIND_AUTOROLL_INVALID_REVISION)
+	k.Parent = fakeAncestor()
+	k.Name = roller + "_" + rev
+	return k
+}
+
+// DatastoreDB is an implementation of DB which uses Datastore.
+type DatastoreDB struct{}
+
+// NewDatastoreDB returns an implementation of DB which uses Datastore.
+func NewDatastoreDB() *DatastoreDB {
+	return &DatastoreDB{}
+}
+
+// Put implements DB.
+func (d *DatastoreDB) Put(ctx context.Context, rev *InvalidRevision) error {
+	_, err := ds.DS.RunInTransaction(ctx, func(tx *datastore.Transaction) error {
+		_, err := tx.Put(key(rev.Roller, rev.Revision), rev)
+		return err
+	})
+	return skerr.Wrap(err)
+}
+
+// GetAll implements DB.
+func (d *DatastoreDB) GetAll(ctx context.Context, roller string) ([]*InvalidRevision, error) {
+	query := ds.NewQuery(ds.KIND_AUTOROLL_INVALID_REVISION).Ancestor(fakeAncestor()).Filter("roller =", roller)
+	var rv []*InvalidRevision
+	if _, err := ds.DS.GetAll(ctx, query, &rv); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return rv, nil
+}
+
+var _ DB = &DatastoreDB{}
+
+// RevisionFilter is a revision_filter.RevisionFilter which skips the Revisions
+// stored in a DB for a given roller.
+type RevisionFilter struct {
+	db      DB
+	roller  string
+	invalid map[string]*InvalidRevision
+	mtx     sync.RWMutex
+}
+
+// NewRevisionFilter returns a RevisionFilter which skips the Revisions stored
+// in the given DB for the given roller.
+func NewRevisionFilter(db DB, roller string) *RevisionFilter {
+	return &RevisionFilter{
+		db:      db,
+		roller:  roller,
+		invalid: map[string]*InvalidRevision{},
+	}
+}
+
+// Get returns the InvalidRevision for the given Revision ID, as of the last
+// call to Update, or nil if the Revision has not been marked invalid.
+func (f *RevisionFilter) Get(id string) *InvalidRevision {
+	f.mtx.RLock()
+	defer f.mtx.RUnlock()
+	return f.invalid[id]
+}
+
+// Add marks the given Revision as invalid.
+func (f *RevisionFilter) Add(ctx context.Context, id, reason string, issue int64, ts time.Time) error {
+	rev := &InvalidRevision{
+		Issue:    issue,
+		Reason:   reason,
+		Revision: id,
+		Roller:   f.roller,
+		Time:     ts,
+	}
+	if err := f.db.Put(ctx, rev); err != nil {
+		return skerr.Wrapf(err, "failed to mark %s invalid", id)
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.invalid[id] = rev
+	return nil
+}
+
+// Skip implements revision_filter.RevisionFilter.
+func (f *RevisionFilter) Skip(_ context.Context, rev revision.Revision) (string, error) {
+	if invalid := f.Get(rev.Id); invalid != nil {
+		return invalid.Reason, nil
+	}
+	return "", nil
+}
+
+// Update implements revision_filter.RevisionFilter.
+func (f *RevisionFilter) Update(ctx context.Context) error {
+	revs, err := f.db.GetAll(ctx, f.roller)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to retrieve invalid revisions")
+	}
+	invalid := make(map[string]*InvalidRevision, len(revs))
+	for _, rev := range revs {
+		invalid[rev.Revision] = rev
+	}
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.invalid = invalid
+	return nil
+}
+
+var _ revision_filter.RevisionFilter = &RevisionFilter{}
@@ -0,0 +1,56 @@
+package invalid_revisions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/ds"
+	"go.skia.org/infra/go/ds/testutil"
+)
+
+func TestRevisionFilter(t *testing.T) {
+
+	ctx := context.Background()
+	testutil.InitDatastore(t, ds.KIND_AUTOROLL_INVALID_REVISION)
+	db := NewDatastoreDB()
+	ts := time.Unix(1682445445, 0).UTC()
+
+	check := func(f *RevisionFilter, id, expect string) {
+		reason, err := f.Skip(ctx, revision.Revision{Id: id})
+		require.NoError(t, err)
+		require.Equal(t, expect, reason)
+	}
+
+	f := NewRevisionFilter(db, "my-roller")
+	require.NoError(t, f.Update(ctx))
+	check(f, "abc", "")
+
+	require.NoError(t, f.Add(ctx, "abc", "it's broken", 123, ts))
+	check(f, "abc", "it's broken")
+	check(f, "def", "")
+
+	// The Revision is still invalid after a restart, but only for the
+	// roller which marked it.
+	f = NewRevisionFilter(db, "my-roller")
+	require.NoError(t, f.Update(ctx))
+	check(f, "abc", "it's broken")
+	require.Equal(t, &InvalidRevision{
+		Issue:    123,
+		Reason:   "it's broken",
+		Revision: "abc",
+		Roller:   "my-roller",
+		Time:     ts,
+	}, f.Get("abc"))
+	other := NewRevisionFilter(db, "other-roller")
+	require.NoError(t, other.Update(ctx))
+	check(other, "abc", "")
+
+	// Marking the Revision again replaces the old entry.
+	require.NoError(t, f.Add(ctx, "abc", "it's still broken", 456, ts))
+	require.NoError(t, f.Update(ctx))
+	check(f, "abc", "it's still broken")
+	require.Equal(t, int64(456), f.Get("abc").Issue)
+}
@@ -18,6 +18,7 @@ import (
 const (
 	// Types of notification message sent by the roller. These can be
 	// selected via notifier.Config.IncludeMsgTypes.
+	MSG_TYPE_CULPRIT_FOUND               = "culprit found"
 	MSG_TYPE_ISSUE_UPDATE                = "issue update"
 	MSG_TYPE_LAST_N_FAILED               = "last n failed"
 	MSG_TYPE_MANUAL_ROLL_CREATION_FAILED = "manual roll cl creation failed"
@@ -25,12 +26,16 @@ const (
 	MSG_TYPE_NEW_FAILURE                 = "new failure"
 	MSG_TYPE_NEW_SUCCESS                 = "new success"
 	MSG_TYPE_ROLL_CREATION_FAILED        = "cl creation failed"
+	MSG_TYPE_ROLL_REVERTED               = "roll reverted"
 	MSG_TYPE_SAFETY_THROTTLE             = "safety throttle"
 	MSG_TYPE_STRATEGY_CHANGE             = "strategy change"
 	MSG_TYPE_SUCCESS_THROTTLE            = "success throttle"
 	MSG_TYPE_TOO_MANY_CLS                = "too many CLs"
 
 	// Templates for messages sent by the roller.
+	subjectCulpritFound = "The {{.ChildName}} into {{.ParentName}} AutoRoller found a revision which fails the commit queue"
+	bodyCulpritFound    = "Bisection found that rolling {{.Revision}} fails the commit queue, most recently in {{.IssueURL}}. The revision has been marked invalid and the roller has stopped itself. Resume the roller once the failure has been fixed."
+
 	subjectIssueUpdate = "The {{.ChildName}} into {{.ParentName}} AutoRoller has uploaded issue {{.IssueID}}"
 
 	bodyManualRollCreationFailed    = "The roller failed to create a manual roll CL requested by {{.User}} to revision \"{{.Revision}}\" with:\n{{.Message}}"
@@ -51,6 +56,9 @@ const (
 	bodyRollCreationFailed    = "The roller failed to create a CL with:\n{{.Message}}"
 	subjectRollCreationFailed = "The {{.ChildName}} into {{.ParentName}} AutoRoller failed to create a CL"
 
+	subjectRollReverted = "The {{.ChildName}} into {{.ParentName}} roll was automatically reverted (issue {{.IssueID}})"
+	bodyRollReverted    = "The roll {{.IssueURL}} appears to have broken the parent:\n{{.Message}}\n\nThe roller uploaded a revert and has stopped itself. The revert is here: {{.RevertURL}}"
+
 	bodyStrategyChange    = "{{.User}} changed the next-roll-revision strategy to \"{{.Strategy}}\" with message: {{.Message}}"
 	subjectStrategyChange = "The {{.ChildName}} into {{.ParentName}} AutoRoller next-roll-revision strategy was changed"
 
@@ -65,6 +73,9 @@ const (
 )
 
 var (
+	subjectTmplCulpritFound = template.Must(template.New("subjectCulpritFound").Parse(subjectCulpritFound))
+	bodyTmplCulpritFound    = template.Must(template.New("bodyCulpritFound").Parse(bodyCulpritFound))
+
 	subjectTmplIssueUpdate = template.Must(template.New("subjectIssueUpdate").Parse(subjectIssueUpdate))
 
 	bodyTmplManualRollCreationFailed    = template.Must(template.New("bodyManualRollCreationFailed").Parse(bodyManualRollCreationFailed))
@@ -85,6 +96,9 @@ var (
 	bodyTmplRollCreationFailed    = template.Must(template.New("bodyRollCreationFailed").Parse(bodyRollCreationFailed))
 	subjectTmplRollCreationFailed = template.Must(template.New("subjectRollCreationFailed").Parse(subjectRollCreationFailed))
 
+	subjectTmplRollReverted = template.Must(template.New("subjectRollReverted").Parse(subjectRollReverted))
+	bodyTmplRollReverted    = template.Must(template.New("bodyRollReverted").Parse(bodyRollReverted))
+
 	subjectTmplStrategyChange = template.Must(template.New("subjectStrategyChange").Parse(subjectStrategyChange))
 	bodyTmplStrategyChange    = template.Must(template.New("bodyStrategyChange").Parse(bodyStrategyChange))
 
@@ -105,6 +119,7 @@ var (
 		config.NotifierConfig_NEW_SUCCESS:                 MSG_TYPE_NEW_SUCCESS,
 		config.NotifierConfig_ROLL_CREATION_FAILED:        MSG_TYPE_ROLL_CREATION_FAILED,
 		config.NotifierConfig_MANUAL_ROLL_CREATION_FAILED: MSG_TYPE_MANUAL_ROLL_CREATION_FAILED,
+		config.NotifierConfig_ROLL_REVERTED:               MSG_TYPE_ROLL_REVERTED,
 		config.NotifierConfig_SAFETY_THROTTLE:             MSG_TYPE_SAFETY_THROTTLE,
 		config.NotifierConfig_STRATEGY_CHANGE:             MSG_TYPE_STRATEGY_CHANGE,
 		config.NotifierConfig_SUCCESS_THROTTLE:            MSG_TYPE_SUCCESS_THROTTLE,
@@ -117,6 +132,7 @@ var (
 		MSG_TYPE_NEW_SUCCESS:                 config.NotifierConfig_NEW_SUCCESS,
 		MSG_TYPE_ROLL_CREATION_FAILED:        config.NotifierConfig_ROLL_CREATION_FAILED,
 		MSG_TYPE_MANUAL_ROLL_CREATION_FAILED: config.NotifierConfig_MANUAL_ROLL_CREATION_FAILED,
+		MSG_TYPE_ROLL_REVERTED:               config.NotifierConfig_ROLL_REVERTED,
 		MSG_TYPE_SAFETY_THROTTLE:             config.NotifierConfig_SAFETY_THROTTLE,
 		MSG_TYPE_STRATEGY_CHANGE:             config.NotifierConfig_STRATEGY_CHANGE,
 		MSG_TYPE_SUCCESS_THROTTLE:            config.NotifierConfig_SUCCESS_THROTTLE,
@@ -151,6 +167,7 @@ type tmplVars struct {
 	Message        string
 	N              int
 	ParentName     string
+	RevertURL      string
 	Revision       string
 	ServerURL      string
 	Strategy       string
@@ -233,6 +250,16 @@ func (a *AutoRollNotifier) send(ctx context.Context, vars *tmplVars, subjectTmpl
 	}
 }
 
+// Send a notification that bisection found a revision which causes rolls to
+// fail.
+func (a *AutoRollNotifier) SendCulpritFound(ctx context.Context, rev, id, url string) {
+	a.send(ctx, &tmplVars{
+		IssueID:  id,
+		IssueURL: url,
+		Revision: rev,
+	}, subjectTmplCulpritFound, bodyTmplCulpritFound, notifier.SEVERITY_ERROR, MSG_TYPE_CULPRIT_FOUND, nil)
+}
+
 // Send an issue update message.
 func (a *AutoRollNotifier) SendIssueUpdate(ctx context.Context, id, url, msg string) {
 	bodyTmpl, err := template.New("body").Parse(msg)
@@ -271,6 +298,16 @@ func (a *AutoRollNotifier) SendRollCreationFailed(ctx context.Context, err error
 	}, subjectTmplRollCreationFailed, bodyTmplRollCreationFailed, notifier.SEVERITY_ERROR, MSG_TYPE_ROLL_CREATION_FAILED, nil)
 }
 
+// Send a notification that a landed roll was automatically reverted.
+func (a *AutoRollNotifier) SendRollReverted(ctx context.Context, id, url, revertURL, reason string) {
+	a.send(ctx, &tmplVars{
+		IssueID:   id,
+		IssueURL:  url,
+		Message:   reason,
+		RevertURL: revertURL,
+	}, subjectTmplRollReverted, bodyTmplRollReverted, notifier.SEVERITY_ERROR, MSG_TYPE_ROLL_REVERTED, nil)
+}
+
 // Send a strategy change message.
 func (a *AutoRollNotifier) SendStrategyChange(ctx context.Context, user, strategy, message string) {
 	a.send(ctx, &tmplVars{
@@ -63,4 +63,18 @@ func TestNotifier(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("The roller is throttled because it attempted to upload too many CLs in too short a time.  The roller will unthrottle at %s."+footer, now.Format(time.RFC1123)), t1.msgs[2].m.Body)
 	require.Equal(t, notifier.SEVERITY_ERROR, t1.msgs[2].m.Severity)
 	require.Equal(t, 1, len(t2.msgs))
+
+	n.SendRollReverted(ctx, "123", "https://codereview/123", "https://codereview/456", "Tree was closed: broken build")
+	require.Equal(t, 4, len(t1.msgs))
+	require.Equal(t, "The childRepo into parentRepo roll was automatically reverted (issue 123)", t1.msgs[3].subject)
+	require.Equal(t, "The roll https://codereview/123 appears to have broken the parent:\nTree was closed: broken build\n\nThe roller uploaded a revert and has stopped itself. The revert is here: https://codereview/456"+footer, t1.msgs[3].m.Body)
+	require.Equal(t, notifier.SEVERITY_ERROR, t1.msgs[3].m.Severity)
+	require.Equal(t, 1, len(t2.msgs))
+
+	n.SendCulpritFound(ctx, "abc123", "123", "https://codereview/123")
+	require.Equal(t, 5, len(t1.msgs))
+	require.Equal(t, "The childRepo into parentRepo AutoRoller found a revision which fails the commit queue", t1.msgs[4].subject)
+	require.Equal(t, "Bisection found that rolling abc123 fails the commit queue, most recently in https://codereview/123. The revision has been marked invalid and the roller has stopped itself. Resume the roller once the failure has been fixed."+footer, t1.msgs[4].m.Body)
+	require.Equal(t, notifier.SEVERITY_ERROR, t1.msgs[4].m.Severity)
+	require.Equal(t, 1, len(t2.msgs))
 }
@@ -0,0 +1,216 @@
+package child
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Masterminds/semver"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/package_registry"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/vfs"
+)
+
+// NewPackageRegistry returns an implementation of Child which tracks the
+// versions of a package published to a package registry, eg. npm, PyPI or
+// crates.io.
+func NewPackageRegistry(ctx context.Context, c *config.PackageRegistryChildConfig, client *http.Client) (*PackageRegistryChild, error) {
+	if err := c.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	var registry package_registry.Registry
+	switch c.Registry {
+	case config.PackageRegistry_NPM:
+		registry = package_registry.NewNPM(client, c.RegistryUrl)
+	case config.PackageRegistry_PYPI:
+		registry = package_registry.NewPyPI(client, c.RegistryUrl)
+	case config.PackageRegistry_CRATES:
+		registry = package_registry.NewCrates(client, c.RegistryUrl)
+	default:
+		return nil, skerr.Fmt("unknown registry %s", c.Registry)
+	}
+	var constraint *semver.Constraints
+	if c.VersionConstraint != "" {
+		var err error
+		constraint, err = semver.NewConstraint(c.VersionConstraint)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+	return &PackageRegistryChild{
+		registry:           registry,
+		pkg:                c.PackageName,
+		constraint:         constraint,
+		includePrereleases: c.IncludePrereleases,
+	}, nil
+}
+
+// PackageRegistryChild is an implementation of Child which tracks the versions
+// of a package published to a package registry.
+type PackageRegistryChild struct {
+	registry           package_registry.Registry
+	pkg                string
+	constraint         *semver.Constraints
+	includePrereleases bool
+}
+
+// invalidReason returns a non-empty string indicating why the given version
+// should not be rolled, if applicable.
+func (c *PackageRegistryChild) invalidReason(v *package_registry.Version) string {
+	if v.Yanked {
+		return "Version has been yanked or deprecated."
+	}
+	sv, err := c.registry.ParseVersion(v.Version)
+	if err != nil {
+		return fmt.Sprintf("Failed to parse version: %s", err)
+	}
+	if sv.Prerelease() != "" {
+		if !c.includePrereleases {
+			return "Version is a pre-release."
+		}
+		// Constraints never match pre-release versions unless the
+		// constraint itself refers to a pre-release, so check the
+		// corresponding release version instead.
+		release, err := sv.SetPrerelease("")
+		if err != nil {
+			return fmt.Sprintf("Failed to parse version: %s", err)
+		}
+		sv = &release
+	}
+	if c.constraint != nil && !c.constraint.Check(sv) {
+		return "Version does not satisfy constraint."
+	}
+	return ""
+}
+
+// toRevision converts the given package version to a Revision.
+func (c *PackageRegistryChild) toRevision(v *package_registry.Version) *revision.Revision {
+	return &revision.Revision{
+		Id:            v.Version,
+		Checksum:      v.Checksum,
+		Author:        v.Author,
+		Description:   fmt.Sprintf("%s %s", c.pkg, v.Version),
+		Display:       v.Version,
+		InvalidReason: c.invalidReason(v),
+		Timestamp:     v.Published,
+		URL:           v.URL,
+	}
+}
+
+// getVersions retrieves all versions of the package, most recent first.
+func (c *PackageRegistryChild) getVersions(ctx context.Context) ([]*package_registry.Version, error) {
+	versions, err := c.registry.GetVersions(ctx, c.pkg)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return package_registry.SortVersions(c.registry, versions), nil
+}
+
+// findVersion returns the Version matching the given ID from the given
+// versions. Versions which are written differently but have the same semantic
+// version, eg. "1.2" and "1.2.0", are considered to match.
+func (c *PackageRegistryChild) findVersion(versions []*package_registry.Version, id string) (*package_registry.Version, error) {
+	for _, v := range versions {
+		if v.Version == id {
+			return v, nil
+		}
+	}
+	sv, err := c.registry.ParseVersion(id)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "unknown version %q of %s", id, c.pkg)
+	}
+	for _, v := range versions {
+		if other, err := c.registry.ParseVersion(v.Version); err == nil && other.Equal(sv) {
+			return v, nil
+		}
+	}
+	return nil, skerr.Fmt("unknown version %q of %s", id, c.pkg)
+}
+
+// GetRevision implements Child.
+func (c *PackageRegistryChild) GetRevision(ctx context.Context, id string) (*revision.Revision, error) {
+	versions, err := c.getVersions(ctx)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	v, err := c.findVersion(versions, id)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return c.toRevision(v), nil
+}
+
+// logRevisions returns the valid versions in the range (from, to], most recent
+// first.
+func (c *PackageRegistryChild) logRevisions(versions []*package_registry.Version, from, to *revision.Revision) ([]*revision.Revision, error) {
+	fromVersion, err := c.registry.ParseVersion(from.Id)
+	if err != nil {
+		// We can't compare against the last-rolled version, so the best we
+		// can do is to consider only the target version.
+		sklog.Warningf("Failed to parse version %q of %s: %s", from.Id, c.pkg, err)
+		if from.Id == to.Id {
+			return []*revision.Revision{}, nil
+		}
+		return []*revision.Revision{to}, nil
+	}
+	toVersion, err := c.registry.ParseVersion(to.Id)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	revs := []*revision.Revision{}
+	for _, v := range versions {
+		sv, err := c.registry.ParseVersion(v.Version)
+		if err != nil {
+			continue
+		}
+		if sv.GreaterThan(fromVersion) && !sv.GreaterThan(toVersion) {
+			if rev := c.toRevision(v); rev.InvalidReason == "" || rev.Id == to.Id {
+				revs = append(revs, rev)
+			}
+		}
+	}
+	return revs, nil
+}
+
+// LogRevisions implements Child.
+func (c *PackageRegistryChild) LogRevisions(ctx context.Context, from, to *revision.Revision) ([]*revision.Revision, error) {
+	versions, err := c.getVersions(ctx)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return c.logRevisions(versions, from, to)
+}
+
+// Update implements Child.
+func (c *PackageRegistryChild) Update(ctx context.Context, lastRollRev *revision.Revision) (*revision.Revision, []*revision.Revision, error) {
+	versions, err := c.getVersions(ctx)
+	if err != nil {
+		return nil, nil, skerr.Wrap(err)
+	}
+	var tipRev *revision.Revision
+	for _, v := range versions {
+		if rev := c.toRevision(v); rev.InvalidReason == "" {
+			tipRev = rev
+			break
+		}
+	}
+	if tipRev == nil {
+		return nil, nil, skerr.Fmt("no valid versions of %s found", c.pkg)
+	}
+	notRolledRevs, err := c.logRevisions(versions, lastRollRev, tipRev)
+	if err != nil {
+		return nil, nil, skerr.Wrap(err)
+	}
+	return tipRev, notRolledRevs, nil
+}
+
+// VFS implements the Child interface.
+func (c *PackageRegistryChild) VFS(ctx context.Context, rev *revision.Revision) (vfs.FS, error) {
+	return nil, skerr.Fmt("VFS not implemented for PackageRegistryChild")
+}
+
+// Assert that PackageRegistryChild implements Child.
+var _ Child = &PackageRegistryChild{}
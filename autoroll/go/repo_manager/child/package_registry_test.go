@@ -0,0 +1,152 @@
+package child
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/package_registry"
+)
+
+// fakeRegistry is a package_registry.Registry which returns a fixed set of
+// versions.
+type fakeRegistry struct {
+	versions []*package_registry.Version
+}
+
+// GetVersions implements package_registry.Registry.
+func (r *fakeRegistry) GetVersions(ctx context.Context, pkg string) ([]*package_registry.Version, error) {
+	return r.versions, nil
+}
+
+// ParseVersion implements package_registry.Registry.
+func (r *fakeRegistry) ParseVersion(version string) (*semver.Version, error) {
+	return semver.NewVersion(version)
+}
+
+func setupPackageRegistryChild(t *testing.T, constraint string, includePrereleases bool) *PackageRegistryChild {
+	ts := time.Unix(1682445445, 0).UTC()
+	version := func(v string, yanked bool) *package_registry.Version {
+		ts = ts.Add(time.Hour)
+		return &package_registry.Version{
+			Version:   v,
+			Published: ts,
+			Author:    "me",
+			URL:       "https://registry/pkg/" + v,
+			Checksum:  "checksum-" + v,
+			Yanked:    yanked,
+		}
+	}
+	c := &PackageRegistryChild{
+		registry: &fakeRegistry{
+			versions: []*package_registry.Version{
+				version("1.0.0", false),
+				version("1.1.0", false),
+				version("1.2.0", true),
+				version("1.3.0", false),
+				version("2.0.0-rc.1", false),
+				version("1.4.0", false),
+				version("2.0.0", false),
+				version("not-a-version", false),
+			},
+		},
+		pkg:                "pkg",
+		includePrereleases: includePrereleases,
+	}
+	if constraint != "" {
+		var err error
+		c.constraint, err = semver.NewConstraint(constraint)
+		require.NoError(t, err)
+	}
+	return c
+}
+
+func revIDs(revs []*revision.Revision) []string {
+	rv := make([]string, 0, len(revs))
+	for _, rev := range revs {
+		rv = append(rv, rev.Id)
+	}
+	return rv
+}
+
+func TestPackageRegistryChild_GetRevision(t *testing.T) {
+	ctx := context.Background()
+	c := setupPackageRegistryChild(t, "", false)
+	rev, err := c.GetRevision(ctx, "1.1.0")
+	require.NoError(t, err)
+	require.Equal(t, &revision.Revision{
+		Id:          "1.1.0",
+		Checksum:    "checksum-1.1.0",
+		Author:      "me",
+		Description: "pkg 1.1.0",
+		Display:     "1.1.0",
+		Timestamp:   time.Unix(1682445445, 0).UTC().Add(2 * time.Hour),
+		URL:         "https://registry/pkg/1.1.0",
+	}, rev)
+
+	// Equivalent versions are matched.
+	rev, err = c.GetRevision(ctx, "1.1")
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", rev.Id)
+
+	// Invalid versions are still returned.
+	rev, err = c.GetRevision(ctx, "1.2.0")
+	require.NoError(t, err)
+	require.Equal(t, "Version has been yanked or deprecated.", rev.InvalidReason)
+	rev, err = c.GetRevision(ctx, "2.0.0-rc.1")
+	require.NoError(t, err)
+	require.Equal(t, "Version is a pre-release.", rev.InvalidReason)
+
+	_, err = c.GetRevision(ctx, "3.0.0")
+	require.ErrorContains(t, err, `unknown version "3.0.0" of pkg`)
+}
+
+func TestPackageRegistryChild_Update(t *testing.T) {
+	ctx := context.Background()
+	c := setupPackageRegistryChild(t, "", false)
+	tipRev, notRolledRevs, err := c.Update(ctx, &revision.Revision{Id: "1.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", tipRev.Id)
+	require.Equal(t, []string{"2.0.0", "1.4.0", "1.3.0", "1.1.0"}, revIDs(notRolledRevs))
+
+	tipRev, notRolledRevs, err = c.Update(ctx, &revision.Revision{Id: "2.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", tipRev.Id)
+	require.Empty(t, notRolledRevs)
+}
+
+func TestPackageRegistryChild_Update_Constraint(t *testing.T) {
+	ctx := context.Background()
+	c := setupPackageRegistryChild(t, "^1.1", false)
+	tipRev, notRolledRevs, err := c.Update(ctx, &revision.Revision{Id: "1.0.0"})
+	require.NoError(t, err)
+	require.Equal(t, "1.4.0", tipRev.Id)
+	require.Equal(t, []string{"1.4.0", "1.3.0", "1.1.0"}, revIDs(notRolledRevs))
+}
+
+func TestPackageRegistryChild_Update_Prereleases(t *testing.T) {
+	ctx := context.Background()
+	c := setupPackageRegistryChild(t, "<2.0.0", true)
+	tipRev, notRolledRevs, err := c.Update(ctx, &revision.Revision{Id: "1.3.0"})
+	require.NoError(t, err)
+	require.Equal(t, "1.4.0", tipRev.Id)
+	require.Equal(t, []string{"1.4.0"}, revIDs(notRolledRevs))
+
+	c = setupPackageRegistryChild(t, ">=1.4.0", true)
+	tipRev, notRolledRevs, err = c.Update(ctx, &revision.Revision{Id: "1.3.0"})
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", tipRev.Id)
+	require.Equal(t, []string{"2.0.0", "2.0.0-rc.1", "1.4.0"}, revIDs(notRolledRevs))
+}
+
+func TestPackageRegistryChild_Update_UnparseableLastRollRev(t *testing.T) {
+	ctx := context.Background()
+	c := setupPackageRegistryChild(t, "", false)
+	tipRev, notRolledRevs, err := c.Update(ctx, &revision.Revision{Id: "latest"})
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", tipRev.Id)
+	require.Equal(t, []*revision.Revision{tipRev}, notRolledRevs)
+}
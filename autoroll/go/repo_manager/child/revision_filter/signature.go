@@ -0,0 +1,429 @@
+package revision_filter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/git"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+// signatureSource retrieves and verifies the signature for a Revision.
+type signatureSource interface {
+	// verify returns an error if the given Revision does not have a valid
+	// signature from one of the trusted keys.
+	verify(ctx context.Context, rev revision.Revision) error
+	// update updates any local state used by the signatureSource.
+	update(ctx context.Context) error
+}
+
+// SignatureRevisionFilter is a RevisionFilter which filters out Revisions
+// which are not signed by one of a set of trusted keys.
+type SignatureRevisionFilter struct {
+	source signatureSource
+}
+
+// Skip implements RevisionFilter.
+func (f *SignatureRevisionFilter) Skip(ctx context.Context, r revision.Revision) (string, error) {
+	if err := f.source.verify(ctx, r); err != nil {
+		if errUnverified, ok := skerr.Unwrap(err).(*unverifiedError); ok {
+			return fmt.Sprintf("revision %q has no valid signature from a trusted key: %s", r.Id, errUnverified.msg), nil
+		}
+		return "", skerr.Wrap(err)
+	}
+	return "", nil
+}
+
+// Update implements RevisionFilter.
+func (f *SignatureRevisionFilter) Update(ctx context.Context) error {
+	return skerr.Wrap(f.source.update(ctx))
+}
+
+// NewSignatureRevisionFilter returns a RevisionFilter which filters out
+// Revisions which are not signed by one of the configured keys.
+func NewSignatureRevisionFilter(ctx context.Context, client *http.Client, cfg *config.SignatureRevisionFilterConfig, workdir string) (*SignatureRevisionFilter, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	keys, err := parseTrustedKeys(cfg.PublicKey)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	var source signatureSource
+	if cfg.GetGit() != nil {
+		repo, err := git.NewRepo(ctx, cfg.GetGit().RepoUrl, filepath.Join(workdir, "signature_revision_filter"))
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		source, err = newGitSignatureSource(repo, cfg.GetGit(), keys)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	} else {
+		source = newArtifactSignatureSource(client, cfg.GetArtifact(), keys)
+	}
+	return &SignatureRevisionFilter{
+		source: source,
+	}, nil
+}
+
+var _ RevisionFilter = &SignatureRevisionFilter{}
+
+// unverifiedError indicates that a Revision could not be verified, as opposed
+// to a transient failure to retrieve the information needed to verify it.
+type unverifiedError struct {
+	msg string
+}
+
+// Error implements error.
+func (e *unverifiedError) Error() string {
+	return e.msg
+}
+
+// unverified returns an unverifiedError with the given message.
+func unverified(format string, args ...interface{}) error {
+	return &unverifiedError{msg: fmt.Sprintf(format, args...)}
+}
+
+// gitRepo is the subset of git.Repo used by gitSignatureSource.
+type gitRepo interface {
+	Git(ctx context.Context, cmd ...string) (string, error)
+	Update(ctx context.Context) error
+}
+
+// gitSignatureSource verifies signed commits or, if a tag regex is provided,
+// signed tags pointing at the commits.
+type gitSignatureSource struct {
+	repo     gitRepo
+	tagRegex *regexp.Regexp
+	keys     *trustedKeys
+}
+
+// newGitSignatureSource returns a gitSignatureSource instance.
+func newGitSignatureSource(repo gitRepo, cfg *config.GitSignatureConfig, keys *trustedKeys) (*gitSignatureSource, error) {
+	var tagRegex *regexp.Regexp
+	if cfg.TagRegex != "" {
+		var err error
+		tagRegex, err = regexp.Compile(cfg.TagRegex)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+	return &gitSignatureSource{
+		repo:     repo,
+		tagRegex: tagRegex,
+		keys:     keys,
+	}, nil
+}
+
+// verify implements signatureSource.
+func (s *gitSignatureSource) verify(ctx context.Context, rev revision.Revision) error {
+	if s.tagRegex != nil {
+		return s.verifyTags(ctx, rev)
+	}
+	return s.verifyCommit(ctx, rev)
+}
+
+// verifyCommit verifies the signature on the commit itself.
+func (s *gitSignatureSource) verifyCommit(ctx context.Context, rev revision.Revision) error {
+	contents, err := s.repo.Git(ctx, "cat-file", "commit", rev.Id)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to read commit %s", rev.Id)
+	}
+	payload, sig := splitCommitSignature(contents)
+	if sig == "" {
+		return unverified("commit %s is not signed", rev.Id)
+	}
+	if err := s.keys.verifyMessage([]byte(payload), []byte(sig), sshNamespaceGit); err != nil {
+		return unverified("commit %s: %s", rev.Id, err)
+	}
+	return nil
+}
+
+// verifyTags verifies that at least one matching tag which points at the
+// commit has a valid signature.
+func (s *gitSignatureSource) verifyTags(ctx context.Context, rev revision.Revision) error {
+	output, err := s.repo.Git(ctx, "for-each-ref", "--points-at", rev.Id, "--format=%(refname:strip=2)", "refs/tags")
+	if err != nil {
+		return skerr.Wrapf(err, "failed to find tags for %s", rev.Id)
+	}
+	var errs []string
+	for _, tag := range strings.Fields(output) {
+		if !s.tagRegex.MatchString(tag) {
+			continue
+		}
+		contents, err := s.repo.Git(ctx, "cat-file", "tag", tag)
+		if err != nil {
+			// Lightweight tags can't be signed.
+			errs = append(errs, fmt.Sprintf("tag %s is not an annotated tag", tag))
+			continue
+		}
+		payload, sig := splitTagSignature(contents)
+		if sig == "" {
+			errs = append(errs, fmt.Sprintf("tag %s is not signed", tag))
+			continue
+		}
+		if err := s.keys.verifyMessage([]byte(payload), []byte(sig), sshNamespaceGit); err != nil {
+			errs = append(errs, fmt.Sprintf("tag %s: %s", tag, err))
+			continue
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return unverified("no tags matching %q point at %s", s.tagRegex.String(), rev.Id)
+	}
+	return unverified("%s", strings.Join(errs, "; "))
+}
+
+// update implements signatureSource.
+func (s *gitSignatureSource) update(ctx context.Context) error {
+	if err := s.repo.Update(ctx); err != nil {
+		return skerr.Wrap(err)
+	}
+	if s.tagRegex != nil {
+		if _, err := s.repo.Git(ctx, "fetch", "--force", "--tags"); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// splitCommitSignature splits the raw contents of a commit object into the
+// signed payload and the signature, which is stored in the "gpgsig" header
+// regardless of the signature type. Returns an empty signature if the commit
+// is not signed.
+func splitCommitSignature(contents string) (string, string) {
+	const header = "gpgsig "
+	lines := strings.SplitAfter(contents, "\n")
+	var payload, sig strings.Builder
+	inHeaders := true
+	inSig := false
+	for _, line := range lines {
+		if inHeaders && line == "\n" {
+			inHeaders = false
+		}
+		if inHeaders && strings.HasPrefix(line, header) {
+			inSig = true
+			sig.WriteString(strings.TrimPrefix(line, header))
+			continue
+		}
+		if inSig && strings.HasPrefix(line, " ") {
+			sig.WriteString(strings.TrimPrefix(line, " "))
+			continue
+		}
+		inSig = false
+		payload.WriteString(line)
+	}
+	return payload.String(), sig.String()
+}
+
+// splitTagSignature splits the raw contents of a tag object into the signed
+// payload and the signature, which is appended to the tag message. Returns an
+// empty signature if the tag is not signed.
+func splitTagSignature(contents string) (string, string) {
+	idx := -1
+	for _, header := range []string{pgpSignatureHeader, sshSignatureHeader} {
+		if i := strings.LastIndex(contents, header); i > idx {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return contents, ""
+	}
+	return contents[:idx], contents[idx:]
+}
+
+// artifactSignatureSource verifies signatures on artifacts, which are
+// retrieved via HTTP.
+type artifactSignatureSource struct {
+	client            *http.Client
+	format            config.ArtifactSignatureConfig_Format
+	signatureURLTmpl  string
+	artifactURLTmpl   string
+	keys              *trustedKeys
+	maxArtifactLength int64
+}
+
+// maxArtifactLength is the largest artifact we're willing to download in order
+// to verify its signature.
+const maxArtifactLength = 512 * 1024 * 1024
+
+// newArtifactSignatureSource returns an artifactSignatureSource instance.
+func newArtifactSignatureSource(client *http.Client, cfg *config.ArtifactSignatureConfig, keys *trustedKeys) *artifactSignatureSource {
+	return &artifactSignatureSource{
+		client:            client,
+		format:            cfg.Format,
+		signatureURLTmpl:  cfg.SignatureUrlTmpl,
+		artifactURLTmpl:   cfg.ArtifactUrlTmpl,
+		keys:              keys,
+		maxArtifactLength: maxArtifactLength,
+	}
+}
+
+// verify implements signatureSource.
+func (s *artifactSignatureSource) verify(ctx context.Context, rev revision.Revision) error {
+	sigURL := fmt.Sprintf(s.signatureURLTmpl, rev.Id)
+	sig, found, err := s.get(ctx, sigURL)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to retrieve signature")
+	}
+	if !found {
+		return unverified("no signature found at %s", sigURL)
+	}
+
+	// Obtain either the artifact itself or its digest.
+	var artifact, digest []byte
+	if s.artifactURLTmpl != "" {
+		artifactURL := fmt.Sprintf(s.artifactURLTmpl, rev.Id)
+		var found bool
+		artifact, found, err = s.get(ctx, artifactURL)
+		if err != nil {
+			return skerr.Wrapf(err, "failed to retrieve artifact")
+		}
+		if !found {
+			return unverified("no artifact found at %s", artifactURL)
+		}
+	} else if d := sha256FromChecksum(rev.Checksum); d != nil {
+		digest = d
+	} else if rev.URL != "" {
+		var found bool
+		artifact, found, err = s.get(ctx, rev.URL)
+		if err != nil {
+			return skerr.Wrapf(err, "failed to retrieve artifact")
+		}
+		if !found {
+			return unverified("no artifact found at %s", rev.URL)
+		}
+	} else {
+		return skerr.Fmt("revision %q has no SHA-256 checksum or URL and no artifact_url_tmpl is configured", rev.Id)
+	}
+	if artifact != nil {
+		d := sha256.Sum256(artifact)
+		digest = d[:]
+	}
+
+	switch s.format {
+	case config.ArtifactSignatureConfig_DETACHED:
+		if artifact != nil {
+			err = s.keys.verifyMessage(artifact, sig, sshNamespaceFile)
+		} else {
+			err = s.keys.verifyDigest(digest, sig)
+		}
+	case config.ArtifactSignatureConfig_SIGSTORE_BUNDLE:
+		err = s.verifyBundle(digest, sig)
+	default:
+		return skerr.Fmt("unknown signature format %s", s.format)
+	}
+	if err != nil {
+		return unverified("%s", err)
+	}
+	return nil
+}
+
+// sigstoreBundle is the subset of a sigstore bundle which is needed to verify
+// a signature over an artifact digest. See
+// https://github.com/sigstore/protobuf-specs for the full definition.
+type sigstoreBundle struct {
+	MessageSignature *struct {
+		MessageDigest *struct {
+			Algorithm string `json:"algorithm"`
+			Digest    string `json:"digest"`
+		} `json:"messageDigest"`
+		Signature string `json:"signature"`
+	} `json:"messageSignature"`
+}
+
+// verifyBundle verifies the given sigstore bundle against the given SHA-256
+// artifact digest.
+func (s *artifactSignatureSource) verifyBundle(digest, contents []byte) error {
+	var bundle sigstoreBundle
+	if err := json.Unmarshal(contents, &bundle); err != nil {
+		return skerr.Wrapf(err, "failed to parse sigstore bundle")
+	}
+	ms := bundle.MessageSignature
+	if ms == nil || ms.Signature == "" {
+		return skerr.Fmt("sigstore bundle has no message signature")
+	}
+	if ms.MessageDigest != nil {
+		if ms.MessageDigest.Algorithm != "SHA2_256" {
+			return skerr.Fmt("unsupported sigstore bundle digest algorithm %q", ms.MessageDigest.Algorithm)
+		}
+		bundleDigest, err := base64.StdEncoding.DecodeString(ms.MessageDigest.Digest)
+		if err != nil {
+			return skerr.Wrapf(err, "failed to decode sigstore bundle digest")
+		}
+		if !bytes.Equal(bundleDigest, digest) {
+			return skerr.Fmt("sigstore bundle digest %x does not match artifact digest %x", bundleDigest, digest)
+		}
+	}
+	sig, err := base64.StdEncoding.DecodeString(ms.Signature)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to decode sigstore bundle signature")
+	}
+	return s.keys.verifyDigest(digest, sig)
+}
+
+// get retrieves the contents of the given URL. Returns false if the URL does
+// not exist.
+func (s *artifactSignatureSource) get(ctx context.Context, url string) ([]byte, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, skerr.Wrapf(err, "failed to create HTTP request")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, false, skerr.Wrapf(err, "failed to execute HTTP request")
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			sklog.Errorf("Failed to close response body: %s", err)
+		}
+	}()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, s.maxArtifactLength+1))
+	if err != nil {
+		return nil, false, skerr.Wrapf(err, "failed to read response body")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, false, skerr.Fmt("got HTTP status code %d from %s; body: %s", resp.StatusCode, url, string(b))
+	}
+	if int64(len(b)) > s.maxArtifactLength {
+		return nil, false, skerr.Fmt("%s exceeds the maximum size of %d bytes", url, s.maxArtifactLength)
+	}
+	return b, true, nil
+}
+
+// update implements signatureSource.
+func (s *artifactSignatureSource) update(_ context.Context) error {
+	return nil
+}
+
+// sha256FromChecksum returns the SHA-256 digest contained in the given
+// Revision checksum, or nil if the checksum is not a SHA-256 digest. CIPD
+// checksums are hex-encoded, while Docker checksums have a "sha256:" prefix.
+func sha256FromChecksum(checksum string) []byte {
+	checksum = strings.TrimPrefix(checksum, "sha256:")
+	if len(checksum) != 2*sha256.Size {
+		return nil
+	}
+	b, err := hex.DecodeString(checksum)
+	if err != nil {
+		return nil
+	}
+	return b
+}
@@ -0,0 +1,235 @@
+package revision_filter
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+	"golang.org/x/crypto/openpgp" //nolint:staticcheck // Still the only OpenPGP implementation available to us.
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	pgpPublicKeyHeader = "-----BEGIN PGP PUBLIC KEY BLOCK-----"
+	pgpSignatureHeader = "-----BEGIN PGP SIGNATURE-----"
+	sshSignatureHeader = "-----BEGIN SSH SIGNATURE-----"
+	sshSignatureFooter = "-----END SSH SIGNATURE-----"
+
+	// sshSigMagic is the preamble of SSH signatures, as defined in
+	// https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+	sshSigMagic = "SSHSIG"
+
+	// sshNamespaceGit is the SSH signature namespace used by git.
+	sshNamespaceGit = "git"
+	// sshNamespaceFile is the SSH signature namespace used by default by
+	// "ssh-keygen -Y sign".
+	sshNamespaceFile = "file"
+)
+
+// trustedKeys is a set of public keys which are trusted to sign revisions.
+type trustedKeys struct {
+	pgp  openpgp.EntityList
+	ssh  []ssh.PublicKey
+	pkix []crypto.PublicKey
+}
+
+// parseTrustedKeys parses the given public keys. Each key may be an
+// ASCII-armored OpenPGP public key block, a PEM-encoded PKIX public key, or an
+// SSH public key in authorized_keys format.
+func parseTrustedKeys(keys []string) (*trustedKeys, error) {
+	rv := &trustedKeys{}
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if strings.HasPrefix(key, pgpPublicKeyHeader) {
+			entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+			if err != nil {
+				return nil, skerr.Wrapf(err, "failed to parse OpenPGP public key")
+			}
+			rv.pgp = append(rv.pgp, entities...)
+		} else if block, _ := pem.Decode([]byte(key)); block != nil {
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, skerr.Wrapf(err, "failed to parse PEM-encoded public key")
+			}
+			rv.pkix = append(rv.pkix, pub)
+		} else {
+			pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key))
+			if err != nil {
+				return nil, skerr.Wrapf(err, "failed to parse public key %q", key)
+			}
+			rv.ssh = append(rv.ssh, pub)
+		}
+	}
+	return rv, nil
+}
+
+// verifyMessage returns an error unless the given signature over the given
+// message was made by one of the trusted keys. The signature may be an
+// ASCII-armored OpenPGP or SSH signature, or a raw or base64-encoded signature
+// made by a PKIX key. SSH signatures must use the given namespace.
+func (k *trustedKeys) verifyMessage(msg, sig []byte, sshNamespace string) error {
+	trimmed := bytes.TrimSpace(sig)
+	if bytes.HasPrefix(trimmed, []byte(pgpSignatureHeader)) {
+		return k.verifyPGP(msg, trimmed)
+	}
+	if bytes.HasPrefix(trimmed, []byte(sshSignatureHeader)) {
+		return k.verifySSH(msg, trimmed, sshNamespace)
+	}
+	raw := decodeRawSignature(sig)
+	digest := sha256.Sum256(msg)
+	for _, pub := range k.pkix {
+		if verifyPKIX(pub, msg, digest[:], raw) {
+			return nil
+		}
+	}
+	return skerr.Fmt("signature does not match any trusted key")
+}
+
+// verifyDigest returns an error unless the given signature over the given
+// SHA-256 digest was made by one of the trusted keys. Only ECDSA and RSA keys
+// can be used to sign a digest; other types of signatures cover the full
+// message.
+func (k *trustedKeys) verifyDigest(digest, sig []byte) error {
+	raw := decodeRawSignature(sig)
+	for _, pub := range k.pkix {
+		if verifyPKIX(pub, nil, digest, raw) {
+			return nil
+		}
+	}
+	return skerr.Fmt("signature does not match any trusted ECDSA or RSA key")
+}
+
+// verifyPGP verifies an ASCII-armored OpenPGP detached signature.
+func (k *trustedKeys) verifyPGP(msg, sig []byte) error {
+	if len(k.pgp) == 0 {
+		return skerr.Fmt("found an OpenPGP signature but no OpenPGP keys are trusted")
+	}
+	if _, err := openpgp.CheckArmoredDetachedSignature(k.pgp, bytes.NewReader(msg), bytes.NewReader(sig)); err != nil {
+		return skerr.Wrapf(err, "invalid OpenPGP signature")
+	}
+	return nil
+}
+
+// sshSignature is the wire format of an SSH signature, following the magic
+// preamble.
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the wire format of the data which is signed by an SSH
+// signature, following the magic preamble.
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// verifySSH verifies an ASCII-armored SSH signature.
+func (k *trustedKeys) verifySSH(msg, armored []byte, namespace string) error {
+	if len(k.ssh) == 0 {
+		return skerr.Fmt("found an SSH signature but no SSH keys are trusted")
+	}
+	body := strings.TrimSpace(string(armored))
+	body = strings.TrimPrefix(body, sshSignatureHeader)
+	body = strings.TrimSuffix(body, sshSignatureFooter)
+	blob, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(body), ""))
+	if err != nil {
+		return skerr.Wrapf(err, "failed to decode SSH signature")
+	}
+	if !bytes.HasPrefix(blob, []byte(sshSigMagic)) {
+		return skerr.Fmt("SSH signature is missing %q preamble", sshSigMagic)
+	}
+	var sig sshSignature
+	if err := ssh.Unmarshal(blob[len(sshSigMagic):], &sig); err != nil {
+		return skerr.Wrapf(err, "failed to parse SSH signature")
+	}
+	if sig.Version != 1 {
+		return skerr.Fmt("unsupported SSH signature version %d", sig.Version)
+	}
+	if sig.Namespace != namespace {
+		return skerr.Fmt("SSH signature has namespace %q; expected %q", sig.Namespace, namespace)
+	}
+	pub, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to parse SSH signature public key")
+	}
+	trusted := false
+	for _, key := range k.ssh {
+		if bytes.Equal(key.Marshal(), pub.Marshal()) {
+			trusted = true
+			break
+		}
+	}
+	if !trusted {
+		return skerr.Fmt("SSH signature was made by untrusted key %s", ssh.FingerprintSHA256(pub))
+	}
+	var hash []byte
+	switch sig.HashAlgorithm {
+	case "sha256":
+		h := sha256.Sum256(msg)
+		hash = h[:]
+	case "sha512":
+		h := sha512.Sum512(msg)
+		hash = h[:]
+	default:
+		return skerr.Fmt("unsupported SSH signature hash algorithm %q", sig.HashAlgorithm)
+	}
+	signed := append([]byte(sshSigMagic), ssh.Marshal(sshSignedData{
+		Namespace:     sig.Namespace,
+		Reserved:      sig.Reserved,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          hash,
+	})...)
+	var sshSig ssh.Signature
+	if err := ssh.Unmarshal(sig.Signature, &sshSig); err != nil {
+		return skerr.Wrapf(err, "failed to parse SSH signature blob")
+	}
+	if err := pub.Verify(signed, &sshSig); err != nil {
+		return skerr.Wrapf(err, "invalid SSH signature")
+	}
+	return nil
+}
+
+// verifyPKIX returns true iff the given signature was made by the given key.
+// ECDSA and RSA signatures are verified against the SHA-256 digest, while
+// Ed25519 signatures require the full message, which may be nil if it isn't
+// available.
+func verifyPKIX(pub crypto.PublicKey, msg, digest, sig []byte) bool {
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest, sig)
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, sig) == nil {
+			return true
+		}
+		return rsa.VerifyPSS(key, crypto.SHA256, digest, sig, nil) == nil
+	case ed25519.PublicKey:
+		return msg != nil && ed25519.Verify(key, msg, sig)
+	default:
+		return false
+	}
+}
+
+// decodeRawSignature returns the decoded signature if the given signature is
+// base64-encoded, or the signature unchanged otherwise.
+func decodeRawSignature(sig []byte) []byte {
+	if decoded, err := base64.StdEncoding.DecodeString(string(bytes.TrimSpace(sig))); err == nil {
+		return decoded
+	}
+	return sig
+}
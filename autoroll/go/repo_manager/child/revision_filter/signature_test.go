@@ -0,0 +1,315 @@
+package revision_filter
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/mockhttpclient"
+	"go.skia.org/infra/go/skerr"
+	"golang.org/x/crypto/openpgp" //nolint:staticcheck
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testCommitPayload = `tree 4b825dc642cb6eb9a060e54bf8d69288fbee4904
+author Test <test@example.com> 1682445445 +0000
+committer Test <test@example.com> 1682445445 +0000
+
+Commit message
+`
+	testTagPayload = `object abc123
+type commit
+tag v1.0
+tagger Test <test@example.com> 1682445445 +0000
+
+Release v1.0
+`
+)
+
+// fakeGitRepo is a gitRepo which returns canned output.
+type fakeGitRepo struct {
+	output map[string]string
+}
+
+// Git implements gitRepo.
+func (r *fakeGitRepo) Git(_ context.Context, cmd ...string) (string, error) {
+	key := strings.Join(cmd, " ")
+	out, ok := r.output[key]
+	if !ok {
+		return "", skerr.Fmt("unexpected command: git %s", key)
+	}
+	return out, nil
+}
+
+// Update implements gitRepo.
+func (r *fakeGitRepo) Update(_ context.Context) error {
+	return nil
+}
+
+func generateECDSAKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func generateSSHKey(t *testing.T) (ssh.Signer, string) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+	return signer, string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+}
+
+func generatePGPKey(t *testing.T) (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity("Test", "", "test@example.com", nil)
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	return entity, buf.String()
+}
+
+func signSSH(t *testing.T, signer ssh.Signer, msg []byte, namespace string) string {
+	hash := sha512.Sum512(msg)
+	signed := append([]byte(sshSigMagic), ssh.Marshal(sshSignedData{
+		Namespace:     namespace,
+		HashAlgorithm: "sha512",
+		Hash:          hash[:],
+	})...)
+	sig, err := signer.Sign(rand.Reader, signed)
+	require.NoError(t, err)
+	blob := append([]byte(sshSigMagic), ssh.Marshal(sshSignature{
+		Version:       1,
+		PublicKey:     signer.PublicKey().Marshal(),
+		Namespace:     namespace,
+		HashAlgorithm: "sha512",
+		Signature:     ssh.Marshal(sig),
+	})...)
+	return fmt.Sprintf("%s\n%s\n%s\n", sshSignatureHeader, base64.StdEncoding.EncodeToString(blob), sshSignatureFooter)
+}
+
+func signPGP(t *testing.T, entity *openpgp.Entity, msg []byte) string {
+	var buf bytes.Buffer
+	require.NoError(t, openpgp.ArmoredDetachSign(&buf, entity, bytes.NewReader(msg), nil))
+	return buf.String() + "\n"
+}
+
+// signedCommit inserts the given signature into the test commit payload.
+func signedCommit(sig string) string {
+	lines := strings.Split(strings.TrimSuffix(sig, "\n"), "\n")
+	header := "gpgsig " + strings.Join(lines, "\n ") + "\n"
+	idx := strings.Index(testCommitPayload, "\n\n") + 1
+	return testCommitPayload[:idx] + header + testCommitPayload[idx:]
+}
+
+func TestSplitCommitSignature(t *testing.T) {
+	sig := "-----BEGIN SSH SIGNATURE-----\nabc\n-----END SSH SIGNATURE-----\n"
+	payload, gotSig := splitCommitSignature(signedCommit(sig))
+	require.Equal(t, testCommitPayload, payload)
+	require.Equal(t, sig, gotSig)
+
+	payload, gotSig = splitCommitSignature(testCommitPayload)
+	require.Equal(t, testCommitPayload, payload)
+	require.Equal(t, "", gotSig)
+}
+
+func TestSignatureRevisionFilter_GitCommit_SSH(t *testing.T) {
+	ctx := context.Background()
+	signer, pub := generateSSHKey(t)
+	keys, err := parseTrustedKeys([]string{pub})
+	require.NoError(t, err)
+	repo := &fakeGitRepo{output: map[string]string{
+		"cat-file commit good":     signedCommit(signSSH(t, signer, []byte(testCommitPayload), sshNamespaceGit)),
+		"cat-file commit wrongns":  signedCommit(signSSH(t, signer, []byte(testCommitPayload), sshNamespaceFile)),
+		"cat-file commit unsigned": testCommitPayload,
+		"cat-file commit tampered": strings.Replace(signedCommit(signSSH(t, signer, []byte(testCommitPayload), sshNamespaceGit)), "Commit message", "Evil message", 1),
+	}}
+	source, err := newGitSignatureSource(repo, &config.GitSignatureConfig{RepoUrl: "fake"}, keys)
+	require.NoError(t, err)
+	f := &SignatureRevisionFilter{source: source}
+
+	reason, err := f.Skip(ctx, revision.Revision{Id: "good"})
+	require.NoError(t, err)
+	require.Equal(t, "", reason)
+
+	reason, err = f.Skip(ctx, revision.Revision{Id: "wrongns"})
+	require.NoError(t, err)
+	require.Contains(t, reason, `revision "wrongns" has no valid signature from a trusted key`)
+	require.Contains(t, reason, "namespace")
+
+	reason, err = f.Skip(ctx, revision.Revision{Id: "unsigned"})
+	require.NoError(t, err)
+	require.Contains(t, reason, "commit unsigned is not signed")
+
+	reason, err = f.Skip(ctx, revision.Revision{Id: "tampered"})
+	require.NoError(t, err)
+	require.Contains(t, reason, "invalid SSH signature")
+
+	// Signed by a different key.
+	otherSigner, _ := generateSSHKey(t)
+	repo.output["cat-file commit other"] = signedCommit(signSSH(t, otherSigner, []byte(testCommitPayload), sshNamespaceGit))
+	reason, err = f.Skip(ctx, revision.Revision{Id: "other"})
+	require.NoError(t, err)
+	require.Contains(t, reason, "untrusted key")
+
+	// Failure to run git is an error, not an invalid revision.
+	_, err = f.Skip(ctx, revision.Revision{Id: "missing"})
+	require.Error(t, err)
+}
+
+func TestSignatureRevisionFilter_GitTag_PGP(t *testing.T) {
+	ctx := context.Background()
+	entity, pub := generatePGPKey(t)
+	keys, err := parseTrustedKeys([]string{pub})
+	require.NoError(t, err)
+	repo := &fakeGitRepo{output: map[string]string{
+		"for-each-ref --points-at good --format=%(refname:strip=2) refs/tags":     "unrelated\nv1.0\n",
+		"for-each-ref --points-at unsigned --format=%(refname:strip=2) refs/tags": "v0.9\n",
+		"for-each-ref --points-at untagged --format=%(refname:strip=2) refs/tags": "unrelated\n",
+		"cat-file tag v1.0": testTagPayload + signPGP(t, entity, []byte(testTagPayload)),
+		"cat-file tag v0.9": testTagPayload,
+	}}
+	source, err := newGitSignatureSource(repo, &config.GitSignatureConfig{RepoUrl: "fake", TagRegex: `^v\d+\.\d+$`}, keys)
+	require.NoError(t, err)
+	f := &SignatureRevisionFilter{source: source}
+
+	reason, err := f.Skip(ctx, revision.Revision{Id: "good"})
+	require.NoError(t, err)
+	require.Equal(t, "", reason)
+
+	reason, err = f.Skip(ctx, revision.Revision{Id: "unsigned"})
+	require.NoError(t, err)
+	require.Contains(t, reason, "tag v0.9 is not signed")
+
+	reason, err = f.Skip(ctx, revision.Revision{Id: "untagged"})
+	require.NoError(t, err)
+	require.Contains(t, reason, "no tags matching")
+}
+
+func TestSignatureRevisionFilter_Artifact_Detached(t *testing.T) {
+	ctx := context.Background()
+	key, pub := generateECDSAKey(t)
+	keys, err := parseTrustedKeys([]string{pub})
+	require.NoError(t, err)
+
+	artifact := []byte("artifact contents")
+	digest := sha256.Sum256(artifact)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+
+	urlmock := mockhttpclient.NewURLMock()
+	urlmock.MockOnce("https://sigs/good.sig", mockhttpclient.MockGetDialogue([]byte(base64.StdEncoding.EncodeToString(sig))))
+	urlmock.MockOnce("https://sigs/good.sig", mockhttpclient.MockGetDialogue(sig))
+	urlmock.MockOnce("https://sigs/good.sig", mockhttpclient.MockGetDialogue(sig))
+	urlmock.MockOnce("https://artifacts/good", mockhttpclient.MockGetDialogue(artifact))
+	urlmock.MockOnce("https://sigs/bad.sig", mockhttpclient.MockGetDialogue(sig))
+	urlmock.MockOnce("https://sigs/missing.sig", mockhttpclient.MockGetError("404 Not Found", 404))
+
+	f := &SignatureRevisionFilter{source: newArtifactSignatureSource(urlmock.Client(), &config.ArtifactSignatureConfig{
+		Format:           config.ArtifactSignatureConfig_DETACHED,
+		SignatureUrlTmpl: "https://sigs/%s.sig",
+	}, keys)}
+
+	// CIPD-style checksum.
+	reason, err := f.Skip(ctx, revision.Revision{Id: "good", Checksum: hex.EncodeToString(digest[:])})
+	require.NoError(t, err)
+	require.Equal(t, "", reason)
+
+	// Docker-style checksum.
+	reason, err = f.Skip(ctx, revision.Revision{Id: "good", Checksum: "sha256:" + hex.EncodeToString(digest[:])})
+	require.NoError(t, err)
+	require.Equal(t, "", reason)
+
+	// GCS-style MD5 checksum; the artifact is downloaded instead.
+	reason, err = f.Skip(ctx, revision.Revision{Id: "good", Checksum: "d41d8cd98f00b204e9800998ecf8427e", URL: "https://artifacts/good"})
+	require.NoError(t, err)
+	require.Equal(t, "", reason)
+
+	// Signature doesn't match.
+	otherDigest := sha256.Sum256([]byte("other contents"))
+	reason, err = f.Skip(ctx, revision.Revision{Id: "bad", Checksum: hex.EncodeToString(otherDigest[:])})
+	require.NoError(t, err)
+	require.Contains(t, reason, `revision "bad" has no valid signature from a trusted key`)
+
+	// No signature.
+	reason, err = f.Skip(ctx, revision.Revision{Id: "missing", Checksum: hex.EncodeToString(digest[:])})
+	require.NoError(t, err)
+	require.Contains(t, reason, "no signature found at https://sigs/missing.sig")
+
+	require.True(t, urlmock.Empty())
+}
+
+func TestSignatureRevisionFilter_Artifact_SigstoreBundle(t *testing.T) {
+	ctx := context.Background()
+	key, pub := generateECDSAKey(t)
+	keys, err := parseTrustedKeys([]string{pub})
+	require.NoError(t, err)
+
+	artifact := []byte("artifact contents")
+	digest := sha256.Sum256(artifact)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	makeBundle := func(d []byte) []byte {
+		b, err := json.Marshal(map[string]interface{}{
+			"messageSignature": map[string]interface{}{
+				"messageDigest": map[string]string{
+					"algorithm": "SHA2_256",
+					"digest":    base64.StdEncoding.EncodeToString(d),
+				},
+				"signature": base64.StdEncoding.EncodeToString(sig),
+			},
+		})
+		require.NoError(t, err)
+		return b
+	}
+	otherDigest := sha256.Sum256([]byte("other contents"))
+
+	urlmock := mockhttpclient.NewURLMock()
+	urlmock.MockOnce("https://bundles/good.json", mockhttpclient.MockGetDialogue(makeBundle(digest[:])))
+	urlmock.MockOnce("https://artifacts/good", mockhttpclient.MockGetDialogue(artifact))
+	urlmock.MockOnce("https://bundles/mismatch.json", mockhttpclient.MockGetDialogue(makeBundle(otherDigest[:])))
+	urlmock.MockOnce("https://artifacts/mismatch", mockhttpclient.MockGetDialogue(artifact))
+
+	f := &SignatureRevisionFilter{source: newArtifactSignatureSource(urlmock.Client(), &config.ArtifactSignatureConfig{
+		Format:           config.ArtifactSignatureConfig_SIGSTORE_BUNDLE,
+		SignatureUrlTmpl: "https://bundles/%s.json",
+		ArtifactUrlTmpl:  "https://artifacts/%s",
+	}, keys)}
+
+	reason, err := f.Skip(ctx, revision.Revision{Id: "good"})
+	require.NoError(t, err)
+	require.Equal(t, "", reason)
+
+	reason, err = f.Skip(ctx, revision.Revision{Id: "mismatch"})
+	require.NoError(t, err)
+	require.Contains(t, reason, "does not match artifact digest")
+
+	require.True(t, urlmock.Empty())
+}
+
+func TestParseTrustedKeys_Invalid(t *testing.T) {
+	_, err := parseTrustedKeys([]string{"not a key"})
+	require.Error(t, err)
+}
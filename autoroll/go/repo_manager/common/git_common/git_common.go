@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"go.skia.org/infra/autoroll/go/config"
@@ -20,6 +22,12 @@ const (
 	RollBranch = "roll_branch"
 )
 
+var (
+	// shortStatRegex matches the output of "git diff --shortstat", eg.
+	// " 3 files changed, 10 insertions(+), 2 deletions(-)".
+	shortStatRegex = regexp.MustCompile(`(\d+) files? changed(?:, (\d+) insertions?\(\+\))?(?:, (\d+) deletions?\(-\))?`)
+)
+
 // Checkout provides common functionality for git checkouts.
 type Checkout struct {
 	*git.Checkout
@@ -28,6 +36,7 @@ type Checkout struct {
 	Dependencies      []*config.VersionFileConfig
 	RepoURL           string
 	RevLinkTmpl       string
+	logOpts           LogOptions
 }
 
 // NewCheckout returns a Checkout instance.
@@ -108,23 +117,163 @@ func (c *Checkout) Update(ctx context.Context) (*revision.Revision, string, erro
 	return tipRev, branch, nil
 }
 
+// LogOptions indicates which optional details LogRevisions records for each
+// Revision. They are only needed by some commit message configs, and are
+// expensive to find for large ranges of revisions.
+type LogOptions struct {
+	// Files indicates whether to record the files changed by each Revision.
+	Files bool
+	// TagAnnotations indicates whether to record the annotations of the tags
+	// which point to each Revision as its ReleaseNotes.
+	TagAnnotations bool
+}
+
+// LogOptionsForCommitMsg returns the LogOptions needed by the given commit
+// message config.
+func LogOptionsForCommitMsg(c *config.CommitMsgConfig) LogOptions {
+	return LogOptions{
+		Files:          c.GetGroupBy() == config.CommitMsgConfig_FILE_AREA,
+		TagAnnotations: c.GetReleaseNotes().GetTagAnnotations(),
+	}
+}
+
+// SetLogOptions sets the LogOptions used by LogRevisions.
+func (c *Checkout) SetLogOptions(opts LogOptions) {
+	c.logOpts = opts
+}
+
 // LogRevisions implements Child.
 func (c *Checkout) LogRevisions(ctx context.Context, from, to *revision.Revision) ([]*revision.Revision, error) {
 	hashes, err := c.RevList(ctx, "--first-parent", git.LogFromTo(from.Id, to.Id))
 	if err != nil {
 		return nil, skerr.Wrap(err)
 	}
+	if len(hashes) == 0 {
+		return []*revision.Revision{}, nil
+	}
+	// Record the size of each revision, for use by roll strategies which
+	// limit the size of rolls. A root commit has no parent to diff against
+	// and is treated as empty.
+	output, err := c.logDiffs(ctx, from, to, "--shortstat")
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	stats := parseLogDiffs(output)
+	// Record the files changed and any tag annotations, for use in commit
+	// messages.
+	var files map[string]string
+	if c.logOpts.Files {
+		output, err := c.logDiffs(ctx, from, to, "--name-only")
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		files = parseLogDiffs(output)
+	}
+	var tagAnnotations map[string][]string
+	if c.logOpts.TagAnnotations {
+		tags, err := c.Git(ctx, "for-each-ref", "--format=%(*objectname)%00%(contents)%00", "refs/tags")
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		tagAnnotations = parseTagAnnotations(tags)
+	}
 	revs := make([]*revision.Revision, 0, len(hashes))
 	for _, hash := range hashes {
 		rev, err := c.GetRevision(ctx, hash)
 		if err != nil {
 			return nil, skerr.Wrap(err)
 		}
+		rev.FilesChanged, rev.LinesChanged, err = parseShortStat(stats[hash])
+		if err != nil {
+			return nil, skerr.Wrapf(err, "failed to find size of %s", hash)
+		}
+		if c.logOpts.Files {
+			rev.Files = splitLines(files[hash])
+		}
+		if c.logOpts.TagAnnotations {
+			rev.ReleaseNotes = strings.Join(tagAnnotations[hash], "\n\n")
+		}
 		revs = append(revs, rev)
 	}
 	return revs, nil
 }
 
+// logDiffs runs "git log" over the first-parent history between the given
+// revisions with the given diff format, eg. "--shortstat", preceding each
+// commit with a NUL byte and its hash. Merge commits are diffed against their
+// first parent and root commits are not diffed at all. Paths are not quoted.
+func (c *Checkout) logDiffs(ctx context.Context, from, to *revision.Revision, diffFormat string) (string, error) {
+	return c.Git(ctx, "-c", "log.showRoot=false", "-c", "core.quotePath=false", "log", "--first-parent", "--format=format:%x00%H", diffFormat, git.LogFromTo(from.Id, to.Id))
+}
+
+// parseLogDiffs parses the output of logDiffs and returns the diff of each
+// commit, keyed by hash.
+func parseLogDiffs(output string) map[string]string {
+	rv := map[string]string{}
+	for _, entry := range strings.Split(output, "\x00") {
+		hash, diff, _ := strings.Cut(entry, "\n")
+		if hash = strings.TrimSpace(hash); hash != "" {
+			rv[hash] = diff
+		}
+	}
+	return rv
+}
+
+// splitLines returns the non-empty lines of the given output.
+func splitLines(output string) []string {
+	var rv []string
+	for _, line := range strings.Split(output, "\n") {
+		if line != "" {
+			rv = append(rv, line)
+		}
+	}
+	return rv
+}
+
+// parseShortStat parses the output of "git diff --shortstat" and returns the
+// number of files changed and the number of lines added or removed.
+func parseShortStat(output string) (int, int, error) {
+	output = strings.TrimSpace(output)
+	if output == "" {
+		// Empty commit.
+		return 0, 0, nil
+	}
+	m := shortStatRegex.FindStringSubmatch(output)
+	if m == nil {
+		return 0, 0, skerr.Fmt("failed to parse diff stats from %q", output)
+	}
+	var counts [3]int
+	for i, match := range m[1:] {
+		if match == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match)
+		if err != nil {
+			return 0, 0, skerr.Wrap(err)
+		}
+		counts[i] = n
+	}
+	return counts[0], counts[1] + counts[2], nil
+}
+
+// parseTagAnnotations parses the output of
+// "git for-each-ref --format=%(*objectname)%00%(contents)%00 refs/tags" and
+// returns the annotations of the tags which point to each commit. Lightweight
+// tags have no annotation and are ignored.
+func parseTagAnnotations(output string) map[string][]string {
+	rv := map[string][]string{}
+	fields := strings.Split(output, "\x00")
+	for i := 0; i+1 < len(fields); i += 2 {
+		hash := strings.TrimSpace(fields[i])
+		annotation := strings.TrimSpace(fields[i+1])
+		if hash == "" || annotation == "" {
+			continue
+		}
+		rv[hash] = append(rv[hash], annotation)
+	}
+	return rv
+}
+
 // ApplyExternalChangeFunc applies the specified ExternalChangeId in whichever
 // way makes sense for the implementation. Example: git_checkout_github uses
 // the ExternalChangeId as a Github PR and cherry-picks the PR patch locally.
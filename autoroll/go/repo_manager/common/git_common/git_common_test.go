@@ -0,0 +1,99 @@
+package git_common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/git"
+	git_testutils "go.skia.org/infra/go/git/testutils"
+)
+
+func TestParseShortStat(t *testing.T) {
+	test := func(output string, expectFiles, expectLines int) {
+		files, lines, err := parseShortStat(output)
+		require.NoError(t, err)
+		require.Equal(t, expectFiles, files)
+		require.Equal(t, expectLines, lines)
+	}
+	test("", 0, 0)
+	test(" 1 file changed, 1 insertion(+)\n", 1, 1)
+	test(" 1 file changed, 4 deletions(-)\n", 1, 4)
+	test(" 3 files changed, 10 insertions(+), 2 deletions(-)\n", 3, 12)
+	test(" 2 files changed, 0 insertions(+), 0 deletions(-)\n", 2, 0)
+
+	_, _, err := parseShortStat("bogus")
+	require.ErrorContains(t, err, "failed to parse diff stats")
+}
+
+func TestParseTagAnnotations(t *testing.T) {
+	require.Empty(t, parseTagAnnotations(""))
+
+	output := "abc123\x00Release 1.0\n\n* Added a thing.\n\x00\n" +
+		"\x00\x00\n" + // Lightweight tag.
+		"def456\x00Release 1.1\x00\n" +
+		"abc123\x00Also 1.0\x00\n"
+	require.Equal(t, map[string][]string{
+		"abc123": {"Release 1.0\n\n* Added a thing.", "Also 1.0"},
+		"def456": {"Release 1.1"},
+	}, parseTagAnnotations(output))
+}
+
+func TestLogRevisions(t *testing.T) {
+	ctx := context.Background()
+	gb := git_testutils.GitInit(t, ctx)
+	defer gb.Cleanup()
+	gb.Add(ctx, "a.txt", "a\n")
+	from := gb.CommitMsg(ctx, "first")
+	gb.Add(ctx, "dir/with space.txt", "one\ntwo\n")
+	second := gb.CommitMsg(ctx, "second")
+	gb.Git(ctx, "tag", "-a", "v1", "-m", "Release 1")
+	gb.CreateBranchTrackBranch(ctx, "side", git.MainBranch)
+	gb.Add(ctx, "b.txt", "b\n")
+	gb.CommitMsg(ctx, "side")
+	gb.CheckoutBranch(ctx, git.MainBranch)
+	merge := gb.MergeBranch(ctx, "side")
+
+	co, err := git.NewCheckout(ctx, gb.RepoUrl(), t.TempDir())
+	require.NoError(t, err)
+	c := &Checkout{Checkout: co}
+	log := func() []*revision.Revision {
+		revs, err := c.LogRevisions(ctx, &revision.Revision{Id: from}, &revision.Revision{Id: merge})
+		require.NoError(t, err)
+		require.Len(t, revs, 2)
+		require.Equal(t, merge, revs[0].Id)
+		require.Equal(t, second, revs[1].Id)
+		// Sizes are always recorded; the merge is diffed against its first
+		// parent.
+		require.Equal(t, 1, revs[0].FilesChanged)
+		require.Equal(t, 1, revs[0].LinesChanged)
+		require.Equal(t, 1, revs[1].FilesChanged)
+		require.Equal(t, 2, revs[1].LinesChanged)
+		return revs
+	}
+
+	revs := log()
+	require.Nil(t, revs[1].Files)
+	require.Empty(t, revs[1].ReleaseNotes)
+
+	c.SetLogOptions(LogOptions{Files: true, TagAnnotations: true})
+	revs = log()
+	require.Equal(t, []string{"b.txt"}, revs[0].Files)
+	require.Empty(t, revs[0].ReleaseNotes)
+	require.Equal(t, []string{"dir/with space.txt"}, revs[1].Files)
+	require.Equal(t, "Release 1", revs[1].ReleaseNotes)
+}
+
+func TestParseLogDiffs(t *testing.T) {
+	require.Empty(t, parseLogDiffs(""))
+
+	output := "\x00abc123\n\n 1 file changed, 1 insertion(+)\n" +
+		"\x00def456\n" + // Empty commit.
+		"\x00fed789\n\ndir/with space.txt\nb.txt\n"
+	require.Equal(t, map[string]string{
+		"abc123": "\n 1 file changed, 1 insertion(+)\n",
+		"def456": "",
+		"fed789": "\ndir/with space.txt\nb.txt\n",
+	}, parseLogDiffs(output))
+}
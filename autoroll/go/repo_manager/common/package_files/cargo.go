@@ -0,0 +1,226 @@
+package package_files
+
+import (
+	"regexp"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+var (
+	// cargoTableRegex matches a TOML table header, eg. "[dependencies]".
+	cargoTableRegex = regexp.MustCompile(`^\s*\[([^\[\]]+)\]\s*(?:#.*)?$`)
+	// cargoArrayTableRegex matches a TOML array of tables header, eg.
+	// "[[package]]".
+	cargoArrayTableRegex = regexp.MustCompile(`^\s*\[\[([^\[\]]+)\]\]\s*(?:#.*)?$`)
+	// cargoKeyValueRegex matches a TOML key/value pair.
+	cargoKeyValueRegex = regexp.MustCompile(`^\s*"?([A-Za-z0-9_-]+)"?\s*=\s*(.*)$`)
+	// cargoStringValueRegex matches a basic TOML string value.
+	cargoStringValueRegex = regexp.MustCompile(`^"([^"]*)"`)
+	// cargoInlineVersionRegex and cargoInlinePackageRegex match the version
+	// and package keys of a dependency specified as an inline table, eg.
+	// `{ version = "1.2.3", package = "my-crate" }`.
+	cargoInlineVersionRegex = regexp.MustCompile(`[{,]\s*version\s*=\s*"([^"]*)"`)
+	cargoInlinePackageRegex = regexp.MustCompile(`[{,]\s*package\s*=\s*"([^"]*)"`)
+)
+
+// isCargoDepTable returns true iff the given TOML table contains dependency
+// specifications, eg. "dependencies" or "target.'cfg(unix)'.dev-dependencies".
+func isCargoDepTable(table string) bool {
+	for _, suffix := range []string{"dependencies", "dev-dependencies", "build-dependencies"} {
+		if table == suffix || strings.HasSuffix(table, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// cargoTomlSpec describes the location of a version specifier in Cargo.toml.
+type cargoTomlSpec struct {
+	line  int
+	start int
+	end   int
+}
+
+// findCargoTomlSpecs returns the locations of all version specifiers for the
+// given crate within the given lines of Cargo.toml.
+func findCargoTomlSpecs(lines []string, crate string) []cargoTomlSpec {
+	var rv []cargoTomlSpec
+	table := ""
+	for idx, line := range lines {
+		if m := cargoTableRegex.FindStringSubmatch(line); m != nil {
+			table = strings.TrimSpace(m[1])
+			continue
+		} else if cargoArrayTableRegex.MatchString(line) {
+			table = ""
+			continue
+		}
+		m := cargoKeyValueRegex.FindStringSubmatchIndex(line)
+		if m == nil {
+			continue
+		}
+		key := line[m[2]:m[3]]
+		valueStart := m[4]
+		value := line[valueStart:]
+		if isCargoDepTable(table) {
+			// Dependencies in a dependency table may be either a string
+			// version specifier or an inline table.
+			if vm := cargoStringValueRegex.FindStringSubmatchIndex(value); vm != nil && key == crate {
+				rv = append(rv, cargoTomlSpec{line: idx, start: valueStart + vm[2], end: valueStart + vm[3]})
+			} else if strings.HasPrefix(value, "{") {
+				name := key
+				if pm := cargoInlinePackageRegex.FindStringSubmatch(value); pm != nil {
+					name = pm[1]
+				}
+				if name != crate {
+					continue
+				}
+				if vm := cargoInlineVersionRegex.FindStringSubmatchIndex(value); vm != nil {
+					rv = append(rv, cargoTomlSpec{line: idx, start: valueStart + vm[2], end: valueStart + vm[3]})
+				}
+			}
+		} else if key == "version" {
+			// Dependencies may also be specified as their own table, eg.
+			// "[dependencies.my-crate]".
+			lastDot := strings.LastIndex(table, ".")
+			if lastDot < 0 || !isCargoDepTable(table[:lastDot]) || strings.Trim(table[lastDot+1:], `"`) != crate {
+				continue
+			}
+			if vm := cargoStringValueRegex.FindStringSubmatchIndex(value); vm != nil {
+				rv = append(rv, cargoTomlSpec{line: idx, start: valueStart + vm[2], end: valueStart + vm[3]})
+			}
+		}
+	}
+	return rv
+}
+
+// getCargoTomlDep returns the version of the given crate pinned in the given
+// Cargo.toml contents.
+func getCargoTomlDep(contents, crate string) (string, error) {
+	lines := strings.Split(contents, "\n")
+	specs := findCargoTomlSpecs(lines, crate)
+	if len(specs) == 0 {
+		return "", skerr.Fmt("no dependency on %q found", crate)
+	}
+	spec := specs[0]
+	_, version, err := splitVersionSpec(lines[spec.line][spec.start:spec.end])
+	if err != nil {
+		return "", skerr.Wrapf(err, "failed to read version of %q", crate)
+	}
+	return version, nil
+}
+
+// setCargoTomlDep updates the version of the given crate in the given
+// Cargo.toml contents.
+func setCargoTomlDep(contents, crate, version string) (string, error) {
+	lines := strings.Split(contents, "\n")
+	specs := findCargoTomlSpecs(lines, crate)
+	if len(specs) == 0 {
+		return "", skerr.Fmt("no dependency on %q found", crate)
+	}
+	for _, spec := range specs {
+		line := lines[spec.line]
+		newSpec, err := setVersionSpec(line[spec.start:spec.end], version)
+		if err != nil {
+			return "", skerr.Wrapf(err, "failed to update version of %q", crate)
+		}
+		lines[spec.line] = line[:spec.start] + newSpec + line[spec.end:]
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// UpdateCargoLock updates the entry for the given crate in the given
+// Cargo.lock contents to refer to the new version, which has the given
+// hex-encoded sha256 checksum. If the lockfile contains more than one version
+// of the crate, the entry for oldVersion is updated, and references to it by
+// other entries are updated as well. Returns an error if the entry to update
+// cannot be found.
+func UpdateCargoLock(contents, crate, oldVersion, newVersion, checksum string) (string, error) {
+	lines := strings.Split(contents, "\n")
+
+	// Each package is a TOML table in the "package" array, eg.
+	//
+	//     [[package]]
+	//     name = "my-crate"
+	//     version = "1.2.3"
+	//     source = "registry+https://github.com/rust-lang/crates.io-index"
+	//     checksum = "<sha256>"
+	//
+	type cargoLockEntry struct {
+		version      string
+		versionLine  int
+		checksumLine int
+	}
+	var entries []*cargoLockEntry
+	blockStart := -1
+	flush := func(blockEnd int) {
+		if blockStart < 0 {
+			return
+		}
+		entry := &cargoLockEntry{versionLine: -1, checksumLine: -1}
+		name := ""
+		for idx := blockStart; idx < blockEnd; idx++ {
+			m := cargoKeyValueRegex.FindStringSubmatch(lines[idx])
+			if m == nil {
+				continue
+			}
+			vm := cargoStringValueRegex.FindStringSubmatch(m[2])
+			if vm == nil {
+				continue
+			}
+			switch m[1] {
+			case "name":
+				name = vm[1]
+			case "version":
+				entry.version = vm[1]
+				entry.versionLine = idx
+			case "checksum":
+				entry.checksumLine = idx
+			}
+		}
+		if name == crate && entry.versionLine >= 0 {
+			entries = append(entries, entry)
+		}
+	}
+	for idx, line := range lines {
+		if m := cargoArrayTableRegex.FindStringSubmatch(line); m != nil {
+			flush(idx)
+			blockStart = -1
+			if strings.TrimSpace(m[1]) == "package" {
+				blockStart = idx
+			}
+		} else if cargoTableRegex.MatchString(line) {
+			flush(idx)
+			blockStart = -1
+		}
+	}
+	flush(len(lines))
+
+	var entry *cargoLockEntry
+	if len(entries) == 1 {
+		entry = entries[0]
+	} else {
+		for _, e := range entries {
+			if e.version == oldVersion {
+				entry = e
+			}
+		}
+	}
+	if entry == nil {
+		return "", skerr.Fmt("no entry for %s %s found in %s", crate, oldVersion, CargoLock)
+	}
+	lines[entry.versionLine] = strings.Replace(lines[entry.versionLine], `"`+entry.version+`"`, `"`+newVersion+`"`, 1)
+	if entry.checksumLine >= 0 {
+		m := cargoKeyValueRegex.FindStringSubmatchIndex(lines[entry.checksumLine])
+		lines[entry.checksumLine] = lines[entry.checksumLine][:m[4]] + `"` + checksum + `"`
+	}
+
+	// When multiple versions of a crate are present, references to it include
+	// the version, and possibly the source, eg. "my-crate 1.2.3 (registry+...)".
+	newContents := strings.Join(lines, "\n")
+	oldRef := `"` + crate + " " + entry.version
+	newRef := `"` + crate + " " + newVersion
+	newContents = strings.ReplaceAll(newContents, oldRef+`"`, newRef+`"`)
+	newContents = strings.ReplaceAll(newContents, oldRef+" (", newRef+" (")
+	return newContents, nil
+}
@@ -0,0 +1,222 @@
+package package_files
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+var (
+	// packageJSONSections are the sections of package.json which pin
+	// dependencies, in order of precedence. Note that peerDependencies are
+	// excluded, since they typically specify a range of compatible versions
+	// rather than a pin.
+	packageJSONSections = []string{"dependencies", "devDependencies", "optionalDependencies"}
+)
+
+// errJSONKeyNotFound is returned by jsonValueSpan when the requested key does
+// not exist.
+var errJSONKeyNotFound = errors.New("key not found")
+
+// jsonValueSpan returns the start and end offsets of the value found by
+// following the given keys from the root object of the given JSON content.
+func jsonValueSpan(contents string, keys ...string) (int, int, error) {
+	dec := json.NewDecoder(strings.NewReader(contents))
+	// skipValue consumes the next value from the decoder.
+	skipValue := func() error {
+		depth := 0
+		for {
+			tok, err := dec.Token()
+			if err != nil {
+				return skerr.Wrap(err)
+			}
+			if delim, ok := tok.(json.Delim); ok {
+				if delim == '{' || delim == '[' {
+					depth++
+				} else {
+					depth--
+				}
+			}
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	for idx, key := range keys {
+		tok, err := dec.Token()
+		if err != nil {
+			return 0, 0, skerr.Wrap(err)
+		}
+		if tok != json.Delim('{') {
+			return 0, 0, skerr.Fmt("expected object containing %q but found %v", strings.Join(keys[:idx+1], "."), tok)
+		}
+		for {
+			tok, err := dec.Token()
+			if err != nil {
+				return 0, 0, skerr.Wrap(err)
+			}
+			if tok == json.Delim('}') {
+				return 0, 0, errJSONKeyNotFound
+			}
+			if tok == key {
+				break
+			}
+			if err := skipValue(); err != nil {
+				return 0, 0, skerr.Wrap(err)
+			}
+		}
+	}
+	// The decoder's offset is now just past the final key. Skip the colon and
+	// any whitespace to find the start of the value.
+	start := int(dec.InputOffset())
+	for start < len(contents) && strings.ContainsRune(" \t\r\n:", rune(contents[start])) {
+		start++
+	}
+	if err := skipValue(); err != nil {
+		return 0, 0, skerr.Wrap(err)
+	}
+	return start, int(dec.InputOffset()), nil
+}
+
+// getJSONString returns the string value found by following the given keys
+// from the root object of the given JSON content.
+func getJSONString(contents string, keys ...string) (string, error) {
+	start, end, err := jsonValueSpan(contents, keys...)
+	if err != nil {
+		return "", err
+	}
+	var rv string
+	if err := json.Unmarshal([]byte(contents[start:end]), &rv); err != nil {
+		return "", skerr.Wrapf(err, "value of %q is not a string", strings.Join(keys, "."))
+	}
+	return rv, nil
+}
+
+// setJSONString replaces the string value found by following the given keys
+// from the root object of the given JSON content, returning the new contents.
+func setJSONString(contents, value string, keys ...string) (string, error) {
+	start, end, err := jsonValueSpan(contents, keys...)
+	if err != nil {
+		return "", err
+	}
+	// Avoid json.Marshal, which escapes characters like '>' which commonly
+	// appear in version specifiers.
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(value); err != nil {
+		return "", skerr.Wrap(err)
+	}
+	return contents[:start] + strings.TrimSpace(buf.String()) + contents[end:], nil
+}
+
+// getPackageJSONDep returns the version of the given package pinned in the
+// given package.json contents.
+func getPackageJSONDep(contents, pkg string) (string, error) {
+	for _, section := range packageJSONSections {
+		spec, err := getJSONString(contents, section, pkg)
+		if err == errJSONKeyNotFound {
+			continue
+		} else if err != nil {
+			return "", skerr.Wrap(err)
+		}
+		_, version, err := splitVersionSpec(spec)
+		if err != nil {
+			return "", skerr.Wrapf(err, "failed to read version of %q", pkg)
+		}
+		return version, nil
+	}
+	return "", skerr.Fmt("no dependency on %q found", pkg)
+}
+
+// setPackageJSONSpecs updates the version specifiers of the given package in
+// all of the dependency sections found by following the given keys from the
+// root object, returning the new contents and the number of updated sections.
+func setPackageJSONSpecs(contents, pkg, version string, keys ...string) (string, int, error) {
+	found := 0
+	for _, section := range packageJSONSections {
+		path := append(append([]string{}, keys...), section, pkg)
+		spec, err := getJSONString(contents, path...)
+		if err == errJSONKeyNotFound {
+			continue
+		} else if err != nil {
+			return "", 0, skerr.Wrap(err)
+		}
+		newSpec, err := setVersionSpec(spec, version)
+		if err != nil {
+			return "", 0, skerr.Wrapf(err, "failed to update version of %q", pkg)
+		}
+		contents, err = setJSONString(contents, newSpec, path...)
+		if err != nil {
+			return "", 0, skerr.Wrap(err)
+		}
+		found++
+	}
+	return contents, found, nil
+}
+
+// setPackageJSONDep updates the version of the given package in the given
+// package.json contents.
+func setPackageJSONDep(contents, pkg, version string) (string, error) {
+	newContents, found, err := setPackageJSONSpecs(contents, pkg, version)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	if found == 0 {
+		return "", skerr.Fmt("no dependency on %q found", pkg)
+	}
+	return newContents, nil
+}
+
+// UpdatePackageLock updates the entries for the given package in the given
+// package-lock.json contents to refer to the given version, which may be
+// downloaded from the given URL and has the given SRI integrity string.
+// Supports lockfile versions 1 through 3. Returns an error if the lockfile
+// does not contain the package.
+func UpdatePackageLock(contents, pkg, version, resolved, integrity string) (string, error) {
+	found := false
+	setEntry := func(keys ...string) error {
+		if _, _, err := jsonValueSpan(contents, keys...); err == errJSONKeyNotFound {
+			return nil
+		} else if err != nil {
+			return skerr.Wrap(err)
+		}
+		found = true
+		for key, value := range map[string]string{
+			"version":   version,
+			"resolved":  resolved,
+			"integrity": integrity,
+		} {
+			newContents, err := setJSONString(contents, value, append(keys, key)...)
+			if err == errJSONKeyNotFound {
+				continue
+			} else if err != nil {
+				return skerr.Wrap(err)
+			}
+			contents = newContents
+		}
+		return nil
+	}
+	// Lockfile versions 2 and 3 contain a "packages" section, keyed by path.
+	if err := setEntry("packages", "node_modules/"+pkg); err != nil {
+		return "", skerr.Wrap(err)
+	}
+	// The root package in the "packages" section mirrors package.json.
+	var err error
+	contents, _, err = setPackageJSONSpecs(contents, pkg, version, "packages", "")
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	// Lockfile versions 1 and 2 contain a "dependencies" section, keyed by
+	// package name.
+	if err := setEntry("dependencies", pkg); err != nil {
+		return "", skerr.Wrap(err)
+	}
+	if !found {
+		return "", skerr.Fmt("no entry for %q found in %s", pkg, PackageLockJSON)
+	}
+	return contents, nil
+}
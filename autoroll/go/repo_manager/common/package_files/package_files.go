@@ -0,0 +1,113 @@
+package package_files
+
+/*
+Package package_files provides utilities for reading and writing the versions
+of dependencies pinned in package manifests and lockfiles used by language-
+specific package managers:
+
+  - npm: package.json and package-lock.json
+  - pip: requirements files, eg. requirements.txt
+  - Cargo: Cargo.toml and Cargo.lock
+
+Files are modified in place, retaining their formatting, rather than being
+re-serialized. Note that lockfiles are updated only for the package itself; any
+changes to the package's own dependencies are not resolved, and the package
+manager is expected to fill them in the next time it runs.
+*/
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+const (
+	// PackageJSON is the name of the npm package manifest.
+	PackageJSON = "package.json"
+	// PackageLockJSON is the name of the npm lockfile.
+	PackageLockJSON = "package-lock.json"
+	// CargoToml is the name of the Cargo package manifest.
+	CargoToml = "Cargo.toml"
+	// CargoLock is the name of the Cargo lockfile.
+	CargoLock = "Cargo.lock"
+)
+
+var (
+	// versionSpecRegex splits a version specifier which pins a single version,
+	// eg. "^1.2.3", into its operator prefix and version.
+	versionSpecRegex = regexp.MustCompile(`^(\s*(?:\^|~|=|>=|<=)?\s*v?)(\d+(?:\.\d+){0,2}(?:[-+][0-9A-Za-z.+-]+)?)\s*$`)
+)
+
+// IsPackageJSON returns true iff the given file is an npm package manifest.
+func IsPackageJSON(file string) bool {
+	return path.Base(file) == PackageJSON
+}
+
+// IsRequirementsFile returns true iff the given file is a pip requirements
+// file, eg. requirements.txt or requirements-dev.txt.
+func IsRequirementsFile(file string) bool {
+	base := path.Base(file)
+	return strings.HasPrefix(base, "requirements") && strings.HasSuffix(base, ".txt")
+}
+
+// IsCargoToml returns true iff the given file is a Cargo package manifest.
+func IsCargoToml(file string) bool {
+	return path.Base(file) == CargoToml
+}
+
+// IsPackageFile returns true iff the given file is a package manifest which
+// can be read and written by this package.
+func IsPackageFile(file string) bool {
+	return IsPackageJSON(file) || IsRequirementsFile(file) || IsCargoToml(file)
+}
+
+// GetDep returns the version of the given package pinned in the given package
+// manifest. Operators in the version specifier, eg. the "^" in "^1.2.3", are
+// removed.
+func GetDep(file, contents, pkg string) (string, error) {
+	if IsPackageJSON(file) {
+		return getPackageJSONDep(contents, pkg)
+	} else if IsRequirementsFile(file) {
+		return getRequirementsDep(contents, pkg)
+	} else if IsCargoToml(file) {
+		return getCargoTomlDep(contents, pkg)
+	}
+	return "", skerr.Fmt("unknown package file type: %s", file)
+}
+
+// SetDep updates the version of the given package pinned in the given package
+// manifest, retaining any operators in the version specifier, and returns the
+// new contents of the file.
+func SetDep(file, contents, pkg, version string) (string, error) {
+	if IsPackageJSON(file) {
+		return setPackageJSONDep(contents, pkg, version)
+	} else if IsRequirementsFile(file) {
+		return setRequirementsDep(contents, pkg, version)
+	} else if IsCargoToml(file) {
+		return setCargoTomlDep(contents, pkg, version)
+	}
+	return "", skerr.Fmt("unknown package file type: %s", file)
+}
+
+// splitVersionSpec splits the given version specifier into its operator prefix
+// and version. Returns an error if the specifier does not pin a single version,
+// eg. ">=1.0 <2.0" or "*".
+func splitVersionSpec(spec string) (string, string, error) {
+	m := versionSpecRegex.FindStringSubmatch(spec)
+	if m == nil {
+		return "", "", skerr.Fmt("unsupported version specifier %q", spec)
+	}
+	return m[1], m[2], nil
+}
+
+// setVersionSpec replaces the version in the given version specifier,
+// retaining its operator prefix.
+func setVersionSpec(spec, version string) (string, error) {
+	prefix, _, err := splitVersionSpec(spec)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	return prefix + version, nil
+}
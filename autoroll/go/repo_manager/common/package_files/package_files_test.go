@@ -0,0 +1,353 @@
+package package_files
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fakePackageJSON = `{
+  "name": "my-package",
+  "version": "1.0.0",
+  "dependencies": {
+    "@scope/pkg": "^1.2.3",
+    "other": "~2.0.0"
+  },
+  "devDependencies": {
+    "typescript": "5.0.4",
+    "@scope/pkg": ">=1.2.3"
+  },
+  "peerDependencies": {
+    "@scope/pkg": "^1.0.0"
+  }
+}
+`
+
+	fakePackageLockJSON = `{
+  "name": "my-package",
+  "version": "1.0.0",
+  "lockfileVersion": 2,
+  "requires": true,
+  "packages": {
+    "": {
+      "name": "my-package",
+      "version": "1.0.0",
+      "dependencies": {
+        "@scope/pkg": "^1.2.3",
+        "other": "~2.0.0"
+      },
+      "devDependencies": {
+        "typescript": "5.0.4"
+      }
+    },
+    "node_modules/@scope/pkg": {
+      "version": "1.2.3",
+      "resolved": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.2.3.tgz",
+      "integrity": "sha512-old",
+      "dependencies": {
+        "other": "^2.0.0"
+      }
+    },
+    "node_modules/other/node_modules/@scope/pkg": {
+      "version": "1.0.0",
+      "resolved": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.0.0.tgz",
+      "integrity": "sha512-older"
+    }
+  },
+  "dependencies": {
+    "@scope/pkg": {
+      "version": "1.2.3",
+      "resolved": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.2.3.tgz",
+      "integrity": "sha512-old"
+    }
+  }
+}
+`
+
+	fakeRequirements = `# This file is autogenerated.
+absl-py==1.4.0 \
+    --hash=sha256:aaaa \
+    --hash=sha256:bbbb
+    # via -r requirements.in
+My_Package[extra]==2.0.0 ; python_version >= "3.8"
+unpinned>=1.0
+`
+
+	fakeCargoToml = `[package]
+name = "my-crate"
+version = "0.1.0"
+
+[dependencies]
+serde = "1.0.160"
+log = { version = "=0.4.17", features = ["std"] }
+renamed = { package = "rand", version = "0.8" }
+
+[target.'cfg(unix)'.dev-dependencies]
+serde = { version = "^1.0.160" }
+
+[dependencies.regex]
+version = "1.8.1"
+default-features = false
+`
+
+	fakeCargoLock = `# This file is automatically @generated by Cargo.
+version = 3
+
+[[package]]
+name = "my-crate"
+version = "0.1.0"
+dependencies = [
+ "serde",
+ "serde_json",
+ "syn 1.0.109",
+]
+
+[[package]]
+name = "serde"
+version = "1.0.160"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "oldchecksum"
+
+[[package]]
+name = "syn"
+version = "1.0.109"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "synchecksum"
+
+[[package]]
+name = "syn"
+version = "2.0.15"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "syn2checksum"
+`
+)
+
+func TestIsPackageFile(t *testing.T) {
+	require.True(t, IsPackageFile("package.json"))
+	require.True(t, IsPackageFile("path/to/package.json"))
+	require.True(t, IsPackageFile("requirements.txt"))
+	require.True(t, IsPackageFile("infra/requirements-dev.txt"))
+	require.True(t, IsPackageFile("Cargo.toml"))
+	require.False(t, IsPackageFile("package-lock.json"))
+	require.False(t, IsPackageFile("Cargo.lock"))
+	require.False(t, IsPackageFile("DEPS"))
+}
+
+func TestSplitVersionSpec(t *testing.T) {
+	test := func(spec, expectPrefix, expectVersion string) {
+		prefix, version, err := splitVersionSpec(spec)
+		require.NoError(t, err, spec)
+		require.Equal(t, expectPrefix, prefix, spec)
+		require.Equal(t, expectVersion, version, spec)
+	}
+	test("1.2.3", "", "1.2.3")
+	test("^1.2.3", "^", "1.2.3")
+	test("~1.2", "~", "1.2")
+	test(">= 1.2.3-beta.1", ">= ", "1.2.3-beta.1")
+	test("=v1.2.3", "=v", "1.2.3")
+
+	for _, spec := range []string{"*", "latest", ">=1.0 <2.0", "1.x", "^1 || ^2", "file:../pkg"} {
+		_, _, err := splitVersionSpec(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestPackageJSON(t *testing.T) {
+	version, err := GetDep("package.json", fakePackageJSON, "@scope/pkg")
+	require.NoError(t, err)
+	require.Equal(t, "1.2.3", version)
+	version, err = GetDep("package.json", fakePackageJSON, "typescript")
+	require.NoError(t, err)
+	require.Equal(t, "5.0.4", version)
+	_, err = GetDep("package.json", fakePackageJSON, "missing")
+	require.ErrorContains(t, err, `no dependency on "missing" found`)
+
+	actual, err := SetDep("package.json", fakePackageJSON, "@scope/pkg", "1.3.0")
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "name": "my-package",
+  "version": "1.0.0",
+  "dependencies": {
+    "@scope/pkg": "^1.3.0",
+    "other": "~2.0.0"
+  },
+  "devDependencies": {
+    "typescript": "5.0.4",
+    "@scope/pkg": ">=1.3.0"
+  },
+  "peerDependencies": {
+    "@scope/pkg": "^1.0.0"
+  }
+}
+`, actual)
+}
+
+func TestUpdatePackageLock(t *testing.T) {
+	actual, err := UpdatePackageLock(fakePackageLockJSON, "@scope/pkg", "1.3.0", "https://registry.npmjs.org/@scope/pkg/-/pkg-1.3.0.tgz", "sha512-new")
+	require.NoError(t, err)
+	require.Equal(t, `{
+  "name": "my-package",
+  "version": "1.0.0",
+  "lockfileVersion": 2,
+  "requires": true,
+  "packages": {
+    "": {
+      "name": "my-package",
+      "version": "1.0.0",
+      "dependencies": {
+        "@scope/pkg": "^1.3.0",
+        "other": "~2.0.0"
+      },
+      "devDependencies": {
+        "typescript": "5.0.4"
+      }
+    },
+    "node_modules/@scope/pkg": {
+      "version": "1.3.0",
+      "resolved": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.3.0.tgz",
+      "integrity": "sha512-new",
+      "dependencies": {
+        "other": "^2.0.0"
+      }
+    },
+    "node_modules/other/node_modules/@scope/pkg": {
+      "version": "1.0.0",
+      "resolved": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.0.0.tgz",
+      "integrity": "sha512-older"
+    }
+  },
+  "dependencies": {
+    "@scope/pkg": {
+      "version": "1.3.0",
+      "resolved": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.3.0.tgz",
+      "integrity": "sha512-new"
+    }
+  }
+}
+`, actual)
+
+	_, err = UpdatePackageLock(fakePackageLockJSON, "missing", "1.0.0", "", "")
+	require.ErrorContains(t, err, `no entry for "missing" found`)
+}
+
+func TestRequirements(t *testing.T) {
+	version, err := GetDep("requirements.txt", fakeRequirements, "absl_py")
+	require.NoError(t, err)
+	require.Equal(t, "1.4.0", version)
+	version, err = GetDep("requirements.txt", fakeRequirements, "my-package")
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", version)
+	_, err = GetDep("requirements.txt", fakeRequirements, "unpinned")
+	require.ErrorContains(t, err, "is not pinned to a single version")
+	_, err = GetDep("requirements.txt", fakeRequirements, "missing")
+	require.ErrorContains(t, err, `no requirement for "missing" found`)
+
+	actual, err := SetDep("requirements.txt", fakeRequirements, "my-package", "2.1.0")
+	require.NoError(t, err)
+	require.Equal(t, `# This file is autogenerated.
+absl-py==1.4.0 \
+    --hash=sha256:aaaa \
+    --hash=sha256:bbbb
+    # via -r requirements.in
+My_Package[extra]==2.1.0 ; python_version >= "3.8"
+unpinned>=1.0
+`, actual)
+
+	// My_Package has no hashes, so they aren't added.
+	withHashes, err := SetRequirementHashes(actual, "my-package", []string{"cccc"})
+	require.NoError(t, err)
+	require.Equal(t, actual, withHashes)
+
+	actual, err = SetDep("requirements.txt", fakeRequirements, "absl-py", "2.0.0")
+	require.NoError(t, err)
+	actual, err = SetRequirementHashes(actual, "absl-py", []string{"cccc", "dddd", "eeee"})
+	require.NoError(t, err)
+	require.Equal(t, `# This file is autogenerated.
+absl-py==2.0.0 \
+    --hash=sha256:cccc \
+    --hash=sha256:dddd \
+    --hash=sha256:eeee
+    # via -r requirements.in
+My_Package[extra]==2.0.0 ; python_version >= "3.8"
+unpinned>=1.0
+`, actual)
+}
+
+func TestCargoToml(t *testing.T) {
+	test := func(crate, expect string) {
+		version, err := GetDep("Cargo.toml", fakeCargoToml, crate)
+		require.NoError(t, err, crate)
+		require.Equal(t, expect, version, crate)
+	}
+	test("serde", "1.0.160")
+	test("log", "0.4.17")
+	test("rand", "0.8")
+	test("regex", "1.8.1")
+	_, err := GetDep("Cargo.toml", fakeCargoToml, "renamed")
+	require.ErrorContains(t, err, `no dependency on "renamed" found`)
+	_, err = GetDep("Cargo.toml", fakeCargoToml, "my-crate")
+	require.ErrorContains(t, err, `no dependency on "my-crate" found`)
+
+	actual, err := SetDep("Cargo.toml", fakeCargoToml, "serde", "1.0.163")
+	require.NoError(t, err)
+	actual, err = SetDep("Cargo.toml", actual, "log", "0.4.18")
+	require.NoError(t, err)
+	actual, err = SetDep("Cargo.toml", actual, "rand", "0.9.0")
+	require.NoError(t, err)
+	actual, err = SetDep("Cargo.toml", actual, "regex", "1.8.4")
+	require.NoError(t, err)
+	require.Equal(t, `[package]
+name = "my-crate"
+version = "0.1.0"
+
+[dependencies]
+serde = "1.0.163"
+log = { version = "=0.4.18", features = ["std"] }
+renamed = { package = "rand", version = "0.9.0" }
+
+[target.'cfg(unix)'.dev-dependencies]
+serde = { version = "^1.0.163" }
+
+[dependencies.regex]
+version = "1.8.4"
+default-features = false
+`, actual)
+}
+
+func TestUpdateCargoLock(t *testing.T) {
+	serdeActual, err := UpdateCargoLock(fakeCargoLock, "serde", "1.0.160", "1.0.163", "newchecksum")
+	require.NoError(t, err)
+	require.Contains(t, serdeActual, `[[package]]
+name = "serde"
+version = "1.0.163"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "newchecksum"
+`)
+
+	// When multiple versions are present, references include the version.
+	actual, err := UpdateCargoLock(fakeCargoLock, "syn", "1.0.109", "1.0.110", "newchecksum")
+	require.NoError(t, err)
+	require.Contains(t, actual, ` "syn 1.0.110",`)
+	require.Contains(t, actual, `[[package]]
+name = "syn"
+version = "1.0.110"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "newchecksum"
+`)
+	require.Contains(t, actual, `[[package]]
+name = "syn"
+version = "2.0.15"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "syn2checksum"
+`)
+
+	// If there's only one version of the crate, the old version doesn't need
+	// to match, since Cargo.toml may not specify the full version.
+	actual, err = UpdateCargoLock(fakeCargoLock, "serde", "1.0", "1.0.163", "newchecksum")
+	require.NoError(t, err)
+	require.Equal(t, serdeActual, actual)
+
+	_, err = UpdateCargoLock(fakeCargoLock, "syn", "1.0", "1.0.110", "newchecksum")
+	require.ErrorContains(t, err, "no entry for syn 1.0 found")
+}
@@ -0,0 +1,159 @@
+package package_files
+
+import (
+	"regexp"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+var (
+	// requirementRegex matches the beginning of a requirement specifier in a
+	// pip requirements file, eg. "my-package[extra]==1.2.3".
+	requirementRegex = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*\])?\s*(===|==|~=|!=|>=|<=|>|<)?\s*([^\s;\\#,]*)`)
+
+	// pythonPackageNameSeparatorRegex matches the separators which are
+	// considered equivalent in Python package names.
+	pythonPackageNameSeparatorRegex = regexp.MustCompile(`[-_.]+`)
+)
+
+const (
+	// hashOption is the pip option which provides a hash for a requirement.
+	hashOption = "--hash="
+)
+
+// normalizePythonPackageName normalizes the given Python package name, per
+// https://peps.python.org/pep-0503/#normalized-names
+func normalizePythonPackageName(name string) string {
+	return strings.ToLower(pythonPackageNameSeparatorRegex.ReplaceAllString(name, "-"))
+}
+
+// requirement describes the location of a requirement within a requirements
+// file.
+type requirement struct {
+	// firstLine and lastLine are the indexes of the first and last physical
+	// lines which make up the requirement, which may span multiple lines
+	// using backslash continuations.
+	firstLine int
+	lastLine  int
+	// versionStart and versionEnd are the offsets of the pinned version
+	// within the first line.
+	versionStart int
+	versionEnd   int
+}
+
+// findRequirement returns the location of the requirement for the given
+// package within the given lines of a requirements file. Returns an error if
+// the package is not found or is not pinned to a single version using "==".
+func findRequirement(lines []string, pkg string) (*requirement, error) {
+	pkg = normalizePythonPackageName(pkg)
+	for idx := 0; idx < len(lines); idx++ {
+		first := idx
+		for idx < len(lines)-1 && strings.HasSuffix(strings.TrimSpace(lines[idx]), `\`) {
+			idx++
+		}
+		line := lines[first]
+		if strings.HasPrefix(strings.TrimSpace(line), "#") || strings.HasPrefix(strings.TrimSpace(line), "-") {
+			continue
+		}
+		m := requirementRegex.FindStringSubmatchIndex(line)
+		if m == nil || normalizePythonPackageName(line[m[2]:m[3]]) != pkg {
+			continue
+		}
+		if m[4] == -1 || line[m[4]:m[5]] != "==" || m[6] == m[7] {
+			return nil, skerr.Fmt("requirement for %q is not pinned to a single version using \"==\": %s", pkg, line)
+		}
+		return &requirement{
+			firstLine:    first,
+			lastLine:     idx,
+			versionStart: m[6],
+			versionEnd:   m[7],
+		}, nil
+	}
+	return nil, skerr.Fmt("no requirement for %q found", pkg)
+}
+
+// getRequirementsDep returns the version of the given package pinned in the
+// given requirements file contents.
+func getRequirementsDep(contents, pkg string) (string, error) {
+	lines := strings.Split(contents, "\n")
+	req, err := findRequirement(lines, pkg)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	return lines[req.firstLine][req.versionStart:req.versionEnd], nil
+}
+
+// setRequirementsDep updates the version of the given package in the given
+// requirements file contents. Note that hashes are not updated; use
+// SetRequirementHashes to do so.
+func setRequirementsDep(contents, pkg, version string) (string, error) {
+	lines := strings.Split(contents, "\n")
+	req, err := findRequirement(lines, pkg)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	line := lines[req.firstLine]
+	lines[req.firstLine] = line[:req.versionStart] + version + line[req.versionEnd:]
+	return strings.Join(lines, "\n"), nil
+}
+
+// SetRequirementHashes replaces the hashes of the given package in the given
+// requirements file contents, as used in pip's hash-checking mode, eg.
+//
+//	my-package==1.2.3 \
+//	    --hash=sha256:<hash1> \
+//	    --hash=sha256:<hash2>
+//
+// The hashes must be hex-encoded sha256 hashes. If the requirement does not
+// specify any hashes, the contents are returned unchanged.
+func SetRequirementHashes(contents, pkg string, hashes []string) (string, error) {
+	lines := strings.Split(contents, "\n")
+	req, err := findRequirement(lines, pkg)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+
+	// Separate the hash options from everything else.
+	var kept []string
+	indent := ""
+	foundHash := false
+	for _, line := range lines[req.firstLine : req.lastLine+1] {
+		line = strings.TrimRight(strings.TrimSuffix(strings.TrimRight(line, " \t"), `\`), " \t")
+		if !strings.Contains(line, hashOption) {
+			kept = append(kept, line)
+			continue
+		}
+		lineIndent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !foundHash {
+			foundHash = true
+			indent = lineIndent
+		}
+		var fields []string
+		for _, field := range strings.Fields(line) {
+			if !strings.HasPrefix(field, hashOption) {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) > 0 {
+			kept = append(kept, lineIndent+strings.Join(fields, " "))
+		}
+	}
+	if !foundHash {
+		return contents, nil
+	}
+	if indent == "" {
+		indent = "    "
+	}
+	for _, hash := range hashes {
+		kept = append(kept, indent+hashOption+"sha256:"+hash)
+	}
+	for idx := range kept[:len(kept)-1] {
+		kept[idx] += ` \`
+	}
+
+	newLines := append([]string{}, lines[:req.firstLine]...)
+	newLines = append(newLines, kept...)
+	newLines = append(newLines, lines[req.lastLine+1:]...)
+	return strings.Join(newLines, "\n"), nil
+}
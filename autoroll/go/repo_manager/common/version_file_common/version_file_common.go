@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/package_files"
 	"go.skia.org/infra/autoroll/go/repo_manager/common/pyl"
 	"go.skia.org/infra/autoroll/go/revision"
 	"go.skia.org/infra/go/bazel"
@@ -56,6 +57,8 @@ func GetPinnedRev(dep *config.VersionFileConfig, contents string) (string, error
 			return "", skerr.Wrap(err)
 		}
 		return entry.Version, nil
+	} else if package_files.IsPackageFile(dep.Path) {
+		return package_files.GetDep(dep.Path, contents, dep.Id)
 	} else {
 		return strings.TrimSpace(contents), nil
 	}
@@ -112,6 +115,8 @@ func SetPinnedRev(dep *config.VersionFileConfig, newRev *revision.Revision, oldC
 			return "", skerr.Wrap(err)
 		}
 		return newContents, nil
+	} else if package_files.IsPackageFile(dep.Path) {
+		return package_files.SetDep(dep.Path, oldContents, dep.Id, newRev.Id)
 	} else {
 		// Various tools expect a newline at the end of the file.
 		// TODO(borenet): This should probably be configurable.
@@ -65,6 +65,17 @@ func TestGetPinnedRev(t *testing.T) {
 			}`,
 			expectRev: "my-other-rev",
 		},
+		{
+			name:            "PackageFile",
+			depId:           "my-dep",
+			versionFilePath: "path/to/package.json",
+			versionFileContents: `{
+  "dependencies": {
+    "my-dep": "^1.2.3"
+  }
+}`,
+			expectRev: "1.2.3",
+		},
 	} {
 		t.Run(c.name, func(t *testing.T) {
 			actual, err := GetPinnedRev(&config.VersionFileConfig{
@@ -141,6 +152,20 @@ cipd_install(
     sha256 = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
     tag = "version:2.30.1.chromium.7",
 )
+`,
+		},
+		{
+			name:            "PackageFile",
+			depId:           "my-dep",
+			versionFilePath: "Cargo.toml",
+			versionFileContents: `[dependencies]
+my-dep = { version = "1.2.3", features = ["std"] }
+`,
+			newRev: &revision.Revision{
+				Id: "1.3.0",
+			},
+			expectNewContents: `[dependencies]
+my-dep = { version = "1.3.0", features = ["std"] }
 `,
 		},
 	} {
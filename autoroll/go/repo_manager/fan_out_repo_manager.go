@@ -0,0 +1,164 @@
+package repo_manager
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"go.skia.org/infra/autoroll/go/codereview"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/vfs"
+)
+
+// FanOutRepoManager is a RepoManager which rolls a single Child into multiple
+// Parents at once.
+type FanOutRepoManager interface {
+	RepoManager
+
+	// CreateNewFanOutRoll uploads one CL to each Parent which has not yet
+	// rolled to rollingTo, primary first, and returns the issue numbers in
+	// the same order. If an error occurs, the
+	// issue numbers of any CLs which were already uploaded are returned
+	// along with the error so that the caller may clean them up.
+	CreateNewFanOutRoll(ctx context.Context, rollingFrom *revision.Revision, rollingTo *revision.Revision, revisions []*revision.Revision, reviewers []string, dryRun bool, commitMsg string) ([]int64, error)
+}
+
+// fanOutRepoManager implements FanOutRepoManager using one
+// parentChildRepoManager per Parent.
+type fanOutRepoManager struct {
+	rms []*parentChildRepoManager
+
+	// mtx protects the fields below, which hold the results of the most
+	// recent Update of each Parent, in the same order as rms.
+	mtx           sync.Mutex
+	lastRollRevs  []*revision.Revision
+	notRolledRevs [][]*revision.Revision
+}
+
+// newFanOutRepoManager returns a FanOutRepoManager. Each Parent uses its own
+// subdirectory of the given workdir.
+func newFanOutRepoManager(ctx context.Context, c *config.FanOutRepoManagerConfig, reg *config_vars.Registry, workdir, rollerName, serverURL string, client *http.Client, cr codereview.CodeReview) (*fanOutRepoManager, error) {
+	rms := make([]*parentChildRepoManager, 0, len(c.ParentChild))
+	for idx, pc := range c.ParentChild {
+		rm, err := newParentChildRepoManager(ctx, pc, reg, filepath.Join(workdir, strconv.Itoa(idx)), rollerName, serverURL, client, cr)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "failed to create RepoManager for ParentChild %d", idx)
+		}
+		rms = append(rms, rm)
+	}
+	return &fanOutRepoManager{rms: rms}, nil
+}
+
+// See documentation for RepoManager interface. The returned revisions are
+// those of the Parent which is furthest behind, so that the next roll brings
+// every Parent up to date. The revisions of every Parent are recorded, so that
+// CreateNewFanOutRoll can roll each Parent from its own last-rolled revision.
+func (rm *fanOutRepoManager) Update(ctx context.Context) (*revision.Revision, *revision.Revision, []*revision.Revision, error) {
+	var lastRollRev, tipRev *revision.Revision
+	var notRolledRevs []*revision.Revision
+	lastRollRevs := make([]*revision.Revision, 0, len(rm.rms))
+	allNotRolledRevs := make([][]*revision.Revision, 0, len(rm.rms))
+	for idx, pc := range rm.rms {
+		last, tip, notRolled, err := pc.Update(ctx)
+		if err != nil {
+			return nil, nil, nil, skerr.Wrapf(err, "failed to update ParentChild %d", idx)
+		}
+		if idx == 0 || len(notRolled) > len(notRolledRevs) {
+			lastRollRev, tipRev, notRolledRevs = last, tip, notRolled
+		}
+		lastRollRevs = append(lastRollRevs, last)
+		allNotRolledRevs = append(allNotRolledRevs, notRolled)
+	}
+	rm.mtx.Lock()
+	defer rm.mtx.Unlock()
+	rm.lastRollRevs = lastRollRevs
+	rm.notRolledRevs = allNotRolledRevs
+	return lastRollRev, tipRev, notRolledRevs, nil
+}
+
+// rollRange returns the revisions from which the Parent with the given index
+// rolls to the given revision, and the revisions it rolls, according to the
+// most recent Update. It returns false if the Parent has already rolled to
+// the given revision or past it. If the Parent was not updated, it returns the
+// given from and rolling revisions.
+func (rm *fanOutRepoManager) rollRange(idx int, from, to *revision.Revision, rolling []*revision.Revision) (*revision.Revision, []*revision.Revision, bool) {
+	rm.mtx.Lock()
+	defer rm.mtx.Unlock()
+	if idx >= len(rm.lastRollRevs) {
+		return from, rolling, true
+	}
+	last := rm.lastRollRevs[idx]
+	if last.Id == to.Id {
+		return nil, nil, false
+	}
+	notRolled := rm.notRolledRevs[idx]
+	for revIdx, rev := range notRolled {
+		if rev.Id == to.Id {
+			return last, notRolled[revIdx:], true
+		}
+	}
+	return nil, nil, false
+}
+
+// See documentation for RepoManager interface.
+func (rm *fanOutRepoManager) GetRevision(ctx context.Context, id string) (*revision.Revision, error) {
+	return rm.rms[0].GetRevision(ctx, id)
+}
+
+// See documentation for RepoManager interface.
+func (rm *fanOutRepoManager) LogRevisions(ctx context.Context, from, to *revision.Revision) ([]*revision.Revision, error) {
+	return rm.rms[0].LogRevisions(ctx, from, to)
+}
+
+// SetLogOptions implements LogOptionsSetter.
+func (rm *fanOutRepoManager) SetLogOptions(opts git_common.LogOptions) {
+	for _, pc := range rm.rms {
+		pc.SetLogOptions(opts)
+	}
+}
+
+// VFS returns a vfs.FS for the Child at the given Revision.
+func (rm *fanOutRepoManager) VFS(ctx context.Context, rev *revision.Revision) (vfs.FS, error) {
+	return rm.rms[0].VFS(ctx, rev)
+}
+
+// See documentation for RepoManager interface. Fan-out rolls consist of
+// multiple CLs, so CreateNewFanOutRoll must be used instead.
+func (rm *fanOutRepoManager) CreateNewRoll(_ context.Context, _, _ *revision.Revision, _ []*revision.Revision, _ []string, _ bool, _ string) (int64, error) {
+	return 0, skerr.Fmt("fan-out rolls must be created using CreateNewFanOutRoll")
+}
+
+// See documentation for FanOutRepoManager interface. Each Parent is rolled
+// from its own last-rolled revision, and Parents which have already rolled to
+// the given revision are skipped. The commit message, which describes the
+// given range of revisions, is shared by every CL.
+func (rm *fanOutRepoManager) CreateNewFanOutRoll(ctx context.Context, from, to *revision.Revision, rolling []*revision.Revision, emails []string, dryRun bool, commitMsg string) ([]int64, error) {
+	issues := make([]int64, 0, len(rm.rms))
+	for idx, pc := range rm.rms {
+		parentFrom, parentRolling, ok := rm.rollRange(idx, from, to, rolling)
+		if !ok {
+			sklog.Infof("ParentChild %d has already rolled to %s; skipping.", idx, to.Id)
+			continue
+		}
+		issue, err := pc.CreateNewRoll(ctx, parentFrom, to, parentRolling, emails, dryRun, commitMsg)
+		if err != nil {
+			return issues, skerr.Wrapf(err, "failed to create roll for ParentChild %d", idx)
+		}
+		issues = append(issues, issue)
+	}
+	if len(issues) == 0 {
+		return nil, skerr.Fmt("every Parent has already rolled to %s", to.Id)
+	}
+	return issues, nil
+}
+
+// fanOutRepoManager implements FanOutRepoManager and LogOptionsSetter.
+var _ FanOutRepoManager = &fanOutRepoManager{}
+var _ LogOptionsSetter = &fanOutRepoManager{}
@@ -0,0 +1,154 @@
+package repo_manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/vfs"
+)
+
+// fanOutTestRevs are the Child's revisions, in reverse chronological order.
+var fanOutTestRevs = []*revision.Revision{{Id: "d"}, {Id: "c"}, {Id: "b"}, {Id: "a"}}
+
+type fanOutTestChild struct{}
+
+func (c fanOutTestChild) Update(ctx context.Context, lastRollRev *revision.Revision) (*revision.Revision, []*revision.Revision, error) {
+	notRolled, err := c.LogRevisions(ctx, lastRollRev, fanOutTestRevs[0])
+	return fanOutTestRevs[0], notRolled, err
+}
+
+func (c fanOutTestChild) GetRevision(_ context.Context, id string) (*revision.Revision, error) {
+	for _, rev := range fanOutTestRevs {
+		if rev.Id == id {
+			return rev, nil
+		}
+	}
+	return nil, skerr.Fmt("unknown revision %q", id)
+}
+
+func (c fanOutTestChild) LogRevisions(_ context.Context, from, _ *revision.Revision) ([]*revision.Revision, error) {
+	for idx, rev := range fanOutTestRevs {
+		if rev.Id == from.Id {
+			return fanOutTestRevs[:idx], nil
+		}
+	}
+	return nil, skerr.Fmt("unknown revision %q", from.Id)
+}
+
+func (c fanOutTestChild) VFS(_ context.Context, _ *revision.Revision) (vfs.FS, error) {
+	return nil, errors.New("not implemented")
+}
+
+type fanOutTestParent struct {
+	lastRollRev string
+	issue       int64
+	err         error
+}
+
+func (p *fanOutTestParent) Update(_ context.Context) (string, error) {
+	return p.lastRollRev, nil
+}
+
+func (p *fanOutTestParent) CreateNewRoll(_ context.Context, _, _ *revision.Revision, _ []*revision.Revision, _ []string, _ bool, _ string) (int64, error) {
+	return p.issue, p.err
+}
+
+func makeFanOutRepoManager(parents ...*fanOutTestParent) *fanOutRepoManager {
+	rm := &fanOutRepoManager{}
+	for _, p := range parents {
+		rm.rms = append(rm.rms, &parentChildRepoManager{
+			Child:  fanOutTestChild{},
+			Parent: p,
+		})
+	}
+	return rm
+}
+
+func TestFanOutRepoManager_Update_UsesFurthestBehindParent(t *testing.T) {
+	ctx := context.Background()
+	rm := makeFanOutRepoManager(
+		&fanOutTestParent{lastRollRev: "c"},
+		&fanOutTestParent{lastRollRev: "a"},
+		&fanOutTestParent{lastRollRev: "b"},
+	)
+	lastRollRev, tipRev, notRolled, err := rm.Update(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a", lastRollRev.Id)
+	require.Equal(t, "d", tipRev.Id)
+	require.Equal(t, fanOutTestRevs[:3], notRolled)
+}
+
+func TestFanOutRepoManager_CreateNewFanOutRoll(t *testing.T) {
+	ctx := context.Background()
+	rm := makeFanOutRepoManager(
+		&fanOutTestParent{lastRollRev: "a", issue: 1},
+		&fanOutTestParent{lastRollRev: "a", issue: 2},
+	)
+	issues, err := rm.CreateNewFanOutRoll(ctx, fanOutTestRevs[3], fanOutTestRevs[0], fanOutTestRevs[:3], nil, false, "msg")
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, issues)
+
+	_, err = rm.CreateNewRoll(ctx, fanOutTestRevs[3], fanOutTestRevs[0], fanOutTestRevs[:3], nil, false, "msg")
+	require.ErrorContains(t, err, "fan-out rolls must be created using CreateNewFanOutRoll")
+}
+
+func TestFanOutRepoManager_CreateNewFanOutRoll_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	rm := makeFanOutRepoManager(
+		&fanOutTestParent{lastRollRev: "a", issue: 1},
+		&fanOutTestParent{lastRollRev: "a", err: errors.New("upload failed")},
+		&fanOutTestParent{lastRollRev: "a", issue: 3},
+	)
+	issues, err := rm.CreateNewFanOutRoll(ctx, fanOutTestRevs[3], fanOutTestRevs[0], fanOutTestRevs[:3], nil, false, "msg")
+	require.ErrorContains(t, err, "upload failed")
+	require.Equal(t, []int64{1}, issues)
+}
+
+// fanOutTestRollingParent is a fanOutTestParent which records the revisions
+// it was asked to roll.
+type fanOutTestRollingParent struct {
+	fanOutTestParent
+	from    string
+	rolling []*revision.Revision
+}
+
+func (p *fanOutTestRollingParent) CreateNewRoll(_ context.Context, from, _ *revision.Revision, rolling []*revision.Revision, _ []string, _ bool, _ string) (int64, error) {
+	p.from = from.Id
+	p.rolling = rolling
+	return p.issue, p.err
+}
+
+func TestFanOutRepoManager_CreateNewFanOutRoll_UsesRangeOfEachParent(t *testing.T) {
+	ctx := context.Background()
+	behind := &fanOutTestRollingParent{fanOutTestParent: fanOutTestParent{lastRollRev: "a", issue: 1}}
+	ahead := &fanOutTestRollingParent{fanOutTestParent: fanOutTestParent{lastRollRev: "c", issue: 2}}
+	upToDate := &fanOutTestRollingParent{fanOutTestParent: fanOutTestParent{lastRollRev: "d", issue: 3}}
+	rm := &fanOutRepoManager{}
+	for _, p := range []*fanOutTestRollingParent{behind, ahead, upToDate} {
+		rm.rms = append(rm.rms, &parentChildRepoManager{
+			Child:  fanOutTestChild{},
+			Parent: p,
+		})
+	}
+	lastRollRev, tipRev, notRolled, err := rm.Update(ctx)
+	require.NoError(t, err)
+
+	issues, err := rm.CreateNewFanOutRoll(ctx, lastRollRev, tipRev, notRolled, nil, false, "msg")
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, issues)
+	require.Equal(t, "a", behind.from)
+	require.Equal(t, fanOutTestRevs[:3], behind.rolling)
+	require.Equal(t, "c", ahead.from)
+	require.Equal(t, fanOutTestRevs[:1], ahead.rolling)
+	require.Empty(t, upToDate.from)
+
+	// A Parent which has rolled past the target revision is skipped too.
+	issues, err = rm.CreateNewFanOutRoll(ctx, lastRollRev, fanOutTestRevs[2], fanOutTestRevs[2:3], nil, false, "msg")
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, issues)
+	require.Equal(t, fanOutTestRevs[2:3], behind.rolling)
+}
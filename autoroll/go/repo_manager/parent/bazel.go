@@ -0,0 +1,130 @@
+package parent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"path"
+
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/gitiles_common"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/version_file_common"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/bazel"
+	"go.skia.org/infra/go/httputils"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+)
+
+// NewBazelParent returns a Parent which rolls a dependency pinned in a Bazel
+// file, eg. MODULE.bazel or WORKSPACE.
+func NewBazelParent(ctx context.Context, c *config.BazelParentConfig, reg *config_vars.Registry, client *http.Client, serverURL string) (*gitilesParent, error) {
+	if err := c.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	getChangesForRoll := func(ctx context.Context, repo *gitiles_common.GitilesRepo, baseCommit string, from, to *revision.Revision, rolling []*revision.Revision) (map[string]string, error) {
+		getFile := func(ctx context.Context, path string) (string, error) {
+			return repo.GetFile(ctx, path, baseCommit)
+		}
+		fileExists := func(ctx context.Context, file string) (bool, error) {
+			dir := path.Dir(file)
+			if dir == "." {
+				dir = ""
+			}
+			contents, err := repo.ListDirAtRef(ctx, dir, baseCommit)
+			if err != nil {
+				return false, skerr.Wrap(err)
+			}
+			for _, fi := range contents {
+				if fi.Name() == path.Base(file) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+		download := func(ctx context.Context, url string) ([]byte, error) {
+			return bazelDownload(ctx, client, url)
+		}
+		return bazelUpdateDep(ctx, c.Gitiles.Dep, to, getFile, fileExists, download)
+	}
+	return newGitiles(ctx, c.Gitiles, reg, client, serverURL, getChangesForRoll)
+}
+
+// bazelDownload retrieves the contents of the given URL.
+func bazelDownload(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	resp, err := httputils.GetWithContext(ctx, client, url)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to download %s", url)
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, skerr.Fmt("failed to download %s; got status %q", url, resp.Status)
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to download %s", url)
+	}
+	return b, nil
+}
+
+// bazelUpdateDep updates the given dependency to the given revision, returning
+// a map whose keys are file names to update and values are their updated
+// contents. If the primary dependency is an archive, its checksum is computed
+// by downloading the new version. If the primary dependency is a registry
+// module in MODULE.bazel and the lockfile exists, it is updated as well.
+func bazelUpdateDep(ctx context.Context, dep *config.DependencyConfig, to *revision.Revision, getFile version_file_common.GetFileFunc, fileExists func(context.Context, string) (bool, error), download bazel.GetRegistryFileFunc) (map[string]string, error) {
+	oldContents, err := getFile(ctx, dep.Primary.Path)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	oldDep, err := bazel.GetDep(oldContents, bazel.DependencyID(dep.Primary.Id))
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	// Archive children don't necessarily provide a checksum in the format we
+	// need, so compute it ourselves.
+	if oldDep.IsArchive() {
+		url := oldDep.URLsForVersion(to.Id)[0]
+		sklog.Infof("Downloading %s to compute its checksum", url)
+		contents, err := download(ctx, url)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		sum := sha256.Sum256(contents)
+		to = to.Copy()
+		to.Checksum = hex.EncodeToString(sum[:])
+	}
+
+	changes, err := version_file_common.UpdateDep(ctx, dep, to, getFile)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	// Update the lockfile, if present.
+	if oldDep.IsRegistryModule() && bazel.IsModuleFile(dep.Primary.Path) {
+		lockfile := path.Join(path.Dir(dep.Primary.Path), bazel.LockfileName)
+		exists, err := fileExists(ctx, lockfile)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		if exists {
+			oldLockfile, err := getFile(ctx, lockfile)
+			if err != nil {
+				return nil, skerr.Wrap(err)
+			}
+			newLockfile, err := bazel.UpdateLockfile(ctx, oldLockfile, oldDep.ID, oldDep.Version, to.Id, download)
+			if err != nil {
+				return nil, skerr.Wrapf(err, "failed to update %s", lockfile)
+			}
+			if newLockfile != oldLockfile {
+				changes[lockfile] = newLockfile
+			}
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,145 @@
+package parent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/bazel"
+	"go.skia.org/infra/go/skerr"
+)
+
+const fakeModuleFile = `module(name = "my_module")
+
+bazel_dep(name = "rules_go", version = "0.41.0")
+
+http_archive = use_repo_rule("@bazel_tools//tools/build_defs/repo:http.bzl", "http_archive")
+
+http_archive(
+    name = "my_archive",
+    sha256 = "0000000000000000000000000000000000000000000000000000000000000000",
+    strip_prefix = "my_archive-1.0.0",
+    urls = ["https://example.com/my_archive/v1.0.0.tar.gz"],
+)
+`
+
+const fakeModuleLockfile = `{
+  "lockFileVersion": 6,
+  "registryFileHashes": {
+    "https://bcr.bazel.build/modules/rules_go/0.41.0/MODULE.bazel": "3477df8bdcc49e698b9d25f734c4f3a9f5931ff34ee48a2c662be168f5f2d3fd"
+  }
+}
+`
+
+func fakeFiles(files map[string]string) (func(context.Context, string) (string, error), func(context.Context, string) (bool, error)) {
+	getFile := func(ctx context.Context, path string) (string, error) {
+		contents, ok := files[path]
+		if !ok {
+			return "", skerr.Fmt("no such file %s", path)
+		}
+		return contents, nil
+	}
+	fileExists := func(ctx context.Context, path string) (bool, error) {
+		_, ok := files[path]
+		return ok, nil
+	}
+	return getFile, fileExists
+}
+
+func fakeDownload(ctx context.Context, url string) ([]byte, error) {
+	return []byte("contents of " + url), nil
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestBazelUpdateDep_RegistryModule_UpdatesLockfile(t *testing.T) {
+	ctx := context.Background()
+	getFile, fileExists := fakeFiles(map[string]string{
+		"MODULE.bazel":      fakeModuleFile,
+		"MODULE.bazel.lock": fakeModuleLockfile,
+	})
+	dep := &config.DependencyConfig{
+		Primary: &config.VersionFileConfig{
+			Id:   "rules_go",
+			Path: "MODULE.bazel",
+		},
+	}
+	changes, err := bazelUpdateDep(ctx, dep, &revision.Revision{Id: "0.42.0"}, getFile, fileExists, fakeDownload)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	newDep, err := bazel.GetDep(changes["MODULE.bazel"], "rules_go")
+	require.NoError(t, err)
+	require.Equal(t, "0.42.0", newDep.Version)
+	const newURL = "https://bcr.bazel.build/modules/rules_go/0.42.0/MODULE.bazel"
+	require.Contains(t, changes["MODULE.bazel.lock"], `"`+newURL+`": "`+sha256Hex([]byte("contents of "+newURL))+`"`)
+}
+
+func TestBazelUpdateDep_RegistryModule_NoLockfile(t *testing.T) {
+	ctx := context.Background()
+	getFile, fileExists := fakeFiles(map[string]string{
+		"MODULE.bazel": fakeModuleFile,
+	})
+	dep := &config.DependencyConfig{
+		Primary: &config.VersionFileConfig{
+			Id:   "rules_go",
+			Path: "MODULE.bazel",
+		},
+	}
+	changes, err := bazelUpdateDep(ctx, dep, &revision.Revision{Id: "0.42.0"}, getFile, fileExists, fakeDownload)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Contains(t, changes, "MODULE.bazel")
+}
+
+func TestBazelUpdateDep_Archive_RecomputesChecksum(t *testing.T) {
+	ctx := context.Background()
+	getFile, fileExists := fakeFiles(map[string]string{
+		"MODULE.bazel":      fakeModuleFile,
+		"MODULE.bazel.lock": fakeModuleLockfile,
+	})
+	dep := &config.DependencyConfig{
+		Primary: &config.VersionFileConfig{
+			Id:   "my_archive",
+			Path: "MODULE.bazel",
+		},
+	}
+	// The Checksum provided by the Child is ignored.
+	changes, err := bazelUpdateDep(ctx, dep, &revision.Revision{Id: "v1.1.0", Checksum: "bogus"}, getFile, fileExists, fakeDownload)
+	require.NoError(t, err)
+	// Archives aren't tracked in the lockfile.
+	require.Len(t, changes, 1)
+	newDep, err := bazel.GetDep(changes["MODULE.bazel"], "my_archive")
+	require.NoError(t, err)
+	require.Equal(t, "v1.1.0", newDep.Version)
+	require.Equal(t, []string{"https://example.com/my_archive/v1.1.0.tar.gz"}, newDep.URLs)
+	require.Equal(t, sha256Hex([]byte("contents of https://example.com/my_archive/v1.1.0.tar.gz")), newDep.SHA256)
+	require.Contains(t, changes["MODULE.bazel"], `strip_prefix = "my_archive-1.1.0",`)
+}
+
+func TestBazelDownload(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("archive"))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	b, err := bazelDownload(ctx, srv.Client(), srv.URL+"/archive.tar.gz")
+	require.NoError(t, err)
+	require.Equal(t, "archive", string(b))
+
+	_, err = bazelDownload(ctx, srv.Client(), srv.URL+"/missing")
+	require.ErrorContains(t, err, "404")
+}
@@ -127,7 +127,7 @@ func NewDEPSLocal(ctx context.Context, c *config.DEPSLocalParentConfig, reg *con
 	}
 
 	// See documentation for GitCheckoutCreateRollFunc.
-	createRollHelper := gitCheckoutFileCreateRollFunc(&config.DependencyConfig{
+	createRollHelper := GitCheckoutFileCreateRollFunc(&config.DependencyConfig{
 		Primary: &config.VersionFileConfig{
 			Id:   c.GitCheckout.Dep.Primary.Id,
 			Path: deps_parser.DepsFileName,
@@ -76,10 +76,10 @@ func (p *GitCheckoutParent) CreateNewRoll(ctx context.Context, from, to *revisio
 	return p.Checkout.CreateNewRoll(ctx, from, to, rolling, emails, dryRun, commitMsg, p.createRoll, p.uploadRoll)
 }
 
-// gitCheckoutFileCreateRollFunc returns a GitCheckoutCreateRollFunc which uses
+// GitCheckoutFileCreateRollFunc returns a GitCheckoutCreateRollFunc which uses
 // a local Git checkout and pins dependencies using a file checked into the
 // repo.
-func gitCheckoutFileCreateRollFunc(dep *config.DependencyConfig) git_common.CreateRollFunc {
+func GitCheckoutFileCreateRollFunc(dep *config.DependencyConfig) git_common.CreateRollFunc {
 	return func(ctx context.Context, co *git.Checkout, from *revision.Revision, to *revision.Revision, rolling []*revision.Revision, commitMsg string) (string, error) {
 		// Determine what changes need to be made.
 		getFileWrapped := func(ctx context.Context, path string) (string, error) {
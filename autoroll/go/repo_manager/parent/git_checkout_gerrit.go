@@ -64,7 +64,7 @@ func NewGitCheckoutGerrit(ctx context.Context, c *config.GitCheckoutGerritParent
 	// See documentation for GitCheckoutUploadRollFunc.
 	uploadRoll := GitCheckoutUploadGerritRollFunc(gerritClient)
 
-	createRoll := gitCheckoutFileCreateRollFunc(c.GitCheckout.Dep)
+	createRoll := GitCheckoutFileCreateRollFunc(c.GitCheckout.Dep)
 
 	// Create the GitCheckout Parent.
 	p, err := NewGitCheckout(ctx, c.GitCheckout, reg, workdir, cr, nil, createRoll, uploadRoll)
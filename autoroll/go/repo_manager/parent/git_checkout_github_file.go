@@ -25,7 +25,7 @@ func NewGitCheckoutGithubFile(ctx context.Context, c *config.GitCheckoutGitHubFi
 		return nil, skerr.Wrap(err)
 	}
 
-	createRollHelper := gitCheckoutFileCreateRollFunc(c.GitCheckout.GitCheckout.Dep)
+	createRollHelper := GitCheckoutFileCreateRollFunc(c.GitCheckout.GitCheckout.Dep)
 	createRoll := func(ctx context.Context, co *git.Checkout, from *revision.Revision, to *revision.Revision, rolling []*revision.Revision, commitMsg string) (string, error) {
 		// Run the helper to add commits pointing to each of the Revision in the
 		// roll.
@@ -0,0 +1,116 @@
+package parent
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.skia.org/infra/autoroll/go/codereview"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/git"
+	"go.skia.org/infra/go/gitlab"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+// GitCheckoutUploadGitLabRollFunc returns a UploadRollFunc which pushes the
+// roll to a new branch in the parent repo and creates a merge request for it.
+func GitCheckoutUploadGitLabRollFunc(gitlabClient *gitlab.GitLab, c *config.GitLabConfig, rollerName string) git_common.UploadRollFunc {
+	return func(ctx context.Context, co *git.Checkout, upstreamBranch, hash string, emails []string, dryRun bool, commitMsg string) (int64, error) {
+		// Generate a source branch name with unique id and creation timestamp.
+		sourceBranch := fmt.Sprintf("%s-%s-%d", rollerName, uuid.New().String(), time.Now().Unix())
+		if _, err := co.Git(ctx, "push", "-f", "--no-verify", git.DefaultRemote, fmt.Sprintf("%s:refs/heads/%s", git_common.RollBranch, sourceBranch)); err != nil {
+			return 0, skerr.Wrap(err)
+		}
+		sklog.Infof("Pushed %s to branch %s", hash, sourceBranch)
+
+		// Use the first line of the commit message as the title of the
+		// merge request and the remainder as the description.
+		commitMsgLines := strings.Split(commitMsg, "\n")
+		mr, err := gitlabClient.CreateMergeRequest(ctx, &gitlab.CreateMergeRequestOptions{
+			SourceBranch:       sourceBranch,
+			TargetBranch:       upstreamBranch,
+			Title:              commitMsgLines[0],
+			Description:        strings.TrimSpace(strings.Join(commitMsgLines[1:], "\n")),
+			Labels:             strings.Join(c.Labels, ","),
+			RemoveSourceBranch: true,
+		})
+		if err != nil {
+			return 0, skerr.Wrapf(err, "failed to create merge request")
+		}
+		if c.SelfApprove {
+			if err := gitlabClient.Approve(ctx, mr.IID); err != nil {
+				return 0, skerr.Wrapf(err, "failed to approve merge request %d", mr.IID)
+			}
+		}
+		if !dryRun {
+			if err := gitlabClient.MergeWhenPipelineSucceeds(ctx, mr.IID, mr.SHA); err != nil {
+				return 0, skerr.Wrapf(err, "failed to set merge request %d to merge when pipeline succeeds", mr.IID)
+			}
+		}
+		return mr.IID, nil
+	}
+}
+
+// NewGitCheckoutGitLab returns an implementation of Parent which uses a local
+// git checkout and uploads merge requests to GitLab.
+func NewGitCheckoutGitLab(ctx context.Context, c *config.GitCheckoutGitLabParentConfig, reg *config_vars.Registry, client *http.Client, serverURL, workdir, rollerName string, cr codereview.CodeReview) (*GitCheckoutParent, error) {
+	if err := c.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	gitlabClient, ok := cr.Client().(*gitlab.GitLab)
+	if !ok {
+		return nil, skerr.Fmt("GitCheckoutGitLab must use GitLab for code review.")
+	}
+
+	// Pre-upload steps are run after setting the new dependency version, but
+	// before committing and uploading.
+	preUploadSteps, err := GetPreUploadSteps(nil, c.PreUploadCommands)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	createRollHelper := GitCheckoutFileCreateRollFunc(c.GitCheckout.Dep)
+	createRoll := func(ctx context.Context, co *git.Checkout, from *revision.Revision, to *revision.Revision, rolling []*revision.Revision, commitMsg string) (string, error) {
+		if _, err := createRollHelper(ctx, co, from, to, rolling, commitMsg); err != nil {
+			return "", skerr.Wrap(err)
+		}
+		if len(preUploadSteps) == 0 {
+			out, err := co.RevParse(ctx, "HEAD")
+			if err != nil {
+				return "", skerr.Wrap(err)
+			}
+			return strings.TrimSpace(out), nil
+		}
+
+		// Run the pre-upload steps.
+		sklog.Infof("Running %d pre-upload steps.", len(preUploadSteps))
+		for _, s := range preUploadSteps {
+			if err := s(ctx, nil, client, co.Dir(), from, to); err != nil {
+				return "", skerr.Wrapf(err, "failed pre-upload step")
+			}
+		}
+
+		// Commit.
+		if _, err := co.Git(ctx, "commit", "-a", "--amend", "--no-edit"); err != nil {
+			return "", skerr.Wrap(err)
+		}
+		out, err := co.RevParse(ctx, "HEAD")
+		if err != nil {
+			return "", skerr.Wrap(err)
+		}
+		return strings.TrimSpace(out), nil
+	}
+
+	// See documentation for GitCheckoutUploadRollFunc.
+	uploadRoll := GitCheckoutUploadGitLabRollFunc(gitlabClient, c.Gitlab, rollerName)
+
+	return NewGitCheckout(ctx, c.GitCheckout, reg, workdir, cr, nil, createRoll, uploadRoll)
+}
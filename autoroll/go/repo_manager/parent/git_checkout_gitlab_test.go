@@ -0,0 +1,67 @@
+package parent
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
+	"go.skia.org/infra/go/git"
+	"go.skia.org/infra/go/gitlab"
+	gitlab_testutils "go.skia.org/infra/go/gitlab/testutils"
+	"go.skia.org/infra/go/testutils"
+)
+
+func TestGitCheckoutUploadGitLabRollFunc(t *testing.T) {
+	ctx, gb := setup(t)
+	defer gb.Cleanup()
+
+	tmpDir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer testutils.RemoveAll(t, tmpDir)
+	co, err := git.NewCheckout(ctx, gb.Dir(), tmpDir)
+	require.NoError(t, err)
+
+	// Create the roll commit.
+	_, err = co.Git(ctx, "checkout", "-b", git_common.RollBranch, "-t", git.DefaultRemoteBranch)
+	require.NoError(t, err)
+	require.NoError(t, writeFile(ctx, co, checkedInFile, "rolled"))
+	_, err = co.Git(ctx, "commit", "-a", "-m", "Roll child\n\nRolled some stuff.")
+	require.NoError(t, err)
+	hash, err := co.RevParse(ctx, "HEAD")
+	require.NoError(t, err)
+
+	f := gitlab_testutils.NewFakeGitLab(t)
+	upload := GitCheckoutUploadGitLabRollFunc(f.GitLab, &config.GitLabConfig{
+		Labels:      []string{"autoroll"},
+		SelfApprove: true,
+	}, "test-roller")
+
+	// Dry run.
+	iid, err := upload(ctx, co, git.MainBranch, hash, nil, true, "Roll child\n\nRolled some stuff.")
+	require.NoError(t, err)
+	mr := f.MergeRequest(iid)
+	require.Equal(t, "Roll child", mr.Title)
+	require.Equal(t, "Rolled some stuff.", mr.Description)
+	require.Equal(t, git.MainBranch, mr.TargetBranch)
+	require.True(t, strings.HasPrefix(mr.SourceBranch, "test-roller-"))
+	require.Equal(t, []string{"autoroll"}, mr.Labels)
+	require.Equal(t, 1, f.Approvals(iid))
+	require.False(t, mr.MergeWhenPipelineSucceeds)
+
+	// The roll was pushed to the source branch.
+	_, err = co.Git(ctx, "fetch", git.DefaultRemote)
+	require.NoError(t, err)
+	pushed, err := co.RevParse(ctx, "origin/"+mr.SourceBranch)
+	require.NoError(t, err)
+	require.Equal(t, strings.TrimSpace(hash), strings.TrimSpace(pushed))
+
+	// Normal roll.
+	iid, err = upload(ctx, co, git.MainBranch, hash, nil, false, "Roll child")
+	require.NoError(t, err)
+	mr = f.MergeRequest(iid)
+	require.Equal(t, gitlab.MERGE_REQUEST_STATE_OPENED, mr.State)
+	require.True(t, mr.MergeWhenPipelineSucceeds)
+}
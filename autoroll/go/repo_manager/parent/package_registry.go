@@ -0,0 +1,114 @@
+package parent
+
+import (
+	"context"
+	"net/http"
+
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/gitiles_common"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/package_files"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/version_file_common"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/package_registry"
+	"go.skia.org/infra/go/skerr"
+)
+
+// NewPackageRegistryParent returns a Parent which rolls a package pinned in a
+// package manifest, eg. package.json, requirements.txt or Cargo.toml.
+func NewPackageRegistryParent(ctx context.Context, c *config.PackageRegistryParentConfig, reg *config_vars.Registry, client *http.Client, serverURL string) (*gitilesParent, error) {
+	if err := c.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	manifest := c.Gitiles.Dep.Primary.Path
+	var registry package_registry.Registry
+	if package_files.IsPackageJSON(manifest) {
+		registry = package_registry.NewNPM(client, c.RegistryUrl)
+	} else if package_files.IsRequirementsFile(manifest) {
+		registry = package_registry.NewPyPI(client, c.RegistryUrl)
+	} else if package_files.IsCargoToml(manifest) {
+		registry = package_registry.NewCrates(client, c.RegistryUrl)
+	} else {
+		return nil, skerr.Fmt("unknown package manifest %q", manifest)
+	}
+	getChangesForRoll := func(ctx context.Context, repo *gitiles_common.GitilesRepo, baseCommit string, from, to *revision.Revision, rolling []*revision.Revision) (map[string]string, error) {
+		getFile := func(ctx context.Context, path string) (string, error) {
+			return repo.GetFile(ctx, path, baseCommit)
+		}
+		return packageRegistryUpdateDep(ctx, c, registry, to, getFile)
+	}
+	return newGitiles(ctx, c.Gitiles, reg, client, serverURL, getChangesForRoll)
+}
+
+// packageRegistryUpdateDep updates the given package to the given version,
+// returning a map whose keys are file names to update and values are their
+// updated contents. The package registry is used to find the checksums of the
+// new version for the lockfile and for requirements which specify hashes.
+func packageRegistryUpdateDep(ctx context.Context, c *config.PackageRegistryParentConfig, registry package_registry.Registry, to *revision.Revision, getFile version_file_common.GetFileFunc) (map[string]string, error) {
+	dep := c.Gitiles.Dep
+	manifest := dep.Primary.Path
+	pkg := dep.Primary.Id
+
+	// Find the currently-pinned version before we change it.
+	oldManifest, err := getFile(ctx, manifest)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	oldVersion, err := package_files.GetDep(manifest, oldManifest, pkg)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	changes, err := version_file_common.UpdateDep(ctx, dep, to, getFile)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if c.Lockfile == "" && !package_files.IsRequirementsFile(manifest) {
+		return changes, nil
+	}
+
+	// Find the new version in the registry.
+	versions, err := registry.GetVersions(ctx, pkg)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	var newVersion *package_registry.Version
+	for _, v := range versions {
+		if v.Version == to.Id {
+			newVersion = v
+			break
+		}
+	}
+	if newVersion == nil {
+		return nil, skerr.Fmt("version %q of %s not found in registry", to.Id, pkg)
+	}
+
+	if package_files.IsRequirementsFile(manifest) {
+		if newManifest, ok := changes[manifest]; ok {
+			changes[manifest], err = package_files.SetRequirementHashes(newManifest, pkg, newVersion.Hashes)
+			if err != nil {
+				return nil, skerr.Wrap(err)
+			}
+		}
+	}
+
+	if c.Lockfile != "" {
+		oldLockfile, err := getFile(ctx, c.Lockfile)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		var newLockfile string
+		if package_files.IsPackageJSON(manifest) {
+			newLockfile, err = package_files.UpdatePackageLock(oldLockfile, pkg, newVersion.Version, newVersion.Tarball, newVersion.Checksum)
+		} else {
+			newLockfile, err = package_files.UpdateCargoLock(oldLockfile, pkg, oldVersion, newVersion.Version, newVersion.Checksum)
+		}
+		if err != nil {
+			return nil, skerr.Wrapf(err, "failed to update %s", c.Lockfile)
+		}
+		if newLockfile != oldLockfile {
+			changes[c.Lockfile] = newLockfile
+		}
+	}
+	return changes, nil
+}
@@ -0,0 +1,183 @@
+package parent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Masterminds/semver"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/package_registry"
+)
+
+// fakePackageRegistry is a package_registry.Registry which returns a fixed set
+// of versions.
+type fakePackageRegistry struct {
+	versions []*package_registry.Version
+}
+
+// GetVersions implements package_registry.Registry.
+func (r *fakePackageRegistry) GetVersions(ctx context.Context, pkg string) ([]*package_registry.Version, error) {
+	return r.versions, nil
+}
+
+// ParseVersion implements package_registry.Registry.
+func (r *fakePackageRegistry) ParseVersion(version string) (*semver.Version, error) {
+	return semver.NewVersion(version)
+}
+
+var fakePackageRegistryVersions = &fakePackageRegistry{
+	versions: []*package_registry.Version{
+		{
+			Version:  "1.2.3",
+			Tarball:  "https://registry/my-pkg-1.2.3.tgz",
+			Checksum: "sha512-old",
+			Hashes:   []string{"aaaa"},
+		},
+		{
+			Version:  "1.3.0",
+			Tarball:  "https://registry/my-pkg-1.3.0.tgz",
+			Checksum: "sha512-new",
+			Hashes:   []string{"bbbb", "cccc"},
+		},
+	},
+}
+
+func packageRegistryParentConfig(manifest, lockfile string) *config.PackageRegistryParentConfig {
+	return &config.PackageRegistryParentConfig{
+		Gitiles: &config.GitilesParentConfig{
+			Dep: &config.DependencyConfig{
+				Primary: &config.VersionFileConfig{
+					Id:   "my-pkg",
+					Path: manifest,
+				},
+			},
+		},
+		Lockfile: lockfile,
+	}
+}
+
+func TestPackageRegistryUpdateDep_PackageJSON(t *testing.T) {
+	ctx := context.Background()
+	getFile, _ := fakeFiles(map[string]string{
+		"package.json": `{
+  "dependencies": {
+    "my-pkg": "^1.2.3"
+  }
+}
+`,
+		"package-lock.json": `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {
+      "dependencies": {
+        "my-pkg": "^1.2.3"
+      }
+    },
+    "node_modules/my-pkg": {
+      "version": "1.2.3",
+      "resolved": "https://registry/my-pkg-1.2.3.tgz",
+      "integrity": "sha512-old"
+    }
+  }
+}
+`,
+	})
+	c := packageRegistryParentConfig("package.json", "package-lock.json")
+	changes, err := packageRegistryUpdateDep(ctx, c, fakePackageRegistryVersions, &revision.Revision{Id: "1.3.0"}, getFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"package.json": `{
+  "dependencies": {
+    "my-pkg": "^1.3.0"
+  }
+}
+`,
+		"package-lock.json": `{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {
+      "dependencies": {
+        "my-pkg": "^1.3.0"
+      }
+    },
+    "node_modules/my-pkg": {
+      "version": "1.3.0",
+      "resolved": "https://registry/my-pkg-1.3.0.tgz",
+      "integrity": "sha512-new"
+    }
+  }
+}
+`,
+	}, changes)
+}
+
+func TestPackageRegistryUpdateDep_Requirements(t *testing.T) {
+	ctx := context.Background()
+	getFile, _ := fakeFiles(map[string]string{
+		"requirements.txt": `my-pkg==1.2.3 \
+    --hash=sha256:aaaa
+`,
+	})
+	c := packageRegistryParentConfig("requirements.txt", "")
+	changes, err := packageRegistryUpdateDep(ctx, c, fakePackageRegistryVersions, &revision.Revision{Id: "1.3.0"}, getFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"requirements.txt": `my-pkg==1.3.0 \
+    --hash=sha256:bbbb \
+    --hash=sha256:cccc
+`,
+	}, changes)
+}
+
+func TestPackageRegistryUpdateDep_Cargo(t *testing.T) {
+	ctx := context.Background()
+	getFile, _ := fakeFiles(map[string]string{
+		"Cargo.toml": `[dependencies]
+my-pkg = "1.2"
+`,
+		"Cargo.lock": `[[package]]
+name = "my-pkg"
+version = "1.2.3"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "old"
+`,
+	})
+	c := packageRegistryParentConfig("Cargo.toml", "Cargo.lock")
+	changes, err := packageRegistryUpdateDep(ctx, c, fakePackageRegistryVersions, &revision.Revision{Id: "1.3.0"}, getFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"Cargo.toml": `[dependencies]
+my-pkg = "1.3.0"
+`,
+		"Cargo.lock": `[[package]]
+name = "my-pkg"
+version = "1.3.0"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "sha512-new"
+`,
+	}, changes)
+}
+
+func TestPackageRegistryUpdateDep_NoLockfile_SkipsRegistry(t *testing.T) {
+	ctx := context.Background()
+	getFile, _ := fakeFiles(map[string]string{
+		"Cargo.toml": `[dependencies]
+my-pkg = "1.2"
+`,
+	})
+	c := packageRegistryParentConfig("Cargo.toml", "")
+	// The registry doesn't know about this version, but we don't need it.
+	changes, err := packageRegistryUpdateDep(ctx, c, &fakePackageRegistry{}, &revision.Revision{Id: "1.4.0"}, getFile)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"Cargo.toml": `[dependencies]
+my-pkg = "1.4.0"
+`,
+	}, changes)
+
+	// If we do need the registry, it's an error for the version to be missing.
+	_, err = packageRegistryUpdateDep(ctx, packageRegistryParentConfig("Cargo.toml", "Cargo.lock"), &fakePackageRegistry{}, &revision.Revision{Id: "1.4.0"}, getFile)
+	require.ErrorContains(t, err, `version "1.4.0" of my-pkg not found in registry`)
+}
@@ -10,6 +10,7 @@ import (
 	"go.skia.org/infra/autoroll/go/config_vars"
 	"go.skia.org/infra/autoroll/go/repo_manager/child"
 	"go.skia.org/infra/autoroll/go/repo_manager/child/revision_filter"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
 	"go.skia.org/infra/autoroll/go/repo_manager/parent"
 	"go.skia.org/infra/autoroll/go/revision"
 	"go.skia.org/infra/go/git"
@@ -53,14 +54,20 @@ func newParentChildRepoManager(ctx context.Context, c *config.ParentChildRepoMan
 		childFullPath := filepath.Join(workdir, childPath)
 		childCheckout = &git.Checkout{GitDir: git.GitDir(childFullPath)}
 		parentRM, err = parent.NewDEPSLocalGitHub(ctx, parentCfg, reg, client, serverURL, workdir, rollerName, cr)
+	} else if c.GetBazelParent() != nil {
+		parentRM, err = parent.NewBazelParent(ctx, c.GetBazelParent(), reg, client, serverURL)
 	} else if c.GetGitCheckoutGerritParent() != nil {
 		parentRM, err = parent.NewGitCheckoutGerrit(ctx, c.GetGitCheckoutGerritParent(), reg, serverURL, workdir, rollerName, cr)
 	} else if c.GetGitCheckoutGithubFileParent() != nil {
 		parentRM, err = parent.NewGitCheckoutGithubFile(ctx, c.GetGitCheckoutGithubFileParent(), reg, client, serverURL, workdir, rollerName, cr)
+	} else if c.GetGitCheckoutGitlabParent() != nil {
+		parentRM, err = parent.NewGitCheckoutGitLab(ctx, c.GetGitCheckoutGitlabParent(), reg, client, serverURL, workdir, rollerName, cr)
 	} else if c.GetGitilesParent() != nil {
 		parentRM, err = parent.NewGitilesFile(ctx, c.GetGitilesParent(), reg, client, serverURL)
 	} else if c.GetGoModGerritParent() != nil {
 		parentRM, err = parent.NewGoModGerritParent(ctx, c.GetGoModGerritParent(), reg, client, workdir, cr)
+	} else if c.GetPackageRegistryParent() != nil {
+		parentRM, err = parent.NewPackageRegistryParent(ctx, c.GetPackageRegistryParent(), reg, client, serverURL)
 	}
 	if err != nil {
 		return nil, skerr.Wrap(err)
@@ -81,6 +88,8 @@ func newParentChildRepoManager(ctx context.Context, c *config.ParentChildRepoMan
 		childRM, err = child.NewSemVerGCS(ctx, c.GetSemverGcsChild(), reg, client)
 	} else if c.GetDockerChild() != nil {
 		childRM, err = child.NewDocker(ctx, c.GetDockerChild())
+	} else if c.GetPackageRegistryChild() != nil {
+		childRM, err = child.NewPackageRegistry(ctx, c.GetPackageRegistryChild(), client)
 	}
 	if err != nil {
 		return nil, skerr.Wrap(err)
@@ -123,6 +132,13 @@ func newParentChildRepoManager(ctx context.Context, c *config.ParentChildRepoMan
 		}
 		revFilters = append(revFilters, revFilter)
 	}
+	for _, rfConfig := range c.GetSignatureRevisionFilter() {
+		revFilter, err := revision_filter.NewSignatureRevisionFilter(ctx, client, rfConfig, workdir)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		revFilters = append(revFilters, revFilter)
+	}
 
 	return &parentChildRepoManager{
 		Child:      childRM,
@@ -131,6 +147,14 @@ func newParentChildRepoManager(ctx context.Context, c *config.ParentChildRepoMan
 	}, nil
 }
 
+// SetLogOptions implements LogOptionsSetter. It has no effect if the Child
+// can't record the optional details.
+func (rm *parentChildRepoManager) SetLogOptions(opts git_common.LogOptions) {
+	if s, ok := rm.Child.(LogOptionsSetter); ok {
+		s.SetLogOptions(opts)
+	}
+}
+
 // See documentation for RepoManager interface.
 func (rm *parentChildRepoManager) Update(ctx context.Context) (*revision.Revision, *revision.Revision, []*revision.Revision, error) {
 	lastRollRevId, err := rm.Parent.Update(ctx)
@@ -7,6 +7,7 @@ import (
 	"go.skia.org/infra/autoroll/go/codereview"
 	"go.skia.org/infra/autoroll/go/config"
 	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
 	"go.skia.org/infra/autoroll/go/revision"
 	"go.skia.org/infra/go/skerr"
 )
@@ -33,6 +34,13 @@ type RepoManager interface {
 	LogRevisions(context.Context, *revision.Revision, *revision.Revision) ([]*revision.Revision, error)
 }
 
+// LogOptionsSetter is implemented by RepoManagers whose LogRevisions can
+// record optional details of each Revision.
+type LogOptionsSetter interface {
+	// SetLogOptions sets the details recorded by LogRevisions.
+	SetLogOptions(git_common.LogOptions)
+}
+
 // New returns a RepoManager instance based on the given RepoManagerConfig.
 func New(ctx context.Context, c config.RepoManagerConfig, reg *config_vars.Registry, workdir, rollerName, serverURL, serviceAccount string, client *http.Client, cr codereview.CodeReview, isInternal bool, local bool) (RepoManager, error) {
 	if c == nil {
@@ -49,6 +57,8 @@ func New(ctx context.Context, c config.RepoManagerConfig, reg *config_vars.Regis
 		return NewFreeTypeRepoManager(ctx, rmc, reg, workdir, serverURL, client, cr, local)
 	} else if rmc, ok := c.(*config.ParentChildRepoManagerConfig); ok {
 		return newParentChildRepoManager(ctx, rmc, reg, workdir, rollerName, serverURL, client, cr)
+	} else if rmc, ok := c.(*config.FanOutRepoManagerConfig); ok {
+		return newFanOutRepoManager(ctx, rmc, reg, workdir, rollerName, serverURL, client, cr)
 	}
 	return nil, skerr.Fmt("Unknown RepoManager type.")
 }
@@ -62,12 +62,27 @@ type Revision struct {
 	// eg. a shortened commit hash.
 	Display string `json:"display"`
 
+	// Files are the paths of the files changed by this Revision, if known.
+	Files []string `json:"files"`
+
+	// FilesChanged is the number of files changed by this Revision, or zero
+	// if unknown.
+	FilesChanged int `json:"filesChanged"`
+
 	// InvalidReason indicates we should not roll to this Revision and why,
 	// if it is non-empty. Note that rolls may still *include* this
 	// Revision, eg. if this is a git commit and we roll to a descendant of
 	// it.
 	InvalidReason string `json:"invalidReason"`
 
+	// LinesChanged is the number of lines added or removed by this Revision,
+	// or zero if unknown.
+	LinesChanged int `json:"linesChanged"`
+
+	// ReleaseNotes are any release notes associated with this Revision, eg.
+	// the annotation of a tag which points to it.
+	ReleaseNotes string `json:"releaseNotes"`
+
 	// Tests are any tests which should be run on rolls including this
 	// Revision.
 	Tests []string `json:"tests"`
@@ -98,7 +113,11 @@ func (r *Revision) Copy() *Revision {
 		Details:          r.Details,
 		Display:          r.Display,
 		Dependencies:     util.CopyStringMap(r.Dependencies),
+		Files:            util.CopyStringSlice(r.Files),
+		FilesChanged:     r.FilesChanged,
 		InvalidReason:    r.InvalidReason,
+		LinesChanged:     r.LinesChanged,
+		ReleaseNotes:     r.ReleaseNotes,
 		Tests:            util.CopyStringSlice(r.Tests),
 		Timestamp:        r.Timestamp,
 		URL:              r.URL,
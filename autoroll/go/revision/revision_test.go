@@ -23,7 +23,11 @@ func TestCopyRevision(t *testing.T) {
 			"dep": "version1",
 		},
 		Details:       "blah blah blah",
+		Files:         []string{"a.txt", "b/c.txt"},
+		FilesChanged:  3,
 		InvalidReason: "flu",
+		LinesChanged:  42,
+		ReleaseNotes:  "Version 1.0",
 		Tests:         []string{"test1"},
 		Timestamp:     time.Now(),
 		URL:           "www.best-commit.com",
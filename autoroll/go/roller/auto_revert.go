@@ -0,0 +1,73 @@
+package roller
+
+import (
+	"context"
+	"fmt"
+
+	"go.skia.org/infra/autoroll/go/modes"
+	"go.skia.org/infra/go/autoroll"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+// maybeAutoRevert reverts the most recently landed roll and stops the roller
+// if the post-submit signals indicate that the roll broke the parent.
+func (r *AutoRoller) maybeAutoRevert(ctx context.Context) error {
+	if r.autoReverter == nil {
+		return nil
+	}
+	// Find the most recently landed roll.
+	var landed *autoroll.AutoRollIssue
+	for _, roll := range r.recent.GetRecentRolls() {
+		if roll.Committed && roll.Result == autoroll.ROLL_RESULT_SUCCESS && !roll.IsDryRun {
+			landed = roll
+			break
+		}
+	}
+
+	// Don't act unless the roller is running normally. If the mode changed
+	// after the roll landed, then either we've already reverted it and
+	// stopped the roller, or a human has intervened since. Either way, leave
+	// it alone, but keep track of the health of the parent so that we know
+	// whether it was healthy when the next roll lands.
+	currentMode := r.modeHistory.CurrentMode()
+	if currentMode == nil || currentMode.Mode != modes.ModeRunning || landed == nil || currentMode.Time.After(landed.Modified) {
+		r.autoReverter.Observe(ctx)
+		return nil
+	}
+
+	reason, err := r.autoReverter.Check(ctx, landed.Modified, landed.CommitHash)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	if reason == "" {
+		return nil
+	}
+
+	issueURL := fmt.Sprintf("%s%d", r.codereview.GetIssueUrlBase(), landed.Issue)
+	sklog.Warningf("Reverting %s: %s", issueURL, reason)
+
+	// Stop the roller before uploading the revert. The mode change is what
+	// prevents later ticks from reverting the roll again, so if we can't
+	// record it we mustn't upload the revert either.
+	modeMsg := fmt.Sprintf("Stopping the roller to revert %s because it broke the %s:\n%s", issueURL, r.cfg.ParentDisplayName, reason)
+	if err := r.modeHistory.Add(ctx, modes.ModeStopped, "AutoRoll Bot", modeMsg); err != nil {
+		return skerr.Wrapf(err, "failed to stop the roller before reverting %s", issueURL)
+	}
+
+	msg := fmt.Sprintf("Revert %q\n\nThis reverts %s.\n\nReason for revert:\n%s\n", landed.Subject, issueURL, reason)
+	revertURL, err := r.codereview.Revert(ctx, landed.Issue, msg)
+	if err != nil {
+		return skerr.Wrapf(err, "stopped the roller but failed to revert %s; it must be reverted manually", issueURL)
+	}
+	// Fan-out rolls must be reverted in every parent.
+	for _, issue := range landed.FanOutIssues {
+		fanOutIssueURL := fmt.Sprintf("%s%d", r.codereview.GetIssueUrlBase(), issue)
+		fanOutMsg := fmt.Sprintf("Revert %q\n\nThis reverts %s.\n\nReason for revert:\n%s\n", landed.Subject, fanOutIssueURL, reason)
+		if _, err := r.codereview.Revert(ctx, issue, fanOutMsg); err != nil {
+			return skerr.Wrapf(err, "stopped the roller and reverted %s but failed to revert %s; it must be reverted manually", issueURL, fanOutIssueURL)
+		}
+	}
+	r.notifier.SendRollReverted(ctx, fmt.Sprintf("%d", landed.Issue), issueURL, revertURL, reason)
+	return nil
+}
@@ -0,0 +1,167 @@
+package roller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/auto_revert"
+	"go.skia.org/infra/autoroll/go/codereview"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/modes"
+	modes_mocks "go.skia.org/infra/autoroll/go/modes/mocks"
+	arb_notifier "go.skia.org/infra/autoroll/go/notifier"
+	"go.skia.org/infra/autoroll/go/recent_rolls"
+	recent_rolls_mocks "go.skia.org/infra/autoroll/go/recent_rolls/mocks"
+	"go.skia.org/infra/email/go/emailclient"
+	"go.skia.org/infra/go/autoroll"
+	"go.skia.org/infra/go/now"
+)
+
+// fakeCodeReview is a codereview.CodeReview which records reverts.
+type fakeCodeReview struct {
+	codereview.CodeReview
+	reverted      int64
+	revertMessage string
+}
+
+// GetIssueUrlBase implements codereview.CodeReview.
+func (c *fakeCodeReview) GetIssueUrlBase() string {
+	return "https://review/c/"
+}
+
+// Revert implements codereview.CodeReview.
+func (c *fakeCodeReview) Revert(ctx context.Context, issue int64, message string) (string, error) {
+	c.reverted = issue
+	c.revertMessage = message
+	return "https://review/c/999", nil
+}
+
+// fakeSignal is an auto_revert.Signal which returns a fixed failure.
+type fakeSignal struct {
+	failure  string
+	commit   string
+	observed int
+}
+
+// Name implements auto_revert.Signal.
+func (s *fakeSignal) Name() string {
+	return "fake"
+}
+
+// Failure implements auto_revert.Signal.
+func (s *fakeSignal) Failure(ctx context.Context, since time.Time, commit string) (string, error) {
+	s.commit = commit
+	return s.failure, nil
+}
+
+// Observe implements auto_revert.observer.
+func (s *fakeSignal) Observe(ctx context.Context) error {
+	s.observed++
+	return nil
+}
+
+func setupAutoRevert(t *testing.T, landed time.Time, modeTime time.Time, failure string) (*AutoRoller, *fakeCodeReview, *modes_mocks.ModeHistory, *fakeSignal) {
+	ctx := context.Background()
+	db := recent_rolls_mocks.NewDB(t)
+	db.On("GetRolls", ctx, "test-roller", "").Return([]*autoroll.AutoRollIssue{
+		{
+			Issue:    124,
+			Subject:  "Roll child from bbb to ccc",
+			Closed:   true,
+			Result:   autoroll.ROLL_RESULT_FAILURE,
+			Modified: landed.Add(time.Minute),
+		},
+		{
+			Issue:      123,
+			Subject:    "Roll child from aaa to bbb",
+			Closed:     true,
+			Committed:  true,
+			CommitHash: "abc123",
+			Result:     autoroll.ROLL_RESULT_SUCCESS,
+			Modified:   landed,
+		},
+	}, "", nil)
+	recent, err := recent_rolls.NewRecentRolls(ctx, db, "test-roller")
+	require.NoError(t, err)
+
+	mh := modes_mocks.NewModeHistory(t)
+	mh.On("CurrentMode").Return(&modes.ModeChange{
+		Mode: modes.ModeRunning,
+		Time: modeTime,
+	})
+
+	n, err := arb_notifier.New(ctx, "child", "parent", "https://autoroll", nil, emailclient.New(), nil, nil)
+	require.NoError(t, err)
+
+	cr := &fakeCodeReview{}
+	signal := &fakeSignal{failure: failure}
+	r := &AutoRoller{
+		autoReverter: auto_revert.NewWithSignals(time.Hour, signal),
+		cfg:          &config.Config{ParentDisplayName: "parent"},
+		codereview:   cr,
+		modeHistory:  mh,
+		notifier:     n,
+		recent:       recent,
+	}
+	return r, cr, mh, signal
+}
+
+func TestMaybeAutoRevert_NoFailure_DoesNothing(t *testing.T) {
+	landed := time.Unix(1682445445, 0).UTC()
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(10*time.Minute))
+	r, cr, _, signal := setupAutoRevert(t, landed, landed.Add(-time.Hour), "")
+	require.NoError(t, r.maybeAutoRevert(ctx))
+	require.Zero(t, cr.reverted)
+	require.Equal(t, "abc123", signal.commit)
+}
+
+func TestMaybeAutoRevert_Failure_RevertsAndStops(t *testing.T) {
+	landed := time.Unix(1682445445, 0).UTC()
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(10*time.Minute))
+	r, cr, mh, _ := setupAutoRevert(t, landed, landed.Add(-time.Hour), "it broke")
+	mh.On("Add", ctx, modes.ModeStopped, "AutoRoll Bot", mock.AnythingOfType("string")).Run(func(args mock.Arguments) {
+		// The roller must be stopped before the revert is uploaded.
+		require.Zero(t, cr.reverted)
+	}).Return(nil)
+	require.NoError(t, r.maybeAutoRevert(ctx))
+	require.Equal(t, int64(123), cr.reverted)
+	require.Equal(t, `Revert "Roll child from aaa to bbb"
+
+This reverts https://review/c/123.
+
+Reason for revert:
+fake: it broke
+`, cr.revertMessage)
+}
+
+func TestMaybeAutoRevert_ModeChangedAfterLanding_DoesNothing(t *testing.T) {
+	landed := time.Unix(1682445445, 0).UTC()
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(10*time.Minute))
+	r, cr, _, signal := setupAutoRevert(t, landed, landed.Add(5*time.Minute), "it broke")
+	require.NoError(t, r.maybeAutoRevert(ctx))
+	require.Zero(t, cr.reverted)
+	// The signals still keep track of the health of the parent.
+	require.Equal(t, 1, signal.observed)
+}
+
+func TestMaybeAutoRevert_OutsideWindow_DoesNothing(t *testing.T) {
+	landed := time.Unix(1682445445, 0).UTC()
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(2*time.Hour))
+	r, cr, _, signal := setupAutoRevert(t, landed, landed.Add(-time.Hour), "it broke")
+	require.NoError(t, r.maybeAutoRevert(ctx))
+	require.Zero(t, cr.reverted)
+	require.Equal(t, 1, signal.observed)
+}
+
+func TestMaybeAutoRevert_FailsToStop_DoesNotRevert(t *testing.T) {
+	landed := time.Unix(1682445445, 0).UTC()
+	ctx := context.WithValue(context.Background(), now.ContextKey, landed.Add(10*time.Minute))
+	r, cr, mh, _ := setupAutoRevert(t, landed, landed.Add(-time.Hour), "it broke")
+	mh.On("Add", ctx, modes.ModeStopped, "AutoRoll Bot", mock.AnythingOfType("string")).Return(errors.New("failed"))
+	require.Error(t, r.maybeAutoRevert(ctx))
+	require.Zero(t, cr.reverted)
+}
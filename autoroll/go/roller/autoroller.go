@@ -12,15 +12,18 @@ import (
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"go.skia.org/infra/autoroll/go/auto_revert"
 	"go.skia.org/infra/autoroll/go/codereview"
 	"go.skia.org/infra/autoroll/go/commit_msg"
 	"go.skia.org/infra/autoroll/go/config"
 	"go.skia.org/infra/autoroll/go/config_vars"
+	"go.skia.org/infra/autoroll/go/invalid_revisions"
 	"go.skia.org/infra/autoroll/go/manual"
 	"go.skia.org/infra/autoroll/go/modes"
 	arb_notifier "go.skia.org/infra/autoroll/go/notifier"
 	"go.skia.org/infra/autoroll/go/recent_rolls"
 	"go.skia.org/infra/autoroll/go/repo_manager"
+	"go.skia.org/infra/autoroll/go/repo_manager/common/git_common"
 	"go.skia.org/infra/autoroll/go/revision"
 	"go.skia.org/infra/autoroll/go/roller_cleanup"
 	"go.skia.org/infra/autoroll/go/state_machine"
@@ -37,6 +40,7 @@ import (
 	"go.skia.org/infra/go/gcs"
 	"go.skia.org/infra/go/gerrit"
 	"go.skia.org/infra/go/github"
+	"go.skia.org/infra/go/gitlab"
 	"go.skia.org/infra/go/human"
 	"go.skia.org/infra/go/metrics2"
 	"go.skia.org/infra/go/notifier"
@@ -44,6 +48,7 @@ import (
 	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/go/vfs"
 )
 
 const (
@@ -68,6 +73,7 @@ const (
 // AutoRoller is a struct which automates the merging new revisions of one
 // project into another.
 type AutoRoller struct {
+	autoReverter          *auto_revert.AutoReverter
 	cfg                   *config.Config
 	cleanup               roller_cleanup.DB
 	client                *http.Client
@@ -78,6 +84,7 @@ type AutoRoller struct {
 	emails                []string
 	emailsMtx             sync.RWMutex
 	failureThrottle       *state_machine.Throttler
+	invalidRevs           *invalid_revisions.RevisionFilter
 	lastRollRev           *revision.Revision
 	liveness              metrics2.Liveness
 	manualRollDB          manual.DB
@@ -111,7 +118,7 @@ type AutoRoller struct {
 }
 
 // NewAutoRoller returns an AutoRoller instance.
-func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Client, chatBotConfigReader chatbot.ConfigReader, g *gerrit.Gerrit, githubClient *github.GitHub, workdir, serverURL string, gcsClient gcs.GCSClient, client *http.Client, rollerName string, local bool, statusDB status.DB, manualRollDB manual.DB, cleanupDB roller_cleanup.DB) (*AutoRoller, error) {
+func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Client, chatBotConfigReader chatbot.ConfigReader, g *gerrit.Gerrit, githubClient *github.GitHub, gitlabClient *gitlab.GitLab, workdir, serverURL string, gcsClient gcs.GCSClient, client *http.Client, rollerName string, local bool, statusDB status.DB, manualRollDB manual.DB, cleanupDB roller_cleanup.DB) (*AutoRoller, error) {
 	// Validation and setup.
 	if err := c.Validate(); err != nil {
 		return nil, skerr.Wrapf(err, "Failed to validate config")
@@ -122,8 +129,10 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 		cr, err = codereview.NewGerrit(c.GetGerrit(), g, client)
 	} else if c.GetGithub() != nil {
 		cr, err = codereview.NewGitHub(c.GetGithub(), githubClient)
+	} else if c.GetGitlab() != nil {
+		cr, err = codereview.NewGitLab(c.GetGitlab(), gitlabClient)
 	} else {
-		return nil, skerr.Fmt("Either GitHub or Gerrit is required.")
+		return nil, skerr.Fmt("One of GitHub, GitLab, or Gerrit is required.")
 	}
 	if err != nil {
 		return nil, skerr.Wrapf(err, "Failed to initialize code review")
@@ -138,6 +147,9 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 	if err != nil {
 		return nil, skerr.Wrap(err)
 	}
+	if s, ok := rm.(repo_manager.LogOptionsSetter); ok {
+		s.SetLogOptions(git_common.LogOptionsForCommitMsg(c.CommitMsg))
+	}
 
 	sklog.Info("Creating strategy history.")
 	sh, err := strategy.NewDatastoreStrategyHistory(ctx, rollerName, c.ValidStrategies())
@@ -153,8 +165,17 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 		}
 		currentStrategy = sh.CurrentStrategy()
 	}
+	sklog.Info("Creating roll history")
+	recent, err := recent_rolls.NewRecentRolls(ctx, recent_rolls.NewDatastoreRollsDB(ctx), rollerName)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "Failed to create recent rolls DB")
+	}
 	sklog.Info("Setting strategy.")
-	strat, err := strategy.GetNextRollStrategy(currentStrategy.Strategy)
+	strategyOpts, err := getStrategyOptions(c, recent)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	strat, err := strategy.GetNextRollStrategy(currentStrategy.Strategy, strategyOpts)
 	if err != nil {
 		return nil, skerr.Wrapf(err, "Failed to get next roll strategy")
 	}
@@ -164,6 +185,10 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 	if err != nil {
 		return nil, skerr.Wrapf(err, "Failed initial repo manager update")
 	}
+	invalidRevs := invalid_revisions.NewRevisionFilter(invalid_revisions.NewDatastoreDB(), rollerName)
+	if err := markInvalidRevisions(ctx, invalidRevs, notRolledRevs); err != nil {
+		return nil, skerr.Wrapf(err, "Failed to mark invalid revisions")
+	}
 	nextRollRev := strat.GetNextRollRev(notRolledRevs)
 	if nextRollRev == nil {
 		nextRollRev = lastRollRev
@@ -177,11 +202,6 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 		reportedRevs[rev.Id] = rev.Timestamp
 	}
 
-	sklog.Info("Creating roll history")
-	recent, err := recent_rolls.NewRecentRolls(ctx, recent_rolls.NewDatastoreRollsDB(ctx), rollerName)
-	if err != nil {
-		return nil, skerr.Wrapf(err, "Failed to create recent rolls DB")
-	}
 	sklog.Info("Creating mode history")
 	mh, err := modes.NewDatastoreModeHistory(ctx, rollerName)
 	if err != nil {
@@ -263,7 +283,21 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 	if err != nil {
 		return nil, skerr.Wrap(err)
 	}
+	if vfsRM, ok := rm.(interface {
+		VFS(context.Context, *revision.Revision) (vfs.FS, error)
+	}); ok {
+		commitMsgBuilder.SetFileReader(commit_msg.VFSFileReader(vfsRM.VFS))
+	}
+	var autoReverter *auto_revert.AutoReverter
+	if c.AutoRevert != nil {
+		sklog.Info("Creating AutoReverter.")
+		autoReverter, err = auto_revert.New(c.AutoRevert, client)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "Failed to create AutoReverter")
+		}
+	}
 	arb := &AutoRoller{
+		autoReverter:          autoReverter,
 		cfg:                   c,
 		cleanup:               cleanupDB,
 		client:                client,
@@ -272,6 +306,7 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 		dryRunSuccessThrottle: dryRunSuccessThrottle,
 		emails:                emails,
 		failureThrottle:       failureThrottle,
+		invalidRevs:           invalidRevs,
 		lastRollRev:           lastRollRev,
 		liveness:              metrics2.NewLiveness("last_autoroll_landed", map[string]string{"roller": c.RollerName}),
 		manualRollDB:          manualRollDB,
@@ -334,6 +369,9 @@ func NewAutoRoller(ctx context.Context, c *config.Config, emailer emailclient.Cl
 // has not yet been inserted into the DB, the RollingFrom, and RollingTo fields
 // must be set as well.
 func (r *AutoRoller) retrieveRoll(ctx context.Context, roll *autoroll.AutoRollIssue, rollingFrom *revision.Revision, rollingTo *revision.Revision) (codereview.RollImpl, error) {
+	if len(roll.FanOutIssues) > 0 {
+		return codereview.NewFanOutRoll(ctx, r.codereview, roll, r.recent, rollingFrom, rollingTo, r.rollFinished)
+	}
 	return r.codereview.RetrieveRoll(ctx, roll, r.recent, rollingFrom, rollingTo, r.rollFinished)
 }
 
@@ -436,6 +474,25 @@ func (r *AutoRoller) Start(ctx context.Context, tickFrequency time.Duration) {
 	}
 }
 
+// getStrategyOptions returns the strategy.Options for the given config.
+func getStrategyOptions(c *config.Config, recent *recent_rolls.RecentRolls) (strategy.Options, error) {
+	opts := strategy.Options{
+		History: recent,
+	}
+	if so := c.StrategyOptions; so != nil {
+		if so.TimeBatchInterval != "" {
+			interval, err := human.ParseDuration(so.TimeBatchInterval)
+			if err != nil {
+				return opts, skerr.Wrap(err)
+			}
+			opts.TimeBatchInterval = interval
+		}
+		opts.MaxFilesChanged = int(so.MaxFilesChanged)
+		opts.MaxLinesChanged = int(so.MaxLinesChanged)
+	}
+	return opts, nil
+}
+
 // Utility for replacing the placeholder $REVIEWERS with real reviewer emails
 // in configs. A modified copy of the passed in configs are returned.
 func replaceReviewersPlaceholder(configs []*config.NotifierConfig, emails []string) []*notifier.Config {
@@ -535,26 +592,52 @@ func (r *AutoRoller) createNewRoll(ctx context.Context, from, to *revision.Revis
 	}
 	r.statusMtx.RUnlock()
 
-	commitMsg, err := r.commitMsgBuilder.Build(from, to, revs, emails, r.cfg.Contacts, canary, manualRollRequester)
+	commitMsg, err := r.commitMsgBuilder.Build(ctx, from, to, revs, emails, r.cfg.Contacts, canary, manualRollRequester)
 	if err != nil {
 		return nil, skerr.Wrap(err)
 	}
 	sklog.Infof("Creating new roll with commit message: \n%s", commitMsg)
-	issueNum, err := r.rm.CreateNewRoll(ctx, from, to, revs, emails, dryRun, commitMsg)
-	if err != nil {
-		return nil, skerr.Wrap(err)
-	}
 	issue := &autoroll.AutoRollIssue{
 		AttemptStart: time.Now(),
 		IsDryRun:     dryRun,
-		Issue:        issueNum,
 		Manual:       manualRollRequester != "",
 		RollingFrom:  from.Id,
 		RollingTo:    to.Id,
 	}
+	if fanOutRM, ok := r.rm.(repo_manager.FanOutRepoManager); ok {
+		issueNums, err := fanOutRM.CreateNewFanOutRoll(ctx, from, to, revs, emails, dryRun, commitMsg)
+		if err != nil {
+			r.abandonPartialFanOutRoll(ctx, issueNums, from, to, err)
+			return nil, skerr.Wrap(err)
+		}
+		issue.Issue = issueNums[0]
+		issue.FanOutIssues = issueNums[1:]
+		return issue, nil
+	}
+	issueNum, err := r.rm.CreateNewRoll(ctx, from, to, revs, emails, dryRun, commitMsg)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	issue.Issue = issueNum
 	return issue, nil
 }
 
+// abandonPartialFanOutRoll closes the CLs which were uploaded as part of a
+// fan-out roll which could not be uploaded to every parent. Errors are logged
+// rather than returned, since the original error is more relevant.
+func (r *AutoRoller) abandonPartialFanOutRoll(ctx context.Context, issueNums []int64, from, to *revision.Revision, uploadErr error) {
+	for _, issueNum := range issueNums {
+		roll, err := r.codereview.RetrieveRoll(ctx, &autoroll.AutoRollIssue{Issue: issueNum}, nil, from, to, nil)
+		if err != nil {
+			sklog.Errorf("Failed to retrieve partially-uploaded fan-out roll %d: %s", issueNum, err)
+			continue
+		}
+		if err := roll.Close(ctx, autoroll.ROLL_RESULT_FAILURE, fmt.Sprintf("Failed to upload the roll to every parent: %s", uploadErr)); err != nil {
+			sklog.Errorf("Failed to close partially-uploaded fan-out roll %d: %s", issueNum, err)
+		}
+	}
+}
+
 // FailureThrottle returns a state_machine.Throttler indicating that we have
 // failed to roll too many times within a time period.
 func (r *AutoRoller) FailureThrottle() *state_machine.Throttler {
@@ -661,9 +744,15 @@ func (r *AutoRoller) UpdateRepos(ctx context.Context) error {
 	if err != nil {
 		return skerr.Wrap(err)
 	}
+	if err := markInvalidRevisions(ctx, r.invalidRevs, notRolledRevs); err != nil {
+		return skerr.Wrap(err)
+	}
 	r.strategyMtx.RLock()
 	defer r.strategyMtx.RUnlock()
 	nextRollRev := r.strategy.GetNextRollRev(notRolledRevs)
+	if err := r.maybeStopForCulprit(ctx, r.strategy); err != nil {
+		return skerr.Wrap(err)
+	}
 	if nextRollRev == nil {
 		nextRollRev = lastRollRev
 	}
@@ -860,7 +949,11 @@ func (r *AutoRoller) Tick(ctx context.Context) error {
 	}
 	newStrategy := r.strategyHistory.CurrentStrategy().Strategy
 	if oldStrategy != newStrategy {
-		strat, err := strategy.GetNextRollStrategy(newStrategy)
+		strategyOpts, err := getStrategyOptions(r.cfg, r.recent)
+		if err != nil {
+			return skerr.Wrap(err)
+		}
+		strat, err := strategy.GetNextRollStrategy(newStrategy, strategyOpts)
 		if err != nil {
 			return skerr.Wrapf(err, "Failed to get next roll strategy")
 		}
@@ -869,6 +962,12 @@ func (r *AutoRoller) Tick(ctx context.Context) error {
 		r.strategyMtx.Unlock()
 	}
 
+	// Revert the last roll if it broke the parent. Failure to check shouldn't
+	// prevent the roller from running.
+	if err := r.maybeAutoRevert(ctx); err != nil {
+		sklog.Errorf("Failed to check whether to revert the last roll: %s", err)
+	}
+
 	// Run the state machine.
 	lastErr := r.sm.NextTransitionSequence(ctx)
 	lastErrStr := ""
@@ -1056,6 +1155,10 @@ func (r *AutoRoller) handleManualRolls(ctx context.Context) error {
 				Issue:        int64(i),
 				Manual:       true,
 			}
+			// The URL only refers to the primary CL of a fan-out roll.
+			if stored, err := r.recent.Get(ctx, issue.Issue); err == nil && stored != nil {
+				issue.FanOutIssues = stored.FanOutIssues
+			}
 		} else {
 			sklog.Errorf("Found manual roll request %s in unknown status %q", req.Id, req.Status)
 			continue
@@ -0,0 +1,79 @@
+package roller
+
+import (
+	"context"
+	"fmt"
+
+	"go.skia.org/infra/autoroll/go/invalid_revisions"
+	"go.skia.org/infra/autoroll/go/modes"
+	"go.skia.org/infra/autoroll/go/repo_manager/child/revision_filter"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/autoroll/go/strategy"
+	"go.skia.org/infra/go/autoroll"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+// markInvalidRevisions updates the given RevisionFilter and uses it to mark
+// the Revisions which the roller previously found to be invalid.
+func markInvalidRevisions(ctx context.Context, f *invalid_revisions.RevisionFilter, revs []*revision.Revision) error {
+	if err := f.Update(ctx); err != nil {
+		return skerr.Wrap(err)
+	}
+	rfs := revision_filter.RevisionFilters{f}
+	for _, rev := range revs {
+		if err := rfs.MaybeSetInvalid(ctx, rev); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// maybeStopForCulprit stops the roller if the given strategy found a Revision
+// which causes rolls to fail. Every later roll includes the culprit, so rather
+// than rolling past it, we stop and wait for a human to resume the roller, eg.
+// once a fix has landed. The culprit is persisted as invalid, so that it is
+// still skipped after the roller restarts or the strategy changes. We stop at
+// most once for each failed roll, so that resuming the roller lets it try any
+// new Revisions.
+func (r *AutoRoller) maybeStopForCulprit(ctx context.Context, s strategy.NextRollStrategy) error {
+	cf, ok := s.(strategy.CulpritFinder)
+	if !ok {
+		return nil
+	}
+	culprit := cf.Culprit()
+	if culprit == nil {
+		return nil
+	}
+	var lastFailed *autoroll.AutoRollIssue
+	for _, roll := range r.recent.GetRecentRolls() {
+		if roll.Closed && !roll.IsDryRun && roll.Result == autoroll.ROLL_RESULT_FAILURE {
+			lastFailed = roll
+			break
+		}
+	}
+	var issue int64
+	if lastFailed != nil {
+		issue = lastFailed.Issue
+	}
+	if prev := r.invalidRevs.Get(culprit.Id); prev != nil && prev.Issue == issue {
+		return nil
+	}
+
+	issueURL := fmt.Sprintf("%s%d", r.codereview.GetIssueUrlBase(), issue)
+	sklog.Warningf("Found culprit %s for failed roll %s", culprit.Id, issueURL)
+	if currentMode := r.modeHistory.CurrentMode(); currentMode == nil || currentMode.Mode != modes.ModeStopped {
+		modeMsg := fmt.Sprintf("Stopping the roller because bisection found that rolling %s fails the commit queue, most recently in %s. Resume the roller once the failure has been fixed.", culprit.Id, issueURL)
+		if err := r.modeHistory.Add(ctx, modes.ModeStopped, "AutoRoll Bot", modeMsg); err != nil {
+			return skerr.Wrapf(err, "failed to stop the roller after finding culprit %s", culprit.Id)
+		}
+	}
+	// Only record the culprit once we've stopped, so that we retry if we
+	// fail to stop.
+	if err := r.invalidRevs.Add(ctx, culprit.Id, culprit.InvalidReason, issue, now.Now(ctx)); err != nil {
+		return skerr.Wrap(err)
+	}
+	r.notifier.SendCulpritFound(ctx, culprit.Id, fmt.Sprintf("%d", issue), issueURL)
+	return nil
+}
@@ -0,0 +1,158 @@
+package roller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/invalid_revisions"
+	"go.skia.org/infra/autoroll/go/modes"
+	modes_mocks "go.skia.org/infra/autoroll/go/modes/mocks"
+	arb_notifier "go.skia.org/infra/autoroll/go/notifier"
+	"go.skia.org/infra/autoroll/go/recent_rolls"
+	recent_rolls_mocks "go.skia.org/infra/autoroll/go/recent_rolls/mocks"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/email/go/emailclient"
+	"go.skia.org/infra/go/autoroll"
+	"go.skia.org/infra/go/now"
+)
+
+// fakeInvalidRevisionsDB is an in-memory invalid_revisions.DB.
+type fakeInvalidRevisionsDB struct {
+	revs map[string]*invalid_revisions.InvalidRevision
+}
+
+// Put implements invalid_revisions.DB.
+func (d *fakeInvalidRevisionsDB) Put(ctx context.Context, rev *invalid_revisions.InvalidRevision) error {
+	d.revs[rev.Roller+"_"+rev.Revision] = rev
+	return nil
+}
+
+// GetAll implements invalid_revisions.DB.
+func (d *fakeInvalidRevisionsDB) GetAll(ctx context.Context, roller string) ([]*invalid_revisions.InvalidRevision, error) {
+	var rv []*invalid_revisions.InvalidRevision
+	for _, rev := range d.revs {
+		if rev.Roller == roller {
+			rv = append(rv, rev)
+		}
+	}
+	return rv, nil
+}
+
+// fakeCulpritStrategy is a strategy.NextRollStrategy which reports a fixed
+// culprit.
+type fakeCulpritStrategy struct {
+	culprit *revision.Revision
+}
+
+// GetNextRollRev implements strategy.NextRollStrategy.
+func (s *fakeCulpritStrategy) GetNextRollRev([]*revision.Revision) *revision.Revision {
+	return nil
+}
+
+// Culprit implements strategy.CulpritFinder.
+func (s *fakeCulpritStrategy) Culprit() *revision.Revision {
+	return s.culprit
+}
+
+func setupCulprit(t *testing.T, lastFailedIssue int64) (*AutoRoller, *fakeInvalidRevisionsDB, *modes_mocks.ModeHistory) {
+	ctx := context.Background()
+	db := recent_rolls_mocks.NewDB(t)
+	db.On("GetRolls", ctx, "test-roller", "").Return([]*autoroll.AutoRollIssue{
+		{
+			Issue:  lastFailedIssue,
+			Closed: true,
+			Result: autoroll.ROLL_RESULT_FAILURE,
+		},
+		{
+			Issue:     100,
+			Closed:    true,
+			Committed: true,
+			Result:    autoroll.ROLL_RESULT_SUCCESS,
+		},
+	}, "", nil)
+	recent, err := recent_rolls.NewRecentRolls(ctx, db, "test-roller")
+	require.NoError(t, err)
+
+	n, err := arb_notifier.New(ctx, "child", "parent", "https://autoroll", nil, emailclient.New(), nil, nil)
+	require.NoError(t, err)
+
+	invalidDB := &fakeInvalidRevisionsDB{revs: map[string]*invalid_revisions.InvalidRevision{}}
+	mh := modes_mocks.NewModeHistory(t)
+	r := &AutoRoller{
+		codereview:  &fakeCodeReview{},
+		invalidRevs: invalid_revisions.NewRevisionFilter(invalidDB, "test-roller"),
+		modeHistory: mh,
+		notifier:    n,
+		recent:      recent,
+	}
+	return r, invalidDB, mh
+}
+
+func TestMaybeStopForCulprit_NoCulprit_DoesNothing(t *testing.T) {
+	r, invalidDB, _ := setupCulprit(t, 123)
+	require.NoError(t, r.maybeStopForCulprit(context.Background(), &fakeCulpritStrategy{}))
+	require.Empty(t, invalidDB.revs)
+}
+
+func TestMaybeStopForCulprit_Culprit_MarksInvalidAndStops(t *testing.T) {
+	ts := time.Unix(1682445445, 0).UTC()
+	ctx := context.WithValue(context.Background(), now.ContextKey, ts)
+	r, invalidDB, mh := setupCulprit(t, 123)
+	mh.On("CurrentMode").Return(&modes.ModeChange{Mode: modes.ModeRunning})
+	mh.On("Add", ctx, modes.ModeStopped, "AutoRoll Bot", mock.AnythingOfType("string")).Return(nil).Once()
+
+	culprit := &revision.Revision{Id: "abc", InvalidReason: "it's broken"}
+	s := &fakeCulpritStrategy{culprit: culprit}
+	require.NoError(t, r.maybeStopForCulprit(ctx, s))
+	require.Equal(t, &invalid_revisions.InvalidRevision{
+		Issue:    123,
+		Reason:   "it's broken",
+		Revision: "abc",
+		Roller:   "test-roller",
+		Time:     ts,
+	}, invalidDB.revs["test-roller_abc"])
+
+	// After a restart, the culprit is still marked invalid.
+	r.invalidRevs = invalid_revisions.NewRevisionFilter(invalidDB, "test-roller")
+	revs := []*revision.Revision{{Id: "def"}, {Id: "abc"}}
+	require.NoError(t, markInvalidRevisions(ctx, r.invalidRevs, revs))
+	require.Empty(t, revs[0].InvalidReason)
+	require.Equal(t, "it's broken", revs[1].InvalidReason)
+
+	// We don't stop again for the same failed roll, eg. once the roller
+	// has been resumed.
+	require.NoError(t, r.maybeStopForCulprit(ctx, s))
+}
+
+func TestMaybeStopForCulprit_NewFailedRoll_StopsAgain(t *testing.T) {
+	ctx := context.Background()
+	r, invalidDB, mh := setupCulprit(t, 456)
+	require.NoError(t, invalidDB.Put(ctx, &invalid_revisions.InvalidRevision{
+		Issue:    123,
+		Reason:   "it's broken",
+		Revision: "abc",
+		Roller:   "test-roller",
+	}))
+	require.NoError(t, r.invalidRevs.Update(ctx))
+	mh.On("CurrentMode").Return(&modes.ModeChange{Mode: modes.ModeRunning})
+	mh.On("Add", ctx, modes.ModeStopped, "AutoRoll Bot", mock.AnythingOfType("string")).Return(nil).Once()
+
+	s := &fakeCulpritStrategy{culprit: &revision.Revision{Id: "abc", InvalidReason: "it's broken"}}
+	require.NoError(t, r.maybeStopForCulprit(ctx, s))
+	require.Equal(t, int64(456), invalidDB.revs["test-roller_abc"].Issue)
+}
+
+func TestMaybeStopForCulprit_FailsToStop_DoesNotMarkInvalid(t *testing.T) {
+	ctx := context.Background()
+	r, invalidDB, mh := setupCulprit(t, 123)
+	mh.On("CurrentMode").Return(&modes.ModeChange{Mode: modes.ModeRunning})
+	mh.On("Add", ctx, modes.ModeStopped, "AutoRoll Bot", mock.AnythingOfType("string")).Return(errors.New("failed"))
+
+	s := &fakeCulpritStrategy{culprit: &revision.Revision{Id: "abc", InvalidReason: "it's broken"}}
+	require.Error(t, r.maybeStopForCulprit(ctx, s))
+	require.Empty(t, invalidDB.revs)
+}
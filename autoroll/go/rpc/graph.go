@@ -0,0 +1,272 @@
+package rpc
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/modes"
+	"go.skia.org/infra/autoroll/go/status"
+)
+
+const (
+	// stuckNumFailed is the number of consecutive failed rolls after which a
+	// roller which is behind is considered to be stuck.
+	stuckNumFailed = 3
+)
+
+// rollerGraphInput contains the information about a single roller which is
+// needed to build the roller graph.
+type rollerGraphInput struct {
+	cfg    *config.Config
+	mode   string
+	status *status.AutoRollStatus
+}
+
+// buildRollerGraph builds the graph of repos connected by the given rollers.
+// Nodes and edges are sorted for consistency.
+func buildRollerGraph(rollers []*rollerGraphInput, now time.Time) *GetRollerGraphResponse {
+	sort.Slice(rollers, func(i, j int) bool {
+		return rollers[i].cfg.RollerName < rollers[j].cfg.RollerName
+	})
+	// Repos may be given different display names by different rollers; use
+	// the first, preferring names from rollers which roll the repo directly.
+	nodes := map[string]*RollerGraphNode{}
+	addNode := func(id, displayName string) {
+		if n, ok := nodes[id]; !ok || n.DisplayName == n.Id {
+			nodes[id] = &RollerGraphNode{
+				Id:          id,
+				DisplayName: displayName,
+			}
+		}
+	}
+	var edges []*RollerGraphEdge
+	for _, r := range rollers {
+		childID := childRepoID(r.cfg)
+		addNode(childID, r.cfg.ChildDisplayName)
+		numBehind, secondsBehind := rollerLag(r.status, now)
+		for _, parentID := range parentRepoIDs(r.cfg) {
+			addNode(parentID, r.cfg.ParentDisplayName)
+			edges = append(edges, &RollerGraphEdge{
+				ChildId:       childID,
+				ParentId:      parentID,
+				RollerId:      r.cfg.RollerName,
+				NumBehind:     numBehind,
+				SecondsBehind: secondsBehind,
+				Stuck:         isStuck(r.mode, r.status),
+			})
+			for _, td := range r.cfg.TransitiveDeps {
+				depID := normalizeRepoID(td.GetParent().GetId())
+				if depID == "" {
+					continue
+				}
+				addNode(depID, depID)
+				edges = append(edges, &RollerGraphEdge{
+					ChildId:    depID,
+					ParentId:   parentID,
+					RollerId:   r.cfg.RollerName,
+					Transitive: true,
+				})
+			}
+		}
+	}
+
+	// Find the stuck rollers upstream of each edge.
+	incoming := map[string][]*RollerGraphEdge{}
+	for _, e := range edges {
+		incoming[e.ParentId] = append(incoming[e.ParentId], e)
+	}
+	for _, e := range edges {
+		blockedBy := map[string]bool{}
+		visited := map[string]bool{}
+		queue := []string{e.ChildId}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			if visited[id] {
+				continue
+			}
+			visited[id] = true
+			for _, in := range incoming[id] {
+				if in.Stuck && in.RollerId != e.RollerId {
+					blockedBy[in.RollerId] = true
+				}
+				queue = append(queue, in.ChildId)
+			}
+		}
+		for rollerID := range blockedBy {
+			e.BlockedBy = append(e.BlockedBy, rollerID)
+		}
+		sort.Strings(e.BlockedBy)
+	}
+
+	rv := &GetRollerGraphResponse{
+		Nodes: make([]*RollerGraphNode, 0, len(nodes)),
+		Edges: edges,
+	}
+	for _, n := range nodes {
+		rv.Nodes = append(rv.Nodes, n)
+	}
+	sort.Slice(rv.Nodes, func(i, j int) bool {
+		return rv.Nodes[i].Id < rv.Nodes[j].Id
+	})
+	sort.SliceStable(rv.Edges, func(i, j int) bool {
+		if rv.Edges[i].RollerId != rv.Edges[j].RollerId {
+			return rv.Edges[i].RollerId < rv.Edges[j].RollerId
+		}
+		if rv.Edges[i].Transitive != rv.Edges[j].Transitive {
+			return !rv.Edges[i].Transitive
+		}
+		if rv.Edges[i].ParentId != rv.Edges[j].ParentId {
+			return rv.Edges[i].ParentId < rv.Edges[j].ParentId
+		}
+		return rv.Edges[i].ChildId < rv.Edges[j].ChildId
+	})
+	return rv
+}
+
+// rollerLag returns the number of not-yet-rolled revisions and the age in
+// seconds of the oldest of them.
+func rollerLag(st *status.AutoRollStatus, now time.Time) (int32, int64) {
+	if st == nil {
+		return 0, 0
+	}
+	var secondsBehind int64
+	if len(st.NotRolledRevisions) > 0 {
+		// NotRolledRevisions are in reverse chronological order.
+		oldest := st.NotRolledRevisions[len(st.NotRolledRevisions)-1]
+		if !oldest.Timestamp.IsZero() && oldest.Timestamp.Before(now) {
+			secondsBehind = int64(now.Sub(oldest.Timestamp).Seconds())
+		}
+	}
+	return int32(st.NumNotRolledCommits), secondsBehind
+}
+
+// isStuck returns true if the roller is behind and is not making progress.
+func isStuck(mode string, st *status.AutoRollStatus) bool {
+	if st == nil || st.NumNotRolledCommits == 0 {
+		return false
+	}
+	if mode != "" && mode != modes.ModeRunning {
+		return true
+	}
+	return st.NumFailedRolls >= stuckNumFailed
+}
+
+// normalizeRepoID returns a canonical form of the given repo ID, so that the
+// same repo is identified consistently across rollers.
+func normalizeRepoID(id string) string {
+	id = strings.TrimSpace(id)
+	id = strings.TrimSuffix(id, "/")
+	id = strings.TrimSuffix(id, ".git")
+	return id
+}
+
+// childRepoID returns the ID of the Child of the given roller, falling back to
+// the Child's display name if no ID can be derived from the config.
+func childRepoID(c *config.Config) string {
+	var id string
+	switch rm := c.GetRepoManagerConfig().(type) {
+	case *config.ParentChildRepoManagerConfig:
+		id = parentChildChildID(rm)
+	case *config.FanOutRepoManagerConfig:
+		if len(rm.ParentChild) > 0 {
+			id = parentChildChildID(rm.ParentChild[0])
+		}
+	case *config.AndroidRepoManagerConfig:
+		id = rm.ChildRepoUrl
+	case *config.FreeTypeRepoManagerConfig:
+		id = rm.GetChild().GetGitiles().GetRepoUrl()
+	case *config.Google3RepoManagerConfig:
+		id = rm.ChildRepo
+	}
+	if id = normalizeRepoID(id); id == "" {
+		id = c.ChildDisplayName
+	}
+	return id
+}
+
+// parentChildChildID returns the ID of the Child of the given
+// ParentChildRepoManagerConfig, or the empty string if it cannot be derived.
+func parentChildChildID(c *config.ParentChildRepoManagerConfig) string {
+	if ch := c.GetCipdChild(); ch != nil {
+		return ch.Name
+	} else if ch := c.GetFuchsiaSdkChild(); ch != nil {
+		return "gs://" + ch.GcsBucket + "/" + ch.LatestLinuxPath
+	} else if ch := c.GetGitCheckoutChild(); ch != nil {
+		return ch.GetGitCheckout().GetRepoUrl()
+	} else if ch := c.GetGitCheckoutGithubChild(); ch != nil {
+		return ch.GetGitCheckout().GetGitCheckout().GetRepoUrl()
+	} else if ch := c.GetGitilesChild(); ch != nil {
+		return ch.GetGitiles().GetRepoUrl()
+	} else if ch := c.GetSemverGcsChild(); ch != nil {
+		return "gs://" + ch.GetGcs().GetGcsBucket() + "/" + ch.GetGcs().GetGcsPath()
+	} else if ch := c.GetDockerChild(); ch != nil {
+		return ch.Registry + "/" + ch.Repository
+	} else if ch := c.GetPackageRegistryChild(); ch != nil {
+		return ch.PackageName
+	}
+	return ""
+}
+
+// parentRepoIDs returns the IDs of the Parents of the given roller, falling
+// back to the Parent's display name if no ID can be derived from the config.
+// Rollers which fan out into multiple Parents have multiple IDs.
+func parentRepoIDs(c *config.Config) []string {
+	var ids []string
+	switch rm := c.GetRepoManagerConfig().(type) {
+	case *config.ParentChildRepoManagerConfig:
+		ids = []string{parentChildParentID(rm)}
+	case *config.FanOutRepoManagerConfig:
+		for _, pc := range rm.ParentChild {
+			ids = append(ids, parentChildParentID(pc))
+		}
+	case *config.AndroidRepoManagerConfig:
+		ids = []string{rm.ParentRepoUrl}
+	case *config.CommandRepoManagerConfig:
+		ids = []string{rm.GetGitCheckout().GetRepoUrl()}
+	case *config.FreeTypeRepoManagerConfig:
+		ids = []string{rm.GetParent().GetGitiles().GetGitiles().GetRepoUrl()}
+	case *config.Google3RepoManagerConfig:
+		ids = []string{"google3"}
+	}
+	rv := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id = normalizeRepoID(id); id == "" {
+			id = c.ParentDisplayName
+		}
+		rv = append(rv, id)
+	}
+	if len(rv) == 0 {
+		rv = append(rv, c.ParentDisplayName)
+	}
+	return rv
+}
+
+// parentChildParentID returns the ID of the Parent of the given
+// ParentChildRepoManagerConfig, or the empty string if it cannot be derived.
+func parentChildParentID(c *config.ParentChildRepoManagerConfig) string {
+	if p := c.GetCopyParent(); p != nil {
+		return p.GetGitiles().GetGitiles().GetRepoUrl()
+	} else if p := c.GetDepsLocalGithubParent(); p != nil {
+		return p.GetDepsLocal().GetGitCheckout().GetGitCheckout().GetRepoUrl()
+	} else if p := c.GetDepsLocalGerritParent(); p != nil {
+		return p.GetDepsLocal().GetGitCheckout().GetGitCheckout().GetRepoUrl()
+	} else if p := c.GetGitCheckoutGithubFileParent(); p != nil {
+		return p.GetGitCheckout().GetGitCheckout().GetGitCheckout().GetRepoUrl()
+	} else if p := c.GetGitilesParent(); p != nil {
+		return p.GetGitiles().GetRepoUrl()
+	} else if p := c.GetGoModGerritParent(); p != nil {
+		return p.GetGoMod().GetGitCheckout().GetRepoUrl()
+	} else if p := c.GetGitCheckoutGerritParent(); p != nil {
+		return p.GetGitCheckout().GetGitCheckout().GetRepoUrl()
+	} else if p := c.GetGitCheckoutGitlabParent(); p != nil {
+		return p.GetGitCheckout().GetGitCheckout().GetRepoUrl()
+	} else if p := c.GetBazelParent(); p != nil {
+		return p.GetGitiles().GetGitiles().GetRepoUrl()
+	} else if p := c.GetPackageRegistryParent(); p != nil {
+		return p.GetGitiles().GetGitiles().GetRepoUrl()
+	}
+	return ""
+}
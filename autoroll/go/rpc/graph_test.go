@@ -0,0 +1,145 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/autoroll/go/config"
+	"go.skia.org/infra/autoroll/go/modes"
+	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/autoroll/go/status"
+	"go.skia.org/infra/go/deepequal/assertdeep"
+)
+
+func makeGraphRoller(name, child, parent string, numFailed int, notRolled ...time.Time) *rollerGraphInput {
+	st := &status.AutoRollStatus{
+		AutoRollMiniStatus: status.AutoRollMiniStatus{
+			NumFailedRolls:      numFailed,
+			NumNotRolledCommits: len(notRolled),
+		},
+	}
+	for _, ts := range notRolled {
+		st.NotRolledRevisions = append(st.NotRolledRevisions, &revision.Revision{Timestamp: ts})
+	}
+	return &rollerGraphInput{
+		cfg: &config.Config{
+			RollerName:        name,
+			ChildDisplayName:  name + " child",
+			ParentDisplayName: name + " parent",
+			RepoManager: &config.Config_ParentChildRepoManager{
+				ParentChildRepoManager: &config.ParentChildRepoManagerConfig{
+					Child: &config.ParentChildRepoManagerConfig_GitilesChild{
+						GitilesChild: &config.GitilesChildConfig{
+							Gitiles: &config.GitilesConfig{RepoUrl: child},
+						},
+					},
+					Parent: &config.ParentChildRepoManagerConfig_GitilesParent{
+						GitilesParent: &config.GitilesParentConfig{
+							Gitiles: &config.GitilesConfig{RepoUrl: parent},
+						},
+					},
+				},
+			},
+		},
+		mode:   modes.ModeRunning,
+		status: st,
+	}
+}
+
+func TestBuildRollerGraph_BlockedChain(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	hourAgo := now.Add(-time.Hour)
+	dayAgo := now.Add(-24 * time.Hour)
+
+	// a -> b is stuck due to failures, b -> c is stopped but up to date, and
+	// c -> d is behind but healthy. b also pins x via a.
+	ab := makeGraphRoller("a-b", "https://a.git", "https://b/", stuckNumFailed, hourAgo, dayAgo)
+	ab.cfg.TransitiveDeps = []*config.TransitiveDepConfig{
+		{
+			Child:  &config.VersionFileConfig{Id: "https://x", Path: "DEPS"},
+			Parent: &config.VersionFileConfig{Id: "https://x", Path: "DEPS"},
+		},
+	}
+	bc := makeGraphRoller("b-c", "https://b", "https://c", 0)
+	bc.mode = modes.ModeStopped
+	cd := makeGraphRoller("c-d", "https://c", "https://d", 1, hourAgo)
+
+	graph := buildRollerGraph([]*rollerGraphInput{cd, bc, ab}, now)
+	assertdeep.Equal(t, &GetRollerGraphResponse{
+		Nodes: []*RollerGraphNode{
+			{Id: "https://a", DisplayName: "a-b child"},
+			{Id: "https://b", DisplayName: "a-b parent"},
+			{Id: "https://c", DisplayName: "b-c parent"},
+			{Id: "https://d", DisplayName: "c-d parent"},
+			{Id: "https://x", DisplayName: "https://x"},
+		},
+		Edges: []*RollerGraphEdge{
+			{
+				ChildId:       "https://a",
+				ParentId:      "https://b",
+				RollerId:      "a-b",
+				NumBehind:     2,
+				SecondsBehind: 24 * 60 * 60,
+				Stuck:         true,
+			},
+			{
+				ChildId:    "https://x",
+				ParentId:   "https://b",
+				RollerId:   "a-b",
+				Transitive: true,
+			},
+			{
+				ChildId:   "https://b",
+				ParentId:  "https://c",
+				RollerId:  "b-c",
+				BlockedBy: []string{"a-b"},
+			},
+			{
+				ChildId:       "https://c",
+				ParentId:      "https://d",
+				RollerId:      "c-d",
+				NumBehind:     1,
+				SecondsBehind: 60 * 60,
+				BlockedBy:     []string{"a-b"},
+			},
+		},
+	}, graph)
+}
+
+func TestBuildRollerGraph_Cycle(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ab := makeGraphRoller("a-b", "https://a", "https://b", stuckNumFailed, now)
+	ba := makeGraphRoller("b-a", "https://b", "https://a", stuckNumFailed, now)
+	graph := buildRollerGraph([]*rollerGraphInput{ab, ba}, now)
+	require.Len(t, graph.Edges, 2)
+	require.Equal(t, []string{"b-a"}, graph.Edges[0].BlockedBy)
+	require.Equal(t, []string{"a-b"}, graph.Edges[1].BlockedBy)
+}
+
+func TestBuildRollerGraph_FanOut(t *testing.T) {
+	r := makeGraphRoller("fan-out", "https://a", "", 0)
+	pc := r.cfg.GetParentChildRepoManager()
+	pc2 := &config.ParentChildRepoManagerConfig{
+		Child: pc.Child,
+		Parent: &config.ParentChildRepoManagerConfig_GitilesParent{
+			GitilesParent: &config.GitilesParentConfig{
+				Gitiles: &config.GitilesConfig{RepoUrl: "https://c"},
+			},
+		},
+	}
+	pc.Parent = &config.ParentChildRepoManagerConfig_GitilesParent{
+		GitilesParent: &config.GitilesParentConfig{
+			Gitiles: &config.GitilesConfig{RepoUrl: "https://b"},
+		},
+	}
+	r.cfg.RepoManager = &config.Config_FanOutRepoManager{
+		FanOutRepoManager: &config.FanOutRepoManagerConfig{
+			ParentChild: []*config.ParentChildRepoManagerConfig{pc, pc2},
+		},
+	}
+	graph := buildRollerGraph([]*rollerGraphInput{r}, time.Now())
+	require.Len(t, graph.Edges, 2)
+	require.Equal(t, "https://b", graph.Edges[0].ParentId)
+	require.Equal(t, "https://c", graph.Edges[1].ParentId)
+}
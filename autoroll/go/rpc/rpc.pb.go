@@ -100,6 +100,15 @@ const (
 	Strategy_N_BATCH Strategy = 1
 	// SINGLE indicates that a single revision is rolled in each CL.
 	Strategy_SINGLE Strategy = 2
+	// TIME_BATCH is similar to BATCH but rolls no more often than a configured
+	// interval.
+	Strategy_TIME_BATCH Strategy = 3
+	// SIZE_BATCH is similar to BATCH but puts a limit on the number of files and
+	// lines changed by the revisions in a single CL.
+	Strategy_SIZE_BATCH Strategy = 4
+	// BISECT is similar to BATCH, but when a roll fails it rolls halves of the
+	// failed range until the offending revision is isolated.
+	Strategy_BISECT Strategy = 5
 )
 
 // Enum value maps for Strategy.
@@ -108,11 +117,17 @@ var (
 		0: "BATCH",
 		1: "N_BATCH",
 		2: "SINGLE",
+		3: "TIME_BATCH",
+		4: "SIZE_BATCH",
+		5: "BISECT",
 	}
 	Strategy_value = map[string]int32{
-		"BATCH":   0,
-		"N_BATCH": 1,
-		"SINGLE":  2,
+		"BATCH":      0,
+		"N_BATCH":    1,
+		"SINGLE":     2,
+		"TIME_BATCH": 3,
+		"SIZE_BATCH": 4,
+		"BISECT":     5,
 	}
 )
 
@@ -2829,6 +2844,281 @@ func (x *CleanupRequest) GetJustification() string {
 	return ""
 }
 
+// RollerGraphNode is a repo in the graph of rollers.
+type RollerGraphNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id uniquely identifies the repo, eg. its URL.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// display_name is the human-friendly name of the repo.
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+}
+
+func (x *RollerGraphNode) Reset() {
+	*x = RollerGraphNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollerGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollerGraphNode) ProtoMessage() {}
+
+func (x *RollerGraphNode) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollerGraphNode.ProtoReflect.Descriptor instead.
+func (*RollerGraphNode) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RollerGraphNode) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RollerGraphNode) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// RollerGraphEdge indicates that a parent repo pins a revision of a child repo.
+type RollerGraphEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// child_id is the ID of the RollerGraphNode which is depended on.
+	ChildId string `protobuf:"bytes,1,opt,name=child_id,json=childId,proto3" json:"child_id,omitempty"`
+	// parent_id is the ID of the RollerGraphNode which depends on the child.
+	ParentId string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// roller_id is the unique ID of the roller which rolls the child into the
+	// parent. For transitive edges, this is the roller whose rolls update the
+	// child as a transitive dependency.
+	RollerId string `protobuf:"bytes,3,opt,name=roller_id,json=rollerId,proto3" json:"roller_id,omitempty"`
+	// transitive indicates that the child is not rolled directly, but is pinned
+	// by the parent as a transitive dependency of another child. Transitive
+	// edges have no lag of their own.
+	Transitive bool `protobuf:"varint,4,opt,name=transitive,proto3" json:"transitive,omitempty"`
+	// num_behind is the number of revisions of the child which have not yet
+	// been rolled into the parent.
+	NumBehind int32 `protobuf:"varint,5,opt,name=num_behind,json=numBehind,proto3" json:"num_behind,omitempty"`
+	// seconds_behind is the age of the oldest revision of the child which has
+	// not yet been rolled into the parent.
+	SecondsBehind int64 `protobuf:"varint,6,opt,name=seconds_behind,json=secondsBehind,proto3" json:"seconds_behind,omitempty"`
+	// stuck indicates that the roller is behind and not making progress, either
+	// because it is not running or because its recent rolls have failed.
+	Stuck bool `protobuf:"varint,7,opt,name=stuck,proto3" json:"stuck,omitempty"`
+	// blocked_by contains the IDs of stuck rollers upstream of this edge, ie.
+	// which prevent the child from receiving updates from its own dependencies.
+	BlockedBy []string `protobuf:"bytes,8,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+}
+
+func (x *RollerGraphEdge) Reset() {
+	*x = RollerGraphEdge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollerGraphEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollerGraphEdge) ProtoMessage() {}
+
+func (x *RollerGraphEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollerGraphEdge.ProtoReflect.Descriptor instead.
+func (*RollerGraphEdge) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RollerGraphEdge) GetChildId() string {
+	if x != nil {
+		return x.ChildId
+	}
+	return ""
+}
+
+func (x *RollerGraphEdge) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *RollerGraphEdge) GetRollerId() string {
+	if x != nil {
+		return x.RollerId
+	}
+	return ""
+}
+
+func (x *RollerGraphEdge) GetTransitive() bool {
+	if x != nil {
+		return x.Transitive
+	}
+	return false
+}
+
+func (x *RollerGraphEdge) GetNumBehind() int32 {
+	if x != nil {
+		return x.NumBehind
+	}
+	return 0
+}
+
+func (x *RollerGraphEdge) GetSecondsBehind() int64 {
+	if x != nil {
+		return x.SecondsBehind
+	}
+	return 0
+}
+
+func (x *RollerGraphEdge) GetStuck() bool {
+	if x != nil {
+		return x.Stuck
+	}
+	return false
+}
+
+func (x *RollerGraphEdge) GetBlockedBy() []string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+// GetRollerGraphRequest is a request to GetRollerGraph.
+type GetRollerGraphRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRollerGraphRequest) Reset() {
+	*x = GetRollerGraphRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRollerGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRollerGraphRequest) ProtoMessage() {}
+
+func (x *GetRollerGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRollerGraphRequest.ProtoReflect.Descriptor instead.
+func (*GetRollerGraphRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+// GetRollerGraphResponse is a response returned by GetRollerGraph.
+type GetRollerGraphResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// nodes are the repos which are rolled to or from by any roller.
+	Nodes []*RollerGraphNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// edges are the dependencies between the repos.
+	Edges []*RollerGraphEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *GetRollerGraphResponse) Reset() {
+	*x = GetRollerGraphResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRollerGraphResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRollerGraphResponse) ProtoMessage() {}
+
+func (x *GetRollerGraphResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRollerGraphResponse.ProtoReflect.Descriptor instead.
+func (*GetRollerGraphResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRollerGraphResponse) GetNodes() []*RollerGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetRollerGraphResponse) GetEdges() []*RollerGraphEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
 var File_rpc_proto protoreflect.FileDescriptor
 
 var file_rpc_proto_rawDesc = []byte{
@@ -3180,84 +3470,123 @@ var file_rpc_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x3a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
-	0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52,
-	0x59, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49,
-	0x4e, 0x45, 0x10, 0x03, 0x2a, 0x2e, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e,
-	0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47,
-	0x4c, 0x45, 0x10, 0x02, 0x32, 0xb7, 0x08, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x6f, 0x6c,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x2e,
-	0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64,
-	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x48, 0x69, 0x73,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65,
-	0x72, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c,
-	0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81,
+	0x02, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64,
+	0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x62,
+	0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d,
+	0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x5f, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x75, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74,
+	0x75, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x42, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x65,
+	0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73,
+	0x2a, 0x3a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x59, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x02, 0x12,
+	0x0b, 0x0a, 0x07, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x03, 0x2a, 0x5a, 0x0a, 0x08,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x54, 0x43,
+	0x48, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a,
+	0x53, 0x49, 0x5a, 0x45, 0x5f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06,
+	0x42, 0x49, 0x53, 0x45, 0x43, 0x54, 0x10, 0x05, 0x32, 0x94, 0x09, 0x0a, 0x0f, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75,
+	0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x52, 0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
 	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x69,
-	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
-	0x0b, 0x53, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x20, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
-	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
-	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
-	0x67, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x25,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
-	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61,
-	0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a,
-	0x0a, 0x55, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x74, 0x68, 0x72,
-	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x74, 0x68,
-	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x23,
-	0x5a, 0x21, 0x67, 0x6f, 0x2e, 0x73, 0x6b, 0x69, 0x61, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x69, 0x6e,
-	0x66, 0x72, 0x61, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2f, 0x67, 0x6f, 0x2f,
-	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x07,
+	0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x6f,
+	0x6c, 0x6c, 0x12, 0x25, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x6f,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x6e, 0x75, 0x61, 0x6c, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x55, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12,
+	0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x55,
+	0x6e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x12, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f,
+	0x72, 0x6f, 0x6c, 0x6c, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x6f, 0x2e, 0x73, 0x6b, 0x69, 0x61, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x69,
+	0x6e, 0x66, 0x72, 0x61, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x72, 0x6f, 0x6c, 0x6c, 0x2f, 0x67, 0x6f,
+	0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3273,7 +3602,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 }
 
 var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_rpc_proto_goTypes = []interface{}{
 	(Mode)(0),                          // 0: autoroll.rpc.Mode
 	(Strategy)(0),                      // 1: autoroll.rpc.Strategy
@@ -3316,27 +3645,31 @@ var file_rpc_proto_goTypes = []interface{}{
 	(*GetCleanupHistoryRequest)(nil),   // 38: autoroll.rpc.GetCleanupHistoryRequest
 	(*GetCleanupHistoryResponse)(nil),  // 39: autoroll.rpc.GetCleanupHistoryResponse
 	(*CleanupRequest)(nil),             // 40: autoroll.rpc.CleanupRequest
-	(*timestamppb.Timestamp)(nil),      // 41: google.protobuf.Timestamp
+	(*RollerGraphNode)(nil),            // 41: autoroll.rpc.RollerGraphNode
+	(*RollerGraphEdge)(nil),            // 42: autoroll.rpc.RollerGraphEdge
+	(*GetRollerGraphRequest)(nil),      // 43: autoroll.rpc.GetRollerGraphRequest
+	(*GetRollerGraphResponse)(nil),     // 44: autoroll.rpc.GetRollerGraphResponse
+	(*timestamppb.Timestamp)(nil),      // 45: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
 	0,  // 0: autoroll.rpc.AutoRollMiniStatus.mode:type_name -> autoroll.rpc.Mode
-	41, // 1: autoroll.rpc.AutoRollMiniStatus.timestamp:type_name -> google.protobuf.Timestamp
-	41, // 2: autoroll.rpc.AutoRollMiniStatus.last_successful_roll_timestamp:type_name -> google.protobuf.Timestamp
+	45, // 1: autoroll.rpc.AutoRollMiniStatus.timestamp:type_name -> google.protobuf.Timestamp
+	45, // 2: autoroll.rpc.AutoRollMiniStatus.last_successful_roll_timestamp:type_name -> google.protobuf.Timestamp
 	3,  // 3: autoroll.rpc.TryJob.status:type_name -> autoroll.rpc.TryJob.Status
 	2,  // 4: autoroll.rpc.TryJob.result:type_name -> autoroll.rpc.TryJob.Result
 	4,  // 5: autoroll.rpc.AutoRollCL.result:type_name -> autoroll.rpc.AutoRollCL.Result
-	41, // 6: autoroll.rpc.AutoRollCL.created:type_name -> google.protobuf.Timestamp
-	41, // 7: autoroll.rpc.AutoRollCL.modified:type_name -> google.protobuf.Timestamp
+	45, // 6: autoroll.rpc.AutoRollCL.created:type_name -> google.protobuf.Timestamp
+	45, // 7: autoroll.rpc.AutoRollCL.modified:type_name -> google.protobuf.Timestamp
 	8,  // 8: autoroll.rpc.AutoRollCL.try_jobs:type_name -> autoroll.rpc.TryJob
-	41, // 9: autoroll.rpc.Revision.time:type_name -> google.protobuf.Timestamp
+	45, // 9: autoroll.rpc.Revision.time:type_name -> google.protobuf.Timestamp
 	0,  // 10: autoroll.rpc.AutoRollConfig.valid_modes:type_name -> autoroll.rpc.Mode
 	0,  // 11: autoroll.rpc.ModeChange.mode:type_name -> autoroll.rpc.Mode
-	41, // 12: autoroll.rpc.ModeChange.time:type_name -> google.protobuf.Timestamp
+	45, // 12: autoroll.rpc.ModeChange.time:type_name -> google.protobuf.Timestamp
 	1,  // 13: autoroll.rpc.StrategyChange.strategy:type_name -> autoroll.rpc.Strategy
-	41, // 14: autoroll.rpc.StrategyChange.time:type_name -> google.protobuf.Timestamp
+	45, // 14: autoroll.rpc.StrategyChange.time:type_name -> google.protobuf.Timestamp
 	5,  // 15: autoroll.rpc.ManualRoll.result:type_name -> autoroll.rpc.ManualRoll.Result
 	6,  // 16: autoroll.rpc.ManualRoll.status:type_name -> autoroll.rpc.ManualRoll.Status
-	41, // 17: autoroll.rpc.ManualRoll.timestamp:type_name -> google.protobuf.Timestamp
+	45, // 17: autoroll.rpc.ManualRoll.timestamp:type_name -> google.protobuf.Timestamp
 	7,  // 18: autoroll.rpc.AutoRollStatus.mini_status:type_name -> autoroll.rpc.AutoRollMiniStatus
 	11, // 19: autoroll.rpc.AutoRollStatus.config:type_name -> autoroll.rpc.AutoRollConfig
 	12, // 20: autoroll.rpc.AutoRollStatus.mode:type_name -> autoroll.rpc.ModeChange
@@ -3346,7 +3679,7 @@ var file_rpc_proto_depIdxs = []int32{
 	9,  // 24: autoroll.rpc.AutoRollStatus.last_roll:type_name -> autoroll.rpc.AutoRollCL
 	9,  // 25: autoroll.rpc.AutoRollStatus.recent_rolls:type_name -> autoroll.rpc.AutoRollCL
 	14, // 26: autoroll.rpc.AutoRollStatus.manual_rolls:type_name -> autoroll.rpc.ManualRoll
-	41, // 27: autoroll.rpc.AutoRollStatus.throttled_until:type_name -> google.protobuf.Timestamp
+	45, // 27: autoroll.rpc.AutoRollStatus.throttled_until:type_name -> google.protobuf.Timestamp
 	40, // 28: autoroll.rpc.AutoRollStatus.cleanup_requested:type_name -> autoroll.rpc.CleanupRequest
 	7,  // 29: autoroll.rpc.GetRollersResponse.rollers:type_name -> autoroll.rpc.AutoRollMiniStatus
 	9,  // 30: autoroll.rpc.GetRollsResponse.rolls:type_name -> autoroll.rpc.AutoRollCL
@@ -3361,36 +3694,40 @@ var file_rpc_proto_depIdxs = []int32{
 	14, // 39: autoroll.rpc.CreateManualRollResponse.roll:type_name -> autoroll.rpc.ManualRoll
 	15, // 40: autoroll.rpc.AddCleanupRequestResponse.status:type_name -> autoroll.rpc.AutoRollStatus
 	40, // 41: autoroll.rpc.GetCleanupHistoryResponse.history:type_name -> autoroll.rpc.CleanupRequest
-	41, // 42: autoroll.rpc.CleanupRequest.timestamp:type_name -> google.protobuf.Timestamp
-	36, // 43: autoroll.rpc.AutoRollService.AddCleanupRequest:input_type -> autoroll.rpc.AddCleanupRequestRequest
-	38, // 44: autoroll.rpc.AutoRollService.GetCleanupHistory:input_type -> autoroll.rpc.GetCleanupHistoryRequest
-	16, // 45: autoroll.rpc.AutoRollService.GetRollers:input_type -> autoroll.rpc.GetRollersRequest
-	18, // 46: autoroll.rpc.AutoRollService.GetRolls:input_type -> autoroll.rpc.GetRollsRequest
-	20, // 47: autoroll.rpc.AutoRollService.GetMiniStatus:input_type -> autoroll.rpc.GetMiniStatusRequest
-	22, // 48: autoroll.rpc.AutoRollService.GetStatus:input_type -> autoroll.rpc.GetStatusRequest
-	24, // 49: autoroll.rpc.AutoRollService.SetMode:input_type -> autoroll.rpc.SetModeRequest
-	26, // 50: autoroll.rpc.AutoRollService.GetModeHistory:input_type -> autoroll.rpc.GetModeHistoryRequest
-	28, // 51: autoroll.rpc.AutoRollService.SetStrategy:input_type -> autoroll.rpc.SetStrategyRequest
-	30, // 52: autoroll.rpc.AutoRollService.GetStrategyHistory:input_type -> autoroll.rpc.GetStrategyHistoryRequest
-	32, // 53: autoroll.rpc.AutoRollService.CreateManualRoll:input_type -> autoroll.rpc.CreateManualRollRequest
-	34, // 54: autoroll.rpc.AutoRollService.Unthrottle:input_type -> autoroll.rpc.UnthrottleRequest
-	37, // 55: autoroll.rpc.AutoRollService.AddCleanupRequest:output_type -> autoroll.rpc.AddCleanupRequestResponse
-	39, // 56: autoroll.rpc.AutoRollService.GetCleanupHistory:output_type -> autoroll.rpc.GetCleanupHistoryResponse
-	17, // 57: autoroll.rpc.AutoRollService.GetRollers:output_type -> autoroll.rpc.GetRollersResponse
-	19, // 58: autoroll.rpc.AutoRollService.GetRolls:output_type -> autoroll.rpc.GetRollsResponse
-	21, // 59: autoroll.rpc.AutoRollService.GetMiniStatus:output_type -> autoroll.rpc.GetMiniStatusResponse
-	23, // 60: autoroll.rpc.AutoRollService.GetStatus:output_type -> autoroll.rpc.GetStatusResponse
-	25, // 61: autoroll.rpc.AutoRollService.SetMode:output_type -> autoroll.rpc.SetModeResponse
-	27, // 62: autoroll.rpc.AutoRollService.GetModeHistory:output_type -> autoroll.rpc.GetModeHistoryResponse
-	29, // 63: autoroll.rpc.AutoRollService.SetStrategy:output_type -> autoroll.rpc.SetStrategyResponse
-	31, // 64: autoroll.rpc.AutoRollService.GetStrategyHistory:output_type -> autoroll.rpc.GetStrategyHistoryResponse
-	33, // 65: autoroll.rpc.AutoRollService.CreateManualRoll:output_type -> autoroll.rpc.CreateManualRollResponse
-	35, // 66: autoroll.rpc.AutoRollService.Unthrottle:output_type -> autoroll.rpc.UnthrottleResponse
-	55, // [55:67] is the sub-list for method output_type
-	43, // [43:55] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
+	45, // 42: autoroll.rpc.CleanupRequest.timestamp:type_name -> google.protobuf.Timestamp
+	41, // 43: autoroll.rpc.GetRollerGraphResponse.nodes:type_name -> autoroll.rpc.RollerGraphNode
+	42, // 44: autoroll.rpc.GetRollerGraphResponse.edges:type_name -> autoroll.rpc.RollerGraphEdge
+	36, // 45: autoroll.rpc.AutoRollService.AddCleanupRequest:input_type -> autoroll.rpc.AddCleanupRequestRequest
+	38, // 46: autoroll.rpc.AutoRollService.GetCleanupHistory:input_type -> autoroll.rpc.GetCleanupHistoryRequest
+	16, // 47: autoroll.rpc.AutoRollService.GetRollers:input_type -> autoroll.rpc.GetRollersRequest
+	18, // 48: autoroll.rpc.AutoRollService.GetRolls:input_type -> autoroll.rpc.GetRollsRequest
+	20, // 49: autoroll.rpc.AutoRollService.GetMiniStatus:input_type -> autoroll.rpc.GetMiniStatusRequest
+	22, // 50: autoroll.rpc.AutoRollService.GetStatus:input_type -> autoroll.rpc.GetStatusRequest
+	24, // 51: autoroll.rpc.AutoRollService.SetMode:input_type -> autoroll.rpc.SetModeRequest
+	26, // 52: autoroll.rpc.AutoRollService.GetModeHistory:input_type -> autoroll.rpc.GetModeHistoryRequest
+	28, // 53: autoroll.rpc.AutoRollService.SetStrategy:input_type -> autoroll.rpc.SetStrategyRequest
+	30, // 54: autoroll.rpc.AutoRollService.GetStrategyHistory:input_type -> autoroll.rpc.GetStrategyHistoryRequest
+	32, // 55: autoroll.rpc.AutoRollService.CreateManualRoll:input_type -> autoroll.rpc.CreateManualRollRequest
+	34, // 56: autoroll.rpc.AutoRollService.Unthrottle:input_type -> autoroll.rpc.UnthrottleRequest
+	43, // 57: autoroll.rpc.AutoRollService.GetRollerGraph:input_type -> autoroll.rpc.GetRollerGraphRequest
+	37, // 58: autoroll.rpc.AutoRollService.AddCleanupRequest:output_type -> autoroll.rpc.AddCleanupRequestResponse
+	39, // 59: autoroll.rpc.AutoRollService.GetCleanupHistory:output_type -> autoroll.rpc.GetCleanupHistoryResponse
+	17, // 60: autoroll.rpc.AutoRollService.GetRollers:output_type -> autoroll.rpc.GetRollersResponse
+	19, // 61: autoroll.rpc.AutoRollService.GetRolls:output_type -> autoroll.rpc.GetRollsResponse
+	21, // 62: autoroll.rpc.AutoRollService.GetMiniStatus:output_type -> autoroll.rpc.GetMiniStatusResponse
+	23, // 63: autoroll.rpc.AutoRollService.GetStatus:output_type -> autoroll.rpc.GetStatusResponse
+	25, // 64: autoroll.rpc.AutoRollService.SetMode:output_type -> autoroll.rpc.SetModeResponse
+	27, // 65: autoroll.rpc.AutoRollService.GetModeHistory:output_type -> autoroll.rpc.GetModeHistoryResponse
+	29, // 66: autoroll.rpc.AutoRollService.SetStrategy:output_type -> autoroll.rpc.SetStrategyResponse
+	31, // 67: autoroll.rpc.AutoRollService.GetStrategyHistory:output_type -> autoroll.rpc.GetStrategyHistoryResponse
+	33, // 68: autoroll.rpc.AutoRollService.CreateManualRoll:output_type -> autoroll.rpc.CreateManualRollResponse
+	35, // 69: autoroll.rpc.AutoRollService.Unthrottle:output_type -> autoroll.rpc.UnthrottleResponse
+	44, // 70: autoroll.rpc.AutoRollService.GetRollerGraph:output_type -> autoroll.rpc.GetRollerGraphResponse
+	58, // [58:71] is the sub-list for method output_type
+	45, // [45:58] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -3807,6 +4144,54 @@ func file_rpc_proto_init() {
 				return nil
 			}
 		}
+		file_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollerGraphNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollerGraphEdge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRollerGraphRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRollerGraphResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3814,7 +4199,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_proto_rawDesc,
 			NumEnums:      7,
-			NumMessages:   34,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
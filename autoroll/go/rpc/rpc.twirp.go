@@ -91,6 +91,9 @@ type AutoRollService interface {
 
 	// Unthrottle clears any throttling of the roller, allowing it to roll again.
 	Unthrottle(context.Context, *UnthrottleRequest) (*UnthrottleResponse, error)
+
+	// GetRollerGraph retrieves the graph of repos connected by all known rollers.
+	GetRollerGraph(context.Context, *GetRollerGraphRequest) (*GetRollerGraphResponse, error)
 }
 
 // ===============================
@@ -99,7 +102,7 @@ type AutoRollService interface {
 
 type autoRollServiceProtobufClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [13]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -119,7 +122,7 @@ func NewAutoRollServiceProtobufClient(baseURL string, client HTTPClient, opts ..
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "autoroll.rpc", "AutoRollService")
-	urls := [12]string{
+	urls := [13]string{
 		serviceURL + "AddCleanupRequest",
 		serviceURL + "GetCleanupHistory",
 		serviceURL + "GetRollers",
@@ -132,6 +135,7 @@ func NewAutoRollServiceProtobufClient(baseURL string, client HTTPClient, opts ..
 		serviceURL + "GetStrategyHistory",
 		serviceURL + "CreateManualRoll",
 		serviceURL + "Unthrottle",
+		serviceURL + "GetRollerGraph",
 	}
 
 	return &autoRollServiceProtobufClient{
@@ -694,13 +698,59 @@ func (c *autoRollServiceProtobufClient) callUnthrottle(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *autoRollServiceProtobufClient) GetRollerGraph(ctx context.Context, in *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "autoroll.rpc")
+	ctx = ctxsetters.WithServiceName(ctx, "AutoRollService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRollerGraph")
+	caller := c.callGetRollerGraph
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRollerGraphRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRollerGraphRequest) when calling interceptor")
+					}
+					return c.callGetRollerGraph(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRollerGraphResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRollerGraphResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *autoRollServiceProtobufClient) callGetRollerGraph(ctx context.Context, in *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+	out := new(GetRollerGraphResponse)
+	ctx, err := doProtobufRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ===========================
 // AutoRollService JSON Client
 // ===========================
 
 type autoRollServiceJSONClient struct {
 	client      HTTPClient
-	urls        [12]string
+	urls        [13]string
 	interceptor twirp.Interceptor
 	opts        twirp.ClientOptions
 }
@@ -720,7 +770,7 @@ func NewAutoRollServiceJSONClient(baseURL string, client HTTPClient, opts ...twi
 	// Build method URLs: <baseURL>[<prefix>]/<package>.<Service>/<Method>
 	serviceURL := sanitizeBaseURL(baseURL)
 	serviceURL += baseServicePath(clientOpts.PathPrefix(), "autoroll.rpc", "AutoRollService")
-	urls := [12]string{
+	urls := [13]string{
 		serviceURL + "AddCleanupRequest",
 		serviceURL + "GetCleanupHistory",
 		serviceURL + "GetRollers",
@@ -733,6 +783,7 @@ func NewAutoRollServiceJSONClient(baseURL string, client HTTPClient, opts ...twi
 		serviceURL + "GetStrategyHistory",
 		serviceURL + "CreateManualRoll",
 		serviceURL + "Unthrottle",
+		serviceURL + "GetRollerGraph",
 	}
 
 	return &autoRollServiceJSONClient{
@@ -1295,6 +1346,52 @@ func (c *autoRollServiceJSONClient) callUnthrottle(ctx context.Context, in *Unth
 	return out, nil
 }
 
+func (c *autoRollServiceJSONClient) GetRollerGraph(ctx context.Context, in *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+	ctx = ctxsetters.WithPackageName(ctx, "autoroll.rpc")
+	ctx = ctxsetters.WithServiceName(ctx, "AutoRollService")
+	ctx = ctxsetters.WithMethodName(ctx, "GetRollerGraph")
+	caller := c.callGetRollerGraph
+	if c.interceptor != nil {
+		caller = func(ctx context.Context, req *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+			resp, err := c.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRollerGraphRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRollerGraphRequest) when calling interceptor")
+					}
+					return c.callGetRollerGraph(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRollerGraphResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRollerGraphResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+	return caller(ctx, in)
+}
+
+func (c *autoRollServiceJSONClient) callGetRollerGraph(ctx context.Context, in *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+	out := new(GetRollerGraphResponse)
+	ctx, err := doJSONRequest(ctx, c.client, c.opts.Hooks, c.urls[12], in, out)
+	if err != nil {
+		twerr, ok := err.(twirp.Error)
+		if !ok {
+			twerr = twirp.InternalErrorWith(err)
+		}
+		callClientError(ctx, c.opts.Hooks, twerr)
+		return nil, err
+	}
+
+	callClientResponseReceived(ctx, c.opts.Hooks)
+
+	return out, nil
+}
+
 // ==============================
 // AutoRollService Server Handler
 // ==============================
@@ -1415,6 +1512,9 @@ func (s *autoRollServiceServer) ServeHTTP(resp http.ResponseWriter, req *http.Re
 	case "Unthrottle":
 		s.serveUnthrottle(ctx, resp, req)
 		return
+	case "GetRollerGraph":
+		s.serveGetRollerGraph(ctx, resp, req)
+		return
 	default:
 		msg := fmt.Sprintf("no handler for path %q", req.URL.Path)
 		s.writeError(ctx, resp, badRouteError(msg, req.Method, req.URL.Path))
@@ -3522,6 +3622,181 @@ func (s *autoRollServiceServer) serveUnthrottleProtobuf(ctx context.Context, res
 	callResponseSent(ctx, s.hooks)
 }
 
+func (s *autoRollServiceServer) serveGetRollerGraph(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	header := req.Header.Get("Content-Type")
+	i := strings.Index(header, ";")
+	if i == -1 {
+		i = len(header)
+	}
+	switch strings.TrimSpace(strings.ToLower(header[:i])) {
+	case "application/json":
+		s.serveGetRollerGraphJSON(ctx, resp, req)
+	case "application/protobuf":
+		s.serveGetRollerGraphProtobuf(ctx, resp, req)
+	default:
+		msg := fmt.Sprintf("unexpected Content-Type: %q", req.Header.Get("Content-Type"))
+		twerr := badRouteError(msg, req.Method, req.URL.Path)
+		s.writeError(ctx, resp, twerr)
+	}
+}
+
+func (s *autoRollServiceServer) serveGetRollerGraphJSON(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRollerGraph")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	reqContent := new(GetRollerGraphRequest)
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err = unmarshaler.Unmarshal(req.Body, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the json request could not be decoded"))
+		return
+	}
+
+	handler := s.AutoRollService.GetRollerGraph
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRollerGraphRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRollerGraphRequest) when calling interceptor")
+					}
+					return s.AutoRollService.GetRollerGraph(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRollerGraphResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRollerGraphResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRollerGraphResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRollerGraphResponse and nil error while calling GetRollerGraph. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: !s.jsonSkipDefaults}
+	if err = marshaler.Marshal(&buf, respContent); err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal json response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	respBytes := buf.Bytes()
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
+func (s *autoRollServiceServer) serveGetRollerGraphProtobuf(ctx context.Context, resp http.ResponseWriter, req *http.Request) {
+	var err error
+	ctx = ctxsetters.WithMethodName(ctx, "GetRollerGraph")
+	ctx, err = callRequestRouted(ctx, s.hooks)
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+
+	buf, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to read request body"))
+		return
+	}
+	reqContent := new(GetRollerGraphRequest)
+	if err = proto.Unmarshal(buf, reqContent); err != nil {
+		s.writeError(ctx, resp, malformedRequestError("the protobuf request could not be decoded"))
+		return
+	}
+
+	handler := s.AutoRollService.GetRollerGraph
+	if s.interceptor != nil {
+		handler = func(ctx context.Context, req *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+			resp, err := s.interceptor(
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					typedReq, ok := req.(*GetRollerGraphRequest)
+					if !ok {
+						return nil, twirp.InternalError("failed type assertion req.(*GetRollerGraphRequest) when calling interceptor")
+					}
+					return s.AutoRollService.GetRollerGraph(ctx, typedReq)
+				},
+			)(ctx, req)
+			if resp != nil {
+				typedResp, ok := resp.(*GetRollerGraphResponse)
+				if !ok {
+					return nil, twirp.InternalError("failed type assertion resp.(*GetRollerGraphResponse) when calling interceptor")
+				}
+				return typedResp, err
+			}
+			return nil, err
+		}
+	}
+
+	// Call service method
+	var respContent *GetRollerGraphResponse
+	func() {
+		defer ensurePanicResponses(ctx, resp, s.hooks)
+		respContent, err = handler(ctx, reqContent)
+	}()
+
+	if err != nil {
+		s.writeError(ctx, resp, err)
+		return
+	}
+	if respContent == nil {
+		s.writeError(ctx, resp, twirp.InternalError("received a nil *GetRollerGraphResponse and nil error while calling GetRollerGraph. nil responses are not supported"))
+		return
+	}
+
+	ctx = callResponsePrepared(ctx, s.hooks)
+
+	respBytes, err := proto.Marshal(respContent)
+	if err != nil {
+		s.writeError(ctx, resp, wrapInternal(err, "failed to marshal proto response"))
+		return
+	}
+
+	ctx = ctxsetters.WithStatusCode(ctx, http.StatusOK)
+	resp.Header().Set("Content-Type", "application/protobuf")
+	resp.Header().Set("Content-Length", strconv.Itoa(len(respBytes)))
+	resp.WriteHeader(http.StatusOK)
+	if n, err := resp.Write(respBytes); err != nil {
+		msg := fmt.Sprintf("failed to write response, %d of %d bytes written: %s", n, len(respBytes), err.Error())
+		twerr := twirp.NewError(twirp.Unknown, msg)
+		ctx = callError(ctx, s.hooks, twerr)
+	}
+	callResponseSent(ctx, s.hooks)
+}
+
 func (s *autoRollServiceServer) ServiceDescriptor() ([]byte, int) {
 	return twirpFileDescriptor0, 0
 }
@@ -4087,137 +4362,151 @@ func callClientError(ctx context.Context, h *twirp.ClientHooks, err twirp.Error)
 }
 
 var twirpFileDescriptor0 = []byte{
-	// 2107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x2f, 0xff, 0x88, 0x04, 0x97, 0x14, 0x45, 0x9f, 0x14, 0x1b, 0xa6, 0xe3, 0x98, 0x86, 0x1d,
-	0x47, 0xcd, 0x64, 0xa8, 0x56, 0x76, 0x13, 0x4f, 0x32, 0x7d, 0x90, 0x69, 0x4a, 0x56, 0x23, 0x53,
-	0x0a, 0x28, 0x8e, 0xdb, 0x74, 0x3a, 0x18, 0x88, 0x38, 0xd2, 0xb0, 0x41, 0x1c, 0x7b, 0x07, 0xc8,
-	0xd5, 0x53, 0x3f, 0x43, 0xbf, 0x45, 0xfb, 0xd4, 0xbe, 0xe5, 0xb1, 0x7d, 0xec, 0xc7, 0xea, 0xdc,
-	0x1f, 0x80, 0x00, 0xf8, 0x47, 0x54, 0xdd, 0x3e, 0x89, 0xb7, 0xfb, 0xdb, 0xbd, 0xbd, 0xdb, 0xbd,
-	0xdf, 0x2e, 0x04, 0x15, 0x3a, 0x1d, 0xb6, 0xa7, 0x94, 0x04, 0x04, 0xd5, 0xec, 0x30, 0x20, 0x94,
-	0x78, 0x5e, 0x9b, 0x4e, 0x87, 0xcd, 0x07, 0x63, 0x42, 0xc6, 0x1e, 0xde, 0x13, 0xba, 0x8b, 0x70,
-	0xb4, 0x17, 0xb8, 0x13, 0xcc, 0x02, 0x7b, 0x32, 0x95, 0x70, 0xe3, 0x5f, 0x05, 0x40, 0x07, 0x61,
-	0x40, 0x4c, 0xe2, 0x79, 0xaf, 0x5d, 0xdf, 0xed, 0x07, 0x76, 0x10, 0x32, 0x74, 0x0f, 0x2a, 0xdc,
-	0x07, 0xa6, 0x96, 0xeb, 0xe8, 0xb9, 0x56, 0x6e, 0xb7, 0x62, 0x6a, 0x52, 0x70, 0xec, 0xa0, 0xfb,
-	0x00, 0xc3, 0xb7, 0xae, 0xe7, 0x58, 0xbe, 0x3d, 0xc1, 0x7a, 0x5e, 0x68, 0x2b, 0x42, 0xd2, 0xb3,
-	0x27, 0x18, 0x3d, 0x80, 0xea, 0xd4, 0xa6, 0xd8, 0x0f, 0xa4, 0xbe, 0x20, 0xf4, 0x20, 0x45, 0x02,
-	0xf0, 0x04, 0x8a, 0x13, 0xe2, 0x60, 0xbd, 0xd8, 0xca, 0xed, 0xd6, 0xf7, 0x51, 0x3b, 0x19, 0x71,
-	0xfb, 0x35, 0x71, 0xb0, 0x29, 0xf4, 0x68, 0x17, 0x1a, 0xc3, 0x90, 0x0a, 0x4f, 0x5c, 0x6d, 0x51,
-	0x7c, 0xa9, 0x6f, 0x08, 0x6f, 0x75, 0x25, 0xe7, 0x51, 0x9b, 0xf8, 0x12, 0x19, 0xb0, 0xe9, 0xd9,
-	0x2c, 0x01, 0x2b, 0x09, 0x58, 0x95, 0x0b, 0x23, 0xcc, 0x7d, 0x00, 0x3f, 0x9c, 0x58, 0x23, 0xdb,
-	0xf5, 0xb0, 0xa3, 0x97, 0x5b, 0xb9, 0xdd, 0x0d, 0xb3, 0xe2, 0x87, 0x93, 0x43, 0x21, 0x88, 0xd4,
-	0x17, 0xf8, 0xad, 0xeb, 0x3b, 0xba, 0x16, 0xab, 0x5f, 0x08, 0x01, 0x7a, 0x0e, 0x95, 0xf8, 0xea,
-	0xf4, 0x4a, 0x2b, 0xb7, 0x5b, 0xdd, 0x6f, 0xb6, 0xe5, 0xe5, 0xb6, 0xa3, 0xcb, 0x6d, 0x9f, 0x47,
-	0x08, 0x73, 0x06, 0x46, 0x16, 0x7c, 0x26, 0x62, 0x63, 0xe1, 0x70, 0x88, 0x19, 0x1b, 0x85, 0x9e,
-	0x0c, 0x73, 0xe6, 0x0e, 0xae, 0x75, 0x77, 0x8f, 0x7b, 0xe8, 0xc7, 0x0e, 0xf8, 0x91, 0x62, 0xa5,
-	0xf1, 0xd7, 0x3c, 0x94, 0xce, 0xe9, 0xd5, 0x6f, 0xc8, 0x05, 0x42, 0x50, 0x14, 0x77, 0x2e, 0x33,
-	0x26, 0x7e, 0xa3, 0xa7, 0x50, 0x62, 0x22, 0xa9, 0x22, 0x53, 0xf5, 0xfd, 0x7b, 0xe9, 0xfb, 0x96,
-	0x96, 0x6d, 0x99, 0x77, 0x53, 0x41, 0xb9, 0x11, 0xc5, 0x2c, 0xf4, 0x02, 0x91, 0xbe, 0x65, 0x46,
-	0xa6, 0x80, 0x98, 0x0a, 0x8a, 0x1a, 0x50, 0x08, 0xa9, 0x27, 0xd2, 0x5a, 0x31, 0xf9, 0x4f, 0xd4,
-	0x04, 0x6d, 0x68, 0x07, 0x78, 0x4c, 0xe8, 0x95, 0xca, 0x5c, 0xbc, 0x36, 0x7e, 0x0d, 0x25, 0x69,
-	0x8f, 0xaa, 0x50, 0x1e, 0xf4, 0xbe, 0xef, 0x9d, 0xbe, 0xe9, 0x35, 0x7e, 0xc6, 0x17, 0xfd, 0x41,
-	0xa7, 0xd3, 0xed, 0xf7, 0x1b, 0x39, 0xbe, 0x38, 0x3c, 0x38, 0x3e, 0x19, 0x98, 0xdd, 0x46, 0x1e,
-	0xd5, 0x40, 0xeb, 0x1c, 0xf4, 0x3a, 0xdd, 0x93, 0xee, 0xcb, 0x46, 0xc1, 0x78, 0x0a, 0x25, 0x55,
-	0xab, 0x9b, 0x50, 0xe9, 0x77, 0x5e, 0x75, 0x5f, 0x0e, 0xb8, 0x42, 0x3a, 0x38, 0x3f, 0x30, 0xcf,
-	0xbb, 0x2f, 0x1b, 0x39, 0xae, 0xeb, 0x9c, 0xbe, 0x3e, 0x3b, 0xe9, 0xf2, 0x65, 0xde, 0xf8, 0x77,
-	0x01, 0x20, 0xaa, 0xf6, 0xce, 0x09, 0xaa, 0x43, 0x3e, 0x2e, 0xef, 0xbc, 0xeb, 0xa0, 0x6f, 0xe2,
-	0x53, 0xcb, 0xab, 0x7a, 0x90, 0x3e, 0xf5, 0xcc, 0x32, 0x7b, 0x72, 0x1d, 0xca, 0x2c, 0xbc, 0x78,
-	0x87, 0x87, 0x81, 0x2a, 0xf7, 0x68, 0xc9, 0xcb, 0x8a, 0xdb, 0xbb, 0xfe, 0xd8, 0x0a, 0x88, 0xba,
-	0x9a, 0x8a, 0x92, 0x9c, 0x13, 0xf4, 0x10, 0x6a, 0x91, 0x7a, 0x44, 0xc9, 0x44, 0x5d, 0x52, 0x55,
-	0xc9, 0x0e, 0x29, 0x99, 0xa0, 0x67, 0x50, 0x1e, 0x52, 0x6c, 0x07, 0xd8, 0x11, 0x55, 0xbd, 0xba,
-	0x50, 0x22, 0x28, 0xfa, 0x1a, 0xb4, 0x09, 0x71, 0xdc, 0x91, 0xab, 0x6a, 0x7d, 0xb5, 0x59, 0x8c,
-	0x45, 0x7b, 0xa0, 0x05, 0xf4, 0xca, 0x7a, 0x47, 0x2e, 0x98, 0xae, 0xb5, 0x0a, 0xbb, 0xd5, 0xfd,
-	0x9d, 0x45, 0xa9, 0x37, 0xcb, 0x81, 0xf8, 0xcb, 0x8c, 0xcb, 0x38, 0x8d, 0x5b, 0x50, 0x3d, 0xee,
-	0x59, 0x67, 0xe6, 0xe9, 0x91, 0xc9, 0xb3, 0xb7, 0x2a, 0x95, 0x77, 0x60, 0xfb, 0xa5, 0xf9, 0x3b,
-	0xcb, 0x1c, 0xf4, 0xac, 0xa4, 0x49, 0x01, 0x6d, 0xc3, 0x56, 0xa4, 0x88, 0x4c, 0x8b, 0x49, 0x61,
-	0xe4, 0x62, 0xc3, 0xf8, 0x67, 0x0e, 0x34, 0x13, 0x5f, 0xba, 0xcc, 0x25, 0xfe, 0x5c, 0x22, 0x75,
-	0x28, 0x3b, 0x2e, 0x9b, 0x7a, 0xf6, 0x95, 0xa2, 0xa7, 0x68, 0x89, 0x5a, 0x50, 0x75, 0x30, 0x1b,
-	0x52, 0x77, 0x1a, 0xb8, 0xc4, 0x57, 0xd9, 0x4a, 0x8a, 0x50, 0x1b, 0x8a, 0xfc, 0x69, 0x8a, 0x5c,
-	0xad, 0xbe, 0x35, 0x81, 0x8b, 0xaa, 0x7e, 0x63, 0x56, 0xf5, 0x9f, 0x43, 0xdd, 0xf5, 0x2f, 0x6d,
-	0xcf, 0x75, 0x2c, 0x8a, 0x6d, 0x46, 0x7c, 0x45, 0x47, 0x9b, 0x4a, 0x6a, 0x0a, 0xa1, 0xf1, 0x8f,
-	0x3c, 0xd4, 0xe3, 0x92, 0x22, 0xfe, 0xc8, 0x1d, 0xa3, 0xc7, 0x50, 0x97, 0xcc, 0x7a, 0x11, 0x8e,
-	0x2d, 0xcf, 0xf5, 0xdf, 0x2b, 0xb7, 0x35, 0x21, 0x7d, 0x11, 0x8e, 0x4f, 0x5c, 0xff, 0x3d, 0x7a,
-	0x02, 0x5b, 0x8a, 0x60, 0x63, 0x98, 0xda, 0x40, 0x8a, 0x23, 0xdc, 0xcf, 0xa1, 0xa1, 0x70, 0x1f,
-	0xec, 0x00, 0xd3, 0x91, 0xed, 0x79, 0xea, 0x8e, 0x94, 0xfd, 0x9b, 0x48, 0x9c, 0xe6, 0xfb, 0x7c,
-	0x86, 0xef, 0xf7, 0xe1, 0x13, 0x16, 0x4e, 0xa7, 0x84, 0x06, 0xcc, 0x9a, 0xd8, 0x7e, 0x68, 0x4b,
-	0x06, 0x63, 0xe2, 0xf6, 0x34, 0x73, 0x3b, 0x52, 0xbe, 0x16, 0x3a, 0x7e, 0x1c, 0xc6, 0x9b, 0x00,
-	0xbf, 0x1d, 0xeb, 0x83, 0xeb, 0x3b, 0xe4, 0x83, 0x2a, 0x7c, 0xe0, 0xa2, 0x37, 0x42, 0x82, 0x9e,
-	0x42, 0x55, 0x5e, 0x11, 0xa7, 0x7a, 0xa6, 0x97, 0x5b, 0x85, 0x25, 0xbd, 0x00, 0x04, 0x8c, 0xff,
-	0x64, 0xc6, 0xdf, 0x73, 0x00, 0xfc, 0x57, 0xe7, 0xad, 0xed, 0x8f, 0xf1, 0xea, 0x2e, 0x15, 0x75,
-	0x99, 0xfc, 0x35, 0x5d, 0x06, 0x41, 0x31, 0x64, 0x98, 0xaa, 0x52, 0x10, 0xbf, 0x6f, 0x5c, 0x03,
-	0x3a, 0x94, 0x27, 0x98, 0x31, 0x7b, 0x8c, 0x55, 0xc2, 0xa2, 0x25, 0x2f, 0xd3, 0x7a, 0x3f, 0xa0,
-	0x9c, 0xf4, 0xae, 0xd6, 0x89, 0x7a, 0x1f, 0x34, 0xa6, 0xe0, 0x2a, 0xf2, 0xdb, 0xe9, 0xc8, 0x23,
-	0x67, 0x66, 0x8c, 0xfb, 0x3f, 0x9f, 0xe0, 0x2f, 0x45, 0x80, 0x59, 0x66, 0xe7, 0x9e, 0xda, 0xca,
-	0xca, 0x69, 0x82, 0x46, 0xd5, 0x1b, 0x55, 0xd1, 0xc5, 0x6b, 0xf4, 0x29, 0x54, 0x28, 0xfe, 0x63,
-	0x88, 0x59, 0x80, 0x69, 0x4c, 0x8c, 0x91, 0x20, 0x41, 0xc5, 0x1b, 0x8b, 0xa8, 0x78, 0x16, 0x50,
-	0x96, 0x8a, 0xbf, 0x89, 0xdb, 0x5d, 0xe9, 0x1a, 0xc3, 0x4c, 0xcb, 0x4b, 0x75, 0xf8, 0xf2, 0x4d,
-	0x3a, 0xbc, 0x62, 0x00, 0x6d, 0xc6, 0x00, 0x77, 0xa0, 0xec, 0xd0, 0x2b, 0x8b, 0x86, 0xbe, 0x98,
-	0x15, 0x34, 0xb3, 0xe4, 0xd0, 0x2b, 0x33, 0xf4, 0xd1, 0x5d, 0xd0, 0x7c, 0x62, 0xe1, 0x89, 0xed,
-	0x7a, 0xa2, 0xed, 0x6b, 0x66, 0xd9, 0x27, 0x5d, 0xbe, 0x44, 0x6d, 0xd8, 0xf6, 0x89, 0x45, 0x31,
-	0x23, 0xde, 0x25, 0xb6, 0xe2, 0x6b, 0xab, 0x0a, 0xd4, 0x2d, 0x9f, 0x98, 0x52, 0x13, 0x73, 0xde,
-	0x6d, 0x28, 0x0d, 0x6d, 0xdf, 0xa6, 0x57, 0x7a, 0x4d, 0x6e, 0x21, 0x57, 0xc6, 0xde, 0xd2, 0xbe,
-	0x1a, 0x91, 0x67, 0x2e, 0xc9, 0xcc, 0x79, 0xe3, 0x97, 0x71, 0x27, 0xad, 0x42, 0xf9, 0xac, 0xdb,
-	0x7b, 0x79, 0xdc, 0x3b, 0xba, 0xa6, 0x8f, 0xfe, 0x54, 0x9a, 0x51, 0x97, 0xb2, 0x3d, 0x80, 0xea,
-	0xc4, 0xf5, 0x5d, 0x4b, 0x5d, 0x7e, 0x4e, 0x5c, 0x60, 0x6b, 0x71, 0x03, 0x9d, 0x0d, 0x9a, 0x26,
-	0x4c, 0x66, 0x43, 0xe7, 0xed, 0xd4, 0xa4, 0x52, 0x89, 0x33, 0xf3, 0x0c, 0x4a, 0x43, 0xc1, 0x8f,
-	0xa2, 0x86, 0xaa, 0xfb, 0x9f, 0x2e, 0x69, 0xcb, 0x02, 0x63, 0x2a, 0x2c, 0x9f, 0x1e, 0x47, 0xa1,
-	0xe7, 0x59, 0x6f, 0x5d, 0x16, 0x10, 0x7a, 0x65, 0xcd, 0x46, 0x93, 0x3a, 0x97, 0xbf, 0x92, 0xe2,
-	0x01, 0xf5, 0x38, 0xeb, 0xba, 0x8c, 0x85, 0x98, 0x43, 0xac, 0x0b, 0x9b, 0x45, 0x4f, 0xa0, 0x26,
-	0xa4, 0x03, 0xea, 0xbd, 0xb0, 0x19, 0x46, 0x5f, 0x29, 0x3e, 0x91, 0x4d, 0x58, 0x9f, 0xe7, 0x13,
-	0xf9, 0xbc, 0x15, 0xab, 0x3c, 0x4f, 0xbc, 0xe3, 0xf2, 0xa2, 0xa8, 0xd3, 0xa4, 0x90, 0x78, 0xcd,
-	0xaf, 0x60, 0xc7, 0x27, 0x72, 0x94, 0xc5, 0x4e, 0x5c, 0x07, 0x51, 0x37, 0xce, 0xb0, 0x41, 0x54,
-	0x0d, 0x26, 0xf2, 0x89, 0x98, 0x74, 0xb1, 0x13, 0x89, 0x18, 0xfa, 0x0e, 0x6a, 0xc9, 0xf9, 0x59,
-	0x8d, 0xad, 0xfa, 0xb2, 0xa1, 0xc6, 0xac, 0x26, 0xa6, 0x6a, 0xf4, 0x2b, 0xa8, 0xc4, 0x23, 0xb5,
-	0x9a, 0x50, 0x97, 0x5b, 0x6a, 0xd1, 0xa0, 0xcd, 0xf7, 0xa4, 0x78, 0x18, 0x6d, 0xc9, 0xf4, 0xaa,
-	0x88, 0x7a, 0xc5, 0x9e, 0x12, 0x2d, 0x9b, 0xc6, 0x77, 0x50, 0x4b, 0xf5, 0x97, 0xda, 0x22, 0xe3,
-	0xd9, 0x0b, 0x36, 0xab, 0x93, 0x44, 0xc7, 0xd9, 0x81, 0x0d, 0x4c, 0x29, 0xa1, 0xfa, 0xa6, 0x48,
-	0x9e, 0x5c, 0xa0, 0x0e, 0x6c, 0x05, 0x6f, 0x29, 0x09, 0x02, 0x7e, 0x99, 0xa1, 0x1f, 0xb8, 0x9e,
-	0x5e, 0xbf, 0xf6, 0x6d, 0xd7, 0x63, 0x93, 0x01, 0xb7, 0x40, 0xc7, 0x70, 0x6b, 0xe8, 0x61, 0xdb,
-	0x0f, 0xa7, 0x56, 0xc4, 0x50, 0x8e, 0xbe, 0xb5, 0x28, 0xab, 0x1d, 0x09, 0x33, 0x25, 0xca, 0x6c,
-	0x0c, 0x53, 0x6b, 0xec, 0x18, 0xdb, 0x70, 0xeb, 0x08, 0xcb, 0x4c, 0x51, 0xa6, 0xc4, 0xc6, 0x19,
-	0xa0, 0xa4, 0x90, 0x4d, 0x89, 0xcf, 0x30, 0xfa, 0x16, 0xca, 0x92, 0x48, 0xf9, 0x6b, 0x2a, 0xac,
-	0xf5, 0x9a, 0x22, 0x03, 0xe3, 0x10, 0xb6, 0x94, 0xc7, 0x68, 0x93, 0xd5, 0x6d, 0x87, 0x93, 0x49,
-	0x48, 0x19, 0xa1, 0xd1, 0xd3, 0x93, 0x2b, 0xe3, 0x47, 0x68, 0xcc, 0xfc, 0xa8, 0xb8, 0xda, 0xb0,
-	0x21, 0xd3, 0x93, 0xbb, 0x26, 0xb7, 0x12, 0xb6, 0xd4, 0xf7, 0x53, 0xd8, 0x39, 0xc2, 0x41, 0x22,
-	0xfa, 0x35, 0x02, 0x35, 0x7e, 0x80, 0x4f, 0x32, 0x46, 0x2a, 0xaa, 0xe7, 0x31, 0x79, 0xac, 0x4b,
-	0x3d, 0x0a, 0x6f, 0xec, 0x89, 0x33, 0xde, 0x20, 0x86, 0x63, 0x91, 0xc3, 0xcc, 0xfe, 0xcf, 0x32,
-	0xfb, 0x2f, 0x21, 0xa9, 0xcc, 0xde, 0x04, 0xea, 0x7d, 0x1c, 0x88, 0x69, 0x64, 0x9d, 0x34, 0xad,
-	0x3b, 0xd3, 0x24, 0xba, 0x79, 0x31, 0xdd, 0xcd, 0x8f, 0x60, 0x2b, 0xde, 0xf0, 0xa3, 0x22, 0x3f,
-	0x91, 0x89, 0x20, 0x0e, 0x56, 0x4c, 0xba, 0x6e, 0x9d, 0x91, 0xd1, 0x88, 0x61, 0xf9, 0x85, 0xb5,
-	0x61, 0xaa, 0x95, 0x31, 0x81, 0xdb, 0x59, 0x6f, 0x2a, 0xba, 0x7d, 0x28, 0x2b, 0x06, 0x5f, 0x5c,
-	0x6f, 0x09, 0xe6, 0x8d, 0x80, 0x7c, 0xf8, 0xf4, 0xf1, 0x9f, 0x02, 0x2b, 0xb5, 0x15, 0x70, 0xd1,
-	0xa9, 0xdc, 0xee, 0xcf, 0x80, 0xfa, 0x3c, 0x83, 0x6a, 0x94, 0x5a, 0x27, 0xf2, 0xff, 0x66, 0x30,
-	0x5b, 0x9e, 0x86, 0xef, 0x61, 0x3b, 0x15, 0xc0, 0x47, 0xa5, 0xe2, 0x0c, 0xee, 0x1e, 0xcd, 0x9c,
-	0xfd, 0x2f, 0xd2, 0x11, 0x42, 0x73, 0x91, 0x47, 0x15, 0xe5, 0xd7, 0xd9, 0x94, 0xac, 0x6e, 0x6d,
-	0xeb, 0xa7, 0xe5, 0x3d, 0xdc, 0xe9, 0x88, 0xef, 0xd7, 0x04, 0xc7, 0xaf, 0x73, 0x8c, 0xe4, 0x98,
-	0x99, 0xcf, 0x8c, 0x99, 0x89, 0x51, 0xac, 0x90, 0x1c, 0xc5, 0x8c, 0x57, 0xa0, 0xcf, 0x6f, 0xa6,
-	0x4e, 0xf8, 0x15, 0x14, 0x45, 0xdf, 0xcb, 0x2d, 0xec, 0xf5, 0x33, 0xbc, 0x40, 0x19, 0xbf, 0x80,
-	0x5b, 0x03, 0x3f, 0x6a, 0x19, 0x6b, 0x31, 0xc8, 0x0e, 0xa0, 0xa4, 0x85, 0xdc, 0xd5, 0xf8, 0x03,
-	0xe8, 0x07, 0x8e, 0x93, 0x69, 0x21, 0xeb, 0x9c, 0xff, 0x31, 0x6c, 0xbe, 0x0b, 0x59, 0xe0, 0x8e,
-	0xdc, 0xa1, 0x1d, 0xcc, 0x2e, 0x21, 0x2d, 0x34, 0x7e, 0x80, 0xbb, 0x0b, 0xdc, 0x7f, 0x54, 0xe5,
-	0xbd, 0x06, 0xfd, 0x08, 0x07, 0xca, 0xe5, 0x4d, 0x0a, 0x6f, 0x07, 0x36, 0x3c, 0x77, 0xe2, 0xca,
-	0x22, 0xd8, 0x34, 0xe5, 0xc2, 0xe8, 0x8b, 0x42, 0xce, 0xba, 0x5b, 0xb3, 0xea, 0x32, 0x07, 0x8b,
-	0xc0, 0xc6, 0xdf, 0x72, 0x50, 0x4f, 0xeb, 0xd0, 0x23, 0xd8, 0xf4, 0x31, 0x76, 0x98, 0xa5, 0xda,
-	0xb3, 0x08, 0x4f, 0x33, 0x6b, 0x42, 0xa8, 0xb0, 0xf1, 0x57, 0x55, 0x3e, 0xf1, 0x55, 0x95, 0xfa,
-	0x46, 0x28, 0xdc, 0xe4, 0x1b, 0x61, 0x2e, 0x45, 0xc5, 0x05, 0x29, 0xfa, 0xf2, 0x5b, 0x28, 0x72,
-	0x3e, 0xe3, 0xb3, 0xb7, 0x39, 0xe8, 0xf5, 0x12, 0x83, 0xf8, 0xe9, 0xd9, 0x99, 0x18, 0xc4, 0xab,
-	0x50, 0x56, 0xff, 0x0b, 0x69, 0xe4, 0xf9, 0xe2, 0xf4, 0xf0, 0xf0, 0xe4, 0xb8, 0xd7, 0x6d, 0x14,
-	0xbe, 0x6c, 0x83, 0x16, 0x3d, 0x3c, 0x54, 0x81, 0x8d, 0x17, 0x07, 0xe7, 0x9d, 0x57, 0xd2, 0xba,
-	0x67, 0xc9, 0x45, 0x0e, 0x01, 0x94, 0xfa, 0xc7, 0xbd, 0xa3, 0x93, 0x6e, 0x23, 0xbf, 0xff, 0x93,
-	0x06, 0x5b, 0x71, 0x5a, 0x31, 0xbd, 0x74, 0x87, 0x18, 0x39, 0x70, 0x6b, 0xae, 0x44, 0xd0, 0x93,
-	0x4c, 0x29, 0x2c, 0x29, 0xd1, 0xe6, 0x17, 0xd7, 0xe2, 0x54, 0x26, 0x1d, 0xd1, 0x3f, 0xd3, 0x69,
-	0xce, 0xee, 0xb2, 0xac, 0xac, 0xb2, 0xbb, 0x2c, 0xaf, 0x97, 0x53, 0x80, 0xd9, 0x50, 0x85, 0x1e,
-	0xcc, 0x99, 0xa5, 0x67, 0xb0, 0x66, 0x6b, 0x39, 0x40, 0x39, 0x3c, 0x06, 0x2d, 0x9a, 0x85, 0xd0,
-	0xfd, 0x85, 0xe8, 0xd8, 0xd9, 0x67, 0xcb, 0xd4, 0xca, 0xd5, 0x6f, 0x61, 0x33, 0x35, 0xc5, 0x20,
-	0x63, 0xce, 0x60, 0x6e, 0x2e, 0x6a, 0x3e, 0x5a, 0x89, 0x51, 0x9e, 0x4f, 0xa0, 0x12, 0xcf, 0x26,
-	0x68, 0x3e, 0x8c, 0xb4, 0xc7, 0x07, 0x4b, 0xf5, 0xca, 0xdb, 0x21, 0x94, 0xd5, 0xb4, 0x80, 0xb2,
-	0x1c, 0x9f, 0x9a, 0x5a, 0x9a, 0xf7, 0x97, 0x68, 0x95, 0x9f, 0xdf, 0x43, 0x3d, 0xdd, 0xde, 0xd1,
-	0x82, 0xc3, 0xcc, 0x8d, 0x12, 0xcd, 0xc7, 0xab, 0x41, 0xca, 0xb9, 0x09, 0xd5, 0x44, 0x2f, 0x45,
-	0xad, 0xb9, 0x50, 0x32, 0x7d, 0xbe, 0xf9, 0x70, 0x05, 0x42, 0xf9, 0x1c, 0x8b, 0x89, 0x3c, 0xd3,
-	0x00, 0xd1, 0x17, 0x0b, 0xee, 0x6b, 0x51, 0xd3, 0x6d, 0xee, 0x5e, 0x0f, 0x54, 0x1b, 0xd9, 0xd0,
-	0xc8, 0x76, 0x21, 0xf4, 0x79, 0x86, 0xd8, 0x16, 0xb7, 0xc4, 0xe6, 0x93, 0xeb, 0x60, 0xb3, 0x87,
-	0x30, 0x6b, 0x36, 0xd9, 0x87, 0x30, 0xd7, 0xb8, 0xb2, 0x0f, 0x61, 0xbe, 0x4f, 0xbd, 0x78, 0xf4,
-	0xe3, 0xc3, 0x31, 0x69, 0xb3, 0xf7, 0xae, 0xdd, 0x26, 0x74, 0xbc, 0xe7, 0xfa, 0x23, 0x6a, 0xef,
-	0x45, 0x46, 0x7b, 0x63, 0xb2, 0x47, 0xa7, 0xc3, 0x8b, 0x92, 0xe0, 0xc3, 0xa7, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xf5, 0x63, 0x27, 0x4d, 0x9b, 0x1a, 0x00, 0x00,
+	// 2326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x73, 0xdb, 0xc8,
+	0x11, 0x0e, 0xdf, 0x64, 0x53, 0xa2, 0xa8, 0x91, 0xd6, 0x86, 0xb9, 0x6b, 0x9b, 0x86, 0x5f, 0xca,
+	0xd6, 0x16, 0x95, 0x48, 0xce, 0xae, 0x6b, 0xb7, 0x72, 0x90, 0x28, 0x4a, 0x66, 0x56, 0xa2, 0xb4,
+	0xa0, 0x54, 0x4e, 0x9c, 0x4a, 0xa1, 0x20, 0x62, 0x48, 0xc1, 0x02, 0x01, 0x66, 0x06, 0x90, 0xc3,
+	0x53, 0x2a, 0xf9, 0x07, 0xa9, 0xca, 0x8f, 0x48, 0x4e, 0xc9, 0x2d, 0xc7, 0xe4, 0x98, 0x9f, 0x95,
+	0x9a, 0x07, 0x40, 0x00, 0x7c, 0x3a, 0x4e, 0x4e, 0xe4, 0xf4, 0x7c, 0xdd, 0xd3, 0x33, 0xdd, 0xf3,
+	0x75, 0x0f, 0xa0, 0x44, 0x46, 0xbd, 0xc6, 0x88, 0xb8, 0x9e, 0x8b, 0xd6, 0x0c, 0xdf, 0x73, 0x89,
+	0x6b, 0xdb, 0x0d, 0x32, 0xea, 0xd5, 0x1e, 0x0f, 0x5c, 0x77, 0x60, 0xe3, 0x5d, 0x3e, 0x77, 0xed,
+	0xf7, 0x77, 0x3d, 0x6b, 0x88, 0xa9, 0x67, 0x0c, 0x47, 0x02, 0xae, 0xfe, 0x2b, 0x03, 0xe8, 0xc0,
+	0xf7, 0x5c, 0xcd, 0xb5, 0xed, 0x33, 0xcb, 0xb1, 0xba, 0x9e, 0xe1, 0xf9, 0x14, 0x7d, 0x0e, 0x25,
+	0x66, 0x03, 0x13, 0xdd, 0x32, 0x95, 0x54, 0x3d, 0xb5, 0x53, 0xd2, 0x8a, 0x42, 0xd0, 0x36, 0xd1,
+	0x43, 0x80, 0xde, 0x8d, 0x65, 0x9b, 0xba, 0x63, 0x0c, 0xb1, 0x92, 0xe6, 0xb3, 0x25, 0x2e, 0xe9,
+	0x18, 0x43, 0x8c, 0x1e, 0x43, 0x79, 0x64, 0x10, 0xec, 0x78, 0x62, 0x3e, 0xc3, 0xe7, 0x41, 0x88,
+	0x38, 0xe0, 0x05, 0x64, 0x87, 0xae, 0x89, 0x95, 0x6c, 0x3d, 0xb5, 0x53, 0xd9, 0x43, 0x8d, 0xa8,
+	0xc7, 0x8d, 0x33, 0xd7, 0xc4, 0x1a, 0x9f, 0x47, 0x3b, 0x50, 0xed, 0xf9, 0x84, 0x5b, 0x62, 0xd3,
+	0x3a, 0xc1, 0x77, 0x4a, 0x8e, 0x5b, 0xab, 0x48, 0x39, 0xf3, 0x5a, 0xc3, 0x77, 0x48, 0x85, 0x75,
+	0xdb, 0xa0, 0x11, 0x58, 0x9e, 0xc3, 0xca, 0x4c, 0x18, 0x60, 0x1e, 0x02, 0x38, 0xfe, 0x50, 0xef,
+	0x1b, 0x96, 0x8d, 0x4d, 0xa5, 0x50, 0x4f, 0xed, 0xe4, 0xb4, 0x92, 0xe3, 0x0f, 0x8f, 0xb9, 0x20,
+	0x98, 0xbe, 0xc6, 0x37, 0x96, 0x63, 0x2a, 0xc5, 0x70, 0xfa, 0x90, 0x0b, 0xd0, 0x6b, 0x28, 0x85,
+	0x47, 0xa7, 0x94, 0xea, 0xa9, 0x9d, 0xf2, 0x5e, 0xad, 0x21, 0x0e, 0xb7, 0x11, 0x1c, 0x6e, 0xe3,
+	0x32, 0x40, 0x68, 0x13, 0x30, 0xd2, 0xe1, 0x11, 0xf7, 0x8d, 0xfa, 0xbd, 0x1e, 0xa6, 0xb4, 0xef,
+	0xdb, 0xc2, 0xcd, 0x89, 0x39, 0x58, 0x6a, 0xee, 0x73, 0x66, 0xa1, 0x1b, 0x1a, 0x60, 0x5b, 0x0a,
+	0x27, 0xd5, 0xbf, 0xa4, 0x21, 0x7f, 0x49, 0xc6, 0xbf, 0x70, 0xaf, 0x11, 0x82, 0x2c, 0x3f, 0x73,
+	0x11, 0x31, 0xfe, 0x1f, 0xed, 0x43, 0x9e, 0xf2, 0xa0, 0xf2, 0x48, 0x55, 0xf6, 0x3e, 0x8f, 0x9f,
+	0xb7, 0xd0, 0x6c, 0x88, 0xb8, 0x6b, 0x12, 0xca, 0x94, 0x08, 0xa6, 0xbe, 0xed, 0x29, 0x99, 0x05,
+	0x4a, 0x1a, 0x87, 0x68, 0x12, 0x8a, 0xaa, 0x90, 0xf1, 0x89, 0xcd, 0xc3, 0x5a, 0xd2, 0xd8, 0x5f,
+	0x54, 0x83, 0x62, 0xcf, 0xf0, 0xf0, 0xc0, 0x25, 0x63, 0x19, 0xb9, 0x70, 0xac, 0xfe, 0x1c, 0xf2,
+	0x42, 0x1f, 0x95, 0xa1, 0x70, 0xd5, 0xf9, 0xbe, 0x73, 0xfe, 0xb6, 0x53, 0xfd, 0x11, 0x1b, 0x74,
+	0xaf, 0x9a, 0xcd, 0x56, 0xb7, 0x5b, 0x4d, 0xb1, 0xc1, 0xf1, 0x41, 0xfb, 0xf4, 0x4a, 0x6b, 0x55,
+	0xd3, 0x68, 0x0d, 0x8a, 0xcd, 0x83, 0x4e, 0xb3, 0x75, 0xda, 0x3a, 0xaa, 0x66, 0xd4, 0x7d, 0xc8,
+	0xcb, 0x5c, 0x5d, 0x87, 0x52, 0xb7, 0xf9, 0xa6, 0x75, 0x74, 0xc5, 0x26, 0x84, 0x81, 0xcb, 0x03,
+	0xed, 0xb2, 0x75, 0x54, 0x4d, 0xb1, 0xb9, 0xe6, 0xf9, 0xd9, 0xc5, 0x69, 0x8b, 0x0d, 0xd3, 0xea,
+	0xbf, 0x33, 0x00, 0x41, 0xb6, 0x37, 0x4f, 0x51, 0x05, 0xd2, 0x61, 0x7a, 0xa7, 0x2d, 0x13, 0x7d,
+	0x13, 0xee, 0x5a, 0x1c, 0xd5, 0xe3, 0xf8, 0xae, 0x27, 0x9a, 0xc9, 0x9d, 0x2b, 0x50, 0xa0, 0xfe,
+	0xf5, 0x7b, 0xdc, 0xf3, 0x64, 0xba, 0x07, 0x43, 0x96, 0x56, 0x4c, 0xdf, 0x72, 0x06, 0xba, 0xe7,
+	0xca, 0xa3, 0x29, 0x49, 0xc9, 0xa5, 0x8b, 0x9e, 0xc0, 0x5a, 0x30, 0xdd, 0x27, 0xee, 0x50, 0x1e,
+	0x52, 0x59, 0xca, 0x8e, 0x89, 0x3b, 0x44, 0xaf, 0xa0, 0xd0, 0x23, 0xd8, 0xf0, 0xb0, 0xa9, 0xe4,
+	0x97, 0x26, 0x4a, 0x00, 0x45, 0x5f, 0x43, 0x71, 0xe8, 0x9a, 0x56, 0xdf, 0x92, 0xb9, 0xbe, 0x58,
+	0x2d, 0xc4, 0xa2, 0x5d, 0x28, 0x7a, 0x64, 0xac, 0xbf, 0x77, 0xaf, 0xa9, 0x52, 0xac, 0x67, 0x76,
+	0xca, 0x7b, 0xdb, 0xb3, 0x42, 0xaf, 0x15, 0x3c, 0xfe, 0x4b, 0xd5, 0xbb, 0x30, 0x8c, 0x1b, 0x50,
+	0x6e, 0x77, 0xf4, 0x0b, 0xed, 0xfc, 0x44, 0x63, 0xd1, 0x5b, 0x14, 0xca, 0xfb, 0xb0, 0x75, 0xa4,
+	0xfd, 0x4a, 0xd7, 0xae, 0x3a, 0x7a, 0x54, 0x25, 0x83, 0xb6, 0x60, 0x23, 0x98, 0x08, 0x54, 0xb3,
+	0x51, 0x61, 0x60, 0x22, 0xa7, 0xfe, 0x33, 0x05, 0x45, 0x0d, 0xdf, 0x59, 0xd4, 0x72, 0x9d, 0xa9,
+	0x40, 0x2a, 0x50, 0x30, 0x2d, 0x3a, 0xb2, 0x8d, 0xb1, 0xa4, 0xa7, 0x60, 0x88, 0xea, 0x50, 0x36,
+	0x31, 0xed, 0x11, 0x6b, 0xe4, 0x59, 0xae, 0x23, 0xa3, 0x15, 0x15, 0xa1, 0x06, 0x64, 0xd9, 0xd5,
+	0x54, 0xb2, 0x4b, 0x4f, 0x8d, 0xe3, 0x82, 0xac, 0xcf, 0x4d, 0xb2, 0xfe, 0x39, 0x54, 0x2c, 0xe7,
+	0xce, 0xb0, 0x2d, 0x53, 0x27, 0xd8, 0xa0, 0xae, 0x23, 0xe9, 0x68, 0x5d, 0x4a, 0x35, 0x2e, 0x54,
+	0xff, 0x9e, 0x86, 0x4a, 0x98, 0x52, 0xae, 0xd3, 0xb7, 0x06, 0xe8, 0x19, 0x54, 0x04, 0xb3, 0x5e,
+	0xfb, 0x03, 0xdd, 0xb6, 0x9c, 0x5b, 0x69, 0x76, 0x8d, 0x4b, 0x0f, 0xfd, 0xc1, 0xa9, 0xe5, 0xdc,
+	0xa2, 0x17, 0xb0, 0x21, 0x09, 0x36, 0x84, 0xc9, 0x05, 0x84, 0x38, 0xc0, 0xfd, 0x18, 0xaa, 0x12,
+	0xf7, 0xc1, 0xf0, 0x30, 0xe9, 0x1b, 0xb6, 0x2d, 0xcf, 0x48, 0xea, 0xbf, 0x0d, 0xc4, 0x71, 0xbe,
+	0x4f, 0x27, 0xf8, 0x7e, 0x0f, 0x3e, 0xa3, 0xfe, 0x68, 0xe4, 0x12, 0x8f, 0xea, 0x43, 0xc3, 0xf1,
+	0x0d, 0xc1, 0x60, 0x94, 0x9f, 0x5e, 0x51, 0xdb, 0x0a, 0x26, 0xcf, 0xf8, 0x1c, 0xdb, 0x0e, 0x65,
+	0x45, 0x80, 0x9d, 0x8e, 0xfe, 0xc1, 0x72, 0x4c, 0xf7, 0x83, 0x4c, 0x7c, 0x60, 0xa2, 0xb7, 0x5c,
+	0x82, 0xf6, 0xa1, 0x2c, 0x8e, 0x88, 0x51, 0x3d, 0x55, 0x0a, 0xf5, 0xcc, 0x9c, 0x5a, 0x00, 0x1c,
+	0xc6, 0xfe, 0x52, 0xf5, 0x6f, 0x29, 0x00, 0xf6, 0xaf, 0x79, 0x63, 0x38, 0x03, 0xbc, 0xb8, 0x4a,
+	0x05, 0x55, 0x26, 0xbd, 0xa4, 0xca, 0x20, 0xc8, 0xfa, 0x14, 0x13, 0x99, 0x0a, 0xfc, 0xff, 0x47,
+	0xe7, 0x80, 0x02, 0x85, 0x21, 0xa6, 0xd4, 0x18, 0x60, 0x19, 0xb0, 0x60, 0xc8, 0xd2, 0xb4, 0xd2,
+	0xf5, 0x08, 0x23, 0xbd, 0xf1, 0x2a, 0x5e, 0xef, 0x41, 0x91, 0x4a, 0xb8, 0xf4, 0xfc, 0x5e, 0xdc,
+	0xf3, 0xc0, 0x98, 0x16, 0xe2, 0xfe, 0xcf, 0x3b, 0xf8, 0x53, 0x16, 0x60, 0x12, 0xd9, 0xa9, 0xab,
+	0xb6, 0x30, 0x73, 0x6a, 0x50, 0x24, 0xf2, 0x8e, 0x4a, 0xef, 0xc2, 0x31, 0xfa, 0x02, 0x4a, 0x04,
+	0xff, 0xd6, 0xc7, 0xd4, 0xc3, 0x24, 0x24, 0xc6, 0x40, 0x10, 0xa1, 0xe2, 0xdc, 0x2c, 0x2a, 0x9e,
+	0x38, 0x94, 0xa4, 0xe2, 0x6f, 0xc2, 0x72, 0x97, 0x5f, 0xa2, 0x98, 0x28, 0x79, 0xb1, 0x0a, 0x5f,
+	0xf8, 0x98, 0x0a, 0x2f, 0x19, 0xa0, 0x38, 0x61, 0x80, 0xfb, 0x50, 0x30, 0xc9, 0x58, 0x27, 0xbe,
+	0xc3, 0x7b, 0x85, 0xa2, 0x96, 0x37, 0xc9, 0x58, 0xf3, 0x1d, 0xf4, 0x00, 0x8a, 0x8e, 0xab, 0xe3,
+	0xa1, 0x61, 0xd9, 0xbc, 0xec, 0x17, 0xb5, 0x82, 0xe3, 0xb6, 0xd8, 0x10, 0x35, 0x60, 0xcb, 0x71,
+	0x75, 0x82, 0xa9, 0x6b, 0xdf, 0x61, 0x3d, 0x3c, 0xb6, 0x32, 0x47, 0x6d, 0x3a, 0xae, 0x26, 0x66,
+	0x42, 0xce, 0xbb, 0x07, 0xf9, 0x9e, 0xe1, 0x18, 0x64, 0xac, 0xac, 0x89, 0x25, 0xc4, 0x48, 0xdd,
+	0x9d, 0x5b, 0x57, 0x03, 0xf2, 0x4c, 0x45, 0x99, 0x39, 0xad, 0xfe, 0x34, 0xac, 0xa4, 0x65, 0x28,
+	0x5c, 0xb4, 0x3a, 0x47, 0xed, 0xce, 0xc9, 0x92, 0x3a, 0xfa, 0x8f, 0xfc, 0x84, 0xba, 0xa4, 0xee,
+	0x01, 0x94, 0x87, 0x96, 0x63, 0xe9, 0xf2, 0xf0, 0x53, 0xfc, 0x00, 0xeb, 0xb3, 0x0b, 0xe8, 0xa4,
+	0xd1, 0xd4, 0x60, 0x18, 0xfe, 0x67, 0x3b, 0x8a, 0x74, 0x2a, 0xa5, 0x30, 0x32, 0xaf, 0x20, 0xdf,
+	0xe3, 0xfc, 0xc8, 0x73, 0xa8, 0xbc, 0xf7, 0xc5, 0x9c, 0xb2, 0xcc, 0x31, 0x9a, 0xc4, 0xb2, 0xee,
+	0xb1, 0xef, 0xdb, 0xb6, 0x7e, 0x63, 0x51, 0xcf, 0x25, 0x63, 0x7d, 0xd2, 0x9a, 0x54, 0x98, 0xfc,
+	0x8d, 0x10, 0x5f, 0x11, 0x9b, 0xb1, 0xae, 0x45, 0xa9, 0x8f, 0x19, 0x44, 0xbf, 0x36, 0x68, 0x70,
+	0x05, 0xd6, 0xb8, 0xf4, 0x8a, 0xd8, 0x87, 0x06, 0xc5, 0xe8, 0x2b, 0xc9, 0x27, 0xa2, 0x08, 0x2b,
+	0xd3, 0x7c, 0x22, 0xae, 0xb7, 0x64, 0x95, 0xd7, 0x91, 0x7b, 0x5c, 0x98, 0xe5, 0x75, 0x9c, 0x14,
+	0x22, 0xb7, 0xf9, 0x0d, 0x6c, 0x3b, 0xae, 0x68, 0x65, 0xb1, 0x19, 0xe6, 0x41, 0x50, 0x8d, 0x13,
+	0x6c, 0x10, 0x64, 0x83, 0x86, 0x1c, 0x97, 0x77, 0xba, 0xd8, 0x0c, 0x44, 0x14, 0x7d, 0x07, 0x6b,
+	0xd1, 0xfe, 0x59, 0x29, 0xcd, 0xf2, 0x7c, 0xd2, 0xd4, 0x68, 0xe5, 0x48, 0x57, 0x8d, 0x7e, 0x06,
+	0xa5, 0xb0, 0xa5, 0x56, 0x60, 0x89, 0x66, 0x31, 0x68, 0xb4, 0xd9, 0x9a, 0x04, 0xf7, 0x82, 0x25,
+	0xa9, 0x52, 0xae, 0x67, 0x16, 0x6a, 0x96, 0x05, 0x5a, 0x14, 0x8d, 0xef, 0x60, 0x2d, 0x56, 0x5f,
+	0xd6, 0x66, 0x29, 0x4f, 0x6e, 0xb0, 0x56, 0x1e, 0x46, 0x2a, 0xce, 0x36, 0xe4, 0x30, 0x21, 0x2e,
+	0x51, 0xd6, 0x79, 0xf0, 0xc4, 0x00, 0x35, 0x61, 0xc3, 0xbb, 0x21, 0xae, 0xe7, 0xb1, 0xc3, 0xf4,
+	0x1d, 0xcf, 0xb2, 0x95, 0xca, 0xd2, 0xbb, 0x5d, 0x09, 0x55, 0xae, 0x98, 0x06, 0x6a, 0xc3, 0x66,
+	0xcf, 0xc6, 0x86, 0xe3, 0x8f, 0xf4, 0x80, 0xa1, 0x4c, 0x65, 0x63, 0x56, 0x54, 0x9b, 0x02, 0xa6,
+	0x09, 0x94, 0x56, 0xed, 0xc5, 0xc6, 0xd8, 0x54, 0xb7, 0x60, 0xf3, 0x04, 0x8b, 0x48, 0x11, 0x2a,
+	0xc5, 0xea, 0x05, 0xa0, 0xa8, 0x90, 0x8e, 0x5c, 0x87, 0x62, 0xf4, 0x2d, 0x14, 0x04, 0x91, 0xb2,
+	0xdb, 0x94, 0x59, 0xe9, 0x36, 0x05, 0x0a, 0xea, 0x31, 0x6c, 0x48, 0x8b, 0xc1, 0x22, 0x8b, 0xcb,
+	0x0e, 0x23, 0x13, 0x9f, 0x50, 0x97, 0x04, 0x57, 0x4f, 0x8c, 0xd4, 0x77, 0x50, 0x9d, 0xd8, 0x91,
+	0x7e, 0x35, 0x20, 0x27, 0xc2, 0x93, 0x5a, 0x12, 0x5b, 0x01, 0x9b, 0x6b, 0x7b, 0x1f, 0xb6, 0x4f,
+	0xb0, 0x17, 0xf1, 0x7e, 0x05, 0x47, 0xd5, 0x1f, 0xe0, 0xb3, 0x84, 0x92, 0xf4, 0xea, 0x75, 0x48,
+	0x1e, 0xab, 0x52, 0x8f, 0xc4, 0xab, 0xbb, 0x7c, 0x8f, 0x1f, 0xe1, 0x43, 0x1b, 0x36, 0x23, 0x0a,
+	0x72, 0xfd, 0x57, 0x89, 0xf5, 0xe7, 0x90, 0x54, 0x62, 0x6d, 0x17, 0x2a, 0x5d, 0xec, 0xf1, 0x6e,
+	0x64, 0x95, 0x30, 0xad, 0xda, 0xd3, 0x44, 0xaa, 0x79, 0x36, 0x5e, 0xcd, 0x4f, 0x60, 0x23, 0x5c,
+	0xf0, 0x93, 0x3c, 0x3f, 0x15, 0x81, 0x70, 0x4d, 0x2c, 0x99, 0x74, 0xd5, 0x3c, 0x73, 0xfb, 0x7d,
+	0x8a, 0xc5, 0x0b, 0x2b, 0xa7, 0xc9, 0x91, 0x3a, 0x84, 0x7b, 0x49, 0x6b, 0xd2, 0xbb, 0x3d, 0x28,
+	0x48, 0x06, 0x9f, 0x9d, 0x6f, 0x11, 0xe6, 0x0d, 0x80, 0xac, 0xf9, 0x74, 0xf0, 0xef, 0x3c, 0x3d,
+	0xb6, 0x14, 0x30, 0xd1, 0xb9, 0x58, 0xee, 0xf7, 0x80, 0xba, 0x2c, 0x82, 0xb2, 0x95, 0x5a, 0xc5,
+	0xf3, 0xff, 0xa6, 0x31, 0x9b, 0x1f, 0x86, 0xef, 0x61, 0x2b, 0xe6, 0xc0, 0x27, 0x85, 0xe2, 0x02,
+	0x1e, 0x9c, 0x4c, 0x8c, 0xfd, 0x2f, 0xc2, 0xe1, 0x43, 0x6d, 0x96, 0x45, 0xe9, 0xe5, 0xd7, 0xc9,
+	0x90, 0x2c, 0x2e, 0x6d, 0xab, 0x87, 0xe5, 0x16, 0xee, 0x37, 0xf9, 0xfb, 0x35, 0xc2, 0xf1, 0xab,
+	0x6c, 0x23, 0xda, 0x66, 0xa6, 0x13, 0x6d, 0x66, 0xa4, 0x15, 0xcb, 0x44, 0x5b, 0x31, 0xf5, 0x0d,
+	0x28, 0xd3, 0x8b, 0xc9, 0x1d, 0x7e, 0x05, 0x59, 0x5e, 0xf7, 0x52, 0x33, 0x6b, 0xfd, 0x04, 0xcf,
+	0x51, 0xea, 0x4f, 0x60, 0xf3, 0xca, 0x09, 0x4a, 0xc6, 0x4a, 0x0c, 0xb2, 0x0d, 0x28, 0xaa, 0x21,
+	0x56, 0x55, 0x7f, 0x03, 0xca, 0x81, 0x69, 0x26, 0x4a, 0xc8, 0x2a, 0xfb, 0x7f, 0x06, 0xeb, 0xef,
+	0x7d, 0xea, 0x59, 0x7d, 0xab, 0x67, 0x78, 0x93, 0x43, 0x88, 0x0b, 0xd5, 0x1f, 0xe0, 0xc1, 0x0c,
+	0xf3, 0x9f, 0x94, 0x79, 0x67, 0xa0, 0x9c, 0x60, 0x4f, 0x9a, 0xfc, 0x98, 0xc4, 0xdb, 0x86, 0x9c,
+	0x6d, 0x0d, 0x2d, 0x91, 0x04, 0xeb, 0x9a, 0x18, 0xa8, 0x5d, 0x78, 0x30, 0xc3, 0xdc, 0x8a, 0x59,
+	0x97, 0xd8, 0x58, 0x00, 0x56, 0xff, 0x9a, 0x82, 0x4a, 0x7c, 0x0e, 0x3d, 0x85, 0x75, 0x07, 0x63,
+	0x93, 0xea, 0xb2, 0x3c, 0x73, 0xf7, 0x8a, 0xda, 0x1a, 0x17, 0x4a, 0x6c, 0xf8, 0xaa, 0x4a, 0x47,
+	0x5e, 0x55, 0xb1, 0x37, 0x42, 0xe6, 0x63, 0xde, 0x08, 0x53, 0x21, 0xca, 0xce, 0x0a, 0xd1, 0x11,
+	0x6c, 0x88, 0x2e, 0xe0, 0x84, 0x18, 0xa3, 0x9b, 0x0e, 0xa3, 0xf2, 0xe4, 0x7b, 0xeb, 0x09, 0xac,
+	0xc9, 0x6f, 0x19, 0xd1, 0xcf, 0xaf, 0x65, 0x29, 0x63, 0xdf, 0x57, 0xd5, 0x3f, 0xa4, 0x63, 0x66,
+	0x5a, 0xe6, 0x00, 0xb3, 0x87, 0x87, 0xf8, 0xb2, 0x10, 0x1a, 0x2b, 0xf0, 0x71, 0x9b, 0xbf, 0xe0,
+	0xe4, 0x67, 0x82, 0xc9, 0x0b, 0x4e, 0x08, 0xda, 0x89, 0xe7, 0x5d, 0x26, 0x11, 0xc5, 0x47, 0x00,
+	0x1e, 0x31, 0x1c, 0x6a, 0x79, 0xd6, 0x9d, 0xa0, 0xb8, 0xa2, 0x16, 0x91, 0x24, 0xbe, 0xa9, 0xe6,
+	0x92, 0xdf, 0x54, 0x9f, 0x43, 0x85, 0xe2, 0x9e, 0xeb, 0x98, 0x34, 0x80, 0xb0, 0xde, 0x3a, 0xa3,
+	0xad, 0x4b, 0xa9, 0x84, 0x6d, 0x43, 0x8e, 0x7a, 0x7e, 0xef, 0x96, 0xf7, 0xd1, 0x45, 0x4d, 0x0c,
+	0x98, 0xed, 0x6b, 0xdb, 0xed, 0xdd, 0x62, 0x53, 0xbf, 0x1e, 0xf3, 0xe6, 0xb8, 0xa4, 0x95, 0xa4,
+	0xe4, 0x70, 0xac, 0xde, 0xe7, 0xe5, 0x29, 0x72, 0x0a, 0x41, 0xaf, 0xf5, 0xc7, 0x14, 0xdc, 0x4b,
+	0xce, 0xc8, 0x0c, 0xdb, 0x87, 0x9c, 0xc3, 0x3f, 0x46, 0x88, 0xfc, 0x7a, 0x98, 0x68, 0xb5, 0xe3,
+	0x81, 0xd1, 0x04, 0x96, 0x29, 0x61, 0x73, 0x80, 0xd9, 0x9b, 0x65, 0xb1, 0x12, 0x0b, 0x83, 0x26,
+	0xb0, 0x5f, 0x7e, 0x0b, 0x59, 0x56, 0xb7, 0xd8, 0x1b, 0x4b, 0xbb, 0xea, 0x74, 0x22, 0x0f, 0xae,
+	0xf3, 0x8b, 0x0b, 0xfe, 0xe0, 0x2a, 0x43, 0x41, 0x7e, 0xf3, 0xaa, 0xa6, 0xd9, 0xe0, 0xfc, 0xf8,
+	0xf8, 0xb4, 0xdd, 0x69, 0x55, 0x33, 0x5f, 0xbe, 0x83, 0x62, 0x40, 0xb0, 0xa8, 0x04, 0xb9, 0xc3,
+	0x83, 0xcb, 0xe6, 0x1b, 0xa1, 0xdd, 0xd1, 0xc5, 0x20, 0x85, 0x00, 0xf2, 0xdd, 0x76, 0xe7, 0xe4,
+	0x94, 0x7d, 0x6b, 0xab, 0x00, 0x5c, 0xb6, 0xcf, 0x5a, 0x72, 0x2e, 0xc3, 0xc6, 0xdd, 0xf6, 0xbb,
+	0x60, 0x9c, 0x65, 0xd8, 0xc3, 0x76, 0xb7, 0xd5, 0xbc, 0xac, 0xe6, 0xf6, 0xfe, 0x5c, 0x82, 0x8d,
+	0xf0, 0xaa, 0x63, 0x72, 0x67, 0xf5, 0x30, 0x32, 0x61, 0x73, 0x8a, 0x36, 0xd0, 0x8b, 0x04, 0x3d,
+	0xcc, 0xa1, 0xad, 0xda, 0xcb, 0xa5, 0x38, 0x79, 0xf6, 0x26, 0xef, 0xa9, 0xe2, 0x57, 0x3f, 0xb9,
+	0xca, 0x3c, 0xaa, 0xa9, 0xbd, 0x5c, 0x8a, 0x93, 0xab, 0x9c, 0x03, 0x4c, 0x1a, 0x6d, 0xf4, 0x78,
+	0x4a, 0x2d, 0xde, 0x97, 0xd7, 0xea, 0xf3, 0x01, 0xd2, 0x60, 0x1b, 0x8a, 0x52, 0x4a, 0xd1, 0xc3,
+	0x99, 0xe8, 0xd0, 0xd8, 0xa3, 0x79, 0xd3, 0xd2, 0xd4, 0x2f, 0x61, 0x3d, 0xd6, 0xd9, 0x22, 0x75,
+	0x4a, 0x61, 0xaa, 0x57, 0xae, 0x3d, 0x5d, 0x88, 0x91, 0x96, 0x4f, 0xa1, 0x14, 0xf6, 0xab, 0x68,
+	0xda, 0x8d, 0xb8, 0xc5, 0xc7, 0x73, 0xe7, 0xa5, 0xb5, 0x63, 0x28, 0xc8, 0x0e, 0x12, 0x25, 0xeb,
+	0x7e, 0xac, 0x93, 0xad, 0x3d, 0x9c, 0x33, 0x2b, 0xed, 0xfc, 0x1a, 0x2a, 0xf1, 0x96, 0x0f, 0xcd,
+	0xd8, 0xcc, 0x54, 0x7b, 0x59, 0x7b, 0xb6, 0x18, 0x24, 0x8d, 0x6b, 0x50, 0x8e, 0xf4, 0x57, 0xa8,
+	0x3e, 0xe5, 0x4a, 0xa2, 0xf7, 0xab, 0x3d, 0x59, 0x80, 0x90, 0x36, 0x07, 0xfc, 0x95, 0x96, 0x68,
+	0x8a, 0xd0, 0xcb, 0x19, 0xe7, 0x35, 0xab, 0x11, 0xab, 0xed, 0x2c, 0x07, 0xca, 0x85, 0x0c, 0xa8,
+	0x26, 0x3b, 0x13, 0xf4, 0x3c, 0x51, 0xec, 0x66, 0xb7, 0x49, 0xb5, 0x17, 0xcb, 0x60, 0x93, 0x8b,
+	0x30, 0x69, 0x40, 0x92, 0x17, 0x61, 0xaa, 0x99, 0xa9, 0xd5, 0xe7, 0x03, 0x62, 0xd1, 0x8c, 0xd0,
+	0xdd, 0x8c, 0x68, 0x4e, 0xb3, 0x71, 0xed, 0xd9, 0x62, 0x90, 0x30, 0x7e, 0xf8, 0xf4, 0xdd, 0x93,
+	0x81, 0xdb, 0xa0, 0xb7, 0x96, 0xd1, 0x70, 0xc9, 0x60, 0xd7, 0x72, 0xfa, 0xc4, 0xd8, 0x0d, 0x14,
+	0x77, 0x07, 0xee, 0x2e, 0x19, 0xf5, 0xae, 0xf3, 0xbc, 0x00, 0xef, 0xff, 0x67, 0x00, 0x72, 0xc8,
+	0x12, 0xc6, 0x0c, 0x1d, 0x00, 0x00,
 }
@@ -367,6 +367,12 @@ func (s *AutoRollServer) SetStrategy(ctx context.Context, req *SetStrategyReques
 		strat = strategy.ROLL_STRATEGY_N_BATCH
 	case Strategy_SINGLE:
 		strat = strategy.ROLL_STRATEGY_SINGLE
+	case Strategy_TIME_BATCH:
+		strat = strategy.ROLL_STRATEGY_TIME_BATCH
+	case Strategy_SIZE_BATCH:
+		strat = strategy.ROLL_STRATEGY_SIZE_BATCH
+	case Strategy_BISECT:
+		strat = strategy.ROLL_STRATEGY_BISECT
 	default:
 		return nil, twirp.InvalidArgumentError("strategy", "invalid strategy")
 	}
@@ -453,6 +459,25 @@ func (s *AutoRollServer) Unthrottle(ctx context.Context, req *UnthrottleRequest)
 	return &UnthrottleResponse{}, nil
 }
 
+// GetRollerGraph implements AutoRollRPCs.
+func (s *AutoRollServer) GetRollerGraph(ctx context.Context, req *GetRollerGraphRequest) (*GetRollerGraphResponse, error) {
+	s.rollersMtx.RLock()
+	defer s.rollersMtx.RUnlock()
+	inputs := make([]*rollerGraphInput, 0, len(s.rollers))
+	for _, roller := range s.rollers {
+		mode := modes.ModeRunning
+		if mc := roller.Mode.CurrentMode(); mc != nil {
+			mode = mc.Mode
+		}
+		inputs = append(inputs, &rollerGraphInput{
+			cfg:    roller.Cfg,
+			mode:   mode,
+			status: roller.Status.Get(),
+		})
+	}
+	return buildRollerGraph(inputs, timeNowFunc()), nil
+}
+
 // AddCleanupRequest implements AutoRollRPCs.
 func (s *AutoRollServer) AddCleanupRequest(ctx context.Context, req *AddCleanupRequestRequest) (*AddCleanupRequestResponse, error) {
 	// Verify that the user has edit access.
@@ -675,6 +700,12 @@ func convertStrategy(s string) (Strategy, error) {
 		return Strategy_N_BATCH, nil
 	case strategy.ROLL_STRATEGY_SINGLE:
 		return Strategy_SINGLE, nil
+	case strategy.ROLL_STRATEGY_TIME_BATCH:
+		return Strategy_TIME_BATCH, nil
+	case strategy.ROLL_STRATEGY_SIZE_BATCH:
+		return Strategy_SIZE_BATCH, nil
+	case strategy.ROLL_STRATEGY_BISECT:
+		return Strategy_BISECT, nil
 	default:
 		return -1, twirp.InternalError(fmt.Sprintf("invalid strategy %q", s))
 	}
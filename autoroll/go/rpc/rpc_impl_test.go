@@ -568,6 +568,39 @@ func TestUnthrottle(t *testing.T) {
 	assertdeep.Equal(t, &UnthrottleResponse{}, res)
 }
 
+func TestGetRollerGraph(t *testing.T) {
+	// Setup, mocks.
+	ctx, _, srv := setup(t)
+	ctx = alogin.FakeStatus(ctx, &notLoggedInStatus)
+
+	// Check results. Both rollers are in dry run mode and are therefore
+	// considered to be stuck.
+	res, err := srv.GetRollerGraph(ctx, &GetRollerGraphRequest{})
+	require.NoError(t, err)
+	assertdeep.Equal(t, &GetRollerGraphResponse{
+		Nodes: []*RollerGraphNode{
+			{Id: "https://fake.child", DisplayName: "roller1_child"},
+			{Id: "https://fake.parent", DisplayName: "roller1_parent"},
+		},
+		Edges: []*RollerGraphEdge{
+			{
+				ChildId:   "https://fake.child",
+				ParentId:  "https://fake.parent",
+				RollerId:  "roller1",
+				NumBehind: 2,
+				Stuck:     true,
+			},
+			{
+				ChildId:   "https://fake.child",
+				ParentId:  "https://fake.parent",
+				RollerId:  "roller2",
+				NumBehind: 2,
+				Stuck:     true,
+			},
+		},
+	}, res)
+}
+
 func TestAddCleanupRequest(t *testing.T) {
 	// Setup, mocks.
 	ctx, rollers, srv := setup(t)
@@ -2,18 +2,40 @@ package strategy
 
 import (
 	"fmt"
+	"sync"
+	"time"
 
 	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/autoroll"
 )
 
 const (
 	ROLL_STRATEGY_BATCH = "batch"
 	// TODO(rmistry): Rename to "batch of " + N_REVISIONS ?
-	ROLL_STRATEGY_N_BATCH = "n_batch"
-	ROLL_STRATEGY_SINGLE  = "single"
+	ROLL_STRATEGY_N_BATCH    = "n_batch"
+	ROLL_STRATEGY_SINGLE     = "single"
+	ROLL_STRATEGY_TIME_BATCH = "time_batch"
+	ROLL_STRATEGY_SIZE_BATCH = "size_batch"
+	ROLL_STRATEGY_BISECT     = "bisect"
 
 	// The number of Revisions to use in ROLL_STRATEGY_N_BATCH.
 	N_REVISIONS = 20
+
+	// The default minimum time between successful rolls in
+	// ROLL_STRATEGY_TIME_BATCH.
+	TIME_BATCH_INTERVAL = 6 * time.Hour
+
+	// The default maximum number of files changed in a single roll in
+	// ROLL_STRATEGY_SIZE_BATCH.
+	MAX_FILES_CHANGED = 200
+
+	// The default maximum number of lines changed in a single roll in
+	// ROLL_STRATEGY_SIZE_BATCH.
+	MAX_LINES_CHANGED = 5000
+
+	// BisectInvalidReason is the InvalidReason given to the Revisions
+	// isolated by ROLL_STRATEGY_BISECT.
+	BisectInvalidReason = "Bisection found that rolling this revision fails the commit queue."
 )
 
 // NextRollStrategy is an interface for modules which determine what the next
@@ -26,8 +48,43 @@ type NextRollStrategy interface {
 	GetNextRollRev([]*revision.Revision) *revision.Revision
 }
 
+// CulpritFinder is implemented by NextRollStrategies which can determine which
+// Revision caused rolls to fail.
+type CulpritFinder interface {
+	// Culprit returns the Revision which the most recent call to
+	// GetNextRollRev found to cause rolls to fail, or nil if it did not find
+	// one.
+	Culprit() *revision.Revision
+}
+
+// RollHistory provides information about previous rolls to strategies which
+// need it. It is implemented by recent_rolls.RecentRolls.
+type RollHistory interface {
+	// GetRecentRolls returns the most recent rolls, most recent first.
+	GetRecentRolls() []*autoroll.AutoRollIssue
+	// LastSuccessfulRollTime returns the time at which the last roll landed.
+	LastSuccessfulRollTime() time.Time
+}
+
+// Options provides parameters for the strategies which need them. Zero values
+// indicate that the defaults should be used.
+type Options struct {
+	// History is required by ROLL_STRATEGY_TIME_BATCH and
+	// ROLL_STRATEGY_BISECT.
+	History RollHistory
+	// TimeBatchInterval is the minimum time between successful rolls in
+	// ROLL_STRATEGY_TIME_BATCH.
+	TimeBatchInterval time.Duration
+	// MaxFilesChanged is the maximum number of files changed in a single
+	// roll in ROLL_STRATEGY_SIZE_BATCH.
+	MaxFilesChanged int
+	// MaxLinesChanged is the maximum number of lines changed in a single
+	// roll in ROLL_STRATEGY_SIZE_BATCH.
+	MaxLinesChanged int
+}
+
 // Return the NextRollStrategy indicated by the given string.
-func GetNextRollStrategy(strategy string) (NextRollStrategy, error) {
+func GetNextRollStrategy(strategy string, opts Options) (NextRollStrategy, error) {
 	switch strategy {
 	case ROLL_STRATEGY_BATCH:
 		return StrategyBatch(), nil
@@ -35,6 +92,30 @@ func GetNextRollStrategy(strategy string) (NextRollStrategy, error) {
 		return StrategyNBatch(), nil
 	case ROLL_STRATEGY_SINGLE:
 		return StrategySingle(), nil
+	case ROLL_STRATEGY_TIME_BATCH:
+		if opts.History == nil {
+			return nil, fmt.Errorf("Roll strategy %q requires roll history", strategy)
+		}
+		interval := opts.TimeBatchInterval
+		if interval == 0 {
+			interval = TIME_BATCH_INTERVAL
+		}
+		return StrategyTimeBatch(opts.History, interval), nil
+	case ROLL_STRATEGY_SIZE_BATCH:
+		maxFiles := opts.MaxFilesChanged
+		if maxFiles == 0 {
+			maxFiles = MAX_FILES_CHANGED
+		}
+		maxLines := opts.MaxLinesChanged
+		if maxLines == 0 {
+			maxLines = MAX_LINES_CHANGED
+		}
+		return StrategySizeBatch(maxFiles, maxLines), nil
+	case ROLL_STRATEGY_BISECT:
+		if opts.History == nil {
+			return nil, fmt.Errorf("Roll strategy %q requires roll history", strategy)
+		}
+		return StrategyBisect(opts.History), nil
 	default:
 		return nil, fmt.Errorf("Unknown roll strategy %q", strategy)
 	}
@@ -103,3 +184,179 @@ func (s *singleStrategy) GetNextRollRev(notRolled []*revision.Revision) *revisio
 func StrategySingle() NextRollStrategy {
 	return &singleStrategy{}
 }
+
+// timeBatchStrategy is a NextRollStrategy which rolls to HEAD of a given
+// branch, but no more often than a given interval.
+type timeBatchStrategy struct {
+	history  RollHistory
+	interval time.Duration
+	now      func() time.Time
+}
+
+// See documentation for NextRollStrategy interface.
+func (s *timeBatchStrategy) GetNextRollRev(notRolled []*revision.Revision) *revision.Revision {
+	// Note that the interval is measured from the last successful roll, so
+	// that failed rolls are retried as usual.
+	if s.now().Sub(s.history.LastSuccessfulRollTime()) < s.interval {
+		return nil
+	}
+	return StrategyBatch().GetNextRollRev(notRolled)
+}
+
+// StrategyTimeBatch returns a NextRollStrategy which rolls to HEAD of a given
+// branch, but no more often than the given interval.
+func StrategyTimeBatch(history RollHistory, interval time.Duration) NextRollStrategy {
+	return &timeBatchStrategy{
+		history:  history,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// sizeBatchStrategy is a NextRollStrategy which rolls as many Revisions as
+// possible without exceeding a limit on the number of files and lines changed.
+// Revisions whose size is unknown are considered to be empty.
+type sizeBatchStrategy struct {
+	maxFiles int
+	maxLines int
+}
+
+// See documentation for NextRollStrategy interface.
+func (s *sizeBatchStrategy) GetNextRollRev(notRolled []*revision.Revision) *revision.Revision {
+	// Revisions are listed in reverse chronological order. Walk forward in
+	// time, choosing the most recent valid Revision which keeps the roll
+	// within the limits. Always choose at least one valid Revision, so that
+	// a single large Revision doesn't block the roller forever.
+	var rv *revision.Revision
+	files := 0
+	lines := 0
+	for i := len(notRolled) - 1; i >= 0; i-- {
+		rev := notRolled[i]
+		files += rev.FilesChanged
+		lines += rev.LinesChanged
+		if rv != nil && (files > s.maxFiles || lines > s.maxLines) {
+			break
+		}
+		if rev.InvalidReason == "" {
+			rv = rev
+		}
+	}
+	return rv
+}
+
+// StrategySizeBatch returns a NextRollStrategy which rolls as many Revisions
+// as possible without exceeding the given number of files and lines changed.
+func StrategySizeBatch(maxFiles, maxLines int) NextRollStrategy {
+	return &sizeBatchStrategy{
+		maxFiles: maxFiles,
+		maxLines: maxLines,
+	}
+}
+
+// bisectStrategy is a NextRollStrategy which behaves like batchStrategy until
+// a roll fails in the commit queue. It then rolls to the midpoint of the
+// failed range until the offending Revision is isolated, marks it invalid and
+// reports it as the Culprit. Since any later roll includes the culprit, it only
+// moves on to Revisions which have not yet been tried, in case they fix the
+// failure; it is up to the caller to stop rolling if that isn't wanted. Note
+// that a flaky failure will cause the strategy to blame the wrong Revision.
+type bisectStrategy struct {
+	history RollHistory
+
+	// culprit is the Revision isolated by the most recent call to
+	// GetNextRollRev, if any. It is protected by mtx.
+	culprit *revision.Revision
+
+	// failed holds the IDs of the Revisions which were the target of a
+	// failed roll and which we haven't rolled past. It is accumulated across
+	// calls, so that the bisection doesn't lose its place when the failed
+	// rolls fall out of the window of recent rolls. It is protected by mtx.
+	failed map[string]bool
+	mtx    sync.Mutex
+}
+
+// See documentation for NextRollStrategy interface.
+func (s *bisectStrategy) GetNextRollRev(notRolled []*revision.Revision) *revision.Revision {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.culprit = nil
+	for _, roll := range s.history.GetRecentRolls() {
+		if !roll.Closed || roll.IsDryRun || roll.Committed || roll.Result != autoroll.ROLL_RESULT_FAILURE || !roll.AtleastOneTrybotFailure() {
+			continue
+		}
+		s.failed[roll.RollingTo] = true
+	}
+
+	// Find the oldest and the newest Revisions which were the target of a
+	// failed roll, forgetting those which we've rolled past. Revisions are
+	// listed in reverse chronological order, so the oldest has the greatest
+	// index.
+	index := make(map[string]int, len(notRolled))
+	for i, rev := range notRolled {
+		index[rev.Id] = i
+	}
+	oldestFailed := -1
+	newestFailed := len(notRolled)
+	for id := range s.failed {
+		i, ok := index[id]
+		if !ok {
+			delete(s.failed, id)
+			continue
+		}
+		if i > oldestFailed {
+			oldestFailed = i
+		}
+		if i < newestFailed {
+			newestFailed = i
+		}
+	}
+	if oldestFailed < 0 {
+		return StrategyBatch().GetNextRollRev(notRolled)
+	}
+
+	// The culprit is somewhere in notRolled[oldestFailed:]. If it's the only
+	// candidate, we've found it. Move on to any Revisions which we haven't
+	// tried, in case the failure has been fixed.
+	if oldestFailed == len(notRolled)-1 {
+		culprit := notRolled[oldestFailed]
+		if culprit.InvalidReason == "" {
+			culprit.InvalidReason = BisectInvalidReason
+		}
+		s.culprit = culprit
+		return StrategyBatch().GetNextRollRev(notRolled[:newestFailed])
+	}
+
+	// Roll to the midpoint of the candidates, not including the Revision
+	// known to fail. Prefer a valid Revision at or before the midpoint.
+	mid := oldestFailed + (len(notRolled)-oldestFailed)/2
+	for i := mid; i < len(notRolled); i++ {
+		if notRolled[i].InvalidReason == "" {
+			return notRolled[i]
+		}
+	}
+	for i := mid - 1; i > oldestFailed; i-- {
+		if notRolled[i].InvalidReason == "" {
+			return notRolled[i]
+		}
+	}
+	// We can't roll to any of the candidates; we have to include the
+	// Revision known to fail.
+	return StrategyBatch().GetNextRollRev(notRolled[:newestFailed])
+}
+
+// Culprit implements CulpritFinder.
+func (s *bisectStrategy) Culprit() *revision.Revision {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.culprit
+}
+
+// StrategyBisect returns a NextRollStrategy which rolls toward HEAD of a given
+// branch in batches, bisecting the range of a failed roll to find the
+// offending Revision.
+func StrategyBisect(history RollHistory) NextRollStrategy {
+	return &bisectStrategy{
+		history: history,
+		failed:  map[string]bool{},
+	}
+}
@@ -3,9 +3,11 @@ package strategy
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.skia.org/infra/autoroll/go/revision"
+	"go.skia.org/infra/go/autoroll"
 )
 
 func TestStrategyBatch(t *testing.T) {
@@ -115,3 +117,194 @@ func TestStrategySingle(t *testing.T) {
 	}
 	require.Nil(t, s.GetNextRollRev(testRevs))
 }
+
+// fakeRollHistory is a RollHistory used for testing.
+type fakeRollHistory struct {
+	lastSuccess time.Time
+	rolls       []*autoroll.AutoRollIssue
+}
+
+// GetRecentRolls implements RollHistory.
+func (h *fakeRollHistory) GetRecentRolls() []*autoroll.AutoRollIssue {
+	return h.rolls
+}
+
+// LastSuccessfulRollTime implements RollHistory.
+func (h *fakeRollHistory) LastSuccessfulRollTime() time.Time {
+	return h.lastSuccess
+}
+
+// makeRevs returns n Revisions in reverse chronological order, with IDs
+// counting down from n to 1.
+func makeRevs(n int) []*revision.Revision {
+	revs := make([]*revision.Revision, 0, n)
+	for i := n; i > 0; i-- {
+		revs = append(revs, &revision.Revision{
+			Id: fmt.Sprintf("%d", i),
+		})
+	}
+	return revs
+}
+
+func TestStrategyTimeBatch(t *testing.T) {
+
+	current := time.Unix(1682445445, 0)
+	history := &fakeRollHistory{
+		lastSuccess: current.Add(-time.Hour),
+	}
+	s := StrategyTimeBatch(history, 6*time.Hour).(*timeBatchStrategy)
+	s.now = func() time.Time {
+		return current
+	}
+	testRevs := makeRevs(3)
+
+	// We rolled too recently.
+	require.Nil(t, s.GetNextRollRev(testRevs))
+
+	// Enough time has passed; roll everything.
+	history.lastSuccess = current.Add(-6 * time.Hour)
+	require.Equal(t, testRevs[0], s.GetNextRollRev(testRevs))
+}
+
+func TestStrategySizeBatch(t *testing.T) {
+
+	s := StrategySizeBatch(10, 100)
+
+	// No revisions to roll.
+	require.Nil(t, s.GetNextRollRev(nil))
+
+	// Revisions of unknown size are rolled in a single batch.
+	testRevs := makeRevs(4)
+	require.Equal(t, testRevs[0], s.GetNextRollRev(testRevs))
+
+	// Limit by number of files.
+	for _, rev := range testRevs {
+		rev.FilesChanged = 4
+	}
+	require.Equal(t, testRevs[2], s.GetNextRollRev(testRevs))
+
+	// Limit by number of lines.
+	for _, rev := range testRevs {
+		rev.FilesChanged = 1
+		rev.LinesChanged = 60
+	}
+	require.Equal(t, testRevs[3], s.GetNextRollRev(testRevs))
+
+	// A single Revision which exceeds the limits is still rolled.
+	testRevs[3].LinesChanged = 1000
+	require.Equal(t, testRevs[3], s.GetNextRollRev(testRevs))
+
+	// Invalid Revisions count toward the limits but aren't rolled to.
+	testRevs[3].LinesChanged = 10
+	testRevs[2].LinesChanged = 10
+	testRevs[2].InvalidReason = "flu"
+	require.Equal(t, testRevs[1], s.GetNextRollRev(testRevs))
+}
+
+func TestStrategyBisect(t *testing.T) {
+
+	history := &fakeRollHistory{}
+	s := StrategyBisect(history)
+	failedRoll := func(to string) *autoroll.AutoRollIssue {
+		return &autoroll.AutoRollIssue{
+			Closed:     true,
+			CqFinished: true,
+			Result:     autoroll.ROLL_RESULT_FAILURE,
+			RollingTo:  to,
+			TryResults: []*autoroll.TryResult{
+				{
+					Builder:  "my-bot",
+					Category: autoroll.TRYBOT_CATEGORY_CQ,
+					Result:   autoroll.TRYBOT_RESULT_FAILURE,
+					Status:   autoroll.TRYBOT_STATUS_COMPLETED,
+				},
+			},
+		}
+	}
+
+	// No failures; behave like batch.
+	testRevs := makeRevs(8)
+	require.Equal(t, "8", s.GetNextRollRev(testRevs).Id)
+
+	// Rolls which were abandoned without failing trybots, eg. because a new
+	// revision landed, don't count.
+	history.rolls = []*autoroll.AutoRollIssue{
+		{
+			Closed:    true,
+			Result:    autoroll.ROLL_RESULT_FAILURE,
+			RollingTo: "8",
+		},
+	}
+	require.Equal(t, "8", s.GetNextRollRev(testRevs).Id)
+
+	// The batch roll failed; roll half of it.
+	history.rolls = []*autoroll.AutoRollIssue{failedRoll("8")}
+	require.Equal(t, "4", s.GetNextRollRev(testRevs).Id)
+
+	// That failed too; roll half again.
+	history.rolls = append([]*autoroll.AutoRollIssue{failedRoll("4")}, history.rolls...)
+	require.Equal(t, "2", s.GetNextRollRev(testRevs).Id)
+
+	// That succeeded; bisect the remaining range.
+	testRevs = makeRevs(8)[:6]
+	require.Equal(t, "3", s.GetNextRollRev(testRevs).Id)
+
+	// Nothing has been isolated yet.
+	cf := s.(CulpritFinder)
+	require.Nil(t, cf.Culprit())
+
+	// That succeeded too, so 4 is the culprit. Mark it invalid and try the
+	// Revisions which haven't been tried yet.
+	// Since all of those have been tried, there's nothing to roll.
+	testRevs = makeRevs(8)[:5]
+	require.Nil(t, s.GetNextRollRev(testRevs))
+	require.Equal(t, BisectInvalidReason, testRevs[4].InvalidReason)
+	require.Equal(t, testRevs[4], cf.Culprit())
+
+	// A new Revision landed, which may contain a fix. Try it. The culprit
+	// is still reported, since the new roll includes it.
+	require.Equal(t, "9", s.GetNextRollRev(append(makeRevs(9)[:1], testRevs...)).Id)
+	require.Equal(t, "4", cf.Culprit().Id)
+
+	// Once we've rolled past it, there is no culprit.
+	require.Equal(t, "9", s.GetNextRollRev(makeRevs(9)[:1]).Id)
+	require.Nil(t, cf.Culprit())
+}
+
+func TestStrategyBisect_FailedRollsLeaveHistory_KeepsBisecting(t *testing.T) {
+	history := &fakeRollHistory{}
+	s := StrategyBisect(history)
+	failedRoll := func(to string) *autoroll.AutoRollIssue {
+		return &autoroll.AutoRollIssue{
+			Closed:     true,
+			CqFinished: true,
+			Result:     autoroll.ROLL_RESULT_FAILURE,
+			RollingTo:  to,
+			TryResults: []*autoroll.TryResult{
+				{
+					Builder:  "my-bot",
+					Category: autoroll.TRYBOT_CATEGORY_CQ,
+					Result:   autoroll.TRYBOT_RESULT_FAILURE,
+					Status:   autoroll.TRYBOT_STATUS_COMPLETED,
+				},
+			},
+		}
+	}
+
+	// Only the most recent roll is in the history each time.
+	testRevs := makeRevs(8)
+	history.rolls = []*autoroll.AutoRollIssue{failedRoll("8")}
+	require.Equal(t, "4", s.GetNextRollRev(testRevs).Id)
+	history.rolls = []*autoroll.AutoRollIssue{failedRoll("4")}
+	require.Equal(t, "2", s.GetNextRollRev(testRevs).Id)
+	history.rolls = nil
+	require.Equal(t, "3", s.GetNextRollRev(makeRevs(8)[:6]).Id)
+
+	// 4 is the culprit. The failed roll to 8 is no longer in the history,
+	// but 8 still isn't retried.
+	require.Nil(t, s.GetNextRollRev(makeRevs(8)[:5]))
+
+	// Once we've rolled past the failed Revisions, they're forgotten.
+	require.Equal(t, "9", s.GetNextRollRev(makeRevs(9)[:1]).Id)
+	require.Equal(t, "8", s.GetNextRollRev(makeRevs(8)[:1]).Id)
+}
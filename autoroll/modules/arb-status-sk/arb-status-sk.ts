@@ -1027,6 +1027,12 @@ export class ARBStatusSk extends ElementSk {
         return 'N_BATCH rolls multiple new revisions in a single CL with a limit on the number of revisions';
       case Strategy.SINGLE:
         return 'SINGLE rolls one revision per CL';
+      case Strategy.TIME_BATCH:
+        return 'TIME_BATCH rolls all new revisions in a single CL, but no more often than a configured interval';
+      case Strategy.SIZE_BATCH:
+        return 'SIZE_BATCH rolls multiple new revisions in a single CL with a limit on the number of files and lines changed';
+      case Strategy.BISECT:
+        return 'BISECT rolls all new revisions in a single CL, but when a roll fails it rolls halves of the failed range until the offending revision is isolated, then marks that revision invalid and stops the roller';
       default:
         return '';
     }
@@ -1,5 +1,11 @@
 import {createTwirpRequest, throwTwirpError, Fetch} from './twirp';
 
+export enum PackageRegistry {
+  NPM = "NPM",
+  PYPI = "PYPI",
+  CRATES = "CRATES",
+}
+
 export enum PreUploadStep {
   ANGLE_CODE_GENERATION = "ANGLE_CODE_GENERATION",
   ANGLE_GN_TO_BP = "ANGLE_GN_TO_BP",
@@ -30,6 +36,12 @@ export enum CommitMsgConfig_BuiltIn {
   CANARY = "CANARY",
 }
 
+export enum CommitMsgConfig_GroupBy {
+  NONE = "NONE",
+  CONVENTIONAL_COMMIT_TYPE = "CONVENTIONAL_COMMIT_TYPE",
+  FILE_AREA = "FILE_AREA",
+}
+
 export enum GerritConfig_Config {
   ANDROID = "ANDROID",
   ANGLE = "ANGLE",
@@ -51,6 +63,11 @@ export enum NotifierConfig_LogLevel {
   DEBUG = "DEBUG",
 }
 
+export enum ArtifactSignatureConfig_Format {
+  DETACHED = "DETACHED",
+  SIGSTORE_BUNDLE = "SIGSTORE_BUNDLE",
+}
+
 export enum NotifierConfig_MsgType {
   ISSUE_UPDATE = "ISSUE_UPDATE",
   LAST_N_FAILED = "LAST_N_FAILED",
@@ -62,6 +79,7 @@ export enum NotifierConfig_MsgType {
   STRATEGY_CHANGE = "STRATEGY_CHANGE",
   SUCCESS_THROTTLE = "SUCCESS_THROTTLE",
   MANUAL_ROLL_CREATION_FAILED = "MANUAL_ROLL_CREATION_FAILED",
+  ROLL_REVERTED = "ROLL_REVERTED",
 }
 
 export interface Config {
@@ -86,12 +104,14 @@ export interface Config {
   gerrit?: GerritConfig;
   github?: GitHubConfig;
   google3?: Google3Config;
+  gitlab?: GitLabConfig;
   kubernetes?: KubernetesConfig;
   parentChildRepoManager?: ParentChildRepoManagerConfig;
   androidRepoManager?: AndroidRepoManagerConfig;
   commandRepoManager?: CommandRepoManagerConfig;
   freetypeRepoManager?: FreeTypeRepoManagerConfig;
   google3RepoManager?: Google3RepoManagerConfig;
+  fanOutRepoManager?: FanOutRepoManagerConfig;
   notifiers?: NotifierConfig[];
   safetyThrottle?: ThrottleConfig;
   transitiveDeps?: TransitiveDepConfig[];
@@ -99,6 +119,8 @@ export interface Config {
   validModes?: Mode[];
   maxRollCqAttempts: number;
   maxRollClsToSameRevision: number;
+  autoRevert?: AutoRevertConfig;
+  strategyOptions?: StrategyOptions;
 }
 
 interface ConfigJSON {
@@ -123,12 +145,14 @@ interface ConfigJSON {
   gerrit?: GerritConfigJSON;
   github?: GitHubConfigJSON;
   google3?: Google3ConfigJSON;
+  gitlab?: GitLabConfigJSON;
   kubernetes?: KubernetesConfigJSON;
   parent_child_repo_manager?: ParentChildRepoManagerConfigJSON;
   android_repo_manager?: AndroidRepoManagerConfigJSON;
   command_repo_manager?: CommandRepoManagerConfigJSON;
   freetype_repo_manager?: FreeTypeRepoManagerConfigJSON;
   google3_repo_manager?: Google3RepoManagerConfigJSON;
+  fan_out_repo_manager?: FanOutRepoManagerConfigJSON;
   notifiers?: NotifierConfigJSON[];
   safety_throttle?: ThrottleConfigJSON;
   transitive_deps?: TransitiveDepConfigJSON[];
@@ -136,6 +160,8 @@ interface ConfigJSON {
   valid_modes?: string[];
   max_roll_cq_attempts?: number;
   max_roll_cls_to_same_revision?: number;
+  auto_revert?: AutoRevertConfigJSON;
+  strategy_options?: StrategyOptionsJSON;
 }
 
 export interface CommitMsgConfig {
@@ -151,6 +177,10 @@ export interface CommitMsgConfig {
   wordWrap: number;
   builtIn: CommitMsgConfig_BuiltIn;
   custom: string;
+  groupBy: CommitMsgConfig_GroupBy;
+  fileAreas?: CommitMsgConfig_FileArea[];
+  includeAuthorSummary: boolean;
+  releaseNotes?: ReleaseNotesConfig;
 }
 
 interface CommitMsgConfigJSON {
@@ -166,6 +196,30 @@ interface CommitMsgConfigJSON {
   word_wrap?: number;
   built_in?: string;
   custom?: string;
+  group_by?: string;
+  file_areas?: CommitMsgConfig_FileAreaJSON[];
+  include_author_summary?: boolean;
+  release_notes?: ReleaseNotesConfigJSON;
+}
+
+export interface CommitMsgConfig_FileArea {
+  name: string;
+  paths?: string[];
+}
+
+interface CommitMsgConfig_FileAreaJSON {
+  name?: string;
+  paths?: string[];
+}
+
+export interface ReleaseNotesConfig {
+  changelogPath: string;
+  tagAnnotations: boolean;
+}
+
+interface ReleaseNotesConfigJSON {
+  changelog_path?: string;
+  tag_annotations?: boolean;
 }
 
 export interface GerritConfig {
@@ -196,6 +250,24 @@ interface GitHubConfigJSON {
   ssh_key_secret?: string;
 }
 
+export interface GitLabConfig {
+  url: string;
+  project: string;
+  labels?: string[];
+  selfApprove: boolean;
+  tokenSecret: string;
+  sshKeySecret: string;
+}
+
+interface GitLabConfigJSON {
+  url?: string;
+  project?: string;
+  labels?: string[];
+  self_approve?: boolean;
+  token_secret?: string;
+  ssh_key_secret?: string;
+}
+
 export interface Google3Config {
 }
 
@@ -322,6 +394,14 @@ interface Google3RepoManagerConfigJSON {
   child_repo?: string;
 }
 
+export interface FanOutRepoManagerConfig {
+  parentChild?: ParentChildRepoManagerConfig[];
+}
+
+interface FanOutRepoManagerConfigJSON {
+  parent_child?: ParentChildRepoManagerConfigJSON[];
+}
+
 export interface ParentChildRepoManagerConfig {
   copyParent?: CopyParentConfig;
   depsLocalGithubParent?: DEPSLocalGitHubParentConfig;
@@ -330,6 +410,9 @@ export interface ParentChildRepoManagerConfig {
   gitilesParent?: GitilesParentConfig;
   goModGerritParent?: GoModGerritParentConfig;
   gitCheckoutGerritParent?: GitCheckoutGerritParentConfig;
+  gitCheckoutGitlabParent?: GitCheckoutGitLabParentConfig;
+  bazelParent?: BazelParentConfig;
+  packageRegistryParent?: PackageRegistryParentConfig;
   cipdChild?: CIPDChildConfig;
   fuchsiaSdkChild?: FuchsiaSDKChildConfig;
   gitCheckoutChild?: GitCheckoutChildConfig;
@@ -337,9 +420,11 @@ export interface ParentChildRepoManagerConfig {
   gitilesChild?: GitilesChildConfig;
   semverGcsChild?: SemVerGCSChildConfig;
   dockerChild?: DockerChildConfig;
+  packageRegistryChild?: PackageRegistryChildConfig;
   buildbucketRevisionFilter?: BuildbucketRevisionFilterConfig[];
   cipdRevisionFilter?: CIPDRevisionFilterConfig[];
   validHttpRevisionFilter?: ValidHttpRevisionFilterConfig[];
+  signatureRevisionFilter?: SignatureRevisionFilterConfig[];
 }
 
 interface ParentChildRepoManagerConfigJSON {
@@ -350,6 +435,9 @@ interface ParentChildRepoManagerConfigJSON {
   gitiles_parent?: GitilesParentConfigJSON;
   go_mod_gerrit_parent?: GoModGerritParentConfigJSON;
   git_checkout_gerrit_parent?: GitCheckoutGerritParentConfigJSON;
+  git_checkout_gitlab_parent?: GitCheckoutGitLabParentConfigJSON;
+  bazel_parent?: BazelParentConfigJSON;
+  package_registry_parent?: PackageRegistryParentConfigJSON;
   cipd_child?: CIPDChildConfigJSON;
   fuchsia_sdk_child?: FuchsiaSDKChildConfigJSON;
   git_checkout_child?: GitCheckoutChildConfigJSON;
@@ -357,9 +445,11 @@ interface ParentChildRepoManagerConfigJSON {
   gitiles_child?: GitilesChildConfigJSON;
   semver_gcs_child?: SemVerGCSChildConfigJSON;
   docker_child?: DockerChildConfigJSON;
+  package_registry_child?: PackageRegistryChildConfigJSON;
   buildbucket_revision_filter?: BuildbucketRevisionFilterConfigJSON[];
   cipd_revision_filter?: CIPDRevisionFilterConfigJSON[];
   valid_http_revision_filter?: ValidHttpRevisionFilterConfigJSON[];
+  signature_revision_filter?: SignatureRevisionFilterConfigJSON[];
 }
 
 export interface CopyParentConfig_CopyEntry {
@@ -422,6 +512,18 @@ interface GitCheckoutGerritParentConfigJSON {
   git_checkout?: GitCheckoutParentConfigJSON;
 }
 
+export interface GitCheckoutGitLabParentConfig {
+  gitCheckout?: GitCheckoutParentConfig;
+  gitlab?: GitLabConfig;
+  preUploadCommands?: PreUploadConfig;
+}
+
+interface GitCheckoutGitLabParentConfigJSON {
+  git_checkout?: GitCheckoutParentConfigJSON;
+  gitlab?: GitLabConfigJSON;
+  pre_upload_commands?: PreUploadConfigJSON;
+}
+
 export interface GitCheckoutGitHubFileParentConfig {
   gitCheckout?: GitCheckoutGitHubParentConfig;
   preUploadSteps?: PreUploadStep[];
@@ -528,6 +630,26 @@ interface FreeTypeParentConfigJSON {
   gitiles?: GitilesParentConfigJSON;
 }
 
+export interface BazelParentConfig {
+  gitiles?: GitilesParentConfig;
+}
+
+interface BazelParentConfigJSON {
+  gitiles?: GitilesParentConfigJSON;
+}
+
+export interface PackageRegistryParentConfig {
+  gitiles?: GitilesParentConfig;
+  registryUrl: string;
+  lockfile: string;
+}
+
+interface PackageRegistryParentConfigJSON {
+  gitiles?: GitilesParentConfigJSON;
+  registry_url?: string;
+  lockfile?: string;
+}
+
 export interface CIPDChildConfig {
   name: string;
   tag: string;
@@ -628,6 +750,22 @@ interface DockerChildConfigJSON {
   tag?: string;
 }
 
+export interface PackageRegistryChildConfig {
+  registry: PackageRegistry;
+  registryUrl: string;
+  packageName: string;
+  versionConstraint: string;
+  includePrereleases: boolean;
+}
+
+interface PackageRegistryChildConfigJSON {
+  registry?: string;
+  registry_url?: string;
+  package_name?: string;
+  version_constraint?: string;
+  include_prereleases?: boolean;
+}
+
 export interface NotifierConfig {
   logLevel: NotifierConfig_LogLevel;
   msgType?: NotifierConfig_MsgType[];
@@ -698,6 +836,44 @@ interface ThrottleConfigJSON {
   time_window?: string;
 }
 
+export interface StrategyOptions {
+  timeBatchInterval: string;
+  maxFilesChanged: number;
+  maxLinesChanged: number;
+}
+
+interface StrategyOptionsJSON {
+  time_batch_interval?: string;
+  max_files_changed?: number;
+  max_lines_changed?: number;
+}
+
+export interface AutoRevertConfig {
+  window: string;
+  treeStatusUrl: string;
+  taskScheduler?: AutoRevertTaskSchedulerConfig;
+  healthCheckUrl?: string[];
+}
+
+interface AutoRevertConfigJSON {
+  window?: string;
+  tree_status_url?: string;
+  task_scheduler?: AutoRevertTaskSchedulerConfigJSON;
+  health_check_url?: string[];
+}
+
+export interface AutoRevertTaskSchedulerConfig {
+  host: string;
+  repo: string;
+  jobs?: string[];
+}
+
+interface AutoRevertTaskSchedulerConfigJSON {
+  host?: string;
+  repo?: string;
+  jobs?: string[];
+}
+
 export interface TransitiveDepConfig {
   child?: VersionFileConfig;
   parent?: VersionFileConfig;
@@ -786,6 +962,40 @@ interface ValidHttpRevisionFilterConfigJSON {
   regex?: string;
 }
 
+export interface SignatureRevisionFilterConfig {
+  publicKey?: string[];
+  git?: GitSignatureConfig;
+  artifact?: ArtifactSignatureConfig;
+}
+
+interface SignatureRevisionFilterConfigJSON {
+  public_key?: string[];
+  git?: GitSignatureConfigJSON;
+  artifact?: ArtifactSignatureConfigJSON;
+}
+
+export interface GitSignatureConfig {
+  repoUrl: string;
+  tagRegex: string;
+}
+
+interface GitSignatureConfigJSON {
+  repo_url?: string;
+  tag_regex?: string;
+}
+
+export interface ArtifactSignatureConfig {
+  format: ArtifactSignatureConfig_Format;
+  signatureUrlTmpl: string;
+  artifactUrlTmpl: string;
+}
+
+interface ArtifactSignatureConfigJSON {
+  format?: string;
+  signature_url_tmpl?: string;
+  artifact_url_tmpl?: string;
+}
+
 export interface PreUploadConfig {
   cipdPackage?: PreUploadCIPDPackageConfig[];
   command?: PreUploadCommandConfig[];
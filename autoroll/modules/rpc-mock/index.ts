@@ -42,9 +42,13 @@ import {
   AddCleanupRequestResponse,
   GetCleanupHistoryRequest,
   GetCleanupHistoryResponse,
+  GetRollerGraphRequest,
+  GetRollerGraphResponse,
   GetRollsRequest,
   GetRollsResponse,
   CleanupRequest,
+  RollerGraphEdge,
+  RollerGraphNode,
 } from '../rpc/rpc';
 
 export * from './fake-status';
@@ -240,4 +244,26 @@ class FakeAutoRollService implements AutoRollService {
       history: this.cleanupRequests,
     });
   }
+
+  getRollerGraph(_: GetRollerGraphRequest): Promise<GetRollerGraphResponse> {
+    const nodes: { [id: string]: RollerGraphNode } = {};
+    const edges: RollerGraphEdge[] = GetFakeMiniStatuses().map((st) => {
+      nodes[st.childName] = { id: st.childName, displayName: st.childName };
+      nodes[st.parentName] = { id: st.parentName, displayName: st.parentName };
+      return {
+        childId: st.childName,
+        parentId: st.parentName,
+        rollerId: st.rollerId,
+        transitive: false,
+        numBehind: st.numBehind,
+        secondsBehind: st.numBehind * 60 * 60,
+        stuck: st.numBehind > 0 && st.mode !== Mode.RUNNING,
+        blockedBy: [],
+      };
+    });
+    return Promise.resolve({
+      nodes: Object.values(nodes),
+      edges: edges,
+    });
+  }
 }
@@ -11,6 +11,9 @@ export enum Strategy {
   BATCH = "BATCH",
   N_BATCH = "N_BATCH",
   SINGLE = "SINGLE",
+  TIME_BATCH = "TIME_BATCH",
+  SIZE_BATCH = "SIZE_BATCH",
+  BISECT = "BISECT",
 }
 
 export enum TryJob_Result {
@@ -760,6 +763,86 @@ const JSONToCleanupRequest = (m: CleanupRequestJSON): CleanupRequest => {
   };
 };
 
+export interface RollerGraphNode {
+  id: string;
+  displayName: string;
+}
+
+interface RollerGraphNodeJSON {
+  id?: string;
+  display_name?: string;
+}
+
+const JSONToRollerGraphNode = (m: RollerGraphNodeJSON): RollerGraphNode => {
+  return {
+    id: m.id || "",
+    displayName: m.display_name || "",
+  };
+};
+
+export interface RollerGraphEdge {
+  childId: string;
+  parentId: string;
+  rollerId: string;
+  transitive: boolean;
+  numBehind: number;
+  secondsBehind: number;
+  stuck: boolean;
+  blockedBy?: string[];
+}
+
+interface RollerGraphEdgeJSON {
+  child_id?: string;
+  parent_id?: string;
+  roller_id?: string;
+  transitive?: boolean;
+  num_behind?: number;
+  seconds_behind?: number;
+  stuck?: boolean;
+  blocked_by?: string[];
+}
+
+const JSONToRollerGraphEdge = (m: RollerGraphEdgeJSON): RollerGraphEdge => {
+  return {
+    childId: m.child_id || "",
+    parentId: m.parent_id || "",
+    rollerId: m.roller_id || "",
+    transitive: m.transitive || false,
+    numBehind: m.num_behind || 0,
+    secondsBehind: m.seconds_behind || 0,
+    stuck: m.stuck || false,
+    blockedBy: m.blocked_by,
+  };
+};
+
+export interface GetRollerGraphRequest {
+}
+
+interface GetRollerGraphRequestJSON {
+}
+
+const GetRollerGraphRequestToJSON = (m: GetRollerGraphRequest): GetRollerGraphRequestJSON => {
+  return {
+  };
+};
+
+export interface GetRollerGraphResponse {
+  nodes?: RollerGraphNode[];
+  edges?: RollerGraphEdge[];
+}
+
+interface GetRollerGraphResponseJSON {
+  nodes?: RollerGraphNodeJSON[];
+  edges?: RollerGraphEdgeJSON[];
+}
+
+const JSONToGetRollerGraphResponse = (m: GetRollerGraphResponseJSON): GetRollerGraphResponse => {
+  return {
+    nodes: m.nodes && m.nodes.map(JSONToRollerGraphNode),
+    edges: m.edges && m.edges.map(JSONToRollerGraphEdge),
+  };
+};
+
 export interface AutoRollService {
   addCleanupRequest: (addCleanupRequestRequest: AddCleanupRequestRequest) => Promise<AddCleanupRequestResponse>;
   getCleanupHistory: (getCleanupHistoryRequest: GetCleanupHistoryRequest) => Promise<GetCleanupHistoryResponse>;
@@ -773,6 +856,7 @@ export interface AutoRollService {
   getStrategyHistory: (getStrategyHistoryRequest: GetStrategyHistoryRequest) => Promise<GetStrategyHistoryResponse>;
   createManualRoll: (createManualRollRequest: CreateManualRollRequest) => Promise<CreateManualRollResponse>;
   unthrottle: (unthrottleRequest: UnthrottleRequest) => Promise<UnthrottleResponse>;
+  getRollerGraph: (getRollerGraphRequest: GetRollerGraphRequest) => Promise<GetRollerGraphResponse>;
 }
 
 export class AutoRollServiceClient implements AutoRollService {
@@ -968,4 +1052,19 @@ export class AutoRollServiceClient implements AutoRollService {
       return resp.json().then(JSONToUnthrottleResponse);
     });
   }
+
+  getRollerGraph(getRollerGraphRequest: GetRollerGraphRequest): Promise<GetRollerGraphResponse> {
+    const url = this.hostname + this.pathPrefix + "GetRollerGraph";
+    let body: GetRollerGraphRequest | GetRollerGraphRequestJSON = getRollerGraphRequest;
+    if (!this.writeCamelCase) {
+      body = GetRollerGraphRequestToJSON(getRollerGraphRequest);
+    }
+    return this.fetch(createTwirpRequest(url, body, this.optionsOverride)).then((resp) => {
+      if (!resp.ok) {
+        return throwTwirpError(resp);
+      }
+
+      return resp.json().then(JSONToGetRollerGraphResponse);
+    });
+  }
 }
@@ -274,6 +274,74 @@ func computeJobFailureMishapRate(ev []*events.Event) ([]map[string]string, []flo
 	return rvTags, rvVals, nil
 }
 
+// computeJobSLAMetrics is an events.DynamicAggregateFn that returns metrics for
+// Job SLA compliance and deadline misses, given a slice of Events created by
+// jobEventDB.update. The first return value will contain the tags "job_name"
+// (types.Job.Name) and "metric" (one of "sla-met-rate", "deadline-exceeded-rate"),
+// and the second return value will be the corresponding ratio of Jobs which met
+// their SLA or exceeded their deadline to all completed Jobs which have an SLA
+// or deadline, respectively. Jobs without an SLA or deadline are ignored.
+// Returns an error if Event.Data can't be GOB-decoded as a types.Job.
+func computeJobSLAMetrics(ev []*events.Event) ([]map[string]string, []float64, error) {
+	if len(ev) > 0 {
+		// ev should be ordered by timestamp
+		sklog.Debugf("Calculating SLA metrics for %d jobs since %s.", len(ev), ev[0].Timestamp)
+	}
+	type jobSum struct {
+		slaMet           int
+		slaCount         int
+		deadlineExceeded int
+		deadlineCount    int
+	}
+	byJob := map[string]*jobSum{}
+	for _, e := range ev {
+		var job types.Job
+		if err := gob.NewDecoder(bytes.NewReader(e.Data)).Decode(&job); err != nil {
+			return nil, nil, err
+		}
+		if job.SLA == 0 && job.Deadline.IsZero() {
+			continue
+		}
+		entry, ok := byJob[job.Name]
+		if !ok {
+			entry = &jobSum{}
+			byJob[job.Name] = entry
+		}
+		if met, ok := job.MetSLA(); ok {
+			entry.slaCount++
+			if met {
+				entry.slaMet++
+			}
+		}
+		if !job.Deadline.IsZero() {
+			entry.deadlineCount++
+			if job.DeadlineExceeded(job.Finished) {
+				entry.deadlineExceeded++
+			}
+		}
+	}
+
+	rvTags := make([]map[string]string, 0, len(byJob)*2)
+	rvVals := make([]float64, 0, len(byJob)*2)
+	add := func(jobName, metric string, value float64) {
+		rvTags = append(rvTags, map[string]string{
+			"job_name": jobName,
+			"job_type": "",
+			"metric":   metric,
+		})
+		rvVals = append(rvVals, value)
+	}
+	for jobName, jobSum := range byJob {
+		if jobSum.slaCount > 0 {
+			add(jobName, "sla-met-rate", float64(jobSum.slaMet)/float64(jobSum.slaCount))
+		}
+		if jobSum.deadlineCount > 0 {
+			add(jobName, "deadline-exceeded-rate", float64(jobSum.deadlineExceeded)/float64(jobSum.deadlineCount))
+		}
+	}
+	return rvTags, rvVals, nil
+}
+
 // isPeriodic returns true if the job runs periodically, as opposed to at every
 // commit.
 // TODO(borenet): We could add a Job.Trigger JobSpec.Trigger field which is
@@ -353,6 +421,11 @@ func addJobAggregates(s *events.EventStream, instance string) error {
 			return err
 		}
 
+		// Job SLA compliance and deadline misses.
+		if err := s.DynamicMetric(map[string]string{"instance": instance}, period, computeJobSLAMetrics); err != nil {
+			return err
+		}
+
 		// Average lag time between commit landing and job creation.
 		if err := s.AggregateMetric(map[string]string{
 			"metric":   MEASUREMENT_JOB_CREATION_LAG,
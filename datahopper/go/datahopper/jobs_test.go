@@ -368,6 +368,69 @@ func TestComputeJobFailureMishapRate(t *testing.T) {
 	tester.Run(evs)
 }
 
+func TestComputeJobSLAMetrics(t *testing.T) {
+	now := time.Now()
+	edb, jdb, wait, cleanup := setupJobs(t, now)
+	defer cleanup()
+	created := now.Add(-time.Hour)
+
+	tester := newDynamicAggregateFnTester(t, computeJobSLAMetrics)
+	expect := func(jobName string, metric string, numer, denom int) {
+		tester.AddAssert(map[string]string{
+			"job_name": jobName,
+			"job_type": "",
+			"metric":   metric,
+		}, float64(numer)/float64(denom))
+	}
+
+	jobCount := 0
+	addJob := func(name string, status types.JobStatus, duration, sla, deadline time.Duration) {
+		jobCount++
+		j := makeJob(created, name, status, NORMAL, duration)
+		j.SLA = sla
+		if deadline != 0 {
+			j.Deadline = created.Add(deadline)
+		}
+		require.NoError(t, jdb.PutJob(context.Background(), j))
+		<-wait
+	}
+
+	{
+		name := "SLAOnly"
+		addJob(name, types.JOB_STATUS_SUCCESS, 10*time.Minute, 20*time.Minute, 0)
+		addJob(name, types.JOB_STATUS_SUCCESS, 30*time.Minute, 20*time.Minute, 0)
+		addJob(name, types.JOB_STATUS_FAILURE, 10*time.Minute, 20*time.Minute, 0)
+		addJob(name, types.JOB_STATUS_SUCCESS, 20*time.Minute, 20*time.Minute, 0)
+		expect(name, "sla-met-rate", 2, 4)
+	}
+	{
+		name := "DeadlineOnly"
+		addJob(name, types.JOB_STATUS_SUCCESS, 10*time.Minute, 0, 20*time.Minute)
+		addJob(name, types.JOB_STATUS_CANCELED, 21*time.Minute, 0, 20*time.Minute)
+		expect(name, "deadline-exceeded-rate", 1, 2)
+	}
+	{
+		name := "SLAAndDeadline"
+		addJob(name, types.JOB_STATUS_SUCCESS, 10*time.Minute, 15*time.Minute, 20*time.Minute)
+		addJob(name, types.JOB_STATUS_SUCCESS, 16*time.Minute, 15*time.Minute, 20*time.Minute)
+		addJob(name, types.JOB_STATUS_CANCELED, 21*time.Minute, 15*time.Minute, 20*time.Minute)
+		expect(name, "sla-met-rate", 1, 3)
+		expect(name, "deadline-exceeded-rate", 1, 3)
+	}
+	{
+		// Jobs with neither an SLA nor a deadline produce no metrics.
+		name := "Neither"
+		addJob(name, types.JOB_STATUS_SUCCESS, 10*time.Minute, 0, 0)
+	}
+
+	require.NoError(t, edb.update())
+	evs, err := edb.Range(JOB_STREAM, created.Add(-time.Hour), created.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, evs, jobCount)
+
+	tester.Run(evs)
+}
+
 func TestOverdueJobSpecMetrics(t *testing.T) {
 
 	wd, err := os.MkdirTemp("", "")
@@ -34,6 +34,7 @@ import (
 	"go.skia.org/infra/go/taskname"
 	"go.skia.org/infra/go/util"
 	"go.skia.org/infra/perf/go/perfclient"
+	bigtable_db "go.skia.org/infra/task_driver/go/db/bigtable"
 	"go.skia.org/infra/task_scheduler/go/db/cache"
 	"go.skia.org/infra/task_scheduler/go/db/firestore"
 	"go.skia.org/infra/task_scheduler/go/task_cfg_cache"
@@ -66,6 +67,7 @@ var (
 	repoUrls           = common.NewMultiStringFlag("repo", nil, "Repositories to query for status.")
 	swarmingServer     = flag.String("swarming_server", "", "Host name of the Swarming server.")
 	swarmingPools      = common.NewMultiStringFlag("swarming_pool", nil, "Swarming pools to use.")
+	taskDriverInstance = flag.String("task_driver_bigtable_instance", "", "BigTable instance used for Task Drivers. If set, report the test summaries of Task Drivers.")
 )
 
 func main() {
@@ -190,6 +192,15 @@ func main() {
 		sklog.Fatal(err)
 	}
 
+	// Test summaries reported by Task Drivers.
+	if *taskDriverInstance != "" {
+		tdDB, err := bigtable_db.NewBigTableDB(ctx, *btProject, *taskDriverInstance, ts)
+		if err != nil {
+			sklog.Fatalf("Failed to create Task Driver DB: %s", err)
+		}
+		StartTestSummaryMetrics(ctx, tCache, tdDB)
+	}
+
 	// Generate "time to X% bot coverage" metrics.
 	if err := bot_metrics.Start(ctx, tCache, repos, tcc, *btProject, *btInstance, ts); err != nil {
 		sklog.Fatal(err)
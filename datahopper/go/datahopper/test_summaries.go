@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"go.skia.org/infra/go/metrics2"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+	task_driver_db "go.skia.org/infra/task_driver/go/db"
+	"go.skia.org/infra/task_scheduler/go/db/cache"
+	"go.skia.org/infra/task_scheduler/go/types"
+)
+
+const (
+	// TEST_SUMMARY_PERIOD is how far back we look for finished tasks whose
+	// test summaries we should report.
+	TEST_SUMMARY_PERIOD = 24 * time.Hour
+
+	measurementTestSummaryTests    = "task_driver_tests"
+	measurementTestSummaryDuration = "task_driver_tests_duration_ms"
+)
+
+// testSummaryMetrics reports the test results which Task Drivers attach to
+// their steps, see td.TestSummaryData, for the most recent finished run of each
+// task on a non-try commit.
+type testSummaryMetrics struct {
+	tCache cache.TaskCache
+	tdDB   task_driver_db.DB
+	// latest maps task names to the most recent task whose Task Driver we
+	// have already looked at.
+	latest map[string]*types.Task
+}
+
+// update loads the Task Drivers of any tasks which finished since the last
+// update and reports their test summaries.
+func (m *testSummaryMetrics) update(ctx context.Context) error {
+	defer metrics2.FuncTimer().Stop()
+	if err := m.tCache.Update(ctx); err != nil {
+		return skerr.Wrapf(err, "failed to update cache")
+	}
+	now := time.Now()
+	tasks, err := m.tCache.GetTasksFromDateRange(now.Add(-TEST_SUMMARY_PERIOD), now)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to load tasks from %s to %s", now.Add(-TEST_SUMMARY_PERIOD), now)
+	}
+	newest := map[string]*types.Task{}
+	for _, task := range tasks {
+		if !task.Done() || task.IsTryJob() || task.IsForceRun() {
+			continue
+		}
+		if prev, ok := newest[task.Name]; !ok || task.Created.After(prev.Created) {
+			newest[task.Name] = task
+		}
+	}
+	for name, task := range newest {
+		if prev, ok := m.latest[name]; ok && !task.Created.After(prev.Created) {
+			continue
+		}
+		run, err := m.tdDB.GetTaskDriver(ctx, task.Id)
+		if err != nil {
+			return skerr.Wrapf(err, "failed to load Task Driver for task %s", task.Id)
+		}
+		m.latest[name] = task
+		if run == nil {
+			// Not all tasks are Task Drivers.
+			continue
+		}
+		summary, err := run.TestSummary()
+		if err != nil {
+			return skerr.Wrapf(err, "failed to read test summary of task %s", task.Id)
+		}
+		if summary == nil {
+			continue
+		}
+		for result, count := range map[string]int{
+			"total":   summary.Total,
+			"passed":  summary.Passed,
+			"failed":  summary.Failed,
+			"skipped": summary.Skipped,
+		} {
+			metrics2.GetInt64Metric(measurementTestSummaryTests, map[string]string{
+				"task_name": name,
+				"result":    result,
+			}).Update(int64(count))
+		}
+		metrics2.GetInt64Metric(measurementTestSummaryDuration, map[string]string{
+			"task_name": name,
+		}).Update(summary.DurationMs)
+	}
+	return nil
+}
+
+// StartTestSummaryMetrics starts a goroutine which ingests the test summaries
+// reported by Task Drivers.
+func StartTestSummaryMetrics(ctx context.Context, tCache cache.TaskCache, tdDB task_driver_db.DB) {
+	m := &testSummaryMetrics{
+		tCache: tCache,
+		tdDB:   tdDB,
+		latest: map[string]*types.Task{},
+	}
+	lv := metrics2.NewLiveness("last_successful_test_summary_metrics_update")
+	go util.RepeatCtx(ctx, 5*time.Minute, func(ctx context.Context) {
+		if err := m.update(ctx); err != nil {
+			sklog.Errorf("Failed to update test summary metrics: %s", err)
+		} else {
+			lv.Reset()
+		}
+	})
+}
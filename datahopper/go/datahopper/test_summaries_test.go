@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/metrics2/testutils"
+	task_driver_memory "go.skia.org/infra/task_driver/go/db/memory"
+	"go.skia.org/infra/task_driver/go/td"
+	"go.skia.org/infra/task_scheduler/go/types"
+)
+
+func TestTestSummaryUpdate_ReportsMostRecentTaskDriver(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	edb, tdb, wait, cancel := setupTasks(t, now)
+	defer cancel()
+	tdDB, err := task_driver_memory.NewInMemoryDB(filepath.Join(t.TempDir(), "td.gob"))
+	require.NoError(t, err)
+
+	tasks := []*types.Task{
+		makeTask(now.Add(-3*time.Hour), "TestSummary-Task", types.TASK_STATUS_SUCCESS),
+		makeTask(now.Add(-2*time.Hour), "TestSummary-Task", types.TASK_STATUS_FAILURE),
+		// Not yet finished, so ignored.
+		makeTask(now.Add(-time.Hour), "TestSummary-Task", types.TASK_STATUS_RUNNING),
+	}
+	require.NoError(t, tdb.PutTasks(ctx, tasks))
+	<-wait
+
+	addSummary := func(task *types.Task, stepId string, summary *td.TestSummaryData) {
+		require.NoError(t, tdDB.UpdateTaskDriver(ctx, task.Id, &td.Message{
+			ID:        stepId,
+			TaskId:    task.Id,
+			StepId:    stepId,
+			Timestamp: task.Created,
+			Type:      td.MsgType_StepData,
+			DataType:  td.DataType_TestSummary,
+			Data:      summary,
+		}))
+	}
+	addSummary(tasks[0], "step", &td.TestSummaryData{Total: 1, Passed: 1})
+	addSummary(tasks[1], "step1", &td.TestSummaryData{Total: 3, Passed: 1, Failed: 1, Skipped: 1, DurationMs: 100, FailedTests: []string{"TestA"}})
+	addSummary(tasks[1], "step2", &td.TestSummaryData{Total: 2, Failed: 2, DurationMs: 50, FailedTests: []string{"TestB", "TestC"}})
+	addSummary(tasks[2], "step", &td.TestSummaryData{Total: 5, Passed: 5})
+
+	m := &testSummaryMetrics{
+		tCache: edb.tCache,
+		tdDB:   tdDB,
+		latest: map[string]*types.Task{},
+	}
+	require.NoError(t, m.update(ctx))
+
+	check := func(result, expect string) {
+		require.Equal(t, expect, testutils.GetRecordedMetric(t, measurementTestSummaryTests, map[string]string{
+			"task_name": "TestSummary-Task",
+			"result":    result,
+		}))
+	}
+	check("total", "5")
+	check("passed", "1")
+	check("failed", "3")
+	check("skipped", "1")
+	require.Equal(t, "150", testutils.GetRecordedMetric(t, measurementTestSummaryDuration, map[string]string{
+		"task_name": "TestSummary-Task",
+	}))
+	require.Equal(t, tasks[1].Id, m.latest["TestSummary-Task"].Id)
+}
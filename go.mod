@@ -86,6 +86,7 @@ require (
 	go.opencensus.io v0.24.0
 	go.temporal.io/api v1.26.2
 	go.temporal.io/sdk v1.25.2-0.20240108215803-6244097c5aca
+	golang.org/x/crypto v0.18.0
 	golang.org/x/exp v0.0.0-20231127185646-65229373498e
 	golang.org/x/net v0.20.0
 	golang.org/x/oauth2 v0.16.0
@@ -221,7 +222,6 @@ require (
 	go.opentelemetry.io/otel/metric v1.22.0 // indirect
 	go.opentelemetry.io/otel/trace v1.22.0 // indirect
 	go.uber.org/atomic v1.9.0 // indirect
-	golang.org/x/crypto v0.18.0 // indirect
 	golang.org/x/mod v0.14.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
 	golang.org/x/tools/go/vcs v0.1.0-deprecated // indirect
@@ -16,6 +16,7 @@ import (
 	"go.skia.org/infra/go/buildbucket"
 	"go.skia.org/infra/go/comment"
 	"go.skia.org/infra/go/github"
+	"go.skia.org/infra/go/gitlab"
 	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/go/util"
@@ -80,7 +81,9 @@ type AutoRollIssue struct {
 	Closed         bool               `json:"closed"`
 	Comments       []*comment.Comment `json:"comments"`
 	Committed      bool               `json:"committed"`
+	CommitHash     string             `json:"commitHash,omitempty"`
 	Created        time.Time          `json:"created"`
+	FanOutIssues   []int64            `json:"fanOutIssues,omitempty"`
 	IsDryRun       bool               `json:"isDryRun"`
 	DryRunFinished bool               `json:"dryRunFinished"`
 	DryRunSuccess  bool               `json:"dryRunSuccess"`
@@ -129,6 +132,11 @@ func (i *AutoRollIssue) Copy() *AutoRollIssue {
 			commentsCpy = append(commentsCpy, c.Copy())
 		}
 	}
+	var fanOutIssuesCpy []int64
+	if i.FanOutIssues != nil {
+		fanOutIssuesCpy = make([]int64, len(i.FanOutIssues))
+		copy(fanOutIssuesCpy, i.FanOutIssues)
+	}
 	var patchsetsCpy []int64
 	if i.Patchsets != nil {
 		patchsetsCpy = make([]int64, len(i.Patchsets))
@@ -147,11 +155,13 @@ func (i *AutoRollIssue) Copy() *AutoRollIssue {
 		Closed:         i.Closed,
 		Comments:       commentsCpy,
 		Committed:      i.Committed,
+		CommitHash:     i.CommitHash,
 		Created:        i.Created,
 		CqFinished:     i.CqFinished,
 		CqSuccess:      i.CqSuccess,
 		DryRunFinished: i.DryRunFinished,
 		DryRunSuccess:  i.DryRunSuccess,
+		FanOutIssues:   fanOutIssuesCpy,
 		IsDryRun:       i.IsDryRun,
 		Issue:          i.Issue,
 		Manual:         i.Manual,
@@ -396,6 +406,51 @@ func TryResultsFromGithubChecks(checks []*github.Check, checksWaitFor []string)
 	return tryResults
 }
 
+// TryResultsFromGitLabJobs returns TryResults based on the jobs of a GitLab
+// pipeline. Manual and skipped jobs are ignored, since they do not block the
+// pipeline, and failures of jobs which are allowed to fail are treated as
+// successes.
+func TryResultsFromGitLabJobs(jobs []*gitlab.Job) []*TryResult {
+	tryResults := []*TryResult{}
+	for _, job := range jobs {
+		status := ""
+		result := ""
+		switch job.Status {
+		case gitlab.STATUS_MANUAL, gitlab.STATUS_SKIPPED:
+			continue
+		case gitlab.STATUS_CREATED, gitlab.STATUS_WAITING_FOR_RESOURCE, gitlab.STATUS_PREPARING, gitlab.STATUS_PENDING, gitlab.STATUS_SCHEDULED:
+			status = TRYBOT_STATUS_SCHEDULED
+		case gitlab.STATUS_RUNNING:
+			status = TRYBOT_STATUS_STARTED
+		case gitlab.STATUS_SUCCESS:
+			status = TRYBOT_STATUS_COMPLETED
+			result = TRYBOT_RESULT_SUCCESS
+		case gitlab.STATUS_FAILED:
+			status = TRYBOT_STATUS_COMPLETED
+			result = TRYBOT_RESULT_FAILURE
+			if job.AllowFailure {
+				result = TRYBOT_RESULT_SUCCESS
+			}
+		case gitlab.STATUS_CANCELED:
+			status = TRYBOT_STATUS_COMPLETED
+			result = TRYBOT_RESULT_CANCELED
+		default:
+			sklog.Warningf("Unknown status %q for GitLab job %d", job.Status, job.ID)
+			status = TRYBOT_STATUS_STARTED
+		}
+		tryResults = append(tryResults, &TryResult{
+			Builder:  job.Name,
+			Category: TRYBOT_CATEGORY_CQ,
+			Created:  job.CreatedAt,
+			Result:   result,
+			Status:   status,
+			Url:      job.WebURL,
+		})
+	}
+	sort.Sort(tryResultSlice(tryResults))
+	return tryResults
+}
+
 // Finished returns true iff the trybot is done running.
 func (t TryResult) Finished() bool {
 	return t.Status == TRYBOT_STATUS_COMPLETED
@@ -11,6 +11,7 @@ import (
 	"go.skia.org/infra/go/comment"
 	"go.skia.org/infra/go/deepequal/assertdeep"
 	"go.skia.org/infra/go/github"
+	"go.skia.org/infra/go/gitlab"
 )
 
 func TestAutoRollIssueCopy(t *testing.T) {
@@ -27,11 +28,13 @@ func TestAutoRollIssueCopy(t *testing.T) {
 			},
 		},
 		Committed:      true,
+		CommitHash:     "0123abcd",
 		Created:        time.Now(),
 		CqFinished:     true,
 		CqSuccess:      true,
 		DryRunFinished: true,
 		DryRunSuccess:  true,
+		FanOutIssues:   []int64{124, 125},
 		IsDryRun:       true,
 		Issue:          123,
 		Manual:         true,
@@ -265,3 +268,31 @@ func TestTryResultsFromGithubChecks(t *testing.T) {
 	require.Equal(t, "", tryResults[2].Result)
 	require.Equal(t, TRYBOT_STATUS_STARTED, tryResults[2].Status)
 }
+
+func TestTryResultsFromGitLabJobs(t *testing.T) {
+	jobs := []*gitlab.Job{
+		{ID: 1, Name: "e-running", Status: gitlab.STATUS_RUNNING},
+		{ID: 2, Name: "d-failed", Status: gitlab.STATUS_FAILED, WebURL: "https://gitlab/job/2"},
+		{ID: 3, Name: "c-allowed-failure", Status: gitlab.STATUS_FAILED, AllowFailure: true},
+		{ID: 4, Name: "b-success", Status: gitlab.STATUS_SUCCESS},
+		{ID: 5, Name: "a-pending", Status: gitlab.STATUS_PENDING},
+		{ID: 6, Name: "manual", Status: gitlab.STATUS_MANUAL},
+		{ID: 7, Name: "skipped", Status: gitlab.STATUS_SKIPPED},
+		{ID: 8, Name: "f-canceled", Status: gitlab.STATUS_CANCELED},
+	}
+	tryResults := TryResultsFromGitLabJobs(jobs)
+	require.Len(t, tryResults, 6)
+	check := func(tr *TryResult, builder, status, result string) {
+		require.Equal(t, builder, tr.Builder)
+		require.Equal(t, TRYBOT_CATEGORY_CQ, tr.Category)
+		require.Equal(t, status, tr.Status)
+		require.Equal(t, result, tr.Result)
+	}
+	check(tryResults[0], "a-pending", TRYBOT_STATUS_SCHEDULED, "")
+	check(tryResults[1], "b-success", TRYBOT_STATUS_COMPLETED, TRYBOT_RESULT_SUCCESS)
+	check(tryResults[2], "c-allowed-failure", TRYBOT_STATUS_COMPLETED, TRYBOT_RESULT_SUCCESS)
+	check(tryResults[3], "d-failed", TRYBOT_STATUS_COMPLETED, TRYBOT_RESULT_FAILURE)
+	require.Equal(t, "https://gitlab/job/2", tryResults[3].Url)
+	check(tryResults[4], "e-running", TRYBOT_STATUS_STARTED, "")
+	check(tryResults[5], "f-canceled", TRYBOT_STATUS_COMPLETED, TRYBOT_RESULT_CANCELED)
+}
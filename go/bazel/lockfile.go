@@ -0,0 +1,63 @@
+package bazel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+// GetRegistryFileFunc retrieves the contents of a file from a Bazel registry.
+type GetRegistryFileFunc func(ctx context.Context, url string) ([]byte, error)
+
+// UpdateLockfile updates the entries in the given MODULE.bazel.lock contents
+// which refer to the given version of a registry module, so that they refer to
+// the new version instead. The hashes of the module's registry files are
+// recomputed by retrieving them using getRegistryFile. Returns the new contents
+// of the lockfile.
+//
+// Note that this does not resolve any changes to the dependency graph which
+// result from the new version of the module; Bazel updates those entries the
+// next time it runs with --lockfile_mode=update.
+func UpdateLockfile(ctx context.Context, content string, module DependencyID, oldVersion, newVersion string, getRegistryFile GetRegistryFileFunc) (string, error) {
+	if oldVersion == newVersion {
+		return content, nil
+	}
+
+	// Update the hashes of registry files, eg.
+	//
+	//     "https://bcr.bazel.build/modules/rules_go/0.41.0/MODULE.bazel": "<sha256>",
+	//
+	oldPath := fmt.Sprintf("/modules/%s/%s/", module, oldVersion)
+	newPath := fmt.Sprintf("/modules/%s/%s/", module, newVersion)
+	hashRegex, err := regexp.Compile(`(?m)^(\s*)"([^"]*` + regexp.QuoteMeta(oldPath) + `[^"]*)": "[0-9a-f]{64}"(,?)$`)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	var fetchErr error
+	content = hashRegex.ReplaceAllStringFunc(content, func(line string) string {
+		if fetchErr != nil {
+			return line
+		}
+		m := hashRegex.FindStringSubmatch(line)
+		newURL := strings.Replace(m[2], oldPath, newPath, 1)
+		contents, err := getRegistryFile(ctx, newURL)
+		if err != nil {
+			fetchErr = skerr.Wrapf(err, "failed to retrieve %s", newURL)
+			return line
+		}
+		sum := sha256.Sum256(contents)
+		return fmt.Sprintf(`%s"%s": "%s"%s`, m[1], newURL, hex.EncodeToString(sum[:]), m[3])
+	})
+	if fetchErr != nil {
+		return "", fetchErr
+	}
+
+	// Update references to the module by name and version, which are used as
+	// keys in older lockfile versions, eg. "rules_go@0.41.0".
+	return strings.ReplaceAll(content, fmt.Sprintf(`"%s@%s"`, module, oldVersion), fmt.Sprintf(`"%s@%s"`, module, newVersion)), nil
+}
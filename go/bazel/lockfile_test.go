@@ -0,0 +1,79 @@
+package bazel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const fakeLockfile = `{
+  "lockFileVersion": 6,
+  "registryFileHashes": {
+    "https://bcr.bazel.build/bazel_registry.json": "8a28e4aff06ee60aed2a8c281907fb8bcbf3b753c91fb5a5c57da3215d5b3497",
+    "https://bcr.bazel.build/modules/gazelle/0.32.0/MODULE.bazel": "b499f58a5d0d3537f3cf5b76d8ada18242f64ec474d8391247438bf04f58c7b8",
+    "https://bcr.bazel.build/modules/rules_go/0.41.0/MODULE.bazel": "3477df8bdcc49e698b9d25f734c4f3a9f5931ff34ee48a2c662be168f5f2d3fd",
+    "https://bcr.bazel.build/modules/rules_go/0.41.0/source.json": "cfe8fd1a2ea8e8e5b3d1e8fdbe5c5bd4e7b2c2e5e0c6d14ef2cbd81bbd8b9a1b"
+  },
+  "moduleDepGraph": {
+    "rules_go@0.41.0": {
+      "name": "rules_go",
+      "version": "0.41.0"
+    }
+  }
+}
+`
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUpdateLockfile(t *testing.T) {
+	ctx := context.Background()
+	var fetched []string
+	getRegistryFile := func(ctx context.Context, url string) ([]byte, error) {
+		fetched = append(fetched, url)
+		return []byte("contents of " + url), nil
+	}
+	actual, err := UpdateLockfile(ctx, fakeLockfile, "rules_go", "0.41.0", "0.42.0", getRegistryFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"https://bcr.bazel.build/modules/rules_go/0.42.0/MODULE.bazel",
+		"https://bcr.bazel.build/modules/rules_go/0.42.0/source.json",
+	}, fetched)
+	require.Equal(t, `{
+  "lockFileVersion": 6,
+  "registryFileHashes": {
+    "https://bcr.bazel.build/bazel_registry.json": "8a28e4aff06ee60aed2a8c281907fb8bcbf3b753c91fb5a5c57da3215d5b3497",
+    "https://bcr.bazel.build/modules/gazelle/0.32.0/MODULE.bazel": "b499f58a5d0d3537f3cf5b76d8ada18242f64ec474d8391247438bf04f58c7b8",
+    "https://bcr.bazel.build/modules/rules_go/0.42.0/MODULE.bazel": "`+sha256Hex([]byte("contents of https://bcr.bazel.build/modules/rules_go/0.42.0/MODULE.bazel"))+`",
+    "https://bcr.bazel.build/modules/rules_go/0.42.0/source.json": "`+sha256Hex([]byte("contents of https://bcr.bazel.build/modules/rules_go/0.42.0/source.json"))+`"
+  },
+  "moduleDepGraph": {
+    "rules_go@0.42.0": {
+      "name": "rules_go",
+      "version": "0.41.0"
+    }
+  }
+}
+`, actual)
+}
+
+func TestUpdateLockfile_UnknownModule_NoChanges(t *testing.T) {
+	actual, err := UpdateLockfile(context.Background(), fakeLockfile, "rules_python", "0.1.0", "0.2.0", func(ctx context.Context, url string) ([]byte, error) {
+		return nil, errors.New("unexpected fetch")
+	})
+	require.NoError(t, err)
+	require.Equal(t, fakeLockfile, actual)
+}
+
+func TestUpdateLockfile_FetchError(t *testing.T) {
+	_, err := UpdateLockfile(context.Background(), fakeLockfile, "rules_go", "0.41.0", "0.42.0", func(ctx context.Context, url string) ([]byte, error) {
+		return nil, errors.New("not found")
+	})
+	require.ErrorContains(t, err, "not found")
+}
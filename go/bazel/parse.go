@@ -1,7 +1,12 @@
 package bazel
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/go-python/gpython/ast"
@@ -16,9 +21,49 @@ func IsBazelFile(file string) bool {
 		strings.HasSuffix(file, ".bzl")
 }
 
+// IsModuleFile returns true if the filename looks like a Bazel module file,
+// ie. MODULE.bazel.
+func IsModuleFile(file string) bool {
+	return file == ModuleFileName || strings.HasSuffix(file, "/"+ModuleFileName)
+}
+
+const (
+	// ModuleFileName is the name of the file which defines a Bazel module.
+	ModuleFileName = "MODULE.bazel"
+	// LockfileName is the name of the lockfile which accompanies
+	// ModuleFileName.
+	LockfileName = "MODULE.bazel.lock"
+)
+
+var (
+	// archiveCommitRegex matches a commit hash in the URL of an archive.
+	archiveCommitRegex = regexp.MustCompile(`\b[0-9a-f]{40}\b`)
+	// archiveVersionRegex matches a version number in the URL of an archive.
+	archiveVersionRegex = regexp.MustCompile(`v?\d+(?:\.\d+)+`)
+)
+
 // DependencyID represents the unique identifier for a dependency.
 type DependencyID string
 
+// dependencyKind indicates how a Dependency is pinned.
+type dependencyKind int
+
+const (
+	// kindVersion indicates that the Dependency is pinned by a version
+	// argument, along with an optional sha256 argument.
+	kindVersion dependencyKind = iota
+	// kindModule indicates that the Dependency is a module from a Bazel
+	// registry, pinned by bazel_dep or single_version_override.
+	kindModule
+	// kindGitCommit indicates that the Dependency is a module pinned to a
+	// commit by git_override.
+	kindGitCommit
+	// kindArchive indicates that the Dependency is an archive downloaded from
+	// a set of URLs which contain the version, along with a sha256 or
+	// integrity checksum.
+	kindArchive
+)
+
 // Dependency represents one dependency version pin.
 type Dependency struct {
 	ID         DependencyID
@@ -26,6 +71,48 @@ type Dependency struct {
 	versionPos *ast.Pos
 	SHA256     string
 	sha256Pos  *ast.Pos
+
+	// Integrity is the Subresource Integrity checksum of an archive, eg.
+	// "sha256-<base64 digest>".
+	Integrity    string
+	integrityPos *ast.Pos
+	// URLs are the URLs from which an archive is downloaded. Each contains the
+	// Version.
+	URLs           []string
+	urlPos         []*ast.Pos
+	stripPrefixPos *ast.Pos
+	// stripPrefixTrimV indicates that the strip_prefix of an archive contains
+	// the Version without its leading "v", as is the case for GitHub archives
+	// of tags.
+	stripPrefixTrimV bool
+
+	kind dependencyKind
+	// override indicates that the Dependency takes precedence over other pins
+	// of the same module, eg. a git_override for a module which also has a
+	// bazel_dep.
+	override bool
+}
+
+// IsArchive returns true if the Dependency is an archive whose checksum must
+// be recomputed when its version changes.
+func (d Dependency) IsArchive() bool {
+	return d.kind == kindArchive
+}
+
+// IsRegistryModule returns true if the Dependency is a module retrieved from a
+// Bazel registry, in which case it may have entries in the lockfile.
+func (d Dependency) IsRegistryModule() bool {
+	return d.kind == kindModule
+}
+
+// URLsForVersion returns the URLs from which the given version of an archive
+// may be downloaded.
+func (d Dependency) URLsForVersion(version string) []string {
+	rv := make([]string, 0, len(d.URLs))
+	for _, u := range d.URLs {
+		rv = append(rv, strings.ReplaceAll(u, d.Version, version))
+	}
+	return rv
 }
 
 // Validate returns an error if the Dependency is not valid.
@@ -39,15 +126,38 @@ func (d Dependency) Validate() error {
 	if d.versionPos == nil {
 		return skerr.Fmt("versionPos is unset")
 	}
-	if d.SHA256 == "" {
-		return skerr.Fmt("SHA256 is unset")
-	}
-	if d.sha256Pos == nil {
-		return skerr.Fmt("sha256Pos is unset")
+	switch d.kind {
+	case kindVersion:
+		if d.SHA256 == "" {
+			return skerr.Fmt("SHA256 is unset")
+		}
+		if d.sha256Pos == nil {
+			return skerr.Fmt("sha256Pos is unset")
+		}
+	case kindArchive:
+		if d.SHA256 == "" && d.Integrity == "" {
+			return skerr.Fmt("SHA256 or Integrity is required for archives")
+		}
+		if len(d.URLs) == 0 || len(d.URLs) != len(d.urlPos) {
+			return skerr.Fmt("URLs are required for archives")
+		}
 	}
 	return nil
 }
 
+// SHA256ToIntegrity converts a hex-encoded SHA256 digest to a Subresource
+// Integrity string, as used by the "integrity" argument of Bazel rules.
+func SHA256ToIntegrity(sha256Hex string) (string, error) {
+	b, err := hex.DecodeString(sha256Hex)
+	if err != nil {
+		return "", skerr.Wrapf(err, "invalid sha256 %q", sha256Hex)
+	}
+	if len(b) != sha256.Size {
+		return "", skerr.Fmt("invalid sha256 %q; expected %d bytes but got %d", sha256Hex, sha256.Size, len(b))
+	}
+	return "sha256-" + base64.StdEncoding.EncodeToString(b), nil
+}
+
 // GetDep parses the file contents and returns the given dependency.
 func GetDep(content string, dep DependencyID) (Dependency, error) {
 	entries, err := ParseDeps(content)
@@ -68,9 +178,11 @@ func GetDep(content string, dep DependencyID) (Dependency, error) {
 
 // SetDep parses the file contents, updates the version of the given
 // dependency, and returns the new file contents or any error that occurred.
+// The sha256 is required unless the dependency is pinned by version alone, eg.
+// a bazel_dep.
 func SetDep(content string, id DependencyID, version, sha256 string) (string, error) {
-	if version == "" || sha256 == "" {
-		return "", skerr.Fmt("version and sha256 are required")
+	if version == "" {
+		return "", skerr.Fmt("version is required")
 	}
 
 	// Parse the file content.
@@ -85,10 +197,36 @@ func SetDep(content string, id DependencyID, version, sha256 string) (string, er
 		return "", skerr.Fmt("Failed to find dependency with id %q", id)
 	}
 
+	if sha256 == "" && (dep.sha256Pos != nil || dep.integrityPos != nil) {
+		return "", skerr.Fmt("sha256 is required for %q", id)
+	}
+
 	// Replace the old version with the new.
 	lines := strings.Split(content, "\n")
-	updateDep(lines, dep.versionPos, dep.Version, version)
-	updateDep(lines, dep.sha256Pos, dep.SHA256, sha256)
+	if dep.IsArchive() {
+		for _, pos := range dep.urlPos {
+			updateStringLiteral(lines, pos, dep.Version, version)
+		}
+		if dep.stripPrefixPos != nil {
+			if dep.stripPrefixTrimV {
+				updateStringLiteral(lines, dep.stripPrefixPos, strings.TrimPrefix(dep.Version, "v"), strings.TrimPrefix(version, "v"))
+			} else {
+				updateStringLiteral(lines, dep.stripPrefixPos, dep.Version, version)
+			}
+		}
+	} else {
+		updateDep(lines, dep.versionPos, dep.Version, version)
+	}
+	if dep.sha256Pos != nil {
+		updateDep(lines, dep.sha256Pos, dep.SHA256, sha256)
+	}
+	if dep.integrityPos != nil {
+		integrity, err := SHA256ToIntegrity(sha256)
+		if err != nil {
+			return "", skerr.Wrap(err)
+		}
+		updateDep(lines, dep.integrityPos, dep.Integrity, integrity)
+	}
 	return strings.Join(lines, "\n"), nil
 }
 
@@ -100,6 +238,24 @@ func updateDep(contentLines []string, pos *ast.Pos, old, new string) {
 	contentLines[lineIdx] = newLine
 }
 
+// updateStringLiteral replaces all instances of old with new within the string
+// literal at the given position in the given file content lines.
+func updateStringLiteral(contentLines []string, pos *ast.Pos, old, new string) {
+	lineIdx := pos.Lineno - 1 // Lineno starts at 1.
+	line := contentLines[lineIdx]
+	start := pos.ColOffset
+	if start >= len(line) {
+		return
+	}
+	quote := line[start]
+	end := strings.IndexByte(line[start+1:], quote)
+	if end < 0 {
+		return
+	}
+	end += start + 1
+	contentLines[lineIdx] = line[:start] + strings.ReplaceAll(line[start:end], old, new) + line[end:]
+}
+
 // ParseDeps parses the file contents and returns all dependencies, keyed by ID.
 func ParseDeps(content string) (map[DependencyID]Dependency, error) {
 	parsed, err := parser.ParseString(content, "exec")
@@ -138,8 +294,14 @@ func ParseDeps(content string) (map[DependencyID]Dependency, error) {
 					if err := dep.Validate(); err != nil {
 						return nil, skerr.Wrap(err)
 					}
-					if _, ok := deps[dep.ID]; ok {
-						return nil, skerr.Fmt("found multiple entries for %q", dep.ID)
+					if existing, ok := deps[dep.ID]; ok {
+						// Overrides in MODULE.bazel take precedence over the
+						// bazel_dep for the same module.
+						if existing.override == dep.override {
+							return nil, skerr.Fmt("found multiple entries for %q", dep.ID)
+						} else if existing.override {
+							continue
+						}
 					}
 					deps[dep.ID] = dep
 				}
@@ -153,7 +315,12 @@ func ParseDeps(content string) (map[DependencyID]Dependency, error) {
 // version is defined, if a dependency can be found. The boolean return value
 // indicates whether a dependency was found.
 func parseCall(call *ast.Call) (Dependency, bool, error) {
-	funcName := string(call.Func.(*ast.Name).Id)
+	name, ok := call.Func.(*ast.Name)
+	if !ok {
+		// Method calls, eg. module extensions, don't pin dependencies.
+		return Dependency{}, false, nil
+	}
+	funcName := string(name.Id)
 	parseFn := map[string]func(*ast.Call) (Dependency, error){
 		"cipd_install":   parseDepFromCallFunc("cipd_package", "tag"),
 		"container_pull": parseContainerPull,
@@ -161,13 +328,121 @@ func parseCall(call *ast.Call) (Dependency, bool, error) {
 	if parseFn != nil {
 		dep, err := parseFn(call)
 		if err != nil {
-			return Dependency{}, false, skerr.Wrapf(err, "parsing %q call at line %d", funcName, call.Func.(*ast.Name).Lineno)
+			return Dependency{}, false, skerr.Wrapf(err, "parsing %q call at line %d", funcName, name.Lineno)
 		}
 		return dep, true, nil
 	}
+	// The following rules are only treated as dependencies if they provide
+	// everything we need to update them; many uses of these rules pin versions
+	// in ways that we can't roll.
+	optionalParseFn := map[string]func(*ast.Call) (Dependency, bool){
+		"archive_override":        parseArchiveFunc("module_name", true),
+		"bazel_dep":               parseModuleFunc("name", "version", kindModule, false),
+		"git_override":            parseModuleFunc("module_name", "commit", kindGitCommit, true),
+		"http_archive":            parseArchiveFunc("name", false),
+		"http_file":               parseArchiveFunc("name", false),
+		"single_version_override": parseModuleFunc("module_name", "version", kindModule, true),
+	}[funcName]
+	if optionalParseFn != nil {
+		dep, ok := optionalParseFn(call)
+		return dep, ok, nil
+	}
 	return Dependency{}, false, nil
 }
 
+// parseModuleFunc returns a function which extracts a module dependency from a
+// Call using the given idKeyword and versionKeyword.
+func parseModuleFunc(idKeyword, versionKeyword string, kind dependencyKind, override bool) func(*ast.Call) (Dependency, bool) {
+	return func(call *ast.Call) (Dependency, bool) {
+		id, _, err := getCallArgValueString(call, idKeyword)
+		if err != nil {
+			return Dependency{}, false
+		}
+		version, versionPos, err := getCallArgValueString(call, versionKeyword)
+		if err != nil {
+			return Dependency{}, false
+		}
+		return Dependency{
+			ID:         DependencyID(id),
+			Version:    version,
+			versionPos: versionPos,
+			kind:       kind,
+			override:   override,
+		}, true
+	}
+}
+
+// parseArchiveFunc returns a function which extracts an archive dependency
+// from a Call using the given idKeyword. The version of the archive is derived
+// from its URLs.
+func parseArchiveFunc(idKeyword string, override bool) func(*ast.Call) (Dependency, bool) {
+	return func(call *ast.Call) (Dependency, bool) {
+		id, _, err := getCallArgValueString(call, idKeyword)
+		if err != nil {
+			return Dependency{}, false
+		}
+		urls, urlPos := getCallArgValueStrings(call, "urls")
+		if len(urls) == 0 {
+			urls, urlPos = getCallArgValueStrings(call, "url")
+		}
+		if len(urls) == 0 {
+			return Dependency{}, false
+		}
+		version := archiveVersion(urls[0])
+		if version == "" {
+			return Dependency{}, false
+		}
+		for _, u := range urls {
+			if !strings.Contains(u, version) {
+				return Dependency{}, false
+			}
+		}
+		dep := Dependency{
+			ID:         DependencyID(id),
+			Version:    version,
+			versionPos: urlPos[0],
+			URLs:       urls,
+			urlPos:     urlPos,
+			kind:       kindArchive,
+			override:   override,
+		}
+		if sha256, pos, err := getCallArgValueString(call, "sha256"); err == nil {
+			dep.SHA256 = sha256
+			dep.sha256Pos = pos
+		}
+		if integrity, pos, err := getCallArgValueString(call, "integrity"); err == nil {
+			dep.Integrity = integrity
+			dep.integrityPos = pos
+		}
+		if dep.sha256Pos == nil && dep.integrityPos == nil {
+			return Dependency{}, false
+		}
+		if prefix, pos, err := getCallArgValueString(call, "strip_prefix"); err == nil {
+			if strings.Contains(prefix, version) {
+				dep.stripPrefixPos = pos
+			} else if strings.HasPrefix(version, "v") && strings.Contains(prefix, version[1:]) {
+				dep.stripPrefixPos = pos
+				dep.stripPrefixTrimV = true
+			}
+		}
+		return dep, true
+	}
+}
+
+// archiveVersion returns the version found in the given archive URL, or the
+// empty string if none can be found. Commit hashes are preferred over version
+// numbers.
+func archiveVersion(archiveURL string) string {
+	path := archiveURL
+	if u, err := url.Parse(archiveURL); err == nil {
+		path = u.Path
+	}
+	if m := archiveCommitRegex.FindString(path); m != "" {
+		return m
+	}
+	return archiveVersionRegex.FindString(path)
+}
+
 // parseDepFromCall is a helper function used to extract a dependency from a
 // Call using the given idKeyword and versionKeyword.
 func parseDepFromCallFunc(idKeyword, versionKeyword string) func(*ast.Call) (Dependency, error) {
@@ -239,3 +514,36 @@ func getCallArgValueString(call *ast.Call, keyword string) (string, *ast.Pos, er
 	}
 	return "", nil, skerr.Fmt("no keyword argument %q found for call", keyword)
 }
+
+// getCallArgValueStrings searches the keyword arguments of the call for one
+// with the matching keyword. If found, it returns the string values of the
+// argument, which may be a single string literal or a list of string literals,
+// along with their positions. Returns nil if the argument is not found or has
+// any other type.
+func getCallArgValueStrings(call *ast.Call, keyword string) ([]string, []*ast.Pos) {
+	for _, kw := range call.Keywords {
+		if string(kw.Arg) != keyword {
+			continue
+		}
+		var elts []ast.Expr
+		if kw.Value.Type().Name == ast.StrType.Name {
+			elts = []ast.Expr{kw.Value}
+		} else if kw.Value.Type().Name == ast.ListType.Name {
+			elts = kw.Value.(*ast.List).Elts
+		}
+		var values []string
+		var positions []*ast.Pos
+		for _, elt := range elts {
+			if elt.Type().Name != ast.StrType.Name {
+				return nil, nil
+			}
+			values = append(values, string(elt.(*ast.Str).S))
+			positions = append(positions, &ast.Pos{
+				Lineno:    elt.GetLineno(),
+				ColOffset: elt.GetColOffset(),
+			})
+		}
+		return values, positions
+	}
+	return nil, nil
+}
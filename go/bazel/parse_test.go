@@ -123,6 +123,34 @@ func TestParseDeps_ReturnsMapOfAllDependencies(t *testing.T) {
 				ColOffset: 13,
 			},
 		},
+		"rules_pkg": {
+			ID:      "rules_pkg",
+			Version: "0.4.0",
+			versionPos: &ast.Pos{
+				Lineno:    36,
+				ColOffset: 8,
+			},
+			SHA256: "038f1caa773a7e35b3663865ffb003169c6a71dc995e39bf4815792f385d837d",
+			sha256Pos: &ast.Pos{
+				Lineno:    34,
+				ColOffset: 13,
+			},
+			URLs: []string{
+				"https://mirror.bazel.build/github.com/bazelbuild/rules_pkg/releases/download/0.4.0/rules_pkg-0.4.0.tar.gz",
+				"https://github.com/bazelbuild/rules_pkg/releases/download/0.4.0/rules_pkg-0.4.0.tar.gz",
+			},
+			urlPos: []*ast.Pos{
+				{
+					Lineno:    36,
+					ColOffset: 8,
+				},
+				{
+					Lineno:    37,
+					ColOffset: 8,
+				},
+			},
+			kind: kindArchive,
+		},
 	}, actual)
 }
 
@@ -390,3 +418,157 @@ func TestSetDep_ErrorForUnknownID(t *testing.T) {
 	check("bogus-id")
 	check("../../")
 }
+
+const fakeModule = `module(
+    name = "my_module",
+    version = "1.0.0",
+)
+
+bazel_dep(name = "rules_go", version = "0.41.0")
+bazel_dep(name = "gazelle", version = "0.32.0", repo_name = "bazel_gazelle")
+bazel_dep(name = "abseil-cpp", version = "20230125.3")
+bazel_dep(name = "skia_infra")
+bazel_dep(name = "my_archive_dep", version = "1.2.3")
+
+git_override(
+    module_name = "skia_infra",
+    commit = "abcdef0123456789abcdef0123456789abcdef01",
+    remote = "https://skia.googlesource.com/buildbot.git",
+)
+
+single_version_override(
+    module_name = "abseil-cpp",
+    version = "20230802.0",
+)
+
+archive_override(
+    module_name = "my_archive_dep",
+    integrity = "sha256-A48cqnc6fjWzZjhl/7ADFpxqcdyZXjm/SBV5Lzhdg30=",
+    strip_prefix = "my_archive_dep-1.2.3",
+    urls = ["https://github.com/example/my_archive_dep/archive/refs/tags/v1.2.3.tar.gz"],
+)
+
+go_deps = use_extension("@bazel_gazelle//:extensions.bzl", "go_deps")
+go_deps.from_file(go_mod = "//:go.mod")
+use_repo(go_deps, "com_github_google_uuid")
+`
+
+func TestParseDeps_ModuleFile(t *testing.T) {
+	deps, err := ParseDeps(fakeModule)
+	require.NoError(t, err)
+	require.Len(t, deps, 5)
+
+	check := func(id DependencyID, version string, isArchive, isRegistryModule bool) {
+		dep, ok := deps[id]
+		require.True(t, ok, id)
+		require.Equal(t, version, dep.Version)
+		require.Equal(t, isArchive, dep.IsArchive())
+		require.Equal(t, isRegistryModule, dep.IsRegistryModule())
+	}
+	check("rules_go", "0.41.0", false, true)
+	check("gazelle", "0.32.0", false, true)
+	// Overrides take precedence over bazel_dep.
+	check("abseil-cpp", "20230802.0", false, true)
+	check("skia_infra", "abcdef0123456789abcdef0123456789abcdef01", false, false)
+	check("my_archive_dep", "v1.2.3", true, false)
+}
+
+func TestParseDeps_MultipleOverridesIsError(t *testing.T) {
+	_, err := ParseDeps(`
+single_version_override(module_name = "rules_go", version = "0.41.0")
+single_version_override(module_name = "rules_go", version = "0.42.0")
+`)
+	require.ErrorContains(t, err, `found multiple entries for "rules_go"`)
+}
+
+func TestParseDeps_SkipsArchivesWithoutVersionOrChecksum(t *testing.T) {
+	deps, err := ParseDeps(`
+http_archive(
+    name = "no_checksum",
+    urls = ["https://example.com/no_checksum-1.2.3.tar.gz"],
+)
+
+http_archive(
+    name = "no_version",
+    sha256 = "038f1caa773a7e35b3663865ffb003169c6a71dc995e39bf4815792f385d837d",
+    urls = ["https://example.com/latest.tar.gz"],
+)
+
+http_archive(
+    name = "mismatched_urls",
+    sha256 = "038f1caa773a7e35b3663865ffb003169c6a71dc995e39bf4815792f385d837d",
+    urls = [
+        "https://example.com/mismatched_urls-1.2.3.tar.gz",
+        "https://mirror.example.com/mismatched_urls.tar.gz",
+    ],
+)
+`)
+	require.NoError(t, err)
+	require.Empty(t, deps)
+}
+
+func TestSetDep_ModuleFile(t *testing.T) {
+	const newSHA256 = "5d41402abc4b2a76b9719d911017c592ae9f1c1e1d3b4f6a5f3e8b2f4a6c9d0e"
+	check := func(id DependencyID, version, sha256 string, expectLines ...string) {
+		actual, err := SetDep(fakeModule, id, version, sha256)
+		require.NoError(t, err)
+		for _, line := range expectLines {
+			require.Contains(t, actual, line)
+		}
+		dep, err := GetDep(actual, id)
+		require.NoError(t, err)
+		require.Equal(t, version, dep.Version)
+	}
+	check("rules_go", "0.42.0", "", `bazel_dep(name = "rules_go", version = "0.42.0")`)
+	check("abseil-cpp", "20240116.0", "", `    version = "20240116.0",`, `bazel_dep(name = "abseil-cpp", version = "20230125.3")`)
+	check("skia_infra", "0123456789abcdef0123456789abcdef01234567", "", `    commit = "0123456789abcdef0123456789abcdef01234567",`)
+	integrity, err := SHA256ToIntegrity(newSHA256)
+	require.NoError(t, err)
+	check("my_archive_dep", "v1.3.0", newSHA256,
+		`    integrity = "`+integrity+`",`,
+		`    strip_prefix = "my_archive_dep-1.3.0",`,
+		`    urls = ["https://github.com/example/my_archive_dep/archive/refs/tags/v1.3.0.tar.gz"],`,
+	)
+}
+
+func TestSetDep_Archive(t *testing.T) {
+	input := `
+http_archive(
+    name = "rules_foo",
+    sha256 = "038f1caa773a7e35b3663865ffb003169c6a71dc995e39bf4815792f385d837d",
+    strip_prefix = "rules_foo-abcdef0123456789abcdef0123456789abcdef01",
+    url = "https://github.com/example/rules_foo/archive/abcdef0123456789abcdef0123456789abcdef01.tar.gz",
+)
+`
+	expect := `
+http_archive(
+    name = "rules_foo",
+    sha256 = "5d41402abc4b2a76b9719d911017c592ae9f1c1e1d3b4f6a5f3e8b2f4a6c9d0e",
+    strip_prefix = "rules_foo-0123456789abcdef0123456789abcdef01234567",
+    url = "https://github.com/example/rules_foo/archive/0123456789abcdef0123456789abcdef01234567.tar.gz",
+)
+`
+	dep, err := GetDep(input, "rules_foo")
+	require.NoError(t, err)
+	require.True(t, dep.IsArchive())
+	require.Equal(t, []string{"https://github.com/example/rules_foo/archive/0123456789abcdef0123456789abcdef01234567.tar.gz"}, dep.URLsForVersion("0123456789abcdef0123456789abcdef01234567"))
+
+	actual, err := SetDep(input, "rules_foo", "0123456789abcdef0123456789abcdef01234567", "5d41402abc4b2a76b9719d911017c592ae9f1c1e1d3b4f6a5f3e8b2f4a6c9d0e")
+	require.NoError(t, err)
+	require.Equal(t, expect, actual)
+
+	// The checksum is required for archives.
+	_, err = SetDep(input, "rules_foo", "0123456789abcdef0123456789abcdef01234567", "")
+	require.ErrorContains(t, err, `sha256 is required for "rules_foo"`)
+}
+
+func TestSHA256ToIntegrity(t *testing.T) {
+	integrity, err := SHA256ToIntegrity("038f1caa773a7e35b3663865ffb003169c6a71dc995e39bf4815792f385d837d")
+	require.NoError(t, err)
+	require.Equal(t, "sha256-A48cqnc6fjWzZjhl/7ADFpxqcdyZXjm/SBV5Lzhdg30=", integrity)
+
+	_, err = SHA256ToIntegrity("not-hex")
+	require.Error(t, err)
+	_, err = SHA256ToIntegrity("abcd")
+	require.Error(t, err)
+}
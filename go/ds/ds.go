@@ -56,16 +56,18 @@ const (
 	CLUSTER_TELEMETRY_IDS           Kind = "ClusterTelemetryIDs"
 
 	// Autoroll
-	KIND_AUTOROLL_MODE                Kind = "AutorollMode"
-	KIND_AUTOROLL_MODE_ANCESTOR       Kind = "AutorollModeAncestor" // Fake; used to force strong consistency for testing's sake.
-	KIND_AUTOROLL_ROLL                Kind = "AutorollRoll"
-	KIND_AUTOROLL_ROLL_ANCESTOR       Kind = "AutorollRollAncestor" // Fake; used to force strong consistency for testing's sake.
-	KIND_AUTOROLL_STATUS              Kind = "AutorollStatus"
-	KIND_AUTOROLL_STATUS_ANCESTOR     Kind = "AutorollStatusAncestor" // Fake; used to force strong consistency for testing's sake.
-	KIND_AUTOROLL_STRATEGY            Kind = "AutorollStrategy"
-	KIND_AUTOROLL_STRATEGY_ANCESTOR   Kind = "AutorollStrategyAncestor" // Fake; used to force strong consistency for testing's sake.
-	KIND_AUTOROLL_UNTHROTTLE          Kind = "AutorollUnthrottle"
-	KIND_AUTOROLL_UNTHROTTLE_ANCESTOR Kind = "AutorollUnthrottleAncestor" // Fake; used to force strong consistency for testing's sake.
+	KIND_AUTOROLL_INVALID_REVISION          Kind = "AutorollInvalidRevision"
+	KIND_AUTOROLL_INVALID_REVISION_ANCESTOR Kind = "AutorollInvalidRevisionAncestor" // Fake; used to force strong consistency for testing's sake.
+	KIND_AUTOROLL_MODE                      Kind = "AutorollMode"
+	KIND_AUTOROLL_MODE_ANCESTOR             Kind = "AutorollModeAncestor" // Fake; used to force strong consistency for testing's sake.
+	KIND_AUTOROLL_ROLL                      Kind = "AutorollRoll"
+	KIND_AUTOROLL_ROLL_ANCESTOR             Kind = "AutorollRollAncestor" // Fake; used to force strong consistency for testing's sake.
+	KIND_AUTOROLL_STATUS                    Kind = "AutorollStatus"
+	KIND_AUTOROLL_STATUS_ANCESTOR           Kind = "AutorollStatusAncestor" // Fake; used to force strong consistency for testing's sake.
+	KIND_AUTOROLL_STRATEGY                  Kind = "AutorollStrategy"
+	KIND_AUTOROLL_STRATEGY_ANCESTOR         Kind = "AutorollStrategyAncestor" // Fake; used to force strong consistency for testing's sake.
+	KIND_AUTOROLL_UNTHROTTLE                Kind = "AutorollUnthrottle"
+	KIND_AUTOROLL_UNTHROTTLE_ANCESTOR       Kind = "AutorollUnthrottleAncestor" // Fake; used to force strong consistency for testing's sake.
 
 	// AlertManager
 	INCIDENT_AM               Kind = "IncidentAm"
@@ -103,8 +105,8 @@ var (
 	// even if that app isn't running there, which has a better failure mode of
 	// possibly backing up too much data rather than too little.
 	KindsToBackup = map[string][]Kind{
-		AUTOROLL_NS:          {KIND_AUTOROLL_MODE, KIND_AUTOROLL_MODE_ANCESTOR, KIND_AUTOROLL_ROLL, KIND_AUTOROLL_ROLL_ANCESTOR, KIND_AUTOROLL_STATUS, KIND_AUTOROLL_STATUS_ANCESTOR, KIND_AUTOROLL_STRATEGY, KIND_AUTOROLL_STRATEGY_ANCESTOR, KIND_AUTOROLL_UNTHROTTLE, KIND_AUTOROLL_UNTHROTTLE_ANCESTOR},
-		AUTOROLL_INTERNAL_NS: {KIND_AUTOROLL_MODE, KIND_AUTOROLL_MODE_ANCESTOR, KIND_AUTOROLL_ROLL, KIND_AUTOROLL_ROLL_ANCESTOR, KIND_AUTOROLL_STATUS, KIND_AUTOROLL_STATUS_ANCESTOR, KIND_AUTOROLL_STRATEGY, KIND_AUTOROLL_STRATEGY_ANCESTOR, KIND_AUTOROLL_UNTHROTTLE, KIND_AUTOROLL_UNTHROTTLE_ANCESTOR},
+		AUTOROLL_NS:          {KIND_AUTOROLL_INVALID_REVISION, KIND_AUTOROLL_INVALID_REVISION_ANCESTOR, KIND_AUTOROLL_MODE, KIND_AUTOROLL_MODE_ANCESTOR, KIND_AUTOROLL_ROLL, KIND_AUTOROLL_ROLL_ANCESTOR, KIND_AUTOROLL_STATUS, KIND_AUTOROLL_STATUS_ANCESTOR, KIND_AUTOROLL_STRATEGY, KIND_AUTOROLL_STRATEGY_ANCESTOR, KIND_AUTOROLL_UNTHROTTLE, KIND_AUTOROLL_UNTHROTTLE_ANCESTOR},
+		AUTOROLL_INTERNAL_NS: {KIND_AUTOROLL_INVALID_REVISION, KIND_AUTOROLL_INVALID_REVISION_ANCESTOR, KIND_AUTOROLL_MODE, KIND_AUTOROLL_MODE_ANCESTOR, KIND_AUTOROLL_ROLL, KIND_AUTOROLL_ROLL_ANCESTOR, KIND_AUTOROLL_STATUS, KIND_AUTOROLL_STATUS_ANCESTOR, KIND_AUTOROLL_STRATEGY, KIND_AUTOROLL_STRATEGY_ANCESTOR, KIND_AUTOROLL_UNTHROTTLE, KIND_AUTOROLL_UNTHROTTLE_ANCESTOR},
 		ANDROID_COMPILE_NS:   {COMPILE_TASK, ANDROID_COMPILE_INSTANCES},
 		LEASING_SERVER_NS:    {TASK},
 		CT_NS:                {CAPTURE_SKPS_TASKS, CHROMIUM_ANALYSIS_TASKS, CHROMIUM_BUILD_TASKS, CHROMIUM_PERF_TASKS, LUA_SCRIPT_TASKS, METRICS_ANALYSIS_TASKS, PIXEL_DIFF_TASKS, RECREATE_PAGESETS_TASKS, RECREATE_WEBPAGE_ARCHIVES_TASKS, CLUSTER_TELEMETRY_IDS},
@@ -468,6 +468,7 @@ type GerritInterface interface {
 	PublishChangeEdit(context.Context, *ChangeInfo) error
 	Rebase(context.Context, *ChangeInfo, string, bool) error
 	RemoveFromCQ(context.Context, *ChangeInfo, string) error
+	Revert(context.Context, *ChangeInfo, string) (*ChangeInfo, error)
 	Search(context.Context, int, bool, ...*SearchTerm) ([]*ChangeInfo, error)
 	SelfApprove(context.Context, *ChangeInfo, string) error
 	SendToCQ(context.Context, *ChangeInfo, string) error
@@ -1562,6 +1563,44 @@ func (g *Gerrit) CreateCherryPickChange(ctx context.Context, changeID, revisionI
 	return fixupChangeInfo(&ci), nil
 }
 
+// Revert creates a new change which reverts the given submitted change, using
+// the given commit message.
+func (g *Gerrit) Revert(ctx context.Context, issue *ChangeInfo, message string) (*ChangeInfo, error) {
+	// Respect the rate limit.
+	if err := g.rl.Wait(ctx); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	b, err := json.Marshal(map[string]interface{}{
+		"message": message,
+	})
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	url := fmt.Sprintf("%s/changes/%s/revert", g.apiUrl, FullChangeId(issue))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := g.doRequest(req)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	defer util.Close(resp.Body)
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, skerr.Fmt("got status %s (%d): %s", resp.Status, resp.StatusCode, string(respBytes))
+	}
+	var ci ChangeInfo
+	if err := json.NewDecoder(bytes.NewReader(respBytes[4:])).Decode(&ci); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return fixupChangeInfo(&ci), nil
+}
+
 // EditFile modifies the given file to have the given content. A ChangeEdit is created, if
 // one is not already active. You must call PublishChangeEdit in order for the
 // change to become a new patch set, otherwise it has no effect.
@@ -829,3 +829,44 @@ func TestCreateCherryPickChange(t *testing.T) {
 	require.Equal(t, "myProject~release~I8473b95934b5732ac55d26311a706c9c2bde9941", ci.Id)
 	require.Equal(t, "I8473b95934b5732ac55d26311a706c9c2bde9941", ci.ChangeId)
 }
+
+func TestRevert(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "/a/changes/myProject~3965/revert", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var data map[string]string
+		require.NoError(t, json.Unmarshal(body, &data))
+		require.Equal(t, "Revert this", data["message"])
+		_, err = fmt.Fprint(w, `)]}'
+{
+  "id": "myProject~main~I8473b95934b5732ac55d26311a706c9c2bde9941",
+  "project": "myProject",
+  "branch": "main",
+  "change_id": "I8473b95934b5732ac55d26311a706c9c2bde9941",
+  "subject": "Revert this",
+  "status": "NEW",
+  "created": "2013-02-01 09:59:32.126000000",
+  "updated": "2013-02-21 11:16:36.775000000",
+  "_number": 3966,
+  "owner": {
+    "name": "John Doe"
+  }
+}
+`)
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	api, err := NewGerritWithConfig(ConfigChromium, ts.URL, c)
+	require.NoError(t, err)
+	ci, err := api.Revert(context.Background(), &ChangeInfo{
+		Project: "myProject",
+		Issue:   3965,
+	}, "Revert this")
+	require.NoError(t, err)
+	require.Equal(t, int64(3966), ci.Issue)
+	require.Equal(t, "Revert this", ci.Subject)
+}
@@ -753,6 +753,36 @@ func (_m *GerritInterface) RemoveFromCQ(_a0 context.Context, _a1 *gerrit.ChangeI
 	return r0
 }
 
+// Revert provides a mock function with given fields: _a0, _a1, _a2
+func (_m *GerritInterface) Revert(_a0 context.Context, _a1 *gerrit.ChangeInfo, _a2 string) (*gerrit.ChangeInfo, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 *gerrit.ChangeInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *gerrit.ChangeInfo, string) (*gerrit.ChangeInfo, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gerrit.ChangeInfo, string) *gerrit.ChangeInfo); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gerrit.ChangeInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *gerrit.ChangeInfo, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *GerritInterface) Search(_a0 context.Context, _a1 int, _a2 bool, _a3 ...*gerrit.SearchTerm) ([]*gerrit.ChangeInfo, error) {
 	_va := make([]interface{}, len(_a3))
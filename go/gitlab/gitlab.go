@@ -0,0 +1,453 @@
+// Package gitlab provides a library for interacting with GitLab via its REST
+// API: https://docs.gitlab.com/ee/api/rest/
+//
+// This library assumes that the http.Client provided in NewGitLab contains the
+// appropriate authentication, eg. an oauth2 client using a personal, project
+// or group access token, all of which GitLab accepts as bearer tokens.
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.skia.org/infra/go/exec"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+)
+
+const (
+	// TOKEN_SERVER_PATH is the location of the access token on servers.
+	TOKEN_SERVER_PATH = "/var/secrets/gitlab-token"
+	// TOKEN_FILENAME is the name of the file containing the access token when
+	// running locally, relative to the home directory.
+	TOKEN_FILENAME = "gitlab_token"
+	// SSH_KEY_FILENAME is the name of the file containing the SSH key used to
+	// push to GitLab repos, relative to the .ssh directory. It differs from
+	// github.SSH_KEY_FILENAME so that the two keys don't overwrite each other.
+	SSH_KEY_FILENAME = "gitlab_id_rsa"
+
+	// Possible values for MergeRequest.State.
+	MERGE_REQUEST_STATE_OPENED = "opened"
+	MERGE_REQUEST_STATE_CLOSED = "closed"
+	MERGE_REQUEST_STATE_LOCKED = "locked"
+	MERGE_REQUEST_STATE_MERGED = "merged"
+
+	// Possible values for Pipeline.Status and Job.Status.
+	STATUS_CREATED              = "created"
+	STATUS_WAITING_FOR_RESOURCE = "waiting_for_resource"
+	STATUS_PREPARING            = "preparing"
+	STATUS_PENDING              = "pending"
+	STATUS_RUNNING              = "running"
+	STATUS_SUCCESS              = "success"
+	STATUS_FAILED               = "failed"
+	STATUS_CANCELED             = "canceled"
+	STATUS_SKIPPED              = "skipped"
+	STATUS_MANUAL               = "manual"
+	STATUS_SCHEDULED            = "scheduled"
+
+	// apiPath is the path of the REST API, relative to the GitLab URL.
+	apiPath = "/api/v4"
+
+	// perPage is the number of results requested per page for paginated
+	// API calls.
+	perPage = 100
+)
+
+// AddToKnownHosts adds the host of the given GitLab instance to the
+// known_hosts file, so that git can push to it over SSH.
+func AddToKnownHosts(ctx context.Context, gitlabURL string) {
+	u, err := url.Parse(gitlabURL)
+	if err != nil {
+		sklog.Errorf("Failed to parse GitLab URL %q: %s", gitlabURL, err)
+		return
+	}
+	// Not throwing error below because GitLab does not provide shell access
+	// and thus always returns an error.
+	_, err = exec.RunCwd(ctx, "", "ssh", "-T", "git@"+u.Hostname(), "-oStrictHostKeyChecking=no")
+	sklog.Info(err)
+}
+
+// AddSSHConfig configures ssh to use the key in SSH_KEY_FILENAME in the given
+// .ssh directory for the host of the given GitLab instance. It does nothing if
+// the host is already configured.
+func AddSSHConfig(sshDir, gitlabURL string) error {
+	u, err := url.Parse(gitlabURL)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to parse GitLab URL %q", gitlabURL)
+	}
+	configFile := filepath.Join(sshDir, "config")
+	contents, err := os.ReadFile(configFile)
+	if err != nil && !os.IsNotExist(err) {
+		return skerr.Wrap(err)
+	}
+	hostLine := "Host " + u.Hostname()
+	for _, line := range strings.Split(string(contents), "\n") {
+		if strings.TrimSpace(line) == hostLine {
+			return nil
+		}
+	}
+	entry := fmt.Sprintf("%s\n\tIdentityFile %s\n\tIdentitiesOnly yes\n", hostLine, filepath.Join(sshDir, SSH_KEY_FILENAME))
+	if len(contents) > 0 && !bytes.HasSuffix(contents, []byte("\n")) {
+		entry = "\n" + entry
+	}
+	f, err := os.OpenFile(configFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	defer util.Close(f)
+	if _, err := f.WriteString(entry); err != nil {
+		return skerr.Wrap(err)
+	}
+	return nil
+}
+
+// StatusFinished returns true iff the given Pipeline or Job status indicates
+// that it has finished.
+func StatusFinished(status string) bool {
+	return status == STATUS_SUCCESS || status == STATUS_FAILED || status == STATUS_CANCELED || status == STATUS_SKIPPED
+}
+
+// User represents a GitLab user.
+type User struct {
+	ID          int64  `json:"id"`
+	Username    string `json:"username"`
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	PublicEmail string `json:"public_email"`
+}
+
+// Project represents a GitLab project.
+type Project struct {
+	ID                int64  `json:"id"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	DefaultBranch     string `json:"default_branch"`
+	WebURL            string `json:"web_url"`
+}
+
+// Pipeline represents a GitLab CI pipeline.
+type Pipeline struct {
+	ID        int64     `json:"id"`
+	SHA       string    `json:"sha"`
+	Ref       string    `json:"ref"`
+	Status    string    `json:"status"`
+	WebURL    string    `json:"web_url"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Job represents a single job within a GitLab CI pipeline.
+type Job struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Stage        string    `json:"stage"`
+	Status       string    `json:"status"`
+	AllowFailure bool      `json:"allow_failure"`
+	WebURL       string    `json:"web_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// MergeRequest represents a GitLab merge request.
+type MergeRequest struct {
+	ID                        int64     `json:"id"`
+	IID                       int64     `json:"iid"`
+	ProjectID                 int64     `json:"project_id"`
+	Title                     string    `json:"title"`
+	Description               string    `json:"description"`
+	State                     string    `json:"state"`
+	SourceBranch              string    `json:"source_branch"`
+	TargetBranch              string    `json:"target_branch"`
+	Labels                    []string  `json:"labels"`
+	SHA                       string    `json:"sha"`
+	MergeCommitSHA            string    `json:"merge_commit_sha"`
+	SquashCommitSHA           string    `json:"squash_commit_sha"`
+	HasConflicts              bool      `json:"has_conflicts"`
+	MergeWhenPipelineSucceeds bool      `json:"merge_when_pipeline_succeeds"`
+	HeadPipeline              *Pipeline `json:"head_pipeline"`
+	WebURL                    string    `json:"web_url"`
+	CreatedAt                 time.Time `json:"created_at"`
+	UpdatedAt                 time.Time `json:"updated_at"`
+}
+
+// MergeRequestVersion represents one version of the diff of a merge request,
+// ie. the equivalent of a Gerrit patchset.
+type MergeRequestVersion struct {
+	ID            int64     `json:"id"`
+	HeadCommitSHA string    `json:"head_commit_sha"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// CreateMergeRequestOptions provides the parameters used to create a merge
+// request.
+type CreateMergeRequestOptions struct {
+	SourceBranch       string `json:"source_branch"`
+	TargetBranch       string `json:"target_branch"`
+	Title              string `json:"title"`
+	Description        string `json:"description,omitempty"`
+	Labels             string `json:"labels,omitempty"`
+	RemoveSourceBranch bool   `json:"remove_source_branch,omitempty"`
+	Squash             bool   `json:"squash,omitempty"`
+}
+
+// GitLab is used for interacting with a single project via the GitLab API.
+type GitLab struct {
+	apiURL  string
+	client  *http.Client
+	project string
+}
+
+// NewGitLab returns a GitLab instance which interacts with the given project
+// on the GitLab instance at the given URL, eg. "https://gitlab.com". The
+// project may be specified by its numeric ID or its full path, eg.
+// "my-group/my-project".
+func NewGitLab(gitlabURL, project string, client *http.Client) *GitLab {
+	return &GitLab{
+		apiURL:  strings.TrimSuffix(gitlabURL, "/") + apiPath,
+		client:  client,
+		project: project,
+	}
+}
+
+// projectPath returns the API path for the project, followed by the given
+// path elements.
+func (g *GitLab) projectPath(elems ...string) string {
+	return "/projects/" + url.PathEscape(g.project) + strings.Join(elems, "")
+}
+
+// mrPath returns the API path for the given merge request, followed by the
+// given path elements.
+func (g *GitLab) mrPath(iid int64, elems ...string) string {
+	return g.projectPath(append([]string{"/merge_requests/", strconv.FormatInt(iid, 10)}, elems...)...)
+}
+
+// do performs an API request with the given method, path and JSON body,
+// decoding the response into rv if it is non-nil. Returns the response
+// headers.
+func (g *GitLab) do(ctx context.Context, method, path string, body, rv interface{}) (http.Header, error) {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		r = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, g.apiURL+path, r)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to %s %s", method, path)
+	}
+	defer util.Close(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to read response body from %s %s", method, path)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, skerr.Fmt("%s %s returned status %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	if rv != nil {
+		if err := json.Unmarshal(respBody, rv); err != nil {
+			return nil, skerr.Wrapf(err, "failed to decode response from %s %s", method, path)
+		}
+	}
+	return resp.Header, nil
+}
+
+// See https://docs.gitlab.com/ee/api/users.html#list-current-user
+// for the API documentation.
+func (g *GitLab) GetCurrentUser(ctx context.Context) (*User, error) {
+	var rv User
+	if _, err := g.do(ctx, http.MethodGet, "/user", nil, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// See https://docs.gitlab.com/ee/api/projects.html#get-single-project
+// for the API documentation.
+func (g *GitLab) GetProject(ctx context.Context) (*Project, error) {
+	var rv Project
+	if _, err := g.do(ctx, http.MethodGet, g.projectPath(), nil, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html#create-mr
+// for the API documentation.
+func (g *GitLab) CreateMergeRequest(ctx context.Context, opts *CreateMergeRequestOptions) (*MergeRequest, error) {
+	var rv MergeRequest
+	if _, err := g.do(ctx, http.MethodPost, g.projectPath("/merge_requests"), opts, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html#get-single-mr
+// for the API documentation.
+func (g *GitLab) GetMergeRequest(ctx context.Context, iid int64) (*MergeRequest, error) {
+	var rv MergeRequest
+	if _, err := g.do(ctx, http.MethodGet, g.mrPath(iid), nil, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html#get-mr-diff-versions
+// for the API documentation. Versions are returned in chronological order.
+func (g *GitLab) GetMergeRequestVersions(ctx context.Context, iid int64) ([]*MergeRequestVersion, error) {
+	var rv []*MergeRequestVersion
+	if err := g.getAllPages(ctx, g.mrPath(iid, "/versions"), func(b []byte) (int, error) {
+		var page []*MergeRequestVersion
+		if err := json.Unmarshal(b, &page); err != nil {
+			return 0, err
+		}
+		rv = append(rv, page...)
+		return len(page), nil
+	}); err != nil {
+		return nil, err
+	}
+	// The API returns the most recent version first.
+	for i, j := 0, len(rv)-1; i < j; i, j = i+1, j-1 {
+		rv[i], rv[j] = rv[j], rv[i]
+	}
+	return rv, nil
+}
+
+// updateMergeRequest updates the given merge request with the given
+// parameters. See https://docs.gitlab.com/ee/api/merge_requests.html#update-mr
+// for the API documentation.
+func (g *GitLab) updateMergeRequest(ctx context.Context, iid int64, params map[string]interface{}) error {
+	_, err := g.do(ctx, http.MethodPut, g.mrPath(iid), params, nil)
+	return err
+}
+
+// AddLabels adds the given labels to the merge request.
+func (g *GitLab) AddLabels(ctx context.Context, iid int64, labels ...string) error {
+	return g.updateMergeRequest(ctx, iid, map[string]interface{}{
+		"add_labels": strings.Join(labels, ","),
+	})
+}
+
+// RemoveLabels removes the given labels from the merge request.
+func (g *GitLab) RemoveLabels(ctx context.Context, iid int64, labels ...string) error {
+	return g.updateMergeRequest(ctx, iid, map[string]interface{}{
+		"remove_labels": strings.Join(labels, ","),
+	})
+}
+
+// CloseMergeRequest closes the merge request without merging it.
+func (g *GitLab) CloseMergeRequest(ctx context.Context, iid int64) error {
+	return g.updateMergeRequest(ctx, iid, map[string]interface{}{
+		"state_event": "close",
+	})
+}
+
+// See https://docs.gitlab.com/ee/api/notes.html#create-new-merge-request-note
+// for the API documentation.
+func (g *GitLab) AddComment(ctx context.Context, iid int64, msg string) error {
+	_, err := g.do(ctx, http.MethodPost, g.mrPath(iid, "/notes"), map[string]interface{}{
+		"body": msg,
+	}, nil)
+	return err
+}
+
+// See https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
+// for the API documentation.
+func (g *GitLab) Approve(ctx context.Context, iid int64) error {
+	_, err := g.do(ctx, http.MethodPost, g.mrPath(iid, "/approve"), nil, nil)
+	return err
+}
+
+// MergeWhenPipelineSucceeds causes the merge request to be merged once its
+// pipeline succeeds. If sha is provided, the merge request is only merged if
+// its HEAD matches. See
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-a-merge-request
+// for the API documentation.
+func (g *GitLab) MergeWhenPipelineSucceeds(ctx context.Context, iid int64, sha string) error {
+	params := map[string]interface{}{
+		"merge_when_pipeline_succeeds": true,
+	}
+	if sha != "" {
+		params["sha"] = sha
+	}
+	_, err := g.do(ctx, http.MethodPut, g.mrPath(iid, "/merge"), params, nil)
+	return err
+}
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html#cancel-merge-when-pipeline-succeeds
+// for the API documentation.
+func (g *GitLab) CancelMergeWhenPipelineSucceeds(ctx context.Context, iid int64) error {
+	_, err := g.do(ctx, http.MethodPost, g.mrPath(iid, "/cancel_merge_when_pipeline_succeeds"), nil, nil)
+	return err
+}
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html#rebase-a-merge-request
+// for the API documentation.
+func (g *GitLab) Rebase(ctx context.Context, iid int64) error {
+	_, err := g.do(ctx, http.MethodPut, g.mrPath(iid, "/rebase"), nil, nil)
+	return err
+}
+
+// See https://docs.gitlab.com/ee/api/merge_requests.html#create-merge-request-pipeline
+// for the API documentation.
+func (g *GitLab) CreateMergeRequestPipeline(ctx context.Context, iid int64) (*Pipeline, error) {
+	var rv Pipeline
+	if _, err := g.do(ctx, http.MethodPost, g.mrPath(iid, "/pipelines"), nil, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// See https://docs.gitlab.com/ee/api/jobs.html#list-pipeline-jobs
+// for the API documentation. Retried jobs are not included.
+func (g *GitLab) GetPipelineJobs(ctx context.Context, pipelineID int64) ([]*Job, error) {
+	var rv []*Job
+	if err := g.getAllPages(ctx, g.projectPath("/pipelines/", strconv.FormatInt(pipelineID, 10), "/jobs"), func(b []byte) (int, error) {
+		var page []*Job
+		if err := json.Unmarshal(b, &page); err != nil {
+			return 0, err
+		}
+		rv = append(rv, page...)
+		return len(page), nil
+	}); err != nil {
+		return nil, err
+	}
+	return rv, nil
+}
+
+// getAllPages retrieves every page of results from the given paginated API
+// path, passing the body of each to the given function, which returns the
+// number of results it contained.
+func (g *GitLab) getAllPages(ctx context.Context, path string, fn func([]byte) (int, error)) error {
+	for page := 1; ; page++ {
+		var raw json.RawMessage
+		header, err := g.do(ctx, http.MethodGet, fmt.Sprintf("%s?per_page=%d&page=%d", path, perPage, page), nil, &raw)
+		if err != nil {
+			return err
+		}
+		n, err := fn(raw)
+		if err != nil {
+			return skerr.Wrapf(err, "failed to decode response from %s", path)
+		}
+		if header.Get("X-Next-Page") == "" || n == 0 {
+			return nil
+		}
+	}
+}
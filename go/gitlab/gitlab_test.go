@@ -0,0 +1,161 @@
+package gitlab_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/gitlab"
+	"go.skia.org/infra/go/gitlab/testutils"
+)
+
+func TestGetCurrentUserAndProject(t *testing.T) {
+	ctx := context.Background()
+	f := testutils.NewFakeGitLab(t)
+
+	user, err := f.GitLab.GetCurrentUser(ctx)
+	require.NoError(t, err)
+	require.Equal(t, testutils.FakeUserName, user.Username)
+	require.Equal(t, testutils.FakeUserEmail, user.Email)
+
+	project, err := f.GitLab.GetProject(ctx)
+	require.NoError(t, err)
+	require.Equal(t, testutils.FakeProject, project.PathWithNamespace)
+	require.Equal(t, f.ProjectURL(), project.WebURL)
+}
+
+func TestMergeRequestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f := testutils.NewFakeGitLab(t)
+	g := f.GitLab
+
+	mr, err := g.CreateMergeRequest(ctx, &gitlab.CreateMergeRequestOptions{
+		SourceBranch: "roll",
+		TargetBranch: "main",
+		Title:        "Roll child",
+		Description:  "Roll description",
+		Labels:       "autoroll,skia",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), mr.IID)
+	require.Equal(t, gitlab.MERGE_REQUEST_STATE_OPENED, mr.State)
+	require.Equal(t, []string{"autoroll", "skia"}, mr.Labels)
+	require.NotNil(t, mr.HeadPipeline)
+
+	// Labels.
+	require.NoError(t, g.AddLabels(ctx, mr.IID, "extra"))
+	require.NoError(t, g.RemoveLabels(ctx, mr.IID, "skia"))
+	mr, err = g.GetMergeRequest(ctx, mr.IID)
+	require.NoError(t, err)
+	require.Equal(t, []string{"autoroll", "extra"}, mr.Labels)
+
+	// Comments and approvals.
+	require.NoError(t, g.AddComment(ctx, mr.IID, "hello"))
+	require.Equal(t, []string{"hello"}, f.Comments(mr.IID))
+	require.NoError(t, g.Approve(ctx, mr.IID))
+	require.Equal(t, 1, f.Approvals(mr.IID))
+
+	// Rebasing creates a new version and pipeline.
+	oldPipeline := mr.HeadPipeline.ID
+	require.NoError(t, g.Rebase(ctx, mr.IID))
+	versions, err := g.GetMergeRequestVersions(ctx, mr.IID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	require.True(t, versions[0].ID < versions[1].ID)
+	mr, err = g.GetMergeRequest(ctx, mr.IID)
+	require.NoError(t, err)
+	require.NotEqual(t, oldPipeline, mr.HeadPipeline.ID)
+	require.Equal(t, versions[1].HeadCommitSHA, mr.SHA)
+
+	// Merge when pipeline succeeds.
+	require.NoError(t, g.MergeWhenPipelineSucceeds(ctx, mr.IID, mr.SHA))
+	mr, err = g.GetMergeRequest(ctx, mr.IID)
+	require.NoError(t, err)
+	require.True(t, mr.MergeWhenPipelineSucceeds)
+	require.NoError(t, g.CancelMergeWhenPipelineSucceeds(ctx, mr.IID))
+	mr, err = g.GetMergeRequest(ctx, mr.IID)
+	require.NoError(t, err)
+	require.False(t, mr.MergeWhenPipelineSucceeds)
+	require.NoError(t, g.MergeWhenPipelineSucceeds(ctx, mr.IID, ""))
+
+	// Pipeline jobs.
+	pipeline, err := g.CreateMergeRequestPipeline(ctx, mr.IID)
+	require.NoError(t, err)
+	f.SetPipelineJobs(mr.IID, gitlab.STATUS_SUCCESS, &gitlab.Job{ID: 1, Name: "test", Status: gitlab.STATUS_SUCCESS})
+	jobs, err := g.GetPipelineJobs(ctx, pipeline.ID)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "test", jobs[0].Name)
+	mr, err = g.GetMergeRequest(ctx, mr.IID)
+	require.NoError(t, err)
+	require.Equal(t, gitlab.MERGE_REQUEST_STATE_MERGED, mr.State)
+
+	// Closing a merged merge request fails.
+	require.Error(t, g.CloseMergeRequest(ctx, mr.IID))
+}
+
+func TestCloseMergeRequest(t *testing.T) {
+	ctx := context.Background()
+	f := testutils.NewFakeGitLab(t)
+	mr, err := f.GitLab.CreateMergeRequest(ctx, &gitlab.CreateMergeRequestOptions{
+		SourceBranch: "roll",
+		TargetBranch: "main",
+		Title:        "Roll child",
+	})
+	require.NoError(t, err)
+	require.NoError(t, f.GitLab.CloseMergeRequest(ctx, mr.IID))
+	require.Equal(t, gitlab.MERGE_REQUEST_STATE_CLOSED, f.MergeRequest(mr.IID).State)
+}
+
+func TestErrorIncludesResponse(t *testing.T) {
+	ctx := context.Background()
+	f := testutils.NewFakeGitLab(t)
+	_, err := f.GitLab.GetMergeRequest(ctx, 42)
+	require.ErrorContains(t, err, "returned status 404")
+}
+
+func TestGetPipelineJobs_Pagination(t *testing.T) {
+	ctx := context.Background()
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/v4/projects/123/pipelines/5/jobs", r.URL.Path)
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		w.Header().Set("Content-Type", "application/json")
+		if page == "1" {
+			w.Header().Set("X-Next-Page", "2")
+			_, _ = w.Write([]byte(`[{"id": 1, "name": "a"}]`))
+		} else {
+			_, _ = w.Write([]byte(`[{"id": 2, "name": "b"}]`))
+		}
+	}))
+	defer srv.Close()
+
+	g := gitlab.NewGitLab(srv.URL+"/", "123", srv.Client())
+	jobs, err := g.GetPipelineJobs(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, pages)
+	names := []string{}
+	for _, j := range jobs {
+		names = append(names, j.Name)
+	}
+	require.Equal(t, "a,b", strings.Join(names, ","))
+}
+
+func TestAddSSHConfig_AddsHostOnce(t *testing.T) {
+	sshDir := t.TempDir()
+	configFile := filepath.Join(sshDir, "config")
+	require.NoError(t, os.WriteFile(configFile, []byte("Host github.com\n\tUser git"), 0600))
+
+	require.NoError(t, gitlab.AddSSHConfig(sshDir, "https://gitlab.example.com"))
+	require.NoError(t, gitlab.AddSSHConfig(sshDir, "https://gitlab.example.com/"))
+
+	contents, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+	require.Equal(t, "Host github.com\n\tUser git\nHost gitlab.example.com\n\tIdentityFile "+filepath.Join(sshDir, gitlab.SSH_KEY_FILENAME)+"\n\tIdentitiesOnly yes\n", string(contents))
+}
@@ -0,0 +1,398 @@
+// Package testutils provides a fake GitLab server for use in tests.
+package testutils
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/gitlab"
+	"go.skia.org/infra/go/sktest"
+)
+
+const (
+	// FakeProject is the path of the project served by FakeGitLab.
+	FakeProject = "my-group/my-project"
+	// FakeUserName is the username of the user authenticated to FakeGitLab.
+	FakeUserName = "roller"
+	// FakeUserEmail is the email address of the user authenticated to
+	// FakeGitLab.
+	FakeUserEmail = "roller@example.com"
+)
+
+// FakeGitLab is an in-memory implementation of the subset of the GitLab API
+// used by the gitlab package, served over HTTP.
+type FakeGitLab struct {
+	// GitLab is a client which talks to the fake server.
+	GitLab *gitlab.GitLab
+	// URL is the base URL of the fake server.
+	URL string
+
+	mtx            sync.Mutex
+	t              sktest.TestingT
+	mergeRequests  map[int64]*gitlab.MergeRequest
+	versions       map[int64][]*gitlab.MergeRequestVersion
+	jobs           map[int64][]*gitlab.Job
+	comments       map[int64][]string
+	approvals      map[int64]int
+	nextIID        int64
+	nextPipelineID int64
+	nextVersionID  int64
+}
+
+// NewFakeGitLab returns a FakeGitLab instance. The server is shut down when
+// the test finishes.
+func NewFakeGitLab(t sktest.TestingT) *FakeGitLab {
+	f := &FakeGitLab{
+		t:              t,
+		mergeRequests:  map[int64]*gitlab.MergeRequest{},
+		versions:       map[int64][]*gitlab.MergeRequestVersion{},
+		jobs:           map[int64][]*gitlab.Job{},
+		comments:       map[int64][]string{},
+		approvals:      map[int64]int{},
+		nextIID:        1,
+		nextPipelineID: 1000,
+		nextVersionID:  1,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(f.handle))
+	t.Cleanup(srv.Close)
+	f.URL = srv.URL
+	f.GitLab = gitlab.NewGitLab(srv.URL, FakeProject, srv.Client())
+	return f
+}
+
+// ProjectURL returns the web URL of the fake project.
+func (f *FakeGitLab) ProjectURL() string {
+	return f.URL + "/" + FakeProject
+}
+
+// MergeRequest returns a copy of the given merge request.
+func (f *FakeGitLab) MergeRequest(iid int64) *gitlab.MergeRequest {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	mr, ok := f.mergeRequests[iid]
+	require.True(f.t, ok, "No such merge request %d", iid)
+	return copyMergeRequest(mr)
+}
+
+// Comments returns the comments which have been added to the given merge
+// request.
+func (f *FakeGitLab) Comments(iid int64) []string {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return append([]string(nil), f.comments[iid]...)
+}
+
+// Approvals returns the number of times the given merge request was approved.
+func (f *FakeGitLab) Approvals(iid int64) int {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.approvals[iid]
+}
+
+// SetPipelineJobs sets the jobs of the head pipeline of the given merge
+// request, along with the status of the pipeline itself. As on a real GitLab
+// instance, a successful pipeline causes the merge request to be merged if
+// merge_when_pipeline_succeeds is set, and a failed pipeline unsets it.
+func (f *FakeGitLab) SetPipelineJobs(iid int64, status string, jobs ...*gitlab.Job) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	mr, ok := f.mergeRequests[iid]
+	require.True(f.t, ok, "No such merge request %d", iid)
+	mr.HeadPipeline.Status = status
+	mr.HeadPipeline.UpdatedAt = now()
+	f.jobs[mr.HeadPipeline.ID] = jobs
+	if status == gitlab.STATUS_SUCCESS && mr.MergeWhenPipelineSucceeds {
+		mr.State = gitlab.MERGE_REQUEST_STATE_MERGED
+	} else if status == gitlab.STATUS_FAILED || status == gitlab.STATUS_CANCELED {
+		mr.MergeWhenPipelineSucceeds = false
+	}
+}
+
+// SetHasConflicts marks the given merge request as conflicting (or not) with
+// its target branch.
+func (f *FakeGitLab) SetHasConflicts(iid int64, hasConflicts bool) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	mr, ok := f.mergeRequests[iid]
+	require.True(f.t, ok, "No such merge request %d", iid)
+	mr.HasConflicts = hasConflicts
+}
+
+// now returns the current time, truncated so that it survives a round trip
+// through JSON.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+// copyMergeRequest returns a deep copy of the given MergeRequest.
+func copyMergeRequest(mr *gitlab.MergeRequest) *gitlab.MergeRequest {
+	rv := *mr
+	rv.Labels = append([]string(nil), mr.Labels...)
+	if mr.HeadPipeline != nil {
+		p := *mr.HeadPipeline
+		rv.HeadPipeline = &p
+	}
+	return &rv
+}
+
+// newPipeline creates a new pipeline for the given merge request. Assumes
+// that the caller holds f.mtx.
+func (f *FakeGitLab) newPipeline(mr *gitlab.MergeRequest) *gitlab.Pipeline {
+	id := f.nextPipelineID
+	f.nextPipelineID++
+	ts := now()
+	mr.HeadPipeline = &gitlab.Pipeline{
+		ID:        id,
+		SHA:       mr.SHA,
+		Ref:       mr.SourceBranch,
+		Status:    gitlab.STATUS_CREATED,
+		WebURL:    f.ProjectURL() + "/-/pipelines/" + strconv.FormatInt(id, 10),
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+	return mr.HeadPipeline
+}
+
+// newVersion creates a new diff version for the given merge request. Assumes
+// that the caller holds f.mtx.
+func (f *FakeGitLab) newVersion(mr *gitlab.MergeRequest) {
+	id := f.nextVersionID
+	f.nextVersionID++
+	mr.SHA = "sha" + strconv.FormatInt(id, 10)
+	// The API returns the most recent version first.
+	f.versions[mr.IID] = append([]*gitlab.MergeRequestVersion{{
+		ID:            id,
+		HeadCommitSHA: mr.SHA,
+		CreatedAt:     now(),
+	}}, f.versions[mr.IID]...)
+}
+
+// writeJSON writes the given value to the response as JSON.
+func (f *FakeGitLab) writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	require.NoError(f.t, json.NewEncoder(w).Encode(v))
+}
+
+// writeError writes an error response in the format used by GitLab.
+func (f *FakeGitLab) writeError(w http.ResponseWriter, code int, msg string) {
+	f.writeJSON(w, code, map[string]string{"message": msg})
+}
+
+// handle serves all requests to the fake server.
+func (f *FakeGitLab) handle(w http.ResponseWriter, r *http.Request) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	var params map[string]interface{}
+	if r.Body != nil && r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			f.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	str := func(key string) string {
+		s, _ := params[key].(string)
+		return s
+	}
+
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v4")
+	if path == "/user" && r.Method == http.MethodGet {
+		f.writeJSON(w, http.StatusOK, &gitlab.User{
+			ID:       1,
+			Username: FakeUserName,
+			Name:     "Roller",
+			Email:    FakeUserEmail,
+		})
+		return
+	}
+	projectPrefix := "/projects/" + url.PathEscape(FakeProject)
+	if !strings.HasPrefix(path, projectPrefix) {
+		f.writeError(w, http.StatusNotFound, "404 Project Not Found")
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(path, projectPrefix), "/")[1:]
+
+	// /projects/:id
+	if len(parts) == 0 && r.Method == http.MethodGet {
+		f.writeJSON(w, http.StatusOK, &gitlab.Project{
+			ID:                1,
+			PathWithNamespace: FakeProject,
+			DefaultBranch:     "main",
+			WebURL:            f.ProjectURL(),
+		})
+		return
+	}
+
+	// /projects/:id/pipelines/:pipeline_id/jobs
+	if len(parts) == 3 && parts[0] == "pipelines" && parts[2] == "jobs" && r.Method == http.MethodGet {
+		id, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			f.writeError(w, http.StatusNotFound, "404 Not found")
+			return
+		}
+		jobs := f.jobs[id]
+		if jobs == nil {
+			jobs = []*gitlab.Job{}
+		}
+		f.writeJSON(w, http.StatusOK, jobs)
+		return
+	}
+
+	if len(parts) == 0 || parts[0] != "merge_requests" {
+		f.writeError(w, http.StatusNotFound, "404 Not found")
+		return
+	}
+
+	// /projects/:id/merge_requests
+	if len(parts) == 1 && r.Method == http.MethodPost {
+		if str("source_branch") == "" || str("target_branch") == "" || str("title") == "" {
+			f.writeError(w, http.StatusBadRequest, "source_branch, target_branch and title are required")
+			return
+		}
+		iid := f.nextIID
+		f.nextIID++
+		ts := now()
+		mr := &gitlab.MergeRequest{
+			ID:           iid + 100,
+			IID:          iid,
+			ProjectID:    1,
+			Title:        str("title"),
+			Description:  str("description"),
+			State:        gitlab.MERGE_REQUEST_STATE_OPENED,
+			SourceBranch: str("source_branch"),
+			TargetBranch: str("target_branch"),
+			Labels:       []string{},
+			WebURL:       f.ProjectURL() + "/-/merge_requests/" + strconv.FormatInt(iid, 10),
+			CreatedAt:    ts,
+			UpdatedAt:    ts,
+		}
+		if labels := str("labels"); labels != "" {
+			mr.Labels = strings.Split(labels, ",")
+		}
+		f.newVersion(mr)
+		f.newPipeline(mr)
+		f.mergeRequests[iid] = mr
+		f.writeJSON(w, http.StatusCreated, mr)
+		return
+	}
+
+	// /projects/:id/merge_requests/:iid/...
+	if len(parts) < 2 {
+		f.writeError(w, http.StatusNotFound, "404 Not found")
+		return
+	}
+	iid, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		f.writeError(w, http.StatusNotFound, "404 Not found")
+		return
+	}
+	mr, ok := f.mergeRequests[iid]
+	if !ok {
+		f.writeError(w, http.StatusNotFound, "404 Not found")
+		return
+	}
+	action := ""
+	if len(parts) > 2 {
+		action = parts[2]
+	}
+	isOpen := mr.State == gitlab.MERGE_REQUEST_STATE_OPENED
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		f.writeJSON(w, http.StatusOK, mr)
+	case action == "" && r.Method == http.MethodPut:
+		if labels := str("add_labels"); labels != "" {
+			for _, l := range strings.Split(labels, ",") {
+				found := false
+				for _, existing := range mr.Labels {
+					if existing == l {
+						found = true
+					}
+				}
+				if !found {
+					mr.Labels = append(mr.Labels, l)
+				}
+			}
+		}
+		if labels := str("remove_labels"); labels != "" {
+			remove := strings.Split(labels, ",")
+			newLabels := []string{}
+			for _, l := range mr.Labels {
+				keep := true
+				for _, r := range remove {
+					if l == r {
+						keep = false
+					}
+				}
+				if keep {
+					newLabels = append(newLabels, l)
+				}
+			}
+			mr.Labels = newLabels
+		}
+		if str("state_event") == "close" {
+			if !isOpen {
+				f.writeError(w, http.StatusMethodNotAllowed, "405 Method Not Allowed")
+				return
+			}
+			mr.State = gitlab.MERGE_REQUEST_STATE_CLOSED
+			mr.MergeWhenPipelineSucceeds = false
+		}
+		mr.UpdatedAt = now()
+		f.writeJSON(w, http.StatusOK, mr)
+	case action == "versions" && r.Method == http.MethodGet:
+		f.writeJSON(w, http.StatusOK, f.versions[iid])
+	case action == "notes" && r.Method == http.MethodPost:
+		f.comments[iid] = append(f.comments[iid], str("body"))
+		f.writeJSON(w, http.StatusCreated, map[string]interface{}{"body": str("body")})
+	case action == "approve" && r.Method == http.MethodPost:
+		f.approvals[iid]++
+		f.writeJSON(w, http.StatusCreated, mr)
+	case action == "merge" && r.Method == http.MethodPut:
+		if !isOpen {
+			f.writeError(w, http.StatusMethodNotAllowed, "405 Method Not Allowed")
+			return
+		}
+		if sha := str("sha"); sha != "" && sha != mr.SHA {
+			f.writeError(w, http.StatusConflict, "SHA does not match HEAD of source branch")
+			return
+		}
+		if mr.HasConflicts {
+			f.writeError(w, http.StatusNotAcceptable, "Branch cannot be merged")
+			return
+		}
+		if mwps, _ := params["merge_when_pipeline_succeeds"].(bool); mwps && mr.HeadPipeline.Status != gitlab.STATUS_SUCCESS {
+			mr.MergeWhenPipelineSucceeds = true
+		} else {
+			mr.State = gitlab.MERGE_REQUEST_STATE_MERGED
+		}
+		mr.UpdatedAt = now()
+		f.writeJSON(w, http.StatusOK, mr)
+	case action == "cancel_merge_when_pipeline_succeeds" && r.Method == http.MethodPost:
+		if !isOpen {
+			f.writeError(w, http.StatusMethodNotAllowed, "405 Method Not Allowed")
+			return
+		}
+		mr.MergeWhenPipelineSucceeds = false
+		f.writeJSON(w, http.StatusCreated, mr)
+	case action == "rebase" && r.Method == http.MethodPut:
+		if !isOpen {
+			f.writeError(w, http.StatusForbidden, "403 Forbidden")
+			return
+		}
+		f.newVersion(mr)
+		f.newPipeline(mr)
+		f.writeJSON(w, http.StatusAccepted, map[string]interface{}{"rebase_in_progress": true})
+	case action == "pipelines" && r.Method == http.MethodPost:
+		f.writeJSON(w, http.StatusCreated, f.newPipeline(mr))
+	default:
+		f.writeError(w, http.StatusNotFound, "404 Not found")
+	}
+}
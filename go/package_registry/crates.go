@@ -0,0 +1,89 @@
+package package_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"go.skia.org/infra/go/skerr"
+)
+
+const (
+	// DefaultCratesURL is the URL of crates.io.
+	DefaultCratesURL = "https://crates.io"
+)
+
+// cratesPackage is the subset of a crates.io crate which we care about. See
+// https://crates.io/data-access
+type cratesPackage struct {
+	Versions []struct {
+		Num         string    `json:"num"`
+		Checksum    string    `json:"checksum"`
+		CreatedAt   time.Time `json:"created_at"`
+		DLPath      string    `json:"dl_path"`
+		Yanked      bool      `json:"yanked"`
+		PublishedBy *struct {
+			Login string `json:"login"`
+		} `json:"published_by"`
+	} `json:"versions"`
+}
+
+// cratesRegistry implements Registry for crates.io and registries which
+// provide the same API.
+type cratesRegistry struct {
+	client *http.Client
+	url    string
+}
+
+// NewCrates returns a Registry which retrieves packages from a crates.io-style
+// registry at the given URL. If the URL is empty, DefaultCratesURL is used.
+func NewCrates(client *http.Client, url string) Registry {
+	if url == "" {
+		url = DefaultCratesURL
+	}
+	return &cratesRegistry{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+	}
+}
+
+// GetVersions implements Registry.
+func (r *cratesRegistry) GetVersions(ctx context.Context, pkg string) ([]*Version, error) {
+	url := fmt.Sprintf("%s/api/v1/crates/%s", r.url, pkg)
+	var resp cratesPackage
+	if err := getJSON(ctx, r.client, url, &resp); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	rv := make([]*Version, 0, len(resp.Versions))
+	for _, v := range resp.Versions {
+		author := ""
+		if v.PublishedBy != nil {
+			author = v.PublishedBy.Login
+		}
+		rv = append(rv, &Version{
+			Version:   v.Num,
+			Published: v.CreatedAt.UTC(),
+			Author:    author,
+			URL:       fmt.Sprintf("%s/crates/%s/%s", r.url, pkg, v.Num),
+			Tarball:   r.url + v.DLPath,
+			Checksum:  v.Checksum,
+			Yanked:    v.Yanked,
+		})
+	}
+	return rv, nil
+}
+
+// ParseVersion implements Registry.
+func (r *cratesRegistry) ParseVersion(version string) (*semver.Version, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return v, nil
+}
+
+// Assert that cratesRegistry implements Registry.
+var _ Registry = &cratesRegistry{}
@@ -0,0 +1,87 @@
+package package_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"go.skia.org/infra/go/skerr"
+)
+
+const (
+	// DefaultNPMURL is the URL of the public npm registry.
+	DefaultNPMURL = "https://registry.npmjs.org"
+)
+
+// npmPackage is the subset of an npm packument which we care about. See
+// https://github.com/npm/registry/blob/master/docs/REGISTRY-API.md
+type npmPackage struct {
+	Versions map[string]struct {
+		Version    string `json:"version"`
+		Deprecated string `json:"deprecated"`
+		Dist       struct {
+			Integrity string `json:"integrity"`
+			Tarball   string `json:"tarball"`
+		} `json:"dist"`
+		NPMUser struct {
+			Name string `json:"name"`
+		} `json:"_npmUser"`
+	} `json:"versions"`
+	Time map[string]time.Time `json:"time"`
+}
+
+// npmRegistry implements Registry for npm.
+type npmRegistry struct {
+	client *http.Client
+	url    string
+}
+
+// NewNPM returns a Registry which retrieves packages from an npm registry at
+// the given URL. If the URL is empty, DefaultNPMURL is used.
+func NewNPM(client *http.Client, url string) Registry {
+	if url == "" {
+		url = DefaultNPMURL
+	}
+	return &npmRegistry{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+	}
+}
+
+// GetVersions implements Registry.
+func (r *npmRegistry) GetVersions(ctx context.Context, pkg string) ([]*Version, error) {
+	// Scoped packages, eg. "@scope/name", must have their slash escaped.
+	url := fmt.Sprintf("%s/%s", r.url, strings.Replace(pkg, "/", "%2f", 1))
+	var resp npmPackage
+	if err := getJSON(ctx, r.client, url, &resp); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	rv := make([]*Version, 0, len(resp.Versions))
+	for version, v := range resp.Versions {
+		rv = append(rv, &Version{
+			Version:   version,
+			Published: resp.Time[version].UTC(),
+			Author:    v.NPMUser.Name,
+			URL:       fmt.Sprintf("https://www.npmjs.com/package/%s/v/%s", pkg, version),
+			Tarball:   v.Dist.Tarball,
+			Checksum:  v.Dist.Integrity,
+			Yanked:    v.Deprecated != "",
+		})
+	}
+	return rv, nil
+}
+
+// ParseVersion implements Registry.
+func (r *npmRegistry) ParseVersion(version string) (*semver.Version, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return v, nil
+}
+
+// Assert that npmRegistry implements Registry.
+var _ Registry = &npmRegistry{}
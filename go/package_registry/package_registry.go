@@ -0,0 +1,109 @@
+// Package package_registry provides clients for retrieving information about
+// the versions of packages published to language-specific package registries,
+// eg. npm, PyPI and crates.io.
+package package_registry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+)
+
+const (
+	// userAgent is sent with all requests. Some registries, eg. crates.io,
+	// reject requests which do not provide a User-Agent.
+	userAgent = "go.skia.org/infra/go/package_registry"
+)
+
+// Version describes one published version of a package.
+type Version struct {
+	// Version string, as provided by the registry.
+	Version string
+	// Published is the time at which the version was published.
+	Published time.Time
+	// Author is the user who published the version, if known.
+	Author string
+	// URL used by a human to view the version.
+	URL string
+	// Tarball is the URL from which the package contents may be downloaded.
+	Tarball string
+	// Checksum of the package contents, in the format preferred by the
+	// registry's tooling, eg. an SRI string for npm or a hex-encoded sha256
+	// for crates.io and PyPI.
+	Checksum string
+	// Hashes are the hex-encoded sha256 hashes of every distribution file of
+	// the version, for registries which provide more than one, eg. PyPI.
+	Hashes []string
+	// Yanked indicates that the version has been withdrawn by its publisher
+	// and should not be used, eg. a yanked crate or PyPI release or a
+	// deprecated npm version.
+	Yanked bool
+}
+
+// Registry is used for retrieving information about packages from a package
+// registry.
+type Registry interface {
+	// GetVersions returns all published versions of the given package, in no
+	// particular order.
+	GetVersions(ctx context.Context, pkg string) ([]*Version, error)
+
+	// ParseVersion parses the given version string, using the registry's
+	// versioning scheme, into a semantic version which may be compared against
+	// others.
+	ParseVersion(version string) (*semver.Version, error)
+}
+
+// SortVersions parses the given versions using the given Registry and returns
+// those which are valid, sorted with the most recent version first.
+// Unparseable versions are dropped.
+func SortVersions(r Registry, versions []*Version) []*Version {
+	type parsedVersion struct {
+		v  *Version
+		sv *semver.Version
+	}
+	parsed := make([]parsedVersion, 0, len(versions))
+	for _, v := range versions {
+		sv, err := r.ParseVersion(v.Version)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, parsedVersion{v: v, sv: sv})
+	}
+	sort.SliceStable(parsed, func(i, j int) bool {
+		return parsed[i].sv.GreaterThan(parsed[j].sv)
+	})
+	rv := make([]*Version, 0, len(parsed))
+	for _, p := range parsed {
+		rv = append(rv, p.v)
+	}
+	return rv
+}
+
+// getJSON performs a GET request to the given URL and decodes the JSON
+// response into dest.
+func getJSON(ctx context.Context, client *http.Client, url string, dest interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to retrieve %s", url)
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return skerr.Fmt("failed to retrieve %s; got status %q", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return skerr.Wrapf(err, "failed to decode response from %s", url)
+	}
+	return nil
+}
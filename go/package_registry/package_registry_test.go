@@ -0,0 +1,220 @@
+package package_registry
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/mockhttpclient"
+)
+
+func sortByVersion(versions []*Version) {
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+}
+
+func TestNPM_GetVersions(t *testing.T) {
+	urlmock := mockhttpclient.NewURLMock()
+	urlmock.MockOnce("https://registry.npmjs.org/@scope%2fpkg", mockhttpclient.MockGetDialogue([]byte(`{
+  "name": "@scope/pkg",
+  "versions": {
+    "1.0.0": {
+      "version": "1.0.0",
+      "dist": {
+        "integrity": "sha512-abc",
+        "tarball": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.0.0.tgz"
+      },
+      "_npmUser": {"name": "me"}
+    },
+    "1.1.0": {
+      "version": "1.1.0",
+      "deprecated": "Don't use this",
+      "dist": {
+        "integrity": "sha512-def",
+        "tarball": "https://registry.npmjs.org/@scope/pkg/-/pkg-1.1.0.tgz"
+      },
+      "_npmUser": {"name": "you"}
+    }
+  },
+  "time": {
+    "created": "2023-01-01T00:00:00.000Z",
+    "1.0.0": "2023-01-02T00:00:00.000Z",
+    "1.1.0": "2023-01-03T00:00:00.000Z"
+  }
+}`)))
+	r := NewNPM(urlmock.Client(), "")
+	versions, err := r.GetVersions(context.Background(), "@scope/pkg")
+	require.NoError(t, err)
+	sortByVersion(versions)
+	require.Equal(t, []*Version{
+		{
+			Version:   "1.0.0",
+			Published: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			Author:    "me",
+			URL:       "https://www.npmjs.com/package/@scope/pkg/v/1.0.0",
+			Tarball:   "https://registry.npmjs.org/@scope/pkg/-/pkg-1.0.0.tgz",
+			Checksum:  "sha512-abc",
+		},
+		{
+			Version:   "1.1.0",
+			Published: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+			Author:    "you",
+			URL:       "https://www.npmjs.com/package/@scope/pkg/v/1.1.0",
+			Tarball:   "https://registry.npmjs.org/@scope/pkg/-/pkg-1.1.0.tgz",
+			Checksum:  "sha512-def",
+			Yanked:    true,
+		},
+	}, versions)
+}
+
+func TestPyPI_GetVersions(t *testing.T) {
+	urlmock := mockhttpclient.NewURLMock()
+	urlmock.MockOnce("https://my-mirror.com/pypi/pkg/json", mockhttpclient.MockGetDialogue([]byte(`{
+  "releases": {
+    "1.0": [
+      {
+        "digests": {"sha256": "bbbb"},
+        "packagetype": "bdist_wheel",
+        "upload_time_iso_8601": "2023-01-02T01:00:00.000000Z",
+        "url": "https://files/pkg-1.0-py3-none-any.whl",
+        "yanked": false
+      },
+      {
+        "digests": {"sha256": "aaaa"},
+        "packagetype": "sdist",
+        "upload_time_iso_8601": "2023-01-02T00:00:00.000000Z",
+        "url": "https://files/pkg-1.0.tar.gz",
+        "yanked": false
+      }
+    ],
+    "1.1": [
+      {
+        "digests": {"sha256": "cccc"},
+        "packagetype": "bdist_wheel",
+        "upload_time_iso_8601": "2023-01-03T00:00:00.000000Z",
+        "url": "https://files/pkg-1.1-py3-none-any.whl",
+        "yanked": true
+      }
+    ],
+    "1.2": []
+  }
+}`)))
+	r := NewPyPI(urlmock.Client(), "https://my-mirror.com/")
+	versions, err := r.GetVersions(context.Background(), "pkg")
+	require.NoError(t, err)
+	sortByVersion(versions)
+	require.Equal(t, []*Version{
+		{
+			Version:   "1.0",
+			Published: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			URL:       "https://my-mirror.com/project/pkg/1.0/",
+			Tarball:   "https://files/pkg-1.0.tar.gz",
+			Checksum:  "aaaa",
+			Hashes:    []string{"aaaa", "bbbb"},
+		},
+		{
+			Version:   "1.1",
+			Published: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+			URL:       "https://my-mirror.com/project/pkg/1.1/",
+			Tarball:   "https://files/pkg-1.1-py3-none-any.whl",
+			Checksum:  "cccc",
+			Hashes:    []string{"cccc"},
+			Yanked:    true,
+		},
+	}, versions)
+}
+
+func TestPyPI_ParseVersion(t *testing.T) {
+	r := NewPyPI(nil, "")
+	test := func(version, expect string) {
+		v, err := r.ParseVersion(version)
+		require.NoError(t, err, version)
+		require.Equal(t, expect, v.String(), version)
+	}
+	test("1", "1.0.0")
+	test("1.2", "1.2.0")
+	test("1.2.3", "1.2.3")
+	test("1.2rc1", "1.2.0-rc.1")
+	test("1.2.0.alpha", "1.2.0-a.0")
+	test("1.2b2", "1.2.0-b.2")
+	test("1.2.dev3", "1.2.0-dev.3")
+	test("1.2a1.dev3", "1.2.0-a.1.dev.3")
+	test("1.2.post1", "1.2.0+post.1")
+	test("1.2-1", "1.2.0+post.1")
+
+	_, err := r.ParseVersion("1.2.3.4")
+	require.Error(t, err)
+	_, err = r.ParseVersion("1!2.0")
+	require.Error(t, err)
+}
+
+func TestCrates_GetVersions(t *testing.T) {
+	urlmock := mockhttpclient.NewURLMock()
+	urlmock.MockOnce("https://crates.io/api/v1/crates/pkg", mockhttpclient.MockGetDialogue([]byte(`{
+  "versions": [
+    {
+      "num": "0.2.0-beta.1",
+      "checksum": "2222",
+      "created_at": "2023-01-03T00:00:00.000000+00:00",
+      "dl_path": "/api/v1/crates/pkg/0.2.0-beta.1/download",
+      "yanked": false,
+      "published_by": null
+    },
+    {
+      "num": "0.1.0",
+      "checksum": "1111",
+      "created_at": "2023-01-02T00:00:00.000000+00:00",
+      "dl_path": "/api/v1/crates/pkg/0.1.0/download",
+      "yanked": true,
+      "published_by": {"login": "me"}
+    }
+  ]
+}`)))
+	r := NewCrates(urlmock.Client(), "")
+	versions, err := r.GetVersions(context.Background(), "pkg")
+	require.NoError(t, err)
+	require.Equal(t, []*Version{
+		{
+			Version:   "0.2.0-beta.1",
+			Published: time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+			URL:       "https://crates.io/crates/pkg/0.2.0-beta.1",
+			Tarball:   "https://crates.io/api/v1/crates/pkg/0.2.0-beta.1/download",
+			Checksum:  "2222",
+		},
+		{
+			Version:   "0.1.0",
+			Published: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+			Author:    "me",
+			URL:       "https://crates.io/crates/pkg/0.1.0",
+			Tarball:   "https://crates.io/api/v1/crates/pkg/0.1.0/download",
+			Checksum:  "1111",
+			Yanked:    true,
+		},
+	}, versions)
+}
+
+func TestGetVersions_Error(t *testing.T) {
+	urlmock := mockhttpclient.NewURLMock()
+	urlmock.MockOnce("https://crates.io/api/v1/crates/missing", mockhttpclient.MockGetError("404 Not Found", 404))
+	_, err := NewCrates(urlmock.Client(), "").GetVersions(context.Background(), "missing")
+	require.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestSortVersions(t *testing.T) {
+	r := NewNPM(nil, "")
+	versions := SortVersions(r, []*Version{
+		{Version: "1.10.0"},
+		{Version: "not-a-version"},
+		{Version: "1.2.0"},
+		{Version: "2.0.0-rc.1"},
+		{Version: "1.9.1"},
+	})
+	actual := make([]string, 0, len(versions))
+	for _, v := range versions {
+		actual = append(actual, v.Version)
+	}
+	require.Equal(t, []string{"2.0.0-rc.1", "1.10.0", "1.9.1", "1.2.0"}, actual)
+}
@@ -0,0 +1,160 @@
+package package_registry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+)
+
+const (
+	// DefaultPyPIURL is the URL of the Python Package Index.
+	DefaultPyPIURL = "https://pypi.org"
+)
+
+var (
+	// pep440Regex matches the subset of PEP 440 versions which we are able to
+	// translate into semantic versions. Epochs and local versions are not
+	// supported. See https://peps.python.org/pep-0440/
+	pep440Regex = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:[-_.]?(a|alpha|b|beta|c|rc|pre|preview)[-_.]?(\d*))?(?:[-_.]?(post|rev|r)[-_.]?(\d*)|-(\d+))?(?:[-_.]?(dev)[-_.]?(\d*))?$`)
+
+	// pep440PreReleases maps the alternate spellings of pre-release segments to
+	// their normalized forms.
+	pep440PreReleases = map[string]string{
+		"a":       "a",
+		"alpha":   "a",
+		"b":       "b",
+		"beta":    "b",
+		"c":       "rc",
+		"rc":      "rc",
+		"pre":     "rc",
+		"preview": "rc",
+	}
+)
+
+// pypiPackage is the subset of a PyPI project which we care about. See
+// https://warehouse.pypa.io/api-reference/json.html
+type pypiPackage struct {
+	Releases map[string][]struct {
+		Digests struct {
+			SHA256 string `json:"sha256"`
+		} `json:"digests"`
+		PackageType string    `json:"packagetype"`
+		UploadTime  time.Time `json:"upload_time_iso_8601"`
+		URL         string    `json:"url"`
+		Yanked      bool      `json:"yanked"`
+	} `json:"releases"`
+}
+
+// pypiRegistry implements Registry for PyPI.
+type pypiRegistry struct {
+	client *http.Client
+	url    string
+}
+
+// NewPyPI returns a Registry which retrieves packages from a PyPI-style
+// registry at the given URL. If the URL is empty, DefaultPyPIURL is used.
+func NewPyPI(client *http.Client, url string) Registry {
+	if url == "" {
+		url = DefaultPyPIURL
+	}
+	return &pypiRegistry{
+		client: client,
+		url:    strings.TrimSuffix(url, "/"),
+	}
+}
+
+// GetVersions implements Registry.
+func (r *pypiRegistry) GetVersions(ctx context.Context, pkg string) ([]*Version, error) {
+	url := fmt.Sprintf("%s/pypi/%s/json", r.url, pkg)
+	var resp pypiPackage
+	if err := getJSON(ctx, r.client, url, &resp); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	rv := make([]*Version, 0, len(resp.Releases))
+	for version, files := range resp.Releases {
+		// Releases with no files can't be installed.
+		if len(files) == 0 {
+			continue
+		}
+		v := &Version{
+			Version: version,
+			URL:     fmt.Sprintf("%s/project/%s/%s/", r.url, pkg, version),
+			Hashes:  make([]string, 0, len(files)),
+			Yanked:  true,
+		}
+		for _, f := range files {
+			if util.TimeIsZero(v.Published) || f.UploadTime.Before(v.Published) {
+				v.Published = f.UploadTime.UTC()
+			}
+			// Prefer the source distribution, if there is one.
+			if v.Tarball == "" || f.PackageType == "sdist" {
+				v.Tarball = f.URL
+				v.Checksum = f.Digests.SHA256
+			}
+			v.Hashes = append(v.Hashes, f.Digests.SHA256)
+			// The release is only considered to be yanked if all of its files
+			// are yanked.
+			v.Yanked = v.Yanked && f.Yanked
+		}
+		sort.Strings(v.Hashes)
+		rv = append(rv, v)
+	}
+	return rv, nil
+}
+
+// ParseVersion implements Registry. PEP 440 versions are translated into
+// semantic versions: alpha, beta and release candidate segments become
+// pre-release identifiers, eg. "1.2rc1" becomes "1.2.0-rc.1", as do development
+// releases, and post-releases become build metadata, which means that they
+// compare equal to the release they follow.
+func (r *pypiRegistry) ParseVersion(version string) (*semver.Version, error) {
+	m := pep440Regex.FindStringSubmatch(strings.ToLower(strings.TrimSpace(version)))
+	if m == nil {
+		return nil, skerr.Fmt("unsupported version %q", version)
+	}
+	release := []string{m[1], m[2], m[3]}
+	for idx, seg := range release {
+		if seg == "" {
+			release[idx] = "0"
+		}
+	}
+	normalized := strings.Join(release, ".")
+	var pre []string
+	if m[4] != "" {
+		pre = append(pre, pep440PreReleases[m[4]], numOrZero(m[5]))
+	}
+	if m[9] != "" {
+		pre = append(pre, "dev", numOrZero(m[10]))
+	}
+	if len(pre) > 0 {
+		normalized += "-" + strings.Join(pre, ".")
+	}
+	if m[6] != "" || m[8] != "" {
+		normalized += "+post." + numOrZero(m[7]+m[8])
+	}
+	v, err := semver.NewVersion(normalized)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to parse %q (normalized from %q)", normalized, version)
+	}
+	return v, nil
+}
+
+// numOrZero returns the given string or "0" if it is empty. PEP 440 allows
+// implicit zeroes in pre-, post- and dev-release segments.
+func numOrZero(s string) string {
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+// Assert that pypiRegistry implements Registry.
+var _ Registry = &pypiRegistry{}
@@ -0,0 +1,141 @@
+// Package inventory checks machines against the expected inventory of their
+// pool, to catch machines whose dimensions drift, for example after an OS or
+// driver update, which silently changes which tasks they run.
+package inventory
+
+import (
+	"encoding/json"
+	"io/fs"
+	"regexp"
+	"sort"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/pools"
+	"go.skia.org/infra/machine/go/machineserver/config"
+)
+
+// entry is a compiled config.InventoryEntry.
+type entry struct {
+	regex      *regexp.Regexp
+	dimensions map[string][]string
+}
+
+// poolInventory is a compiled config.Inventory.
+type poolInventory struct {
+	quarantineOnDrift bool
+	entries           []entry
+}
+
+// Inventory checks machines against the expected inventory of their pool.
+type Inventory struct {
+	pools *pools.Pools
+
+	// inventories maps pool names to their inventory. Pools without an
+	// inventory are absent.
+	inventories map[string]poolInventory
+}
+
+// New returns a new *Inventory, loading the inventory files named in the
+// config from fsys.
+func New(cfg config.InstanceConfig, fsys fs.FS, p *pools.Pools) (*Inventory, error) {
+	ret := &Inventory{
+		pools:       p,
+		inventories: map[string]poolInventory{},
+	}
+	for _, pool := range cfg.Pools {
+		if pool.Inventory == "" {
+			continue
+		}
+		b, err := fs.ReadFile(fsys, pool.Inventory)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "reading inventory for pool %q", pool.Name)
+		}
+		var inv config.Inventory
+		if err := json.Unmarshal(b, &inv); err != nil {
+			return nil, skerr.Wrapf(err, "parsing inventory for pool %q", pool.Name)
+		}
+		compiled, err := compile(inv)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "in inventory for pool %q", pool.Name)
+		}
+		ret.inventories[pool.Name] = compiled
+	}
+	return ret, nil
+}
+
+func compile(inv config.Inventory) (poolInventory, error) {
+	ret := poolInventory{
+		quarantineOnDrift: inv.QuarantineOnDrift,
+	}
+	for _, e := range inv.Machines {
+		r, err := regexp.Compile(e.Regex)
+		if err != nil {
+			return ret, skerr.Wrapf(err, "compiling regex %q", e.Regex)
+		}
+		if len(e.Dimensions) == 0 {
+			return ret, skerr.Fmt("no dimensions given for regex %q", e.Regex)
+		}
+		if _, ok := e.Dimensions[machine.DimID]; ok {
+			return ret, skerr.Fmt("the %q dimension can't be checked", machine.DimID)
+		}
+		ret.entries = append(ret.entries, entry{
+			regex:      r,
+			dimensions: e.Dimensions,
+		})
+	}
+	return ret, nil
+}
+
+// expected returns the expected dimensions for the given machine, and whether
+// the machine should be quarantined if they drift.
+func (i *Inventory) expected(machineID string) (map[string][]string, bool) {
+	inv, ok := i.inventories[i.pools.PoolForMachine(machineID)]
+	if !ok {
+		return nil, false
+	}
+	ret := map[string][]string{}
+	for _, e := range inv.entries {
+		if !e.regex.MatchString(machineID) {
+			continue
+		}
+		for k, v := range e.dimensions {
+			ret[k] = v
+		}
+	}
+	return ret, inv.quarantineOnDrift
+}
+
+// Check compares the dimensions of the given machine against its expected
+// inventory. It returns the drift, sorted by dimension, which is empty if the
+// machine matches or isn't in the inventory, and whether the machine should be
+// quarantined because of the drift.
+func (i *Inventory) Check(d machine.Description) ([]machine.DimensionDrift, bool) {
+	expected, quarantineOnDrift := i.expected(d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID))
+	ret := []machine.DimensionDrift{}
+	for dim, want := range expected {
+		got := d.Dimensions[dim]
+		if sameValues(want, got) {
+			continue
+		}
+		ret = append(ret, machine.DimensionDrift{
+			Dimension: dim,
+			Expected:  want,
+			Actual:    got,
+		})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Dimension < ret[j].Dimension
+	})
+	return ret, quarantineOnDrift && len(ret) > 0
+}
+
+// sameValues returns true if both slices contain the same values, ignoring
+// order.
+func sameValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	return util.NewStringSet(a).Equals(util.NewStringSet(b))
+}
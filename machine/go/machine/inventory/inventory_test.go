@@ -0,0 +1,154 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/pools"
+	"go.skia.org/infra/machine/go/machineserver/config"
+)
+
+const (
+	machineID         = "skia-rpi2-rack4-shelf1-001"
+	internalMachineID = "skia-i-rpi-001"
+)
+
+const skiaInventory = `{
+	"quarantine_on_drift": true,
+	"machines": [
+		{
+			"regex": "^skia-rpi2-",
+			"dimensions": {
+				"device_type": ["sargo"],
+				"device_os": ["Q", "QQ1A.191205.008"]
+			}
+		},
+		{
+			"regex": "^skia-rpi2-rack4-shelf1-001$",
+			"dimensions": {
+				"device_type": ["sunfish"]
+			}
+		}
+	]
+}`
+
+func setupForTest(t *testing.T) *Inventory {
+	cfg := config.InstanceConfig{
+		Pools: []config.Pool{
+			{
+				Name:  machine.PoolSkiaInternal,
+				Regex: "^skia-i-",
+			},
+			{
+				Name:      machine.PoolSkia,
+				Regex:     "^skia-",
+				Inventory: "skia.json",
+			},
+		},
+	}
+	p, err := pools.New(cfg)
+	require.NoError(t, err)
+	inv, err := New(cfg, fstest.MapFS{
+		"skia.json": {Data: []byte(skiaInventory)},
+	}, p)
+	require.NoError(t, err)
+	return inv
+}
+
+func newDescription(machineID string, dims machine.SwarmingDimensions) machine.Description {
+	d := machine.NewDescription(context.Background())
+	for k, v := range dims {
+		d.Dimensions[k] = v
+	}
+	d.Dimensions[machine.DimID] = []string{machineID}
+	return d
+}
+
+func TestCheck_DimensionsMatchInAnyOrder_NoDrift(t *testing.T) {
+	inv := setupForTest(t)
+	drift, quarantine := inv.Check(newDescription(machineID, machine.SwarmingDimensions{
+		"device_type": {"sunfish"},
+		"device_os":   {"QQ1A.191205.008", "Q"},
+		"gpu":         {"not-checked"},
+	}))
+	require.Empty(t, drift)
+	require.False(t, quarantine)
+}
+
+func TestCheck_DimensionsDiffer_ReturnsSortedDriftAndQuarantine(t *testing.T) {
+	inv := setupForTest(t)
+	drift, quarantine := inv.Check(newDescription(machineID, machine.SwarmingDimensions{
+		"device_type": {"sargo"},
+		"device_os":   {"R", "RP1A.200720.009"},
+	}))
+	require.Equal(t, []machine.DimensionDrift{
+		{
+			Dimension: "device_os",
+			Expected:  []string{"Q", "QQ1A.191205.008"},
+			Actual:    []string{"R", "RP1A.200720.009"},
+		},
+		{
+			Dimension: "device_type",
+			Expected:  []string{"sunfish"},
+			Actual:    []string{"sargo"},
+		},
+	}, drift)
+	require.True(t, quarantine)
+}
+
+func TestCheck_DimensionMissing_ReturnsDrift(t *testing.T) {
+	inv := setupForTest(t)
+	drift, _ := inv.Check(newDescription("skia-rpi2-rack4-shelf1-002", machine.SwarmingDimensions{
+		"device_type": {"sargo"},
+	}))
+	require.Equal(t, []machine.DimensionDrift{
+		{
+			Dimension: "device_os",
+			Expected:  []string{"Q", "QQ1A.191205.008"},
+		},
+	}, drift)
+}
+
+func TestCheck_PoolHasNoInventory_NoDrift(t *testing.T) {
+	inv := setupForTest(t)
+	drift, quarantine := inv.Check(newDescription(internalMachineID, machine.SwarmingDimensions{
+		"device_type": {"sargo"},
+	}))
+	require.Empty(t, drift)
+	require.False(t, quarantine)
+}
+
+func TestNew_MissingInventoryFile_ReturnsError(t *testing.T) {
+	cfg := config.InstanceConfig{
+		Pools: []config.Pool{
+			{
+				Name:      machine.PoolSkia,
+				Inventory: "missing.json",
+			},
+		},
+	}
+	p, err := pools.New(cfg)
+	require.NoError(t, err)
+	_, err = New(cfg, fstest.MapFS{}, p)
+	require.Error(t, err)
+}
+
+func TestNew_InventoryChecksIDDimension_ReturnsError(t *testing.T) {
+	cfg := config.InstanceConfig{
+		Pools: []config.Pool{
+			{
+				Name:      machine.PoolSkia,
+				Inventory: "skia.json",
+			},
+		},
+	}
+	p, err := pools.New(cfg)
+	require.NoError(t, err)
+	_, err = New(cfg, fstest.MapFS{
+		"skia.json": {Data: []byte(`{"machines": [{"regex": "", "dimensions": {"id": ["foo"]}}]}`)},
+	}, p)
+	require.Error(t, err)
+}
@@ -2,6 +2,7 @@ package machine
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -132,9 +133,104 @@ const (
 	// AttachedDeviceSSH means a ChromeOS device, or any other device we
 	// interact with via SSH.
 	AttachedDeviceSSH AttachedDevice = "ssh"
+
+	// AttachedDeviceEmulator means a headless Android emulator that
+	// test_machine_monitor runs on the test machine and talks to via adb.
+	AttachedDeviceEmulator AttachedDevice = "emulator"
 )
 
-var AllAttachedDevices = []AttachedDevice{AttachedDeviceNone, AttachedDeviceAdb, AttachedDeviceIOS, AttachedDeviceSSH}
+var AllAttachedDevices = []AttachedDevice{AttachedDeviceNone, AttachedDeviceAdb, AttachedDeviceIOS, AttachedDeviceSSH, AttachedDeviceEmulator}
+
+// RecoveryStep is a single step in the automated recovery workflow that
+// machineserver runs for quarantined machines.
+type RecoveryStep string
+
+const (
+	// RecoveryStepNone means the machine is not being recovered. This is the
+	// default.
+	RecoveryStepNone RecoveryStep = ""
+
+	// RecoveryStepRebootDevice asks test_machine_monitor to reboot the attached
+	// device.
+	RecoveryStepRebootDevice RecoveryStep = "reboot_device"
+
+	// RecoveryStepPowerCycle requests a powercycle of the machine.
+	RecoveryStepPowerCycle RecoveryStep = "powercycle"
+
+	// RecoveryStepReprovision calls an external hook to reflash or re-provision
+	// the machine.
+	RecoveryStepReprovision RecoveryStep = "reprovision"
+
+	// RecoveryStepMaintenance puts the machine into maintenance mode and sends a
+	// notification so a human can take a look. This is always the last step.
+	RecoveryStepMaintenance RecoveryStep = "maintenance"
+
+	// RecoveryStepRecovered is only used in RecoveryAttempt to record that the
+	// machine left quarantine.
+	RecoveryStepRecovered RecoveryStep = "recovered"
+)
+
+// AllRecoverySteps is every RecoveryStep that can appear in a recovery policy.
+var AllRecoverySteps = []RecoveryStep{RecoveryStepRebootDevice, RecoveryStepPowerCycle, RecoveryStepReprovision, RecoveryStepMaintenance}
+
+// MaxRecoveryHistory is the maximum number of RecoveryAttempts kept for a
+// single machine.
+const MaxRecoveryHistory = 20
+
+// RecoveryAttempt records a single step taken by the recovery workflow.
+type RecoveryAttempt struct {
+	Step      RecoveryStep
+	Message   string
+	Timestamp time.Time
+}
+
+// RecoveryState is the state of the automated recovery workflow for a single
+// machine.
+type RecoveryState struct {
+	// Step is the step currently being tried, or RecoveryStepNone if the
+	// machine isn't being recovered.
+	Step RecoveryStep
+
+	// StepStarted is when Step was started.
+	StepStarted time.Time
+
+	// History of the most recent attempts, oldest first.
+	History []RecoveryAttempt
+}
+
+// AddAttempt appends an attempt to the History, dropping the oldest entries
+// so that at most MaxRecoveryHistory remain.
+func (r *RecoveryState) AddAttempt(attempt RecoveryAttempt) {
+	r.History = append(r.History, attempt)
+	if len(r.History) > MaxRecoveryHistory {
+		r.History = r.History[len(r.History)-MaxRecoveryHistory:]
+	}
+}
+
+// Reservation is a lease of a single machine by a user, during which the
+// machine doesn't run any tasks.
+type Reservation struct {
+	// User is the email address of the user that holds the reservation. The
+	// machine is reserved if this is non-empty.
+	User string
+
+	// Reason is a user supplied message describing why the machine was
+	// reserved.
+	Reason string
+
+	// Start is when the reservation was made.
+	Start time.Time
+
+	// Expires is when the reservation ends and the machine is released.
+	Expires time.Time
+
+	// PowerCycleOnRelease is true if the machine should be power-cycled when
+	// it is released.
+	PowerCycleOnRelease bool
+
+	// ReminderSent is true once the expiry reminder has been sent.
+	ReminderSent bool
+}
 
 // Annotation represents a timestamped message.
 type Annotation struct {
@@ -216,6 +312,13 @@ type Description struct {
 	// machineserver during Update.
 	TaskStarted time.Time `sql:"task_started TIMESTAMPTZ NOT NULL DEFAULT (0)::TIMESTAMPTZ"`
 
+	// Recovery is the state of the automated recovery workflow for this
+	// machine. See machine/go/machine/recovery.
+	Recovery RecoveryState `sql:"recovery JSONB NOT NULL DEFAULT '{}'::JSONB"`
+
+	// Reservation is the current reservation of the machine, if any.
+	Reservation Reservation `sql:"reservation JSONB NOT NULL DEFAULT '{}'::JSONB"`
+
 	// Create a computed column with the machine id to use as the primary key.
 	machineIDComputed struct{} `sql:"machine_id STRING PRIMARY KEY AS (dimensions->'id'->>0) STORED"`
 
@@ -243,6 +346,11 @@ func (d Description) InMaintenanceMode() bool {
 	return d.MaintenanceMode != ""
 }
 
+// IsReserved returns true if the machine is reserved, i.e. has a non-empty Reservation.User.
+func (d Description) IsReserved() bool {
+	return d.Reservation.User != ""
+}
+
 // DestFromDescription returns a slice of interface containing pointers to every public member
 // of Description. This is useful in code that stores the Description in an SQL database.
 //
@@ -270,6 +378,8 @@ func DestFromDescription(d *Description) []interface{} {
 		&d.Dimensions,
 		&d.TaskRequest,
 		&d.TaskStarted,
+		&d.Recovery,
+		&d.Reservation,
 	}
 }
 
@@ -286,6 +396,9 @@ func SetSwarmingQuarantinedMessage(d *Description) bool {
 	if d.IsRecovering() {
 		parts = append(parts, "Recovering: "+d.Recovering)
 	}
+	if d.IsReserved() {
+		parts = append(parts, "Reserved: "+d.Reservation.User)
+	}
 	msg := strings.Join(parts, ", ")
 
 	delete(d.Dimensions, DimQuarantined)
@@ -319,6 +432,10 @@ func (d Description) Copy() Description {
 		tr := *d.TaskRequest
 		ret.TaskRequest = &tr
 	}
+	if d.Recovery.History != nil {
+		ret.Recovery.History = make([]RecoveryAttempt, len(d.Recovery.History))
+		copy(ret.Recovery.History, d.Recovery.History)
+	}
 	return ret
 }
 
@@ -459,3 +576,56 @@ type TaskResult struct {
 	machineIndex struct{}         `sql:"INDEX by_machine_id (machine_id)"`
 	statusIndex  struct{}         `sql:"INDEX by_status (status)"`
 }
+
+// TelemetrySample is a snapshot of the health of a machine taken each time the
+// machine reports in. Unlike the Battery, Temperature, and DeviceUptime values
+// in Description, which only hold the latest values, samples are kept so that
+// trends can be computed over time.
+type TelemetrySample struct {
+	MachineID    string             `sql:"machine_id STRING NOT NULL"`
+	Timestamp    time.Time          `sql:"ts TIMESTAMPTZ NOT NULL"`
+	Battery      int                `sql:"battery INT NOT NULL DEFAULT 0"`
+	Temperature  map[string]float64 `sql:"temperatures JSONB NOT NULL"`
+	DeviceUptime int32              `sql:"device_uptime INT4 NOT NULL DEFAULT 0"`
+	primaryKey   struct{}           `sql:"PRIMARY KEY (machine_id, ts)"`
+	tsIndex      struct{}           `sql:"INDEX by_ts (ts)"`
+}
+
+// NewTelemetrySample returns a TelemetrySample of the given Description taken
+// at the Description's LastUpdated time.
+func NewTelemetrySample(d Description) TelemetrySample {
+	temperature := map[string]float64{}
+	for k, v := range d.Temperature {
+		temperature[k] = v
+	}
+	return TelemetrySample{
+		MachineID:    d.Dimensions.GetDimensionValueOrEmptyString(DimID),
+		Timestamp:    d.LastUpdated,
+		Battery:      d.Battery,
+		Temperature:  temperature,
+		DeviceUptime: d.DeviceUptime,
+	}
+}
+
+// DimensionDrift describes a dimension whose values don't match what is
+// expected for the machine.
+type DimensionDrift struct {
+	Dimension string
+	Expected  []string
+	Actual    []string
+}
+
+// String returns a human readable description of the drift.
+func (d DimensionDrift) String() string {
+	return fmt.Sprintf("%s: expected %q, got %q", d.Dimension, d.Expected, d.Actual)
+}
+
+// DimensionDriftMessage returns a human readable description of all the
+// drift, or the empty string if there is none.
+func DimensionDriftMessage(drift []DimensionDrift) string {
+	messages := make([]string, 0, len(drift))
+	for _, d := range drift {
+		messages = append(messages, d.String())
+	}
+	return strings.Join(messages, "; ")
+}
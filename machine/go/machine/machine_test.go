@@ -122,6 +122,14 @@ func TestSetSwarmingQuarantinedMessage_MaintenanceModeAndQuarantinedAndRecoverin
 	require.True(t, quarantined)
 }
 
+func TestSetSwarmingQuarantinedMessage_Reserved_MessageIsSet(t *testing.T) {
+	d := descForCombination("", false, "")
+	d.Reservation.User = "barney@example.com"
+	quarantined := machine.SetSwarmingQuarantinedMessage(&d)
+	require.Equal(t, "Reserved: barney@example.com", d.Dimensions[machine.DimQuarantined][0])
+	require.True(t, quarantined)
+}
+
 func TestDescription_IsRecovering_ReturnsTrueIfHasRecoveryMessage(t *testing.T) {
 	require.True(t, machine.Description{Recovering: "any non-empty string"}.IsRecovering())
 }
@@ -137,3 +145,28 @@ func TestDescription_InMaintenanceMode_ReturnsTrueIfHasMaintenanceModeMessage(t
 func TestDescription_InMaintenanceMode_ReturnsFalseIfMaintenanceModeMessageIsEmpty(t *testing.T) {
 	require.False(t, machine.Description{}.InMaintenanceMode())
 }
+
+func TestRecoveryState_AddAttempt_HistoryIsCapped(t *testing.T) {
+	var r machine.RecoveryState
+	for i := 0; i < machine.MaxRecoveryHistory+5; i++ {
+		r.AddAttempt(machine.RecoveryAttempt{
+			Step:      machine.RecoveryStepRebootDevice,
+			Timestamp: time.Unix(int64(i), 0),
+		})
+	}
+	require.Len(t, r.History, machine.MaxRecoveryHistory)
+	require.Equal(t, time.Unix(5, 0), r.History[0].Timestamp)
+	require.Equal(t, time.Unix(int64(machine.MaxRecoveryHistory+4), 0), r.History[machine.MaxRecoveryHistory-1].Timestamp)
+}
+
+func TestDimensionDriftMessage_NoDrift_ReturnsEmptyString(t *testing.T) {
+	require.Equal(t, "", machine.DimensionDriftMessage(nil))
+}
+
+func TestDimensionDriftMessage_MultipleDrifts_JoinsDescriptions(t *testing.T) {
+	drift := []machine.DimensionDrift{
+		{Dimension: machine.DimGPU, Expected: []string{"10de:2184"}, Actual: []string{"10de:1cb3"}},
+		{Dimension: machine.DimOS, Expected: []string{"Debian-11"}},
+	}
+	require.Equal(t, `gpu: expected ["10de:2184"], got ["10de:1cb3"]; os: expected ["Debian-11"], got []`, machine.DimensionDriftMessage(drift))
+}
@@ -35,7 +35,7 @@ var FullyFilledInDescription = machine.Description{
 		"alpha":         []string{"beta", "gamma"},
 		"task_type":     []string{"swarming"},
 		machine.DimQuarantined: []string{
-			"Maintenance: jcgregorio 2022-11-08, Forced Quarantine, Recovering: too hot",
+			"Maintenance: jcgregorio 2022-11-08, Forced Quarantine, Recovering: too hot, Reserved: fred@example.com",
 		},
 	},
 	SuppliedDimensions: machine.SwarmingDimensions{
@@ -56,4 +56,22 @@ var FullyFilledInDescription = machine.Description{
 		Command: []string{"./helloworld"},
 	},
 	TaskStarted: MockTime,
+	Recovery: machine.RecoveryState{
+		Step:        machine.RecoveryStepPowerCycle,
+		StepStarted: MockTime,
+		History: []machine.RecoveryAttempt{
+			{
+				Step:      machine.RecoveryStepRebootDevice,
+				Message:   "Requested device reboot.",
+				Timestamp: MockTime,
+			},
+		},
+	},
+	Reservation: machine.Reservation{
+		User:                "fred@example.com",
+		Reason:              "Debugging a flaky test.",
+		Start:               MockTime,
+		Expires:             MockTime.Add(4 * time.Hour),
+		PowerCycleOnRelease: true,
+	},
 }
@@ -72,16 +72,20 @@ func (p *Pools) HasValidPool(d machine.Description) bool {
 	return ok && len(pool) == 1 && util.In(pool[0], p.allValidPoolNames)
 }
 
-// SetSwarmingPool based on the machine id.
+// PoolForMachine returns the name of the pool the machine with the given id
+// belongs to, or UnknownPool if it doesn't match any pool.
 //
 // Pools are checked in the order they appear in the config file.
-func (p *Pools) SetSwarmingPool(d *machine.Description) {
-	machineName := d.Dimensions.GetDimensionValueOrEmptyString("id")
+func (p *Pools) PoolForMachine(machineID string) string {
 	for _, pool := range p.pools {
-		if pool.Regex.MatchString(machineName) {
-			d.Dimensions[machine.DimPool] = []string{pool.Name}
-			return
+		if pool.Regex.MatchString(machineID) {
+			return pool.Name
 		}
 	}
-	d.Dimensions[machine.DimPool] = []string{UnknownPool}
+	return UnknownPool
+}
+
+// SetSwarmingPool based on the machine id.
+func (p *Pools) SetSwarmingPool(d *machine.Description) {
+	d.Dimensions[machine.DimPool] = []string{p.PoolForMachine(d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID))}
 }
@@ -60,6 +60,13 @@ func TestSetSwarmingPool_EmpytPoolConfig_DimPoolSetToUnknownPool(t *testing.T) {
 	require.Equal(t, UnknownPool, d.Dimensions.GetDimensionValueOrEmptyString(machine.DimPool))
 }
 
+func TestPoolForMachine_PoolsAreCheckedInOrder(t *testing.T) {
+	p, _ := setupForTest(t)
+	require.Equal(t, machine.PoolSkiaInternal, p.PoolForMachine("skia-i-rpi-001"))
+	require.Equal(t, machine.PoolSkia, p.PoolForMachine("skia-rpi2-rack4-shelf1-002"))
+	require.Equal(t, UnknownPool, p.PoolForMachine("some-other-machine"))
+}
+
 func TestNew_InvalidPoolName_ReturnsError(t *testing.T) {
 	_, err := New(config.InstanceConfig{
 		Pools: []config.Pool{
@@ -21,6 +21,7 @@ import (
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/go/util"
 	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/inventory"
 )
 
 const (
@@ -89,13 +90,18 @@ var (
 
 // ProcessorImpl implements the Processor interface.
 type ProcessorImpl struct {
+	// inventory, if not nil, is used to detect dimension drift.
+	inventory *inventory.Inventory
+
 	unknownEventTypeCount metrics2.Counter
 	eventsProcessedCount  metrics2.Counter
 }
 
-// New returns a new Processor instance.
-func New(ctx context.Context) *ProcessorImpl {
+// New returns a new Processor instance. The inventory may be nil, in which
+// case dimension drift isn't checked.
+func New(ctx context.Context, inv *inventory.Inventory) *ProcessorImpl {
 	return &ProcessorImpl{
+		inventory:             inv,
 		unknownEventTypeCount: metrics2.GetCounter("machineserver_processor_unknown_event_type"),
 		eventsProcessedCount:  metrics2.GetCounter("machineserver_processor_events_processed"),
 	}
@@ -110,6 +116,7 @@ func (p *ProcessorImpl) Process(ctx context.Context, previous machine.Descriptio
 		return previous
 	}
 	next := p.processEvent(ctx, previous, event)
+	next = p.handleDimensionDrift(ctx, previous, next)
 
 	if event.ForcedQuarantine {
 		next.IsQuarantined = true
@@ -177,6 +184,33 @@ func processAndroidEvent(ctx context.Context, previous machine.Description, even
 	return ret
 }
 
+// handleDimensionDrift checks the machine against its expected inventory,
+// records changes in the drift as an Annotation, and quarantines the machine
+// if the inventory asks for it.
+func (p *ProcessorImpl) handleDimensionDrift(ctx context.Context, previous, current machine.Description) machine.Description {
+	if p.inventory == nil {
+		return current
+	}
+	drift, quarantine := p.inventory.Check(current)
+	metrics2.GetBoolMetric("machine_processor_dimension_drift", map[string]string{"machine": current.Dimensions.GetDimensionValueOrEmptyString(machine.DimID)}).Update(len(drift) > 0)
+
+	message := machine.DimensionDriftMessage(drift)
+	previousDrift, _ := p.inventory.Check(previous)
+	if message != machine.DimensionDriftMessage(previousDrift) {
+		current.Annotation.Timestamp = now.Now(ctx)
+		current.Annotation.User = machineUserName
+		if message == "" {
+			current.Annotation.Message = "Dimensions match the inventory again."
+		} else {
+			current.Annotation.Message = "Dimension drift: " + message
+		}
+	}
+	if quarantine {
+		current.IsQuarantined = true
+	}
+	return current
+}
+
 // handleGeneralFields extracts general information from the event.
 func handleGeneralFields(ctx context.Context, current machine.Description, event machine.Event) machine.Description {
 	current.LastUpdated = now.Now(ctx)
@@ -432,6 +466,13 @@ func dimensionsFromAndroidProperties(prop map[string]string) map[string][]string
 		ret["android_hwasan_build"] = []string{"1"}
 	}
 
+	// Detects whether the device is an emulator, and if so, advertises it via
+	// an extra dimension so that tasks can ask for, or avoid, emulators. Newer
+	// emulators set ro.boot.qemu, older ones set ro.kernel.qemu.
+	if prop["ro.boot.qemu"] == "1" || prop["ro.kernel.qemu"] == "1" {
+		ret["android_emulator"] = []string{"1"}
+	}
+
 	return ret
 }
 
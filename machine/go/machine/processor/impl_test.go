@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,9 @@ import (
 	"go.skia.org/infra/go/metrics2"
 	"go.skia.org/infra/go/now"
 	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/inventory"
+	"go.skia.org/infra/machine/go/machine/pools"
+	"go.skia.org/infra/machine/go/machineserver/config"
 )
 
 func TestParseAndroidProperties_HappyPath(t *testing.T) {
@@ -83,6 +87,27 @@ func TestDimensionsFromAndroidProperties_AppendIncrementalBuildToDeviceOS(t *tes
 	assert.Equal(t, expected, got)
 }
 
+func TestDimensionsFromAndroidProperties_Emulator_AddsEmulatorDimension(t *testing.T) {
+
+	adbResponse := strings.Join([]string{
+		"[ro.build.id]: [TE1A.220922.010]", // device_os
+		"[ro.product.device]: [emu64x]",    // device_type
+		"[ro.boot.qemu]: [1]",              // android_emulator
+	}, "\n")
+
+	dimensions := parseAndroidProperties(adbResponse)
+	got := dimensionsFromAndroidProperties(dimensions)
+
+	expected := map[string][]string{
+		"android_devices":     {"1"},
+		"android_emulator":    {"1"},
+		"device_os":           {"T", "TE1A.220922.010"},
+		machine.DimDeviceType: {"emu64x"},
+		machine.DimOS:         {"Android"},
+	}
+	assert.Equal(t, expected, got)
+}
+
 func TestDimensionsFromAndroidProperties_EmptyFromEmpty(t *testing.T) {
 
 	dimensions := parseAndroidProperties("")
@@ -90,7 +115,7 @@ func TestDimensionsFromAndroidProperties_EmptyFromEmpty(t *testing.T) {
 }
 
 func newProcessorForTest() *ProcessorImpl {
-	p := New(context.Background())
+	p := New(context.Background(), nil)
 	p.eventsProcessedCount.Reset()
 	p.unknownEventTypeCount.Reset()
 	return p
@@ -1400,3 +1425,84 @@ func TestProcess_Standalone_DockerInstalled_Success(t *testing.T) {
 		Version: "2021-07-22-jcgregorio-78bcc725fef1e29b518291469b8ad8f0cc3b21e4",
 	}, next)
 }
+
+func newProcessorWithInventoryForTest(t *testing.T) *ProcessorImpl {
+	cfg := config.InstanceConfig{
+		Pools: []config.Pool{
+			{
+				Name:      machine.PoolSkia,
+				Regex:     "^skia-",
+				Inventory: "skia.json",
+			},
+		},
+	}
+	pl, err := pools.New(cfg)
+	require.NoError(t, err)
+	inv, err := inventory.New(cfg, fstest.MapFS{
+		"skia.json": {Data: []byte(`{
+			"quarantine_on_drift": true,
+			"machines": [
+				{"regex": "^skia-e-linux-", "dimensions": {"gpu": ["10de:2184-470.82.01"]}}
+			]
+		}`)},
+	}, pl)
+	require.NoError(t, err)
+	return New(context.Background(), inv)
+}
+
+func standaloneEventWithGPU(gpu string) machine.Event {
+	return machine.Event{
+		EventType: machine.EventTypeRawState,
+		Host: machine.Host{
+			Name: "skia-e-linux-100",
+		},
+		Standalone: machine.Standalone{
+			Cores:      4,
+			CPUs:       []string{"x86-64"},
+			GPUs:       []string{gpu},
+			OSVersions: []string{"Debian", "Debian-11", "Debian-11.0", "Linux"},
+		},
+	}
+}
+
+func TestProcess_DimensionsDriftFromInventory_AnnotatedAndQuarantined(t *testing.T) {
+	serverTime := time.Date(2021, time.September, 1, 10, 1, 5, 0, time.UTC)
+	ctx := now.TimeTravelingContext(serverTime)
+	p := newProcessorWithInventoryForTest(t)
+	previous := p.Process(ctx, machine.Description{}, standaloneEventWithGPU("10de:2184-470.82.01"))
+	require.False(t, previous.IsQuarantined)
+
+	next := p.Process(ctx, previous, standaloneEventWithGPU("10de:2184-510.47.03"))
+
+	assert.True(t, next.IsQuarantined)
+	assert.Equal(t, machine.Annotation{
+		Message:   `Dimension drift: gpu: expected ["10de:2184-470.82.01"], got ["10de:2184-510.47.03"]`,
+		User:      machineUserName,
+		Timestamp: serverTime,
+	}, next.Annotation)
+}
+
+func TestProcess_DimensionsMatchInventoryAgain_Annotated(t *testing.T) {
+	serverTime := time.Date(2021, time.September, 1, 10, 1, 5, 0, time.UTC)
+	ctx := now.TimeTravelingContext(serverTime)
+	p := newProcessorWithInventoryForTest(t)
+	previous := p.Process(ctx, machine.Description{}, standaloneEventWithGPU("10de:2184-510.47.03"))
+	require.True(t, previous.IsQuarantined)
+
+	next := p.Process(ctx, previous, standaloneEventWithGPU("10de:2184-470.82.01"))
+
+	assert.Equal(t, "Dimensions match the inventory again.", next.Annotation.Message)
+	// Clearing the quarantine is left to a human.
+	assert.True(t, next.IsQuarantined)
+}
+
+func TestProcess_DriftUnchanged_AnnotationNotUpdated(t *testing.T) {
+	ctx := now.TimeTravelingContext(time.Date(2021, time.September, 1, 10, 1, 5, 0, time.UTC))
+	p := newProcessorWithInventoryForTest(t)
+	previous := p.Process(ctx, machine.Description{}, standaloneEventWithGPU("10de:2184-510.47.03"))
+	previous.Annotation.Message = "Some other message."
+
+	next := p.Process(ctx, previous, standaloneEventWithGPU("10de:2184-510.47.03"))
+
+	assert.Equal(t, "Some other message.", next.Annotation.Message)
+}
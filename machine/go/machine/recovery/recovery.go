@@ -0,0 +1,350 @@
+// Package recovery runs an automated, escalating recovery workflow for
+// quarantined machines, e.g. reboot the device, then powercycle, then
+// re-provision, then put the machine into maintenance mode and notify a human.
+//
+// The policy is configured per pool, see config.RecoveryPolicy, and the state
+// of the workflow for each machine is stored in machine.Description.Recovery.
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.skia.org/infra/go/metrics2"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/store"
+	"go.skia.org/infra/machine/go/machineserver/config"
+)
+
+// User is the name recorded in Annotations and MaintenanceMode for changes
+// made by the recovery workflow.
+const User = "machineserver-recovery"
+
+// HookRequest is the body of the POST request sent to hook URLs.
+type HookRequest struct {
+	MachineID string                    `json:"machine_id"`
+	Pool      string                    `json:"pool"`
+	Step      machine.RecoveryStep      `json:"step"`
+	History   []machine.RecoveryAttempt `json:"history"`
+}
+
+// Hook is called for steps that rely on an external system, i.e. to reflash
+// or re-provision a machine, or to file a notification.
+type Hook interface {
+	// Call the hook at the given URL.
+	Call(ctx context.Context, url string, req HookRequest) error
+}
+
+// HTTPHook implements Hook by sending the HookRequest as JSON in a POST
+// request.
+type HTTPHook struct {
+	client *http.Client
+}
+
+// NewHTTPHook returns a new *HTTPHook that uses the given client.
+func NewHTTPHook(client *http.Client) *HTTPHook {
+	return &HTTPHook{
+		client: client,
+	}
+}
+
+// Call implements Hook.
+func (h *HTTPHook) Call(ctx context.Context, url string, req HookRequest) error {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := h.client.Do(httpReq)
+	if err != nil {
+		return skerr.Wrapf(err, "calling hook %q", url)
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return skerr.Fmt("hook %q returned status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+// Confirm *HTTPHook implements Hook.
+var _ Hook = (*HTTPHook)(nil)
+
+// step is the parsed form of config.RecoveryStep.
+type step struct {
+	step    machine.RecoveryStep
+	timeout time.Duration
+	url     string
+}
+
+// policy is the parsed form of config.RecoveryPolicy.
+type policy []step
+
+// newPolicy parses and validates the given config.
+func newPolicy(cfg *config.RecoveryPolicy) (policy, error) {
+	var ret policy
+	for i, s := range cfg.Steps {
+		rs := machine.RecoveryStep(s.Step)
+		if !util.In(string(rs), stepNames()) {
+			return nil, skerr.Fmt("unknown recovery step %q", s.Step)
+		}
+		if rs == machine.RecoveryStepMaintenance && i != len(cfg.Steps)-1 {
+			return nil, skerr.Fmt("%q must be the last recovery step", rs)
+		}
+		if rs == machine.RecoveryStepReprovision && s.URL == "" {
+			return nil, skerr.Fmt("%q requires a url", rs)
+		}
+		var timeout time.Duration
+		if s.Timeout != "" {
+			var err error
+			timeout, err = time.ParseDuration(s.Timeout)
+			if err != nil {
+				return nil, skerr.Wrapf(err, "parsing timeout for step %q", rs)
+			}
+		} else if rs != machine.RecoveryStepMaintenance && i != len(cfg.Steps)-1 {
+			return nil, skerr.Fmt("step %q requires a timeout", rs)
+		}
+		ret = append(ret, step{
+			step:    rs,
+			timeout: timeout,
+			url:     s.URL,
+		})
+	}
+	return ret, nil
+}
+
+func stepNames() []string {
+	ret := make([]string, 0, len(machine.AllRecoverySteps))
+	for _, s := range machine.AllRecoverySteps {
+		ret = append(ret, string(s))
+	}
+	return ret
+}
+
+// indexOf returns the index of the given step in the policy, or -1 if it
+// isn't present.
+func (p policy) indexOf(rs machine.RecoveryStep) int {
+	for i, s := range p {
+		if s.step == rs {
+			return i
+		}
+	}
+	return -1
+}
+
+// applicable returns true if the given step can be applied to the machine.
+func applicable(rs machine.RecoveryStep, d machine.Description) bool {
+	switch rs {
+	case machine.RecoveryStepRebootDevice:
+		return d.AttachedDevice != machine.AttachedDeviceNone && d.AttachedDevice != ""
+	case machine.RecoveryStepPowerCycle:
+		return d.PowerCycleState == machine.Available
+	}
+	return true
+}
+
+// firstApplicable returns the first step at or after index i that can be
+// applied to the machine.
+func (p policy) firstApplicable(i int, d machine.Description) (step, bool) {
+	for ; i < len(p); i++ {
+		if applicable(p[i].step, d) {
+			return p[i], true
+		}
+	}
+	return step{}, false
+}
+
+// next returns the step to start for the given machine, which is
+// machine.RecoveryStepRecovered if the machine has left quarantine. Returns
+// false if nothing needs to be done.
+func (p policy) next(d machine.Description, ts time.Time) (step, bool) {
+	current := d.Recovery.Step
+	if !d.IsQuarantined {
+		if current == machine.RecoveryStepNone {
+			return step{}, false
+		}
+		return step{step: machine.RecoveryStepRecovered}, true
+	}
+	if len(p) == 0 {
+		return step{}, false
+	}
+
+	idx := p.indexOf(current)
+	if current == machine.RecoveryStepNone || idx == -1 {
+		// Don't interfere with machines that someone has already put into
+		// maintenance mode.
+		if d.InMaintenanceMode() {
+			return step{}, false
+		}
+		return p.firstApplicable(0, d)
+	}
+	if current == machine.RecoveryStepMaintenance {
+		if d.InMaintenanceMode() {
+			// Waiting on a human.
+			return step{}, false
+		}
+		// Someone took the machine out of maintenance mode without clearing
+		// the quarantine, so start over.
+		return p.firstApplicable(0, d)
+	}
+	if ts.Sub(d.Recovery.StepStarted) < p[idx].timeout {
+		return step{}, false
+	}
+	// If we run out of steps the machine stays on the last step.
+	return p.firstApplicable(idx+1, d)
+}
+
+// message returns the description of starting the given step.
+func message(rs machine.RecoveryStep) string {
+	switch rs {
+	case machine.RecoveryStepRebootDevice:
+		return "Requested device reboot."
+	case machine.RecoveryStepPowerCycle:
+		return "Requested powercycle."
+	case machine.RecoveryStepReprovision:
+		return "Requested re-provisioning."
+	case machine.RecoveryStepMaintenance:
+		return "Automated recovery failed, moved into maintenance mode."
+	}
+	return ""
+}
+
+// apply returns a copy of the Description with the given step started.
+func apply(in machine.Description, s step, msg string, ts time.Time) machine.Description {
+	ret := in.Copy()
+	if s.step == machine.RecoveryStepRecovered {
+		ret.Recovery.AddAttempt(machine.RecoveryAttempt{
+			Step:      machine.RecoveryStepRecovered,
+			Message:   fmt.Sprintf("Left quarantine during step %q.", in.Recovery.Step),
+			Timestamp: ts,
+		})
+		ret.Recovery.Step = machine.RecoveryStepNone
+		ret.Recovery.StepStarted = time.Time{}
+		return ret
+	}
+
+	ret.Recovery.Step = s.step
+	ret.Recovery.StepStarted = ts
+	ret.Recovery.AddAttempt(machine.RecoveryAttempt{
+		Step:      s.step,
+		Message:   msg,
+		Timestamp: ts,
+	})
+	switch s.step {
+	case machine.RecoveryStepPowerCycle:
+		ret.PowerCycle = true
+	case machine.RecoveryStepMaintenance:
+		ret.MaintenanceMode = fmt.Sprintf("%s %s", User, ts.Format(time.RFC3339))
+	}
+	ret.Annotation = machine.Annotation{
+		Message:   msg,
+		User:      User,
+		Timestamp: ts,
+	}
+	return ret
+}
+
+// Recoverer runs the recovery workflow.
+type Recoverer struct {
+	store    store.Store
+	hook     Hook
+	policies map[string]policy
+
+	// Metrics
+	stepFailures metrics2.Counter
+}
+
+// New returns a new *Recoverer for the pools in the given config.
+func New(cfg config.InstanceConfig, s store.Store, hook Hook) (*Recoverer, error) {
+	policies := map[string]policy{}
+	for _, pool := range cfg.Pools {
+		if pool.Recovery == nil {
+			continue
+		}
+		p, err := newPolicy(pool.Recovery)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "recovery policy for pool %q", pool.Name)
+		}
+		policies[pool.Name] = p
+	}
+	return &Recoverer{
+		store:        s,
+		hook:         hook,
+		policies:     policies,
+		stepFailures: metrics2.GetCounter("machineserver_recovery_step_failures"),
+	}, nil
+}
+
+// Start runs Step every interval until the context is cancelled. This
+// function doesn't block.
+func (r *Recoverer) Start(ctx context.Context, interval time.Duration) {
+	go util.RepeatCtx(ctx, interval, func(ctx context.Context) {
+		if err := r.Step(ctx); err != nil {
+			sklog.Errorf("Recovery step failed: %s", err)
+		}
+	})
+}
+
+// Step makes a single pass over all the machines, starting the next recovery
+// step for any machine that needs it.
+func (r *Recoverer) Step(ctx context.Context) error {
+	descriptions, err := r.store.List(ctx)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	for _, d := range descriptions {
+		if err := r.recoverMachine(ctx, d); err != nil {
+			r.stepFailures.Inc(1)
+			sklog.Errorf("Failed to run recovery for %q: %s", d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID), err)
+		}
+	}
+	return nil
+}
+
+// recoverMachine starts the next recovery step for a single machine, if any.
+func (r *Recoverer) recoverMachine(ctx context.Context, d machine.Description) error {
+	machineID := d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID)
+	pool := d.Dimensions.GetDimensionValueOrEmptyString(machine.DimPool)
+	ts := now.Now(ctx)
+	s, ok := r.policies[pool].next(d, ts)
+	if !ok {
+		return nil
+	}
+
+	msg := message(s.step)
+	if s.url != "" {
+		// Hooks are called before the Description is updated, so they may be
+		// called more than once for the same step and should be idempotent.
+		err := r.hook.Call(ctx, s.url, HookRequest{
+			MachineID: machineID,
+			Pool:      pool,
+			Step:      s.step,
+			History:   d.Recovery.History,
+		})
+		if err != nil {
+			sklog.Errorf("Recovery hook failed for %q: %s", machineID, err)
+			msg = fmt.Sprintf("%s Hook failed: %s", msg, err)
+		}
+	}
+	sklog.Infof("Recovery for %q: %s %s", machineID, s.step, msg)
+	metrics2.GetCounter("machineserver_recovery_step", map[string]string{"pool": pool, "step": string(s.step)}).Inc(1)
+
+	return r.store.Update(ctx, machineID, func(in machine.Description) machine.Description {
+		// Don't clobber changes made since the machine was listed.
+		if in.Recovery.Step != d.Recovery.Step || !in.Recovery.StepStarted.Equal(d.Recovery.StepStarted) {
+			return in
+		}
+		return apply(in, s, msg, ts)
+	})
+}
@@ -0,0 +1,251 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/testutils"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/machinetest"
+	"go.skia.org/infra/machine/go/machine/store"
+	"go.skia.org/infra/machine/go/machine/store/mocks"
+	"go.skia.org/infra/machine/go/machineserver/config"
+)
+
+const (
+	machineID      = "skia-rpi2-rack4-shelf1-002"
+	poolName       = "Skia"
+	reprovisionURL = "https://provisioner.example.com/reflash"
+	notifyURL      = "https://chat.example.com/notify"
+)
+
+var testConfig = config.InstanceConfig{
+	Pools: []config.Pool{
+		{
+			Name:  poolName,
+			Regex: "^skia-",
+			Recovery: &config.RecoveryPolicy{
+				Steps: []config.RecoveryStep{
+					{Step: "reboot_device", Timeout: "10m"},
+					{Step: "powercycle", Timeout: "20m"},
+					{Step: "reprovision", Timeout: "1h", URL: reprovisionURL},
+					{Step: "maintenance", URL: notifyURL},
+				},
+			},
+		},
+	},
+}
+
+func testPolicy(t *testing.T) policy {
+	p, err := newPolicy(testConfig.Pools[0].Recovery)
+	require.NoError(t, err)
+	return p
+}
+
+func quarantinedDescription() machine.Description {
+	d := machine.NewDescription(context.Background())
+	d.Dimensions[machine.DimID] = []string{machineID}
+	d.Dimensions[machine.DimPool] = []string{poolName}
+	d.IsQuarantined = true
+	d.AttachedDevice = machine.AttachedDeviceAdb
+	d.PowerCycleState = machine.Available
+	return d
+}
+
+func TestNewPolicy_InvalidConfigs_ReturnError(t *testing.T) {
+	for name, steps := range map[string][]config.RecoveryStep{
+		"unknown step":                 {{Step: "unplug", Timeout: "1m"}},
+		"maintenance not last":         {{Step: "maintenance"}, {Step: "powercycle", Timeout: "1m"}},
+		"reprovision without url":      {{Step: "reprovision", Timeout: "1m"}},
+		"invalid timeout":              {{Step: "powercycle", Timeout: "soon"}, {Step: "maintenance"}},
+		"missing intermediate timeout": {{Step: "powercycle"}, {Step: "maintenance"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := newPolicy(&config.RecoveryPolicy{Steps: steps})
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPolicyNext_NewlyQuarantined_StartsFirstStep(t *testing.T) {
+	s, ok := testPolicy(t).next(quarantinedDescription(), machinetest.MockTime)
+	require.True(t, ok)
+	require.Equal(t, machine.RecoveryStepRebootDevice, s.step)
+}
+
+func TestPolicyNext_NoAttachedDevice_SkipsReboot(t *testing.T) {
+	d := quarantinedDescription()
+	d.AttachedDevice = machine.AttachedDeviceNone
+	s, ok := testPolicy(t).next(d, machinetest.MockTime)
+	require.True(t, ok)
+	require.Equal(t, machine.RecoveryStepPowerCycle, s.step)
+}
+
+func TestPolicyNext_StepHasNotTimedOut_NothingToDo(t *testing.T) {
+	d := quarantinedDescription()
+	d.Recovery.Step = machine.RecoveryStepRebootDevice
+	d.Recovery.StepStarted = machinetest.MockTime
+	_, ok := testPolicy(t).next(d, machinetest.MockTime.Add(5*time.Minute))
+	require.False(t, ok)
+}
+
+func TestPolicyNext_StepTimedOut_Escalates(t *testing.T) {
+	d := quarantinedDescription()
+	d.Recovery.Step = machine.RecoveryStepRebootDevice
+	d.Recovery.StepStarted = machinetest.MockTime
+	s, ok := testPolicy(t).next(d, machinetest.MockTime.Add(11*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, machine.RecoveryStepPowerCycle, s.step)
+}
+
+func TestPolicyNext_PowerCycleNotAvailable_SkipsPowerCycle(t *testing.T) {
+	d := quarantinedDescription()
+	d.PowerCycleState = machine.InError
+	d.Recovery.Step = machine.RecoveryStepRebootDevice
+	d.Recovery.StepStarted = machinetest.MockTime
+	s, ok := testPolicy(t).next(d, machinetest.MockTime.Add(11*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, machine.RecoveryStepReprovision, s.step)
+	require.Equal(t, reprovisionURL, s.url)
+}
+
+func TestPolicyNext_InMaintenanceStep_WaitsForHuman(t *testing.T) {
+	d := quarantinedDescription()
+	d.MaintenanceMode = User
+	d.Recovery.Step = machine.RecoveryStepMaintenance
+	d.Recovery.StepStarted = machinetest.MockTime
+	_, ok := testPolicy(t).next(d, machinetest.MockTime.Add(24*time.Hour))
+	require.False(t, ok)
+}
+
+func TestPolicyNext_MaintenanceClearedButStillQuarantined_StartsOver(t *testing.T) {
+	d := quarantinedDescription()
+	d.Recovery.Step = machine.RecoveryStepMaintenance
+	d.Recovery.StepStarted = machinetest.MockTime
+	s, ok := testPolicy(t).next(d, machinetest.MockTime.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, machine.RecoveryStepRebootDevice, s.step)
+}
+
+func TestPolicyNext_ManuallyInMaintenanceMode_NothingToDo(t *testing.T) {
+	d := quarantinedDescription()
+	d.MaintenanceMode = "barney@example.com"
+	_, ok := testPolicy(t).next(d, machinetest.MockTime)
+	require.False(t, ok)
+}
+
+func TestPolicyNext_LeftQuarantine_Recovered(t *testing.T) {
+	d := quarantinedDescription()
+	d.IsQuarantined = false
+	d.Recovery.Step = machine.RecoveryStepPowerCycle
+	s, ok := testPolicy(t).next(d, machinetest.MockTime)
+	require.True(t, ok)
+	require.Equal(t, machine.RecoveryStepRecovered, s.step)
+
+	d.Recovery.Step = machine.RecoveryStepNone
+	_, ok = testPolicy(t).next(d, machinetest.MockTime)
+	require.False(t, ok)
+}
+
+func TestApply_PowerCycle_RequestsPowerCycleAndRecordsAttempt(t *testing.T) {
+	d := quarantinedDescription()
+	ret := apply(d, step{step: machine.RecoveryStepPowerCycle}, "Requested powercycle.", machinetest.MockTime)
+	require.True(t, ret.PowerCycle)
+	require.Equal(t, machine.RecoveryStepPowerCycle, ret.Recovery.Step)
+	require.Equal(t, machinetest.MockTime, ret.Recovery.StepStarted)
+	require.Equal(t, []machine.RecoveryAttempt{
+		{
+			Step:      machine.RecoveryStepPowerCycle,
+			Message:   "Requested powercycle.",
+			Timestamp: machinetest.MockTime,
+		},
+	}, ret.Recovery.History)
+	require.Equal(t, User, ret.Annotation.User)
+}
+
+func TestApply_Recovered_ClearsStepAndKeepsHistory(t *testing.T) {
+	d := quarantinedDescription()
+	d = apply(d, step{step: machine.RecoveryStepRebootDevice}, "Requested device reboot.", machinetest.MockTime)
+	d.IsQuarantined = false
+	ret := apply(d, step{step: machine.RecoveryStepRecovered}, "", machinetest.MockTime.Add(time.Minute))
+	require.Equal(t, machine.RecoveryStepNone, ret.Recovery.Step)
+	require.True(t, ret.Recovery.StepStarted.IsZero())
+	require.Len(t, ret.Recovery.History, 2)
+	require.Equal(t, machine.RecoveryStepRecovered, ret.Recovery.History[1].Step)
+}
+
+type fakeHook struct {
+	calls []HookRequest
+	err   error
+}
+
+func (f *fakeHook) Call(ctx context.Context, url string, req HookRequest) error {
+	f.calls = append(f.calls, req)
+	return f.err
+}
+
+// setupStoreForStep returns a mock Store that lists the given Description and
+// applies any Update to it.
+func setupStoreForStep(t *testing.T, d *machine.Description) *mocks.Store {
+	s := mocks.NewStore(t)
+	s.On("List", testutils.AnyContext).Return([]machine.Description{*d}, nil)
+	s.On("Update", testutils.AnyContext, machineID, mock.Anything).Run(func(args mock.Arguments) {
+		*d = args.Get(2).(store.UpdateCallback)(*d)
+	}).Return(nil).Maybe()
+	return s
+}
+
+func TestStep_ReprovisionHookFails_FailureIsRecordedAndStepStarts(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime.Add(2 * time.Hour))
+	d := quarantinedDescription()
+	d.Recovery.Step = machine.RecoveryStepPowerCycle
+	d.Recovery.StepStarted = machinetest.MockTime
+	s := setupStoreForStep(t, &d)
+	hook := &fakeHook{err: errors.New("provisioner is down")}
+	r, err := New(testConfig, s, hook)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Step(ctx))
+	require.Len(t, hook.calls, 1)
+	require.Equal(t, HookRequest{
+		MachineID: machineID,
+		Pool:      poolName,
+		Step:      machine.RecoveryStepReprovision,
+	}, hook.calls[0])
+	require.Equal(t, machine.RecoveryStepReprovision, d.Recovery.Step)
+	require.Contains(t, d.Recovery.History[0].Message, "provisioner is down")
+}
+
+func TestStep_LastStep_MaintenanceModeSetAndNotificationSent(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime.Add(2 * time.Hour))
+	d := quarantinedDescription()
+	d.Recovery.Step = machine.RecoveryStepReprovision
+	d.Recovery.StepStarted = machinetest.MockTime
+	s := setupStoreForStep(t, &d)
+	hook := &fakeHook{}
+	r, err := New(testConfig, s, hook)
+	require.NoError(t, err)
+
+	require.NoError(t, r.Step(ctx))
+	require.Len(t, hook.calls, 1)
+	require.Equal(t, machine.RecoveryStepMaintenance, hook.calls[0].Step)
+	require.Equal(t, machine.RecoveryStepMaintenance, d.Recovery.Step)
+	require.True(t, d.InMaintenanceMode())
+}
+
+func TestStep_PoolWithoutPolicy_NothingIsUpdated(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	d := quarantinedDescription()
+	d.Dimensions[machine.DimPool] = []string{"SkiaInternal"}
+	s := setupStoreForStep(t, &d)
+	r, err := New(testConfig, s, &fakeHook{})
+	require.NoError(t, err)
+
+	require.NoError(t, r.Step(ctx))
+	s.AssertNotCalled(t, "Update", testutils.AnyContext, machineID, mock.Anything)
+}
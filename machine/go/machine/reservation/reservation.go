@@ -0,0 +1,346 @@
+// Package reservation handles reserving machines for exclusive use by a user.
+//
+// While a machine is reserved it is reported to Swarming as quarantined and it
+// isn't handed out by store.GetFreeMachines, so it runs no tasks. Reservations
+// expire automatically, optionally power-cycling the machine on release.
+package reservation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"go.skia.org/infra/go/metrics2"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/store"
+	"go.skia.org/infra/machine/go/machineserver/config"
+	"go.skia.org/infra/machine/go/machineserver/rpc"
+)
+
+const (
+	defaultMaxDuration    = 72 * time.Hour
+	defaultReminderBefore = time.Hour
+)
+
+var (
+	// ErrNoMachineAvailable is returned from Reserve if no available machine
+	// matches the requested dimensions.
+	ErrNoMachineAvailable = errors.New("no matching machine is available")
+
+	// ErrInvalidRequest is returned from Reserve if the request is malformed.
+	ErrInvalidRequest = errors.New("invalid reservation request")
+)
+
+// NotificationKind is the kind of Notification being sent.
+type NotificationKind string
+
+const (
+	// Reminder is sent shortly before a reservation expires.
+	Reminder NotificationKind = "reminder"
+
+	// Released is sent when a reservation ends.
+	Released NotificationKind = "released"
+)
+
+// Notification is sent to the holder of a reservation.
+type Notification struct {
+	Kind        NotificationKind    `json:"kind"`
+	MachineID   string              `json:"machine_id"`
+	Reservation machine.Reservation `json:"reservation"`
+}
+
+// Notifier sends Notifications.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// HTTPNotifier implements Notifier by sending the Notification as JSON in a
+// POST request.
+type HTTPNotifier struct {
+	client *http.Client
+	url    string
+}
+
+// NewHTTPNotifier returns a new *HTTPNotifier that sends Notifications to the
+// given url.
+func NewHTTPNotifier(client *http.Client, url string) *HTTPNotifier {
+	return &HTTPNotifier{
+		client: client,
+		url:    url,
+	}
+}
+
+// Notify implements Notifier.
+func (h *HTTPNotifier) Notify(ctx context.Context, n Notification) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(b))
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return skerr.Wrapf(err, "sending notification to %q", h.url)
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return skerr.Fmt("notification to %q returned status %d", h.url, resp.StatusCode)
+	}
+	return nil
+}
+
+// Confirm *HTTPNotifier implements Notifier.
+var _ Notifier = (*HTTPNotifier)(nil)
+
+// Manager makes and expires reservations.
+type Manager struct {
+	store          store.Store
+	notifier       Notifier
+	maxDuration    time.Duration
+	reminderBefore time.Duration
+
+	// Metrics
+	notifyFailures metrics2.Counter
+}
+
+// New returns a new *Manager. The notifier may be nil, in which case no
+// notifications are sent.
+func New(cfg config.ReservationConfig, s store.Store, notifier Notifier) (*Manager, error) {
+	ret := &Manager{
+		store:          s,
+		notifier:       notifier,
+		maxDuration:    defaultMaxDuration,
+		reminderBefore: defaultReminderBefore,
+		notifyFailures: metrics2.GetCounter("machineserver_reservation_notify_failures"),
+	}
+	var err error
+	if cfg.MaxDuration != "" {
+		if ret.maxDuration, err = time.ParseDuration(cfg.MaxDuration); err != nil {
+			return nil, skerr.Wrapf(err, "parsing max_duration")
+		}
+	}
+	if cfg.ReminderBefore != "" {
+		if ret.reminderBefore, err = time.ParseDuration(cfg.ReminderBefore); err != nil {
+			return nil, skerr.Wrapf(err, "parsing reminder_before")
+		}
+	}
+	return ret, nil
+}
+
+// available returns true if the machine can be reserved.
+func available(d machine.Description) bool {
+	return !d.IsReserved() &&
+		!d.IsQuarantined &&
+		!d.InMaintenanceMode() &&
+		!d.IsRecovering() &&
+		!d.RunningSwarmingTask &&
+		d.TaskRequest == nil
+}
+
+// matches returns true if the machine has all the given dimensions.
+func matches(d machine.Description, dims machine.SwarmingDimensions) bool {
+	for key, values := range dims {
+		for _, value := range values {
+			if !util.In(value, d.Dimensions[key]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// connectionInfo returns the information needed to connect to the machine.
+func connectionInfo(d machine.Description) rpc.ConnectionInfo {
+	return rpc.ConnectionInfo{
+		Host:           d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID),
+		AttachedDevice: d.AttachedDevice,
+		SSHUserIP:      d.SSHUserIP,
+	}
+}
+
+// Reserve reserves an available machine that matches the request on behalf of
+// the given user.
+func (m *Manager) Reserve(ctx context.Context, user string, req rpc.ReserveMachineRequest) (rpc.ReserveMachineResponse, error) {
+	var ret rpc.ReserveMachineResponse
+	if user == "" {
+		return ret, skerr.Wrapf(ErrInvalidRequest, "a user is required")
+	}
+	if len(req.Dimensions) == 0 {
+		return ret, skerr.Wrapf(ErrInvalidRequest, "at least one dimension is required")
+	}
+	duration, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		return ret, skerr.Wrapf(ErrInvalidRequest, "parsing duration %q: %s", req.Duration, err)
+	}
+	if duration <= 0 || duration > m.maxDuration {
+		return ret, skerr.Wrapf(ErrInvalidRequest, "duration must be between 0 and %s", m.maxDuration)
+	}
+
+	descriptions, err := m.store.List(ctx)
+	if err != nil {
+		return ret, skerr.Wrap(err)
+	}
+	sort.Slice(descriptions, func(i, j int) bool {
+		return descriptions[i].Dimensions.GetDimensionValueOrEmptyString(machine.DimID) < descriptions[j].Dimensions.GetDimensionValueOrEmptyString(machine.DimID)
+	})
+
+	ts := now.Now(ctx)
+	reservation := machine.Reservation{
+		User:                user,
+		Reason:              req.Reason,
+		Start:               ts,
+		Expires:             ts.Add(duration),
+		PowerCycleOnRelease: req.PowerCycleOnRelease,
+	}
+	for _, d := range descriptions {
+		if !available(d) || !matches(d, req.Dimensions) {
+			continue
+		}
+		machineID := d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID)
+		reserved := false
+		var updated machine.Description
+		err := m.store.Update(ctx, machineID, func(in machine.Description) machine.Description {
+			// The machine may have changed since it was listed.
+			reserved = available(in) && matches(in, req.Dimensions)
+			if !reserved {
+				return in
+			}
+			updated = in.Copy()
+			updated.Reservation = reservation
+			updated.Annotation = machine.Annotation{
+				Message:   fmt.Sprintf("Reserved until %s: %s", reservation.Expires.Format(time.RFC3339), req.Reason),
+				User:      user,
+				Timestamp: ts,
+			}
+			return updated
+		})
+		if err != nil {
+			return ret, skerr.Wrap(err)
+		}
+		if !reserved {
+			continue
+		}
+		sklog.Infof("%s reserved %q until %s", user, machineID, reservation.Expires)
+		return rpc.ReserveMachineResponse{
+			MachineID:   machineID,
+			Reservation: reservation,
+			Connection:  connectionInfo(updated),
+		}, nil
+	}
+	return ret, skerr.Wrap(ErrNoMachineAvailable)
+}
+
+// release returns a copy of the Description with the reservation removed.
+func release(ctx context.Context, user string, in machine.Description) machine.Description {
+	ret := in.Copy()
+	if ret.Reservation.PowerCycleOnRelease && ret.PowerCycleState == machine.Available {
+		ret.PowerCycle = true
+	}
+	ret.Annotation = machine.Annotation{
+		Message:   fmt.Sprintf("Released reservation held by %s", in.Reservation.User),
+		User:      user,
+		Timestamp: now.Now(ctx),
+	}
+	ret.Reservation = machine.Reservation{}
+	return ret
+}
+
+// Release ends the reservation of the given machine, if any. The user is the
+// person releasing the machine, which may not be the holder of the
+// reservation.
+func (m *Manager) Release(ctx context.Context, machineID, user string) error {
+	var released machine.Reservation
+	err := m.store.Update(ctx, machineID, func(in machine.Description) machine.Description {
+		released = in.Reservation
+		if !in.IsReserved() {
+			return in
+		}
+		return release(ctx, user, in)
+	})
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	if released.User != "" {
+		m.notify(ctx, Released, machineID, released)
+	}
+	return nil
+}
+
+// notify sends a Notification, logging any failure.
+func (m *Manager) notify(ctx context.Context, kind NotificationKind, machineID string, r machine.Reservation) {
+	if m.notifier == nil {
+		return
+	}
+	err := m.notifier.Notify(ctx, Notification{
+		Kind:        kind,
+		MachineID:   machineID,
+		Reservation: r,
+	})
+	if err != nil {
+		m.notifyFailures.Inc(1)
+		sklog.Errorf("Failed to send %s notification for %q: %s", kind, machineID, err)
+	}
+}
+
+// Start runs Step every interval until the context is cancelled. This
+// function doesn't block.
+func (m *Manager) Start(ctx context.Context, interval time.Duration) {
+	go util.RepeatCtx(ctx, interval, func(ctx context.Context) {
+		if err := m.Step(ctx); err != nil {
+			sklog.Errorf("Reservation step failed: %s", err)
+		}
+	})
+}
+
+// Step releases expired reservations and sends reminders for reservations
+// that are about to expire.
+func (m *Manager) Step(ctx context.Context) error {
+	descriptions, err := m.store.List(ctx)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	ts := now.Now(ctx)
+	for _, d := range descriptions {
+		if !d.IsReserved() {
+			continue
+		}
+		machineID := d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID)
+		if !ts.Before(d.Reservation.Expires) {
+			if err := m.Release(ctx, machineID, d.Reservation.User); err != nil {
+				sklog.Errorf("Failed to release expired reservation of %q: %s", machineID, err)
+			}
+			continue
+		}
+		if d.Reservation.ReminderSent || ts.Before(d.Reservation.Expires.Add(-m.reminderBefore)) {
+			continue
+		}
+		err := m.store.Update(ctx, machineID, func(in machine.Description) machine.Description {
+			// The reservation may have been released or replaced since it was
+			// listed.
+			if in.Reservation.User != d.Reservation.User || !in.Reservation.Expires.Equal(d.Reservation.Expires) {
+				return in
+			}
+			ret := in.Copy()
+			ret.Reservation.ReminderSent = true
+			return ret
+		})
+		if err != nil {
+			sklog.Errorf("Failed to record reminder for %q: %s", machineID, err)
+			continue
+		}
+		m.notify(ctx, Reminder, machineID, d.Reservation)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package reservation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/testutils"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/machinetest"
+	"go.skia.org/infra/machine/go/machine/store"
+	"go.skia.org/infra/machine/go/machine/store/mocks"
+	"go.skia.org/infra/machine/go/machineserver/config"
+	"go.skia.org/infra/machine/go/machineserver/rpc"
+)
+
+const (
+	user       = "barney@example.com"
+	machineID1 = "skia-rpi2-rack4-shelf1-001"
+	machineID2 = "skia-rpi2-rack4-shelf1-002"
+)
+
+type fakeNotifier struct {
+	notifications []Notification
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, n Notification) error {
+	f.notifications = append(f.notifications, n)
+	return nil
+}
+
+func newDescription(machineID string) machine.Description {
+	d := machine.NewDescription(context.Background())
+	d.Dimensions[machine.DimID] = []string{machineID}
+	d.Dimensions[machine.DimOS] = []string{"Android"}
+	d.Dimensions[machine.DimDeviceType] = []string{"sargo"}
+	d.AttachedDevice = machine.AttachedDeviceAdb
+	d.PowerCycleState = machine.Available
+	return d
+}
+
+// setupStore returns a mock Store that serves the given Descriptions from List
+// and applies Updates to them.
+func setupStore(t *testing.T, descriptions map[string]*machine.Description) *mocks.Store {
+	s := mocks.NewStore(t)
+	s.On("List", testutils.AnyContext).Return(func(context.Context) []machine.Description {
+		var ret []machine.Description
+		for _, d := range descriptions {
+			ret = append(ret, *d)
+		}
+		return ret
+	}, nil).Maybe()
+	s.On("Update", testutils.AnyContext, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		d := descriptions[args.String(1)]
+		*d = args.Get(2).(store.UpdateCallback)(*d)
+	}).Return(nil).Maybe()
+	return s
+}
+
+func setupManager(t *testing.T, descriptions map[string]*machine.Description) (*Manager, *fakeNotifier) {
+	notifier := &fakeNotifier{}
+	m, err := New(config.ReservationConfig{MaxDuration: "24h", ReminderBefore: "30m"}, setupStore(t, descriptions), notifier)
+	require.NoError(t, err)
+	return m, notifier
+}
+
+func TestNew_InvalidDuration_ReturnsError(t *testing.T) {
+	_, err := New(config.ReservationConfig{MaxDuration: "a while"}, nil, nil)
+	require.Error(t, err)
+}
+
+func TestReserve_MatchingMachineAvailable_MachineIsReserved(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	busy := newDescription(machineID1)
+	busy.RunningSwarmingTask = true
+	free := newDescription(machineID2)
+	m, _ := setupManager(t, map[string]*machine.Description{
+		machineID1: &busy,
+		machineID2: &free,
+	})
+
+	resp, err := m.Reserve(ctx, user, rpc.ReserveMachineRequest{
+		Dimensions:          machine.SwarmingDimensions{machine.DimDeviceType: {"sargo"}},
+		Duration:            "4h",
+		Reason:              "Bisecting a crash.",
+		PowerCycleOnRelease: true,
+	})
+	require.NoError(t, err)
+	expected := machine.Reservation{
+		User:                user,
+		Reason:              "Bisecting a crash.",
+		Start:               machinetest.MockTime,
+		Expires:             machinetest.MockTime.Add(4 * time.Hour),
+		PowerCycleOnRelease: true,
+	}
+	require.Equal(t, rpc.ReserveMachineResponse{
+		MachineID:   machineID2,
+		Reservation: expected,
+		Connection: rpc.ConnectionInfo{
+			Host:           machineID2,
+			AttachedDevice: machine.AttachedDeviceAdb,
+		},
+	}, resp)
+	require.Equal(t, expected, free.Reservation)
+	require.False(t, busy.IsReserved())
+}
+
+func TestReserve_NoMatchingMachine_ReturnsErrNoMachineAvailable(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	reserved := newDescription(machineID1)
+	reserved.Reservation.User = "fred@example.com"
+	quarantined := newDescription(machineID2)
+	quarantined.IsQuarantined = true
+	m, _ := setupManager(t, map[string]*machine.Description{
+		machineID1: &reserved,
+		machineID2: &quarantined,
+	})
+
+	_, err := m.Reserve(ctx, user, rpc.ReserveMachineRequest{
+		Dimensions: machine.SwarmingDimensions{machine.DimDeviceType: {"sargo"}},
+		Duration:   "1h",
+	})
+	require.True(t, errors.Is(err, ErrNoMachineAvailable))
+}
+
+func TestReserve_DurationTooLong_ReturnsErrInvalidRequest(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	m, _ := setupManager(t, map[string]*machine.Description{})
+
+	_, err := m.Reserve(ctx, user, rpc.ReserveMachineRequest{
+		Dimensions: machine.SwarmingDimensions{machine.DimDeviceType: {"sargo"}},
+		Duration:   "48h",
+	})
+	require.True(t, errors.Is(err, ErrInvalidRequest))
+}
+
+func TestRelease_PowerCycleOnRelease_PowerCycleIsRequested(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	d := newDescription(machineID1)
+	d.Reservation = machine.Reservation{
+		User:                user,
+		Expires:             machinetest.MockTime.Add(time.Hour),
+		PowerCycleOnRelease: true,
+	}
+	m, notifier := setupManager(t, map[string]*machine.Description{machineID1: &d})
+
+	require.NoError(t, m.Release(ctx, machineID1, user))
+	require.False(t, d.IsReserved())
+	require.True(t, d.PowerCycle)
+	require.Len(t, notifier.notifications, 1)
+	require.Equal(t, Released, notifier.notifications[0].Kind)
+}
+
+func TestRelease_NotReserved_NoNotificationIsSent(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	d := newDescription(machineID1)
+	m, notifier := setupManager(t, map[string]*machine.Description{machineID1: &d})
+
+	require.NoError(t, m.Release(ctx, machineID1, user))
+	require.False(t, d.PowerCycle)
+	require.Empty(t, notifier.notifications)
+}
+
+func TestStep_ReservationAboutToExpire_ReminderIsSentOnce(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	d := newDescription(machineID1)
+	d.Reservation = machine.Reservation{
+		User:    user,
+		Expires: machinetest.MockTime.Add(20 * time.Minute),
+	}
+	m, notifier := setupManager(t, map[string]*machine.Description{machineID1: &d})
+
+	require.NoError(t, m.Step(ctx))
+	require.NoError(t, m.Step(ctx))
+	require.True(t, d.IsReserved())
+	require.True(t, d.Reservation.ReminderSent)
+	require.Len(t, notifier.notifications, 1)
+	require.Equal(t, Reminder, notifier.notifications[0].Kind)
+	require.Equal(t, machineID1, notifier.notifications[0].MachineID)
+}
+
+func TestStep_ReservationExpired_MachineIsReleased(t *testing.T) {
+	ctx := now.TimeTravelingContext(machinetest.MockTime)
+	d := newDescription(machineID1)
+	d.Reservation = machine.Reservation{
+		User:         user,
+		Expires:      machinetest.MockTime.Add(-time.Minute),
+		ReminderSent: true,
+	}
+	m, notifier := setupManager(t, map[string]*machine.Description{machineID1: &d})
+
+	require.NoError(t, m.Step(ctx))
+	require.False(t, d.IsReserved())
+	require.False(t, d.PowerCycle)
+	require.Len(t, notifier.notifications, 1)
+	require.Equal(t, Released, notifier.notifications[0].Kind)
+}
@@ -42,10 +42,16 @@ const (
 	List
 	Delete
 	GetFreeMachines
+	RecordTelemetry
+	ListTelemetry
+	DeleteTelemetryBefore
+	ListTaskResults
 )
 
 var (
 	descriptionAllNonComputedColumns = strings.Join(Description, ",")
+	telemetryAllColumns              = strings.Join(Telemetry, ",")
+	taskResultAllColumns             = strings.Join(TaskResult, ",")
 )
 
 // Statements are all the SQL statements used in Store.
@@ -113,12 +119,50 @@ WHERE
 AND
 	dimensions @> CONCAT('{"task_type": ["sktask"], "pool":["', $1, '"]}')::JSONB
 `, descriptionAllNonComputedColumns),
+	RecordTelemetry: fmt.Sprintf(`
+UPSERT INTO
+	Telemetry (%s)
+VALUES
+	%s
+`, telemetryAllColumns, sqlutil.ValuesPlaceholders(len(Telemetry), 1),
+	),
+	ListTelemetry: fmt.Sprintf(`
+SELECT
+	%s
+FROM
+	Telemetry
+WHERE
+	machine_id = $1
+	AND ts >= $2
+	AND ts < $3
+ORDER BY
+	ts ASC
+`, telemetryAllColumns),
+	DeleteTelemetryBefore: `
+DELETE FROM
+	Telemetry@by_ts
+WHERE
+	ts < $1
+`,
+	ListTaskResults: fmt.Sprintf(`
+SELECT
+	%s
+FROM
+	TaskResult@by_machine_id
+WHERE
+	machine_id = $1
+	AND finished >= $2
+	AND finished < $3
+ORDER BY
+	finished ASC
+`, taskResultAllColumns),
 }
 
 // Tables represents all SQL tables used by machineserver.
 type Tables struct {
 	Description []machine.Description
 	TaskResult  []machine.TaskResult
+	Telemetry   []machine.TelemetrySample
 }
 
 // Store implements ../store.Store.
@@ -320,8 +364,79 @@ func (s *Store) GetFreeMachines(ctx context.Context, pool string) ([]machine.Des
 		if err != nil {
 			return nil, wrappedError(err)
 		}
+		// Reserved machines are not available for tasks.
+		if d.IsReserved() {
+			continue
+		}
 		ret = append(ret, d)
 	}
 
 	return ret, nil
 }
+
+// RecordTelemetry implements ../store.Store.
+func (s *Store) RecordTelemetry(ctx context.Context, sample machine.TelemetrySample) error {
+	if sample.Temperature == nil {
+		sample.Temperature = map[string]float64{}
+	}
+	sample.Timestamp = sample.Timestamp.UTC().Truncate(time.Millisecond)
+	_, err := s.db.Exec(ctx, Statements[RecordTelemetry], sample.MachineID, sample.Timestamp, sample.Battery, sample.Temperature, sample.DeviceUptime)
+	if err != nil {
+		return wrappedErrorForID(err, sample.MachineID)
+	}
+	return nil
+}
+
+// ListTelemetry implements ../store.Store.
+func (s *Store) ListTelemetry(ctx context.Context, machineID string, begin, end time.Time) ([]machine.TelemetrySample, error) {
+	var ret []machine.TelemetrySample
+
+	rows, err := s.db.Query(ctx, Statements[ListTelemetry], machineID, begin, end)
+	if err != nil {
+		return nil, wrappedErrorForID(err, machineID)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sample machine.TelemetrySample
+		err := rows.Scan(&sample.MachineID, &sample.Timestamp, &sample.Battery, &sample.Temperature, &sample.DeviceUptime)
+		if err != nil {
+			return nil, wrappedErrorForID(err, machineID)
+		}
+		sample.Timestamp = sample.Timestamp.UTC()
+		ret = append(ret, sample)
+	}
+
+	return ret, nil
+}
+
+// DeleteTelemetryBefore implements ../store.Store.
+func (s *Store) DeleteTelemetryBefore(ctx context.Context, before time.Time) error {
+	if _, err := s.db.Exec(ctx, Statements[DeleteTelemetryBefore], before); err != nil {
+		return wrappedError(err)
+	}
+	return nil
+}
+
+// ListTaskResults implements ../store.Store.
+func (s *Store) ListTaskResults(ctx context.Context, machineID string, begin, end time.Time) ([]machine.TaskResult, error) {
+	var ret []machine.TaskResult
+
+	rows, err := s.db.Query(ctx, Statements[ListTaskResults], machineID, begin, end)
+	if err != nil {
+		return nil, wrappedErrorForID(err, machineID)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var result machine.TaskResult
+		err := rows.Scan(&result.TaskResult, &result.ID, &result.MachineID, &result.Finished, &result.Status)
+		if err != nil {
+			return nil, wrappedErrorForID(err, machineID)
+		}
+		result.Finished = result.Finished.UTC()
+		ret = append(ret, result)
+	}
+
+	return ret, nil
+}
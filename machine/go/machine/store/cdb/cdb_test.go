@@ -24,7 +24,7 @@ import (
 func Test_Statements_SprintfReturnsCorrectResults(t *testing.T) {
 	require.Equal(t, `
 SELECT
-	maintenance_mode,is_quarantined,recovering,attached_device,annotation,note,version,powercycle,powercycle_state,last_updated,battery,temperatures,running_swarmingTask,launched_swarming,recovery_start,device_uptime,ssh_user_ip,supplied_dimensions,dimensions,task_request,task_started
+	maintenance_mode,is_quarantined,recovering,attached_device,annotation,note,version,powercycle,powercycle_state,last_updated,battery,temperatures,running_swarmingTask,launched_swarming,recovery_start,device_uptime,ssh_user_ip,supplied_dimensions,dimensions,task_request,task_started,recovery,reservation
 FROM
 	Description
 WHERE
@@ -33,9 +33,9 @@ FOR UPDATE`, cdb.Statements[cdb.GetAndLockRow])
 
 	require.Equal(t, `
 UPSERT INTO
-	Description (maintenance_mode,is_quarantined,recovering,attached_device,annotation,note,version,powercycle,powercycle_state,last_updated,battery,temperatures,running_swarmingTask,launched_swarming,recovery_start,device_uptime,ssh_user_ip,supplied_dimensions,dimensions,task_request,task_started)
+	Description (maintenance_mode,is_quarantined,recovering,attached_device,annotation,note,version,powercycle,powercycle_state,last_updated,battery,temperatures,running_swarmingTask,launched_swarming,recovery_start,device_uptime,ssh_user_ip,supplied_dimensions,dimensions,task_request,task_started,recovery,reservation)
 VALUES
-	($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21)
+	($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23)
 `, cdb.Statements[cdb.Update])
 }
 
@@ -237,6 +237,54 @@ func TestStore_Delete_MachineDoesNotExist_Success(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func telemetrySampleAt(machineID string, ts time.Time, battery int) machine.TelemetrySample {
+	return machine.TelemetrySample{
+		MachineID:    machineID,
+		Timestamp:    ts,
+		Battery:      battery,
+		Temperature:  map[string]float64{"cpu": 30.5},
+		DeviceUptime: 120,
+	}
+}
+
+func TestStore_RecordTelemetryAndListTelemetry_ReturnsSamplesInRangeOldestFirst(t *testing.T) {
+	ctx, s, _ := setupForTestWithEmptyStore(t)
+	first := telemetrySampleAt(machineID1, machinetest.MockTime, 90)
+	second := telemetrySampleAt(machineID1, machinetest.MockTime.Add(time.Hour), 80)
+	outOfRange := telemetrySampleAt(machineID1, machinetest.MockTime.Add(2*time.Hour), 70)
+	otherMachine := telemetrySampleAt(machineID2, machinetest.MockTime, 50)
+	for _, sample := range []machine.TelemetrySample{second, first, outOfRange, otherMachine} {
+		require.NoError(t, s.RecordTelemetry(ctx, sample))
+	}
+
+	samples, err := s.ListTelemetry(ctx, machineID1, machinetest.MockTime, machinetest.MockTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	first.Timestamp = first.Timestamp.Truncate(time.Millisecond)
+	second.Timestamp = second.Timestamp.Truncate(time.Millisecond)
+	require.Equal(t, []machine.TelemetrySample{first, second}, samples)
+}
+
+func TestStore_DeleteTelemetryBefore_RemovesOnlyOlderSamples(t *testing.T) {
+	ctx, s, _ := setupForTestWithEmptyStore(t)
+	require.NoError(t, s.RecordTelemetry(ctx, telemetrySampleAt(machineID1, machinetest.MockTime, 90)))
+	require.NoError(t, s.RecordTelemetry(ctx, telemetrySampleAt(machineID1, machinetest.MockTime.Add(time.Hour), 80)))
+
+	require.NoError(t, s.DeleteTelemetryBefore(ctx, machinetest.MockTime.Add(time.Minute)))
+
+	samples, err := s.ListTelemetry(ctx, machineID1, machinetest.MockTime.Add(-time.Hour), machinetest.MockTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	require.Equal(t, 80, samples[0].Battery)
+}
+
+func TestStore_ListTaskResults_NoResults_ReturnsEmptySlice(t *testing.T) {
+	ctx, s, _ := setupForTestWithEmptyStore(t)
+
+	results, err := s.ListTaskResults(ctx, machineID1, machinetest.MockTime, machinetest.MockTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
 func TestGetFreeMachines_AllMachinesRunningTasks_ReturnsZeroMatches(t *testing.T) {
 	// The added machines in setupForTest are running tasks, so this should return 0 machines.
 	ctx, s := setupForTest(t)
@@ -278,6 +326,22 @@ func TestGetFreeMachines_OneMachineNotRunningTasks_ReturnsMatchingMachine(t *tes
 	deepequal.DeepEqual(expected, descriptions[0])
 }
 
+func TestGetFreeMachines_OneMachineNotRunningTasksIsReserved_ReturnsZeroMatches(t *testing.T) {
+	ctx, s := setupForTest(t)
+
+	_ = clearRunningTest(t, ctx, s, machineID3)
+	err := s.Update(ctx, machineID3, func(in machine.Description) machine.Description {
+		ret := in.Copy()
+		ret.Reservation.User = "barney@example.com"
+		return ret
+	})
+	require.NoError(t, err)
+
+	descriptions, err := s.GetFreeMachines(ctx, "Skia")
+	require.NoError(t, err)
+	require.Empty(t, descriptions)
+}
+
 func TestGetFreeMachines_TwoMachinesNotRunningTasksOnlyOneInTheRightPool_ReturnsMatchingMachine(t *testing.T) {
 	ctx, s := setupForTest(t)
 
@@ -342,6 +406,12 @@ const FromLiveToNext = `
 ALTER TABLE Description
 	ADD COLUMN IF NOT EXISTS running_task bool AS (task_request IS NOT NULL) STORED;
 
+ALTER TABLE Description
+	ADD COLUMN IF NOT EXISTS recovery JSONB NOT NULL DEFAULT '{}'::JSONB;
+
+ALTER TABLE Description
+	ADD COLUMN IF NOT EXISTS reservation JSONB NOT NULL DEFAULT '{}'::JSONB;
+
 CREATE INDEX by_running_task ON Description (running_task);
 
 CREATE TABLE IF NOT EXISTS TaskResult (
@@ -353,6 +423,16 @@ CREATE TABLE IF NOT EXISTS TaskResult (
 	INDEX by_machine_id (machine_id),
 	INDEX by_status (status)
   );
+
+CREATE TABLE IF NOT EXISTS Telemetry (
+	machine_id STRING NOT NULL,
+	ts TIMESTAMPTZ NOT NULL,
+	battery INT NOT NULL DEFAULT 0,
+	temperatures JSONB NOT NULL,
+	device_uptime INT4 NOT NULL DEFAULT 0,
+	PRIMARY KEY (machine_id, ts),
+	INDEX by_ts (ts)
+  );
 `
 
 func getSchema(t *testing.T, db pool.Pool) *schema.Description {
@@ -372,6 +452,8 @@ func Test_LiveToNextSchemaMigration(t *testing.T) {
 	require.NoError(t, err)
 	_, err = db.Exec(ctx, "DROP TABLE IF EXISTS TaskResult")
 	require.NoError(t, err)
+	_, err = db.Exec(ctx, "DROP TABLE IF EXISTS Telemetry")
+	require.NoError(t, err)
 
 	_, err = db.Exec(ctx, LiveSchema)
 	require.NoError(t, err)
@@ -25,6 +25,8 @@ const Schema = `CREATE TABLE IF NOT EXISTS Description (
   dimensions JSONB NOT NULL,
   task_request JSONB,
   task_started TIMESTAMPTZ NOT NULL DEFAULT (0)::TIMESTAMPTZ,
+  recovery JSONB NOT NULL DEFAULT '{}'::JSONB,
+  reservation JSONB NOT NULL DEFAULT '{}'::JSONB,
   machine_id STRING PRIMARY KEY AS (dimensions->'id'->>0) STORED,
   running_task bool AS (task_request IS NOT NULL) STORED,
   INVERTED INDEX dimensions_gin (dimensions),
@@ -40,6 +42,15 @@ CREATE TABLE IF NOT EXISTS TaskResult (
   INDEX by_machine_id (machine_id),
   INDEX by_status (status)
 );
+CREATE TABLE IF NOT EXISTS Telemetry (
+  machine_id STRING NOT NULL,
+  ts TIMESTAMPTZ NOT NULL,
+  battery INT NOT NULL DEFAULT 0,
+  temperatures JSONB NOT NULL,
+  device_uptime INT4 NOT NULL DEFAULT 0,
+  PRIMARY KEY (machine_id, ts),
+  INDEX by_ts (ts)
+);
 `
 
 var Description = []string{
@@ -64,6 +75,8 @@ var Description = []string{
 	"dimensions",
 	"task_request",
 	"task_started",
+	"recovery",
+	"reservation",
 }
 
 var TaskResult = []string{
@@ -73,3 +86,11 @@ var TaskResult = []string{
 	"finished",
 	"status",
 }
+
+var Telemetry = []string{
+	"machine_id",
+	"ts",
+	"battery",
+	"temperatures",
+	"device_uptime",
+}
@@ -9,6 +9,8 @@ import (
 	machine "go.skia.org/infra/machine/go/machine"
 
 	store "go.skia.org/infra/machine/go/machine/store"
+
+	time "time"
 )
 
 // Store is an autogenerated mock type for the Store type
@@ -34,6 +36,24 @@ func (_m *Store) Delete(ctx context.Context, machineID string) error {
 	return r0
 }
 
+// DeleteTelemetryBefore provides a mock function with given fields: ctx, before
+func (_m *Store) DeleteTelemetryBefore(ctx context.Context, before time.Time) error {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTelemetryBefore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, machineID
 func (_m *Store) Get(ctx context.Context, machineID string) (machine.Description, error) {
 	ret := _m.Called(ctx, machineID)
@@ -152,6 +172,84 @@ func (_m *Store) ListPowerCycle(ctx context.Context) ([]string, error) {
 	return r0, r1
 }
 
+// ListTaskResults provides a mock function with given fields: ctx, machineID, begin, end
+func (_m *Store) ListTaskResults(ctx context.Context, machineID string, begin time.Time, end time.Time) ([]machine.TaskResult, error) {
+	ret := _m.Called(ctx, machineID, begin, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTaskResults")
+	}
+
+	var r0 []machine.TaskResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]machine.TaskResult, error)); ok {
+		return rf(ctx, machineID, begin, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []machine.TaskResult); ok {
+		r0 = rf(ctx, machineID, begin, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]machine.TaskResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, machineID, begin, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTelemetry provides a mock function with given fields: ctx, machineID, begin, end
+func (_m *Store) ListTelemetry(ctx context.Context, machineID string, begin time.Time, end time.Time) ([]machine.TelemetrySample, error) {
+	ret := _m.Called(ctx, machineID, begin, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTelemetry")
+	}
+
+	var r0 []machine.TelemetrySample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]machine.TelemetrySample, error)); ok {
+		return rf(ctx, machineID, begin, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []machine.TelemetrySample); ok {
+		r0 = rf(ctx, machineID, begin, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]machine.TelemetrySample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, machineID, begin, end)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordTelemetry provides a mock function with given fields: ctx, sample
+func (_m *Store) RecordTelemetry(ctx context.Context, sample machine.TelemetrySample) error {
+	ret := _m.Called(ctx, sample)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTelemetry")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, machine.TelemetrySample) error); ok {
+		r0 = rf(ctx, sample)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Update provides a mock function with given fields: ctx, machineID, updateCallback
 func (_m *Store) Update(ctx context.Context, machineID string, updateCallback store.UpdateCallback) error {
 	ret := _m.Called(ctx, machineID, updateCallback)
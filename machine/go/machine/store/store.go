@@ -3,6 +3,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"go.skia.org/infra/machine/go/machine"
 )
@@ -35,4 +36,19 @@ type Store interface {
 
 	// Get a list of Kingsford machines that aren't running tasks.
 	GetFreeMachines(ctx context.Context, pool string) ([]machine.Description, error)
+
+	// RecordTelemetry stores a single TelemetrySample.
+	RecordTelemetry(ctx context.Context, sample machine.TelemetrySample) error
+
+	// ListTelemetry returns the TelemetrySamples for the given machine with
+	// timestamps in [begin, end), oldest first.
+	ListTelemetry(ctx context.Context, machineID string, begin, end time.Time) ([]machine.TelemetrySample, error)
+
+	// DeleteTelemetryBefore removes all TelemetrySamples older than the given
+	// time.
+	DeleteTelemetryBefore(ctx context.Context, before time.Time) error
+
+	// ListTaskResults returns the TaskResults for tasks that ran on the given
+	// machine and finished in [begin, end), oldest first.
+	ListTaskResults(ctx context.Context, machineID string, begin, end time.Time) ([]machine.TaskResult, error)
 }
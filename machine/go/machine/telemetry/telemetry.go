@@ -0,0 +1,428 @@
+// Package telemetry records a time series of the health of each machine and
+// flags machines whose health is trending worse than the rest of their pool.
+//
+// A sample of Battery, Temperature, and DeviceUptime is stored each time a
+// machine reports in, at most once per sample interval. Trends are computed by
+// comparing the average of each metric over the later half of the trend
+// window to its average over the earlier half, and a machine is flagged if its
+// change is worse than the median change across its pool by more than a
+// margin. Task outcomes are read from the TaskResult table.
+package telemetry
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.skia.org/infra/go/metrics2"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/store"
+	"go.skia.org/infra/machine/go/machineserver/config"
+	"go.skia.org/infra/machine/go/machineserver/rpc"
+	"go.skia.org/infra/task_scheduler/go/types"
+)
+
+const (
+	defaultSampleInterval    = 5 * time.Minute
+	defaultRetention         = 30 * 24 * time.Hour
+	defaultTrendWindow       = 7 * 24 * time.Hour
+	defaultBatteryMargin     = 10
+	defaultTemperatureMargin = 3
+	defaultFailureRateMargin = 0.2
+
+	// minPoolSize is the fewest machines in a pool that must have a change
+	// for a metric before any of them are flagged, since the median of fewer
+	// machines says little about the pool.
+	minPoolSize = 3
+
+	// minSamplesPerHalf is the fewest samples needed in each half of the trend
+	// window to compute a change in battery or temperature.
+	minSamplesPerHalf = 3
+
+	// minTasksPerHalf is the fewest finished tasks needed in each half of the
+	// trend window to compute a change in the task failure rate.
+	minTasksPerHalf = 5
+
+	// badTemperature is the value reported for sensors that couldn't be read,
+	// see processor.badTemperature.
+	badTemperature float64 = -99
+)
+
+// worseDirection is +1 for metrics where an increase is bad, and -1 for
+// metrics where a decrease is bad.
+var worseDirection = map[rpc.TrendMetric]float64{
+	rpc.TrendBattery:      -1,
+	rpc.TrendThermal:      1,
+	rpc.TrendTaskFailures: 1,
+}
+
+// Monitor records telemetry samples and periodically computes trends.
+type Monitor struct {
+	store          store.Store
+	sampleInterval time.Duration
+	retention      time.Duration
+	trendWindow    time.Duration
+	margins        map[rpc.TrendMetric]float64
+
+	// mutex protects lastSample and trends.
+	mutex sync.Mutex
+
+	// lastSample is the timestamp of the last sample recorded for each
+	// machine.
+	lastSample map[string]time.Time
+
+	// trends are the most recently computed trends.
+	trends rpc.MachineTrendsResponse
+
+	// Metrics
+	recordFailures metrics2.Counter
+}
+
+// New returns a new *Monitor.
+func New(cfg config.TelemetryConfig, s store.Store) (*Monitor, error) {
+	ret := &Monitor{
+		store:          s,
+		sampleInterval: defaultSampleInterval,
+		retention:      defaultRetention,
+		trendWindow:    defaultTrendWindow,
+		margins: map[rpc.TrendMetric]float64{
+			rpc.TrendBattery:      defaultBatteryMargin,
+			rpc.TrendThermal:      defaultTemperatureMargin,
+			rpc.TrendTaskFailures: defaultFailureRateMargin,
+		},
+		lastSample:     map[string]time.Time{},
+		trends:         rpc.MachineTrendsResponse{Trends: []rpc.MachineTrend{}},
+		recordFailures: metrics2.GetCounter("machineserver_telemetry_record_failures"),
+	}
+	var err error
+	if cfg.SampleInterval != "" {
+		if ret.sampleInterval, err = time.ParseDuration(cfg.SampleInterval); err != nil {
+			return nil, skerr.Wrapf(err, "parsing sample_interval")
+		}
+	}
+	if cfg.Retention != "" {
+		if ret.retention, err = time.ParseDuration(cfg.Retention); err != nil {
+			return nil, skerr.Wrapf(err, "parsing retention")
+		}
+	}
+	if cfg.TrendWindow != "" {
+		if ret.trendWindow, err = time.ParseDuration(cfg.TrendWindow); err != nil {
+			return nil, skerr.Wrapf(err, "parsing trend_window")
+		}
+	}
+	if ret.trendWindow <= 0 || ret.retention < ret.trendWindow {
+		return nil, skerr.Fmt("trend_window must be positive and no longer than retention")
+	}
+	if cfg.BatteryMargin != 0 {
+		ret.margins[rpc.TrendBattery] = cfg.BatteryMargin
+	}
+	if cfg.TemperatureMargin != 0 {
+		ret.margins[rpc.TrendThermal] = cfg.TemperatureMargin
+	}
+	if cfg.FailureRateMargin != 0 {
+		ret.margins[rpc.TrendTaskFailures] = cfg.FailureRateMargin
+	}
+	return ret, nil
+}
+
+// Record stores a sample of the given Description, unless a sample was
+// already recorded for the machine within the sample interval.
+func (m *Monitor) Record(ctx context.Context, d machine.Description) error {
+	sample := machine.NewTelemetrySample(d)
+	if sample.MachineID == "" {
+		return nil
+	}
+
+	m.mutex.Lock()
+	last, ok := m.lastSample[sample.MachineID]
+	if ok && sample.Timestamp.Sub(last) < m.sampleInterval {
+		m.mutex.Unlock()
+		return nil
+	}
+	m.lastSample[sample.MachineID] = sample.Timestamp
+	m.mutex.Unlock()
+
+	if err := m.store.RecordTelemetry(ctx, sample); err != nil {
+		m.recordFailures.Inc(1)
+		// Forget this sample so the next report from the machine is recorded.
+		m.mutex.Lock()
+		if ok {
+			m.lastSample[sample.MachineID] = last
+		} else {
+			delete(m.lastSample, sample.MachineID)
+		}
+		m.mutex.Unlock()
+		return skerr.Wrap(err)
+	}
+	return nil
+}
+
+// Telemetry returns the samples and task outcomes for the given machine in
+// [begin, end).
+func (m *Monitor) Telemetry(ctx context.Context, machineID string, begin, end time.Time) (rpc.MachineTelemetryResponse, error) {
+	samples, err := m.store.ListTelemetry(ctx, machineID, begin, end)
+	if err != nil {
+		return rpc.MachineTelemetryResponse{}, skerr.Wrap(err)
+	}
+	results, err := m.store.ListTaskResults(ctx, machineID, begin, end)
+	if err != nil {
+		return rpc.MachineTelemetryResponse{}, skerr.Wrap(err)
+	}
+	ret := rpc.MachineTelemetryResponse{
+		MachineID:    machineID,
+		Samples:      samples,
+		TaskOutcomes: make([]rpc.TaskOutcome, 0, len(results)),
+	}
+	if ret.Samples == nil {
+		ret.Samples = []machine.TelemetrySample{}
+	}
+	for _, r := range results {
+		ret.TaskOutcomes = append(ret.TaskOutcomes, rpc.TaskOutcome{
+			ID:       r.ID,
+			Finished: r.Finished,
+			Status:   r.Status,
+		})
+	}
+	return ret, nil
+}
+
+// Trends returns the most recently computed trends.
+func (m *Monitor) Trends() rpc.MachineTrendsResponse {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	ret := m.trends
+	ret.Trends = append([]rpc.MachineTrend{}, m.trends.Trends...)
+	return ret
+}
+
+// Start runs Step every interval until the context is cancelled. This
+// function doesn't block.
+func (m *Monitor) Start(ctx context.Context, interval time.Duration) {
+	go util.RepeatCtx(ctx, interval, func(ctx context.Context) {
+		if err := m.Step(ctx); err != nil {
+			sklog.Errorf("Telemetry step failed: %s", err)
+		}
+	})
+}
+
+// Step removes samples older than the retention period and recomputes the
+// trends for all machines.
+func (m *Monitor) Step(ctx context.Context) error {
+	ts := now.Now(ctx)
+	if err := m.store.DeleteTelemetryBefore(ctx, ts.Add(-m.retention)); err != nil {
+		return skerr.Wrap(err)
+	}
+
+	descriptions, err := m.store.List(ctx)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	begin := ts.Add(-m.trendWindow)
+	mid := ts.Add(-m.trendWindow / 2)
+	all := make([]machineChanges, 0, len(descriptions))
+	for _, d := range descriptions {
+		machineID := d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID)
+		samples, err := m.store.ListTelemetry(ctx, machineID, begin, ts)
+		if err != nil {
+			sklog.Errorf("Failed to list telemetry for %q: %s", machineID, err)
+			continue
+		}
+		results, err := m.store.ListTaskResults(ctx, machineID, begin, ts)
+		if err != nil {
+			sklog.Errorf("Failed to list task results for %q: %s", machineID, err)
+			continue
+		}
+		all = append(all, changesFor(machineID, d.Dimensions.GetDimensionValueOrEmptyString(machine.DimPool), samples, results, mid))
+	}
+
+	trends := findTrends(all, m.margins)
+	updateMetrics(all, trends)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.trends = rpc.MachineTrendsResponse{
+		Computed: ts,
+		Trends:   trends,
+	}
+	return nil
+}
+
+// machineChanges holds how much each metric changed for a single machine
+// between the two halves of the trend window. Metrics without enough data
+// are absent.
+type machineChanges struct {
+	machineID string
+	pool      string
+	changes   map[rpc.TrendMetric]float64
+}
+
+// halves accumulates values that fall before and after the middle of the
+// trend window.
+type halves struct {
+	early, late []float64
+}
+
+func (h *halves) add(ts, mid time.Time, value float64) {
+	if ts.Before(mid) {
+		h.early = append(h.early, value)
+	} else {
+		h.late = append(h.late, value)
+	}
+}
+
+// change returns the difference between the mean of the late and early
+// values, and false if either half has fewer than min values.
+func (h *halves) change(min int) (float64, bool) {
+	if len(h.early) < min || len(h.late) < min {
+		return 0, false
+	}
+	return mean(h.late) - mean(h.early), true
+}
+
+func mean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func median(values []float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// maxTemperature returns the highest readable temperature in the sample, and
+// false if there are none.
+func maxTemperature(sample machine.TelemetrySample) (float64, bool) {
+	ret := badTemperature
+	found := false
+	for _, t := range sample.Temperature {
+		if t > badTemperature && (!found || t > ret) {
+			ret = t
+			found = true
+		}
+	}
+	return ret, found
+}
+
+// changesFor computes the machineChanges for a single machine, where mid is
+// the middle of the trend window.
+func changesFor(machineID, pool string, samples []machine.TelemetrySample, results []machine.TaskResult, mid time.Time) machineChanges {
+	var battery, thermal, failures halves
+	for _, s := range samples {
+		// Machines without a battery, or without an attached device, report 0.
+		if s.Battery > 0 {
+			battery.add(s.Timestamp, mid, float64(s.Battery))
+		}
+		if t, ok := maxTemperature(s); ok {
+			thermal.add(s.Timestamp, mid, t)
+		}
+	}
+	for _, r := range results {
+		switch r.Status {
+		case types.TASK_STATUS_SUCCESS:
+			failures.add(r.Finished, mid, 0)
+		case types.TASK_STATUS_FAILURE, types.TASK_STATUS_MISHAP:
+			failures.add(r.Finished, mid, 1)
+		}
+	}
+
+	ret := machineChanges{
+		machineID: machineID,
+		pool:      pool,
+		changes:   map[rpc.TrendMetric]float64{},
+	}
+	if c, ok := battery.change(minSamplesPerHalf); ok {
+		ret.changes[rpc.TrendBattery] = c
+	}
+	if c, ok := thermal.change(minSamplesPerHalf); ok {
+		ret.changes[rpc.TrendThermal] = c
+	}
+	if c, ok := failures.change(minTasksPerHalf); ok {
+		ret.changes[rpc.TrendTaskFailures] = c
+	}
+	return ret
+}
+
+// findTrends returns a MachineTrend for every machine and metric where the
+// machine's change is worse than the median change of its pool by more than
+// the metric's margin. The results are sorted by pool, machine, and metric.
+func findTrends(all []machineChanges, margins map[rpc.TrendMetric]float64) []rpc.MachineTrend {
+	byPool := map[string][]machineChanges{}
+	for _, mc := range all {
+		byPool[mc.pool] = append(byPool[mc.pool], mc)
+	}
+
+	ret := []rpc.MachineTrend{}
+	for pool, machines := range byPool {
+		for _, metric := range rpc.AllTrendMetrics {
+			var changes []float64
+			for _, mc := range machines {
+				if c, ok := mc.changes[metric]; ok {
+					changes = append(changes, c)
+				}
+			}
+			if len(changes) < minPoolSize {
+				continue
+			}
+			poolMedian := median(changes)
+			for _, mc := range machines {
+				c, ok := mc.changes[metric]
+				if !ok {
+					continue
+				}
+				if worseDirection[metric]*(c-poolMedian) > margins[metric] {
+					ret = append(ret, rpc.MachineTrend{
+						MachineID:        mc.machineID,
+						Pool:             pool,
+						Metric:           metric,
+						Change:           c,
+						PoolMedianChange: poolMedian,
+					})
+				}
+			}
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Pool != ret[j].Pool {
+			return ret[i].Pool < ret[j].Pool
+		}
+		if ret[i].MachineID != ret[j].MachineID {
+			return ret[i].MachineID < ret[j].MachineID
+		}
+		return ret[i].Metric < ret[j].Metric
+	})
+	return ret
+}
+
+// updateMetrics reflects the trends into metrics, one per machine and metric
+// that has enough data to be evaluated.
+func updateMetrics(all []machineChanges, trends []rpc.MachineTrend) {
+	flagged := map[string]map[rpc.TrendMetric]bool{}
+	for _, t := range trends {
+		if flagged[t.MachineID] == nil {
+			flagged[t.MachineID] = map[rpc.TrendMetric]bool{}
+		}
+		flagged[t.MachineID][t.Metric] = true
+	}
+	for _, mc := range all {
+		for metric := range mc.changes {
+			tags := map[string]string{
+				"machine": mc.machineID,
+				"metric":  string(metric),
+			}
+			metrics2.GetBoolMetric("machineserver_telemetry_trending_worse", tags).Update(flagged[mc.machineID][metric])
+		}
+	}
+}
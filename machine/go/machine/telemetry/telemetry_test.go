@@ -0,0 +1,234 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/now"
+	"go.skia.org/infra/go/testutils"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machine/store/mocks"
+	"go.skia.org/infra/machine/go/machineserver/config"
+	"go.skia.org/infra/machine/go/machineserver/rpc"
+	"go.skia.org/infra/task_scheduler/go/types"
+)
+
+const (
+	machineID = "skia-rpi2-rack4-shelf1-001"
+	pool      = "Skia"
+)
+
+var fakeNow = time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+func newDescription(machineID string, ts time.Time) machine.Description {
+	d := machine.NewDescription(context.Background())
+	d.Dimensions[machine.DimID] = []string{machineID}
+	d.Dimensions[machine.DimPool] = []string{pool}
+	d.LastUpdated = ts
+	d.Battery = 80
+	d.Temperature = map[string]float64{"cpu": 30}
+	return d
+}
+
+// samples returns n samples in each half of the default trend window ending
+// at fakeNow, with the given battery levels and cpu temperatures.
+func samples(machineID string, n int, earlyBattery, lateBattery int, earlyTemp, lateTemp float64) []machine.TelemetrySample {
+	var ret []machine.TelemetrySample
+	for i := 0; i < n; i++ {
+		ret = append(ret, machine.TelemetrySample{
+			MachineID:   machineID,
+			Timestamp:   fakeNow.Add(-defaultTrendWindow + time.Duration(i)*time.Hour),
+			Battery:     earlyBattery,
+			Temperature: map[string]float64{"cpu": earlyTemp, "broken": badTemperature},
+		})
+		ret = append(ret, machine.TelemetrySample{
+			MachineID:   machineID,
+			Timestamp:   fakeNow.Add(-time.Duration(i+1) * time.Hour),
+			Battery:     lateBattery,
+			Temperature: map[string]float64{"cpu": lateTemp},
+		})
+	}
+	return ret
+}
+
+// taskResults returns n results in each half of the default trend window
+// ending at fakeNow, where the given number in each half failed.
+func taskResults(machineID string, n, earlyFailures, lateFailures int) []machine.TaskResult {
+	var ret []machine.TaskResult
+	status := func(i, failures int) types.TaskStatus {
+		if i < failures {
+			return types.TASK_STATUS_FAILURE
+		}
+		return types.TASK_STATUS_SUCCESS
+	}
+	for i := 0; i < n; i++ {
+		ret = append(ret, machine.TaskResult{
+			ID:        fmt.Sprintf("early-%d", i),
+			MachineID: machineID,
+			Finished:  fakeNow.Add(-defaultTrendWindow + time.Duration(i)*time.Hour),
+			Status:    status(i, earlyFailures),
+		})
+		ret = append(ret, machine.TaskResult{
+			ID:        fmt.Sprintf("late-%d", i),
+			MachineID: machineID,
+			Finished:  fakeNow.Add(-time.Duration(i+1) * time.Hour),
+			Status:    status(i, lateFailures),
+		})
+	}
+	return ret
+}
+
+func TestNew_InvalidDuration_ReturnsError(t *testing.T) {
+	_, err := New(config.TelemetryConfig{SampleInterval: "often"}, nil)
+	require.Error(t, err)
+}
+
+func TestNew_TrendWindowLongerThanRetention_ReturnsError(t *testing.T) {
+	_, err := New(config.TelemetryConfig{Retention: "24h", TrendWindow: "48h"}, nil)
+	require.Error(t, err)
+}
+
+func TestRecord_SecondSampleWithinSampleInterval_IsNotRecorded(t *testing.T) {
+	s := mocks.NewStore(t)
+	s.On("RecordTelemetry", testutils.AnyContext, mock.Anything).Return(nil).Twice()
+	m, err := New(config.TelemetryConfig{}, s)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, m.Record(ctx, newDescription(machineID, fakeNow)))
+	require.NoError(t, m.Record(ctx, newDescription(machineID, fakeNow.Add(time.Minute))))
+	require.NoError(t, m.Record(ctx, newDescription(machineID, fakeNow.Add(defaultSampleInterval))))
+
+	s.AssertNumberOfCalls(t, "RecordTelemetry", 2)
+	sample := s.Calls[0].Arguments.Get(1).(machine.TelemetrySample)
+	require.Equal(t, machine.TelemetrySample{
+		MachineID:   machineID,
+		Timestamp:   fakeNow,
+		Battery:     80,
+		Temperature: map[string]float64{"cpu": 30},
+	}, sample)
+}
+
+func TestRecord_StoreFails_NextSampleIsRecorded(t *testing.T) {
+	s := mocks.NewStore(t)
+	s.On("RecordTelemetry", testutils.AnyContext, mock.Anything).Return(errors.New("my fake error")).Once()
+	s.On("RecordTelemetry", testutils.AnyContext, mock.Anything).Return(nil).Once()
+	m, err := New(config.TelemetryConfig{}, s)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.Error(t, m.Record(ctx, newDescription(machineID, fakeNow)))
+	require.NoError(t, m.Record(ctx, newDescription(machineID, fakeNow.Add(time.Minute))))
+}
+
+func TestTelemetry_ReturnsSamplesAndTaskOutcomes(t *testing.T) {
+	begin := fakeNow.Add(-time.Hour)
+	s := mocks.NewStore(t)
+	s.On("ListTelemetry", testutils.AnyContext, machineID, begin, fakeNow).Return([]machine.TelemetrySample{
+		{MachineID: machineID, Timestamp: begin, Battery: 90},
+	}, nil)
+	s.On("ListTaskResults", testutils.AnyContext, machineID, begin, fakeNow).Return([]machine.TaskResult{
+		{ID: "task1", MachineID: machineID, Finished: begin, Status: types.TASK_STATUS_MISHAP},
+	}, nil)
+	m, err := New(config.TelemetryConfig{}, s)
+	require.NoError(t, err)
+
+	resp, err := m.Telemetry(context.Background(), machineID, begin, fakeNow)
+	require.NoError(t, err)
+	require.Equal(t, rpc.MachineTelemetryResponse{
+		MachineID: machineID,
+		Samples: []machine.TelemetrySample{
+			{MachineID: machineID, Timestamp: begin, Battery: 90},
+		},
+		TaskOutcomes: []rpc.TaskOutcome{
+			{ID: "task1", Finished: begin, Status: types.TASK_STATUS_MISHAP},
+		},
+	}, resp)
+}
+
+func TestChangesFor_NotEnoughData_MetricsAreAbsent(t *testing.T) {
+	mid := fakeNow.Add(-defaultTrendWindow / 2)
+	mc := changesFor(machineID, pool, samples(machineID, minSamplesPerHalf-1, 90, 80, 30, 31), taskResults(machineID, minTasksPerHalf-1, 0, 1), mid)
+	require.Empty(t, mc.changes)
+}
+
+func TestChangesFor_EnoughData_ReturnsChangeBetweenHalves(t *testing.T) {
+	mid := fakeNow.Add(-defaultTrendWindow / 2)
+	mc := changesFor(machineID, pool, samples(machineID, minSamplesPerHalf, 90, 75, 30, 34), taskResults(machineID, 10, 1, 4), mid)
+	require.Len(t, mc.changes, 3)
+	require.InDelta(t, -15, mc.changes[rpc.TrendBattery], 0.001)
+	require.InDelta(t, 4, mc.changes[rpc.TrendThermal], 0.001)
+	require.InDelta(t, 0.3, mc.changes[rpc.TrendTaskFailures], 0.001)
+}
+
+func TestFindTrends_OneMachineWorseThanPool_IsFlagged(t *testing.T) {
+	all := []machineChanges{
+		{machineID: "m1", pool: pool, changes: map[rpc.TrendMetric]float64{rpc.TrendBattery: -1, rpc.TrendThermal: 0.5}},
+		{machineID: "m2", pool: pool, changes: map[rpc.TrendMetric]float64{rpc.TrendBattery: -2, rpc.TrendThermal: 0}},
+		{machineID: "m3", pool: pool, changes: map[rpc.TrendMetric]float64{rpc.TrendBattery: -20, rpc.TrendThermal: 1}},
+		// A machine whose battery improves a lot isn't flagged.
+		{machineID: "m4", pool: pool, changes: map[rpc.TrendMetric]float64{rpc.TrendBattery: 30}},
+	}
+	trends := findTrends(all, map[rpc.TrendMetric]float64{
+		rpc.TrendBattery:      defaultBatteryMargin,
+		rpc.TrendThermal:      defaultTemperatureMargin,
+		rpc.TrendTaskFailures: defaultFailureRateMargin,
+	})
+	require.Equal(t, []rpc.MachineTrend{
+		{
+			MachineID:        "m3",
+			Pool:             pool,
+			Metric:           rpc.TrendBattery,
+			Change:           -20,
+			PoolMedianChange: -1.5,
+		},
+	}, trends)
+}
+
+func TestFindTrends_TooFewMachinesInPool_NothingIsFlagged(t *testing.T) {
+	all := []machineChanges{
+		{machineID: "m1", pool: pool, changes: map[rpc.TrendMetric]float64{rpc.TrendTaskFailures: 0}},
+		{machineID: "m2", pool: pool, changes: map[rpc.TrendMetric]float64{rpc.TrendTaskFailures: 0.9}},
+		{machineID: "m3", pool: "other", changes: map[rpc.TrendMetric]float64{rpc.TrendTaskFailures: 0}},
+	}
+	require.Empty(t, findTrends(all, map[rpc.TrendMetric]float64{rpc.TrendTaskFailures: defaultFailureRateMargin}))
+}
+
+func TestStep_PrunesOldSamplesAndComputesTrends(t *testing.T) {
+	ctx := now.TimeTravelingContext(fakeNow)
+	ids := []string{"m1", "m2", "m3"}
+	var descriptions []machine.Description
+	for _, id := range ids {
+		descriptions = append(descriptions, newDescription(id, fakeNow))
+	}
+	s := mocks.NewStore(t)
+	s.On("DeleteTelemetryBefore", testutils.AnyContext, fakeNow.Add(-defaultRetention)).Return(nil)
+	s.On("List", testutils.AnyContext).Return(descriptions, nil)
+	begin := fakeNow.Add(-defaultTrendWindow)
+	s.On("ListTelemetry", testutils.AnyContext, "m1", begin, fakeNow).Return(samples("m1", minSamplesPerHalf, 90, 89, 30, 30), nil)
+	s.On("ListTelemetry", testutils.AnyContext, "m2", begin, fakeNow).Return(samples("m2", minSamplesPerHalf, 90, 90, 30, 31), nil)
+	s.On("ListTelemetry", testutils.AnyContext, "m3", begin, fakeNow).Return(samples("m3", minSamplesPerHalf, 90, 88, 30, 40), nil)
+	s.On("ListTaskResults", testutils.AnyContext, mock.Anything, begin, fakeNow).Return(nil, nil)
+	m, err := New(config.TelemetryConfig{}, s)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Step(ctx))
+
+	require.Equal(t, rpc.MachineTrendsResponse{
+		Computed: fakeNow,
+		Trends: []rpc.MachineTrend{
+			{
+				MachineID:        "m3",
+				Pool:             pool,
+				Metric:           rpc.TrendThermal,
+				Change:           10,
+				PoolMedianChange: 1,
+			},
+		},
+	}, m.Trends())
+}
@@ -24,11 +24,17 @@ func main() {
 		rpc.SetNoteRequest{},
 		rpc.SupplyChromeOSRequest{},
 		rpc.SetAttachedDevice{},
+		rpc.ReserveMachineRequest{},
+		rpc.ReserveMachineResponse{},
+		rpc.MachineTelemetryResponse{},
+		rpc.MachineTrendsResponse{},
+		rpc.ListDriftedMachinesResponse{},
 	)
 	generator.AddIgnoreNil(rpc.ListMachinesResponse{})
 	generator.AddUnion(machine.AllAttachedDevices)
 	generator.AddUnion(machine.AllPowerCycleStates)
 	generator.AddUnion(machine.AllTaskRequestorStates)
+	generator.AddUnion(rpc.AllTrendMetrics)
 
 	err := util.WithWriteFile(*outputPath, func(w io.Writer) error {
 		return generator.Render(w)
@@ -9,6 +9,110 @@ type Pool struct {
 	// Regex is a regular expression that matches a machine id if that machine
 	// is in this pool.
 	Regex string `json:"regex"`
+
+	// Recovery, if supplied, is the automated recovery policy for quarantined
+	// machines in this pool.
+	Recovery *RecoveryPolicy `json:"recovery,omitempty"`
+
+	// Inventory, if supplied, is the name of a file in the same directory as
+	// this config that describes the dimensions machines in this pool are
+	// expected to have. See Inventory.
+	Inventory string `json:"inventory,omitempty"`
+}
+
+// RecoveryStep is a single step of a RecoveryPolicy.
+type RecoveryStep struct {
+	// Step is the name of the step, one of "reboot_device", "powercycle",
+	// "reprovision", or "maintenance". See machine.RecoveryStep.
+	Step string `json:"step"`
+
+	// Timeout is how long to wait for the machine to leave quarantine before
+	// escalating to the next step, in a format understood by
+	// time.ParseDuration, e.g. "15m".
+	Timeout string `json:"timeout"`
+
+	// URL is the hook that is sent a POST request when the step starts. It is
+	// required for "reprovision" and optional for "maintenance", where it can
+	// be used to file a notification.
+	URL string `json:"url,omitempty"`
+}
+
+// RecoveryPolicy describes how to escalate recovery attempts for quarantined
+// machines.
+type RecoveryPolicy struct {
+	// Steps are tried in the order they appear, moving on to the next step
+	// each time a step times out.
+	Steps []RecoveryStep `json:"steps"`
+}
+
+// InventoryEntry is the expected dimensions for a set of machines.
+type InventoryEntry struct {
+	// Regex is a regular expression that matches the ids of the machines this
+	// entry applies to.
+	Regex string `json:"regex"`
+
+	// Dimensions are the expected values for each dimension. Dimensions that
+	// aren't listed aren't checked. An empty list means the dimension is
+	// expected to be absent.
+	Dimensions map[string][]string `json:"dimensions"`
+}
+
+// Inventory is the expected inventory of a pool, stored in its own file.
+type Inventory struct {
+	// QuarantineOnDrift quarantines machines whose dimensions don't match.
+	QuarantineOnDrift bool `json:"quarantine_on_drift"`
+
+	// Machines is a list of InventoryEntry. A machine is checked against
+	// every entry that matches it; if more than one entry lists the same
+	// dimension the last one wins.
+	Machines []InventoryEntry `json:"machines"`
+}
+
+// ReservationConfig controls how machines are reserved.
+type ReservationConfig struct {
+	// MaxDuration is the longest a machine can be reserved for, in a format
+	// understood by time.ParseDuration. Defaults to "72h".
+	MaxDuration string `json:"max_duration,omitempty"`
+
+	// ReminderBefore is how long before a reservation expires that a
+	// reminder is sent, in a format understood by time.ParseDuration.
+	// Defaults to "1h".
+	ReminderBefore string `json:"reminder_before,omitempty"`
+
+	// NotificationURL, if supplied, is sent a POST request for expiry
+	// reminders and when reservations end.
+	NotificationURL string `json:"notification_url,omitempty"`
+}
+
+// TelemetryConfig controls how machine telemetry is recorded and how trends
+// are detected.
+type TelemetryConfig struct {
+	// SampleInterval is the minimum time between recorded samples for a
+	// single machine, in a format understood by time.ParseDuration. Defaults
+	// to "5m".
+	SampleInterval string `json:"sample_interval,omitempty"`
+
+	// Retention is how long samples are kept, in a format understood by
+	// time.ParseDuration. Defaults to "720h".
+	Retention string `json:"retention,omitempty"`
+
+	// TrendWindow is the time range over which trends are computed, in a
+	// format understood by time.ParseDuration. Defaults to "168h".
+	TrendWindow string `json:"trend_window,omitempty"`
+
+	// BatteryMargin is how many more percentage points a machine's average
+	// battery level must drop than the pool median to be flagged. Defaults to
+	// 10.
+	BatteryMargin float64 `json:"battery_margin,omitempty"`
+
+	// TemperatureMargin is how many more degrees C a machine's average
+	// temperature must rise than the pool median to be flagged. Defaults to 3.
+	TemperatureMargin float64 `json:"temperature_margin,omitempty"`
+
+	// FailureRateMargin is how much more a machine's task failure rate, a
+	// fraction in [0, 1], must rise than the pool median to be flagged.
+	// Defaults to 0.2.
+	FailureRateMargin float64 `json:"failure_rate_margin,omitempty"`
 }
 
 // InstanceConfig is the config for an instance of machineserver.
@@ -20,4 +124,10 @@ type InstanceConfig struct {
 	// Pools is a list of Pools. They are evaluated in the order they appear in
 	// the config file.
 	Pools []Pool `json:"pools"`
+
+	// Reservations configures machine reservations.
+	Reservations ReservationConfig `json:"reservations"`
+
+	// Telemetry configures the recording of machine telemetry.
+	Telemetry TelemetryConfig `json:"telemetry"`
 }
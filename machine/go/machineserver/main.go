@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"text/template"
@@ -38,10 +39,14 @@ import (
 	changeSink "go.skia.org/infra/machine/go/machine/change/sink"
 	sseChangeSink "go.skia.org/infra/machine/go/machine/change/sink/sse"
 	httpEventSource "go.skia.org/infra/machine/go/machine/event/source/httpsource"
+	"go.skia.org/infra/machine/go/machine/inventory"
 	"go.skia.org/infra/machine/go/machine/pools"
 	machineProcessor "go.skia.org/infra/machine/go/machine/processor"
+	"go.skia.org/infra/machine/go/machine/recovery"
+	"go.skia.org/infra/machine/go/machine/reservation"
 	machineStore "go.skia.org/infra/machine/go/machine/store"
 	"go.skia.org/infra/machine/go/machine/store/cdb"
+	"go.skia.org/infra/machine/go/machine/telemetry"
 	"go.skia.org/infra/machine/go/machineserver/config"
 	"go.skia.org/infra/machine/go/machineserver/rpc"
 )
@@ -49,6 +54,18 @@ import (
 // The default timeout to use on a context when talking to the database.
 const defaultSQLTimeout = time.Minute
 
+// How often to run the recovery workflow for quarantined machines.
+const recoveryInterval = time.Minute
+
+// How often to look for expiring reservations.
+const reservationInterval = time.Minute
+
+// How often to prune old telemetry and recompute trends.
+const telemetryInterval = time.Hour
+
+// The time range returned by the telemetry endpoint if none is given.
+const defaultTelemetryRange = 24 * time.Hour
+
 var errFailedToGetID = errors.New("failed to get id from URL")
 
 type flags struct {
@@ -91,6 +108,12 @@ type server struct {
 
 	processor machineProcessor.Processor
 
+	reservations *reservation.Manager
+
+	telemetry *telemetry.Monitor
+
+	inventory *inventory.Inventory
+
 	login alogin.Login
 }
 
@@ -118,8 +141,6 @@ func new(args []string) (*server, error) {
 		sklog.Fatal(err)
 	}
 
-	processor := machineProcessor.New(ctx)
-
 	if instanceConfig.ConnectionString == "" {
 		sklog.Fatal("ConnectionString must be supplied in the instance config")
 	}
@@ -129,6 +150,13 @@ func new(args []string) (*server, error) {
 		return nil, skerr.Wrap(err)
 	}
 
+	inv, err := inventory.New(instanceConfig, configs.Configs, pools)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	processor := machineProcessor.New(ctx, inv)
+
 	unwrappedPool, err := pgxpool.Connect(ctx, instanceConfig.ConnectionString)
 	if err != nil {
 		return nil, skerr.Wrap(err)
@@ -139,6 +167,28 @@ func new(args []string) (*server, error) {
 		return nil, skerr.Wrap(err)
 	}
 
+	recoverer, err := recovery.New(instanceConfig, store, recovery.NewHTTPHook(httputils.NewTimeoutClient()))
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	recoverer.Start(ctx, recoveryInterval)
+
+	var notifier reservation.Notifier
+	if instanceConfig.Reservations.NotificationURL != "" {
+		notifier = reservation.NewHTTPNotifier(httputils.NewTimeoutClient(), instanceConfig.Reservations.NotificationURL)
+	}
+	reservations, err := reservation.New(instanceConfig.Reservations, store, notifier)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	reservations.Start(ctx, reservationInterval)
+
+	telemetryMonitor, err := telemetry.New(instanceConfig.Telemetry, store)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	telemetryMonitor.Start(ctx, telemetryInterval)
+
 	httpSource, err := httpEventSource.New()
 	if err != nil {
 		return nil, skerr.Wrap(err)
@@ -161,6 +211,9 @@ func new(args []string) (*server, error) {
 		httpEventSource: httpSource,
 		sserServer:      *sserChangeSink,
 		processor:       processor,
+		reservations:    reservations,
+		telemetry:       telemetryMonitor,
+		inventory:       inv,
 		httpSourceCh:    httpSourceCh,
 	}
 	s.loadTemplates()
@@ -177,22 +230,28 @@ func (s *server) listenMachineEvents(ctx context.Context) {
 	for {
 		select {
 		case event := <-s.httpSourceCh:
-			processEventArrival(ctx, s.store, storeUpdateFail, s.processor, event)
+			processEventArrival(ctx, s.store, storeUpdateFail, s.processor, s.telemetry, event)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func processEventArrival(ctx context.Context, store machineStore.Store, storeUpdateFail metrics2.Counter, processor machineProcessor.Processor, event machine.Event) {
+func processEventArrival(ctx context.Context, store machineStore.Store, storeUpdateFail metrics2.Counter, processor machineProcessor.Processor, telemetryMonitor *telemetry.Monitor, event machine.Event) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, defaultSQLTimeout)
 	defer cancel()
+	var updated machine.Description
 	err := store.Update(timeoutCtx, event.Host.Name, func(previous machine.Description) machine.Description {
-		return processor.Process(timeoutCtx, previous, event)
+		updated = processor.Process(timeoutCtx, previous, event)
+		return updated
 	})
 	if err != nil {
 		storeUpdateFail.Inc(1)
 		sklog.Errorf("Failed to update: %s", err)
+		return
+	}
+	if err := telemetryMonitor.Record(timeoutCtx, updated); err != nil {
+		sklog.Errorf("Failed to record telemetry: %s", err)
 	}
 }
 
@@ -545,6 +604,50 @@ func (s *server) machineSupplyChromeOSInfoHandler(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusOK)
 }
 
+func (s *server) machineReserveHandler(w http.ResponseWriter, r *http.Request) {
+	var req rpc.ReserveMachineRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httputils.ReportError(w, err, "Failed to parse request.", http.StatusBadRequest)
+		return
+	}
+
+	s.audit(w, r, "reserve", req)
+
+	ctx, cancel := context.WithTimeout(r.Context(), defaultSQLTimeout)
+	defer cancel()
+	resp, err := s.reservations.Reserve(ctx, string(s.login.LoggedInAs(r)), req)
+	if errors.Is(err, reservation.ErrInvalidRequest) {
+		httputils.ReportError(w, err, "Invalid reservation request.", http.StatusBadRequest)
+		return
+	} else if errors.Is(err, reservation.ErrNoMachineAvailable) {
+		httputils.ReportError(w, err, "No matching machine is available.", http.StatusConflict)
+		return
+	} else if err != nil {
+		httputils.ReportError(w, err, "Failed to reserve machine.", http.StatusInternalServerError)
+		return
+	}
+	s.triggerDescriptionUpdateEvent(ctx, resp.MachineID)
+	sendJSONResponse(resp, w)
+}
+
+func (s *server) machineReleaseHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := getID(w, r)
+	if err != nil {
+		return
+	}
+
+	s.audit(w, r, "release", id)
+
+	ctx, cancel := context.WithTimeout(r.Context(), defaultSQLTimeout)
+	defer cancel()
+	if err := s.reservations.Release(ctx, id, string(s.login.LoggedInAs(r))); err != nil {
+		httputils.ReportError(w, err, "Failed to release machine.", http.StatusInternalServerError)
+		return
+	}
+	s.triggerDescriptionUpdateEvent(ctx, id)
+	w.WriteHeader(http.StatusOK)
+}
+
 func (s *server) apiMachineDescriptionHandler(w http.ResponseWriter, r *http.Request) {
 	id, err := getID(w, r)
 	if err != nil {
@@ -562,6 +665,82 @@ func (s *server) apiMachineDescriptionHandler(w http.ResponseWriter, r *http.Req
 	sendJSONResponse(desc, w)
 }
 
+// parseTimeRange returns the time range given by the optional "begin" and
+// "end" query parameters, which are in RFC 3339 format.
+func parseTimeRange(ctx context.Context, r *http.Request) (time.Time, time.Time, error) {
+	end := now.Now(ctx)
+	if e := r.FormValue("end"); e != "" {
+		var err error
+		if end, err = time.Parse(time.RFC3339, e); err != nil {
+			return time.Time{}, time.Time{}, skerr.Wrapf(err, "parsing end")
+		}
+	}
+	begin := end.Add(-defaultTelemetryRange)
+	if b := r.FormValue("begin"); b != "" {
+		var err error
+		if begin, err = time.Parse(time.RFC3339, b); err != nil {
+			return time.Time{}, time.Time{}, skerr.Wrapf(err, "parsing begin")
+		}
+	}
+	if !begin.Before(end) {
+		return time.Time{}, time.Time{}, skerr.Fmt("begin must be before end")
+	}
+	return begin, end, nil
+}
+
+func (s *server) apiMachineTelemetryHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := getID(w, r)
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), defaultSQLTimeout)
+	defer cancel()
+
+	begin, end, err := parseTimeRange(ctx, r)
+	if err != nil {
+		httputils.ReportError(w, err, "Invalid time range.", http.StatusBadRequest)
+		return
+	}
+	resp, err := s.telemetry.Telemetry(ctx, id, begin, end)
+	if err != nil {
+		httputils.ReportError(w, err, "Failed to read telemetry.", http.StatusInternalServerError)
+		return
+	}
+	sendJSONResponse(resp, w)
+}
+
+func (s *server) apiMachineTrendsHandler(w http.ResponseWriter, r *http.Request) {
+	sendJSONResponse(s.telemetry.Trends(), w)
+}
+
+func (s *server) apiMachineDriftHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), defaultSQLTimeout)
+	defer cancel()
+
+	descriptions, err := s.store.List(ctx)
+	if err != nil {
+		httputils.ReportError(w, err, "Failed to read from datastore", http.StatusInternalServerError)
+		return
+	}
+	resp := rpc.ListDriftedMachinesResponse{}
+	for _, d := range descriptions {
+		drift, _ := s.inventory.Check(d)
+		if len(drift) == 0 {
+			continue
+		}
+		resp = append(resp, rpc.DriftedMachine{
+			MachineID: d.Dimensions.GetDimensionValueOrEmptyString(machine.DimID),
+			Pool:      d.Dimensions.GetDimensionValueOrEmptyString(machine.DimPool),
+			Drift:     drift,
+		})
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].MachineID < resp[j].MachineID
+	})
+	sendJSONResponse(resp, w)
+}
+
 func (s *server) apiPowerCycleListHandler(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), defaultSQLTimeout)
 	defer cancel()
@@ -693,6 +872,8 @@ func (s *server) AddHandlers(r chi.Router) {
 	r.Post("/_/machine/set_note/{id:.+}", s.editorSecureGzip(http.HandlerFunc(s.machineSetNoteHandler)).ServeHTTP)
 	r.Post("/_/machine/supply_chromeos/{id:.+}", s.editorSecureGzip(http.HandlerFunc(s.machineSupplyChromeOSInfoHandler)).ServeHTTP)
 	r.Post("/_/machine/clear_quarantined/{id:.+}", s.editorSecureGzip(http.HandlerFunc(s.machineClearQuarantinedHandler)).ServeHTTP)
+	r.Post("/_/machine/reserve", s.editorSecureGzip(http.HandlerFunc(s.machineReserveHandler)).ServeHTTP)
+	r.Post("/_/machine/release/{id:.+}", s.editorSecureGzip(http.HandlerFunc(s.machineReleaseHandler)).ServeHTTP)
 
 	// External APIs
 	r.Post(rpc.PowerCycleCompleteURL, s.editorSecureGzip(http.HandlerFunc(s.apiPowerCycleCompleteHandler)).ServeHTTP)
@@ -703,6 +884,10 @@ func (s *server) AddHandlers(r chi.Router) {
 	// Public APIs
 	r.Get("/_/machines", gzip(http.HandlerFunc(s.machinesHandler)).ServeHTTP)
 	r.Get(rpc.MachineDescriptionURL, gzip(http.HandlerFunc(s.apiMachineDescriptionHandler)).ServeHTTP)
+	r.Get(rpc.MachineListURL, gzip(http.HandlerFunc(s.machinesHandler)).ServeHTTP)
+	r.Get(rpc.MachineTelemetryURL, gzip(http.HandlerFunc(s.apiMachineTelemetryHandler)).ServeHTTP)
+	r.Get(rpc.MachineDriftURL, gzip(http.HandlerFunc(s.apiMachineDriftHandler)).ServeHTTP)
+	r.Get(rpc.MachineTrendsURL, gzip(http.HandlerFunc(s.apiMachineTrendsHandler)).ServeHTTP)
 	r.Get(rpc.PowerCycleListURL, gzip(http.HandlerFunc(s.apiPowerCycleListHandler)).ServeHTTP)
 	r.Get("/loginstatus/", gzip(http.HandlerFunc(s.loginStatus)).ServeHTTP)
 }
@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -23,7 +24,13 @@ import (
 	"go.skia.org/infra/kube/go/authproxy"
 	"go.skia.org/infra/machine/go/machine"
 	changeSinkMocks "go.skia.org/infra/machine/go/machine/change/sink/mocks"
+	"go.skia.org/infra/machine/go/machine/inventory"
+	"go.skia.org/infra/machine/go/machine/pools"
+	"go.skia.org/infra/machine/go/machine/reservation"
+	machineStore "go.skia.org/infra/machine/go/machine/store"
 	"go.skia.org/infra/machine/go/machine/store/mocks"
+	"go.skia.org/infra/machine/go/machine/telemetry"
+	"go.skia.org/infra/machine/go/machineserver/config"
 	"go.skia.org/infra/machine/go/machineserver/rpc"
 )
 
@@ -65,6 +72,25 @@ func setupForTestLocalOrProd(t *testing.T, local bool) (context.Context, machine
 
 	storeMock := mocks.NewStore(t)
 	changeSinkMock := changeSinkMocks.NewSink(t)
+	reservations, err := reservation.New(config.ReservationConfig{}, storeMock, nil)
+	require.NoError(t, err)
+	telemetryMonitor, err := telemetry.New(config.TelemetryConfig{}, storeMock)
+	require.NoError(t, err)
+	instanceConfig := config.InstanceConfig{
+		Pools: []config.Pool{
+			{
+				Name:      machine.PoolSkia,
+				Regex:     "^skia-",
+				Inventory: "skia.json",
+			},
+		},
+	}
+	p, err := pools.New(instanceConfig)
+	require.NoError(t, err)
+	inv, err := inventory.New(instanceConfig, fstest.MapFS{
+		"skia.json": {Data: []byte(`{"machines": [{"regex": "^skia-rpi2-", "dimensions": {"device_type": ["sargo"]}}]}`)},
+	}, p)
+	require.NoError(t, err)
 
 	s := &server{
 		flags: &flags{
@@ -74,6 +100,12 @@ func setupForTestLocalOrProd(t *testing.T, local bool) (context.Context, machine
 
 		sserChangeSink: changeSinkMock,
 
+		reservations: reservations,
+
+		telemetry: telemetryMonitor,
+
+		inventory: inv,
+
 		login: proxylogin.NewWithDefaults(),
 	}
 
@@ -437,6 +469,111 @@ func TestApiMachineDescriptionHandler_NoMachineIDSupplied_ReturnsNotFound(t *tes
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestApiMachineTelemetryHandler_NoRangeSupplied_ReturnsLastDay(t *testing.T) {
+	ctx, _, s, router, w := setupForTest(t)
+
+	samples := []machine.TelemetrySample{
+		{MachineID: machineID, Timestamp: fakeTime.Add(-time.Hour), Battery: 90, Temperature: map[string]float64{"cpu": 30}},
+	}
+	storeMock := s.store.(*mocks.Store)
+	storeMock.On("ListTelemetry", testutils.AnyContext, machineID, fakeTime.Add(-defaultTelemetryRange), fakeTime).Return(samples, nil)
+	storeMock.On("ListTaskResults", testutils.AnyContext, machineID, fakeTime.Add(-defaultTelemetryRange), fakeTime).Return(nil, nil)
+
+	r := newAuthorizedRequest("GET", fmt.Sprintf("/json/v1/machine/telemetry/%s", machineID), nil)
+	r = r.WithContext(ctx)
+
+	// Make the request.
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var actual rpc.MachineTelemetryResponse
+	err := json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	assert.Equal(t, rpc.MachineTelemetryResponse{
+		MachineID:    machineID,
+		Samples:      samples,
+		TaskOutcomes: []rpc.TaskOutcome{},
+	}, actual)
+}
+
+func TestApiMachineTelemetryHandler_RangeSupplied_QueriesThatRange(t *testing.T) {
+	_, _, s, router, w := setupForTest(t)
+
+	begin := time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, time.August, 8, 0, 0, 0, 0, time.UTC)
+	storeMock := s.store.(*mocks.Store)
+	storeMock.On("ListTelemetry", testutils.AnyContext, machineID, begin, end).Return(nil, nil)
+	storeMock.On("ListTaskResults", testutils.AnyContext, machineID, begin, end).Return(nil, nil)
+
+	r := newAuthorizedRequest("GET", fmt.Sprintf("/json/v1/machine/telemetry/%s?begin=2021-08-01T00:00:00Z&end=2021-08-08T00:00:00Z", machineID), nil)
+
+	// Make the request.
+	router.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestApiMachineTelemetryHandler_BeginAfterEnd_ReturnsBadRequest(t *testing.T) {
+	_, _, _, router, w := setupForTest(t)
+
+	r := newAuthorizedRequest("GET", fmt.Sprintf("/json/v1/machine/telemetry/%s?begin=2021-08-08T00:00:00Z&end=2021-08-01T00:00:00Z", machineID), nil)
+
+	// Make the request.
+	router.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestApiMachineTrendsHandler_NoTrendsComputed_ReturnsEmptyList(t *testing.T) {
+	_, _, _, router, w := setupForTest(t)
+
+	r := newAuthorizedRequest("GET", "/json/v1/machine/trends", nil)
+
+	// Make the request.
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var actual rpc.MachineTrendsResponse
+	err := json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	assert.Empty(t, actual.Trends)
+	assert.NotNil(t, actual.Trends)
+}
+
+func TestApiMachineDriftHandler_OneMachineDrifted_ReturnsOnlyThatMachine(t *testing.T) {
+	_, desc, s, router, w := setupForTest(t)
+
+	drifted := desc.Copy()
+	drifted.Dimensions[machine.DimDeviceType] = []string{"sunfish"}
+	drifted.Dimensions[machine.DimPool] = []string{machine.PoolSkia}
+	matching := desc.Copy()
+	matching.Dimensions[machine.DimID] = []string{"skia-rpi2-rack4-shelf1-002"}
+	matching.Dimensions[machine.DimDeviceType] = []string{"sargo"}
+	storeMock := s.store.(*mocks.Store)
+	storeMock.On("List", testutils.AnyContext).Return([]machine.Description{matching, drifted}, nil)
+
+	r := newAuthorizedRequest("GET", "/json/v1/machine/drift", nil)
+
+	// Make the request.
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var actual rpc.ListDriftedMachinesResponse
+	err := json.Unmarshal(w.Body.Bytes(), &actual)
+	require.NoError(t, err)
+	assert.Equal(t, rpc.ListDriftedMachinesResponse{
+		{
+			MachineID: machineID,
+			Pool:      machine.PoolSkia,
+			Drift: []machine.DimensionDrift{
+				{
+					Dimension: machine.DimDeviceType,
+					Expected:  []string{"sargo"},
+					Actual:    []string{"sunfish"},
+				},
+			},
+		},
+	}, actual)
+}
+
 func TestApiPowerCycleListHandler_NoMachinesNeedPowerCycling_ReturnsEmptyList(t *testing.T) {
 	_, _, s, router, w := setupForTest(t)
 	storeMock := s.store.(*mocks.Store)
@@ -618,3 +755,63 @@ func TestMachineClearQuarantineHandler_MachineIDNotSupplied_ReturnsNotFound(t *t
 func TestClearQuarantined(t *testing.T) {
 	require.False(t, clearQuarantined(machine.Description{IsQuarantined: true}).IsQuarantined)
 }
+
+func TestMachineReserveHandler_MachineAvailable_ReturnsReservation(t *testing.T) {
+	ctx, desc, s, router, w := setupForTest(t)
+	storeMock := s.store.(*mocks.Store)
+	storeMock.On("List", testutils.AnyContext).Return([]machine.Description{desc}, nil)
+	storeMock.On("Update", testutils.AnyContext, machineID, mock.Anything).Run(func(args mock.Arguments) {
+		desc = args.Get(2).(machineStore.UpdateCallback)(desc)
+	}).Return(nil)
+	changeSinkMock := s.sserChangeSink.(*changeSinkMocks.Sink)
+	changeSinkMock.On("Send", testutils.AnyContext, machineID).Return(nil)
+	r := newAuthorizedRequest("POST", "/_/machine/reserve", strings.NewReader(`{"Dimensions": {"id": ["skia-rpi2-rack4-shelf1-001"]}, "Duration": "2h"}`)).WithContext(ctx)
+	r.Header.Add(authproxy.WebAuthHeaderName, testUser)
+
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp rpc.ReserveMachineResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, machineID, resp.MachineID)
+	assert.Equal(t, testUser, resp.Reservation.User)
+	assert.Equal(t, fakeTime.Add(2*time.Hour), resp.Reservation.Expires)
+	assert.Equal(t, sshUserIP, resp.Connection.SSHUserIP)
+	assert.Equal(t, testUser, desc.Reservation.User)
+}
+
+func TestMachineReserveHandler_NoMachineAvailable_ReturnsStatusConflict(t *testing.T) {
+	_, desc, s, router, w := setupForTest(t)
+	desc.IsQuarantined = true
+	storeMock := s.store.(*mocks.Store)
+	storeMock.On("List", testutils.AnyContext).Return([]machine.Description{desc}, nil)
+	r := newAuthorizedRequest("POST", "/_/machine/reserve", strings.NewReader(`{"Dimensions": {"id": ["skia-rpi2-rack4-shelf1-001"]}, "Duration": "2h"}`))
+	r.Header.Add(authproxy.WebAuthHeaderName, testUser)
+
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestMachineReserveHandler_InvalidDuration_ReturnsStatusBadRequest(t *testing.T) {
+	_, _, _, router, w := setupForTest(t)
+	r := newAuthorizedRequest("POST", "/_/machine/reserve", strings.NewReader(`{"Dimensions": {"id": ["skia-rpi2-rack4-shelf1-001"]}, "Duration": "forever"}`))
+	r.Header.Add(authproxy.WebAuthHeaderName, testUser)
+
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestMachineReleaseHandler_Success(t *testing.T) {
+	_, _, s, router, w := setupForTest(t)
+	storeMock := s.store.(*mocks.Store)
+	storeMock.On("Update", testutils.AnyContext, machineID, mock.Anything).Return(nil)
+	changeSinkMock := s.sserChangeSink.(*changeSinkMocks.Sink)
+	changeSinkMock.On("Send", testutils.AnyContext, machineID).Return(nil)
+	r := newAuthorizedRequest("POST", fmt.Sprintf("/_/machine/release/%s", machineID), nil)
+
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
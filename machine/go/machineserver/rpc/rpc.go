@@ -1,7 +1,10 @@
 package rpc
 
 import (
+	"time"
+
 	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/task_scheduler/go/types"
 )
 
 // URL paths.
@@ -9,14 +12,22 @@ const (
 	APIPrefix = "/json/v1"
 
 	MachineDescriptionRelativeURL           = "/machine/description/{id:.+}"
+	MachineDriftRelativeURL                 = "/machine/drift"
 	MachineEventRelativeURL                 = "/machine/event/"
+	MachineListRelativeURL                  = "/machine/list"
+	MachineTelemetryRelativeURL             = "/machine/telemetry/{id:.+}"
+	MachineTrendsRelativeURL                = "/machine/trends"
 	PowerCycleCompleteRelativeURL           = "/powercycle/complete/{id:.+}"
 	PowerCycleListRelativeURL               = "/powercycle/list"
 	PowerCycleStateUpdateRelativeURL        = "/powercycle/state/update"
 	SSEMachineDescriptionUpdatedRelativeURL = "/machine/sse/description/updated"
 
 	MachineDescriptionURL           = APIPrefix + MachineDescriptionRelativeURL
+	MachineDriftURL                 = APIPrefix + MachineDriftRelativeURL
 	MachineEventURL                 = APIPrefix + MachineEventRelativeURL
+	MachineListURL                  = APIPrefix + MachineListRelativeURL
+	MachineTelemetryURL             = APIPrefix + MachineTelemetryRelativeURL
+	MachineTrendsURL                = APIPrefix + MachineTrendsRelativeURL
 	PowerCycleCompleteURL           = APIPrefix + PowerCycleCompleteRelativeURL
 	PowerCycleListURL               = APIPrefix + PowerCycleListRelativeURL
 	PowerCycleStateUpdateURL        = APIPrefix + PowerCycleStateUpdateRelativeURL
@@ -37,6 +48,116 @@ type SetAttachedDevice struct {
 	AttachedDevice machine.AttachedDevice
 }
 
+// ReserveMachineRequest asks to reserve any available machine that has all of
+// the given Dimensions.
+type ReserveMachineRequest struct {
+	Dimensions machine.SwarmingDimensions
+
+	// Duration of the reservation in a format understood by
+	// time.ParseDuration, e.g. "4h".
+	Duration string
+
+	Reason string
+
+	// PowerCycleOnRelease power-cycles the machine when the reservation ends.
+	PowerCycleOnRelease bool
+	// User will be added by the server
+}
+
+// ConnectionInfo describes how to connect to a reserved machine.
+type ConnectionInfo struct {
+	// Host is the hostname of the test machine, which can be reached over SSH.
+	// Attached Android and iOS devices are reached with adb and idevice*
+	// commands run on Host.
+	Host string
+
+	// AttachedDevice is the kind of device attached to Host, if any.
+	AttachedDevice machine.AttachedDevice
+
+	// SSHUserIP is the user and address of an attached device that is reached
+	// over SSH, e.g. a ChromeOS device.
+	SSHUserIP string
+}
+
+// ReserveMachineResponse is the response to a ReserveMachineRequest.
+type ReserveMachineResponse struct {
+	MachineID   string
+	Reservation machine.Reservation
+	Connection  ConnectionInfo
+}
+
+// TaskOutcome is the outcome of a single task that ran on a machine.
+type TaskOutcome struct {
+	ID       string
+	Finished time.Time
+	Status   types.TaskStatus
+}
+
+// MachineTelemetryResponse is the telemetry recorded for a single machine
+// over the requested time range.
+type MachineTelemetryResponse struct {
+	MachineID    string
+	Samples      []machine.TelemetrySample
+	TaskOutcomes []TaskOutcome
+}
+
+// TrendMetric is the kind of measurement a MachineTrend is about.
+type TrendMetric string
+
+const (
+	// TrendBattery is the battery level of the attached device.
+	TrendBattery TrendMetric = "battery"
+
+	// TrendThermal is the highest temperature reported by the machine.
+	TrendThermal TrendMetric = "thermal"
+
+	// TrendTaskFailures is the fraction of tasks that failed or had a mishap.
+	TrendTaskFailures TrendMetric = "task_failures"
+)
+
+// AllTrendMetrics is a slice of all TrendMetrics. Used to generate TS.
+var AllTrendMetrics = []TrendMetric{
+	TrendBattery,
+	TrendThermal,
+	TrendTaskFailures,
+}
+
+// MachineTrend flags a machine whose metric is getting worse faster than the
+// rest of its pool.
+type MachineTrend struct {
+	MachineID string
+	Pool      string
+	Metric    TrendMetric
+
+	// Change is how much the metric moved between the earlier and the later
+	// half of the trend window, e.g. -12 means the average battery level
+	// dropped by 12 percentage points.
+	Change float64
+
+	// PoolMedianChange is the median Change across all machines in the pool.
+	PoolMedianChange float64
+}
+
+// MachineTrendsResponse is the list of machines that are trending worse than
+// their pool peers.
+type MachineTrendsResponse struct {
+	// Computed is when the trends were last computed.
+	Computed time.Time
+	Trends   []MachineTrend
+}
+
+// DriftedMachine is a machine whose dimensions don't match the expected
+// inventory of its pool.
+type DriftedMachine struct {
+	MachineID string
+	Pool      string
+	Drift     []machine.DimensionDrift
+}
+
+// ListDriftedMachinesResponse is the list of all machines whose dimensions
+// have drifted.
+type ListDriftedMachinesResponse []DriftedMachine
+
 type PowerCycleStateForMachine struct {
 	MachineID       string
 	PowerCycleState machine.PowerCycleState
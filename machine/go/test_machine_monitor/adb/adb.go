@@ -18,13 +18,26 @@ const (
 )
 
 // AdbImpl handles talking to the adb process.
-type AdbImpl struct{}
+type AdbImpl struct {
+	// serial is the serial number of the device to talk to. If empty then adb
+	// talks to the only attached device.
+	serial string
+}
 
 // New returns a new Adb.
 func New() AdbImpl {
 	return AdbImpl{}
 }
 
+// NewWithSerial returns a new Adb that talks to the device with the given
+// serial number, for example "emulator-5554", which is needed when more than
+// one device is attached.
+func NewWithSerial(serial string) AdbImpl {
+	return AdbImpl{
+		serial: serial,
+	}
+}
+
 // Adb is the interface that AdbImpl provides.
 type Adb interface {
 	// EnsureOnline returns nil if the Android device is online and ready to
@@ -50,6 +63,9 @@ type Adb interface {
 func (a AdbImpl) adbCommand(ctx context.Context, args ...string) (string, string, error) {
 	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
 	defer cancel()
+	if a.serial != "" {
+		args = append([]string{"-s", a.serial}, args...)
+	}
 	cmd := executil.CommandContext(ctx, "adb", args...)
 
 	b, err := cmd.Output()
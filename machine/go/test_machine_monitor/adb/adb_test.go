@@ -114,6 +114,16 @@ func TestRawProperties_HappyPath(t *testing.T) {
 	assert.Equal(t, adbShellGetPropSuccess, got)
 }
 
+func TestRawProperties_WithSerial_SerialIsPassedToAdb(t *testing.T) {
+
+	ctx := executil.FakeTestsContext("Test_FakeExe_AdbShellGetPropWithSerial_Success")
+
+	a := NewWithSerial("emulator-5554")
+	got, err := a.RawProperties(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, adbShellGetPropSuccess, got)
+}
+
 func TestRawProperties_ErrFromAdbNonZeroExitCode(t *testing.T) {
 
 	ctx := executil.FakeTestsContext("Test_FakeExe_AdbShellGetProp_NonZeroExitCode")
@@ -148,6 +158,21 @@ func Test_FakeExe_AdbShellGetProp_Success(t *testing.T) {
 	os.Exit(0)
 }
 
+func Test_FakeExe_AdbShellGetPropWithSerial_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	// Check the input arguments to make sure they were as expected.
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"adb", "-s", "emulator-5554", "shell", "getprop"}, args)
+
+	fmt.Print(adbShellGetPropSuccess)
+
+	// Force exit so we don't get PASS in the output.
+	os.Exit(0)
+}
+
 func Test_FakeExe_AdbShellGetProp_EmptyOutput(t *testing.T) {
 	if executil.IsCallingFakeCommand() {
 		// Force exit so we don't get PASS in the output.
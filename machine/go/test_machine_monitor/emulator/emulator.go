@@ -0,0 +1,356 @@
+// Package emulator manages a headless Android emulator (AVD) on a Linux host,
+// so that a test machine can run tasks against an emulator instead of a
+// physical device.
+//
+// A host can run a pool of emulators by running one test_machine_monitor per
+// emulator, each with a different slot. The slot determines the name of the
+// AVD and the console port the emulator listens on, so instances never step on
+// each other.
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.skia.org/infra/go/executil"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/machine/go/test_machine_monitor/adb"
+)
+
+const (
+	// MaxSlots is the number of emulators that can run on a single host. The
+	// emulator console ports are limited to the even numbers in [5554, 5682].
+	MaxSlots = 64
+
+	// basePort is the console port of the emulator in slot 0. Each emulator
+	// uses two consecutive ports, the console port and the adb port.
+	basePort = 5554
+
+	// baseMonitorPort and baseMonitorPromPort are the HTTP and metrics ports
+	// of the test_machine_monitor which runs the emulator in slot 0. They are
+	// one above the test_machine_monitor defaults, so that a
+	// test_machine_monitor for the host itself can run alongside the pool.
+	baseMonitorPort     = 11001
+	baseMonitorPromPort = 20001
+
+	avdNamePrefix = "tmm-avd-"
+
+	createTimeout    = 2 * time.Minute
+	bootTimeout      = 5 * time.Minute
+	bootPollInterval = 5 * time.Second
+	killTimeout      = 30 * time.Second
+	commandTimeout   = 5 * time.Second
+
+	// bootCompletedProperty appears in the output of `adb shell getprop` once
+	// Android has finished booting.
+	bootCompletedProperty = "[sys.boot_completed]: [1]"
+
+	// emulatorDumpSysBattery is returned in place of `adb shell dumpsys
+	// battery` if the emulator doesn't report a battery, which always looks
+	// fully charged and at room temperature, so that emulators never get
+	// quarantined for a low battery.
+	emulatorDumpSysBattery = `Current Battery Service state:
+  AC powered: true
+  USB powered: false
+  Wireless powered: false
+  status: 2
+  health: 2
+  present: true
+  level: 100
+  scale: 100
+  temperature: 250
+  technology: Li-ion
+`
+)
+
+// MachineID returns the machine ID of the emulator in the given slot on the
+// host with the given ID. Each emulator in a pool is a separate machine.
+func MachineID(hostID string, slot int) string {
+	return fmt.Sprintf("%s-emulator%d", hostID, slot)
+}
+
+// MonitorPorts returns the HTTP and metrics service addresses of the
+// test_machine_monitor which runs the emulator in the given slot, so that each
+// test_machine_monitor in a pool listens on different ports.
+func MonitorPorts(slot int) (string, string) {
+	return fmt.Sprintf(":%d", baseMonitorPort+slot), fmt.Sprintf(":%d", baseMonitorPromPort+slot)
+}
+
+// Config describes the emulator to run.
+type Config struct {
+	// Slot is the index of this emulator among all the emulators running on
+	// the host, in [0, MaxSlots).
+	Slot int
+
+	// SystemImage is the sdkmanager package of the system image to create
+	// the AVD from, e.g. "system-images;android-33;google_apis;x86_64".
+	SystemImage string
+
+	// Device is the avdmanager device profile to use, e.g. "pixel_5". If
+	// empty then the avdmanager default is used.
+	Device string
+}
+
+// Emulator manages a single emulator instance and talks to it via adb.
+//
+// Emulator implements adb.Adb, booting the emulator if needed in
+// EnsureOnline, and returning values that make sense for an emulator for
+// anything the emulator can't report.
+type Emulator struct {
+	cfg    Config
+	name   string
+	port   int
+	serial string
+	adb    adb.AdbImpl
+
+	// mutex protects the fields below, and serializes starting and stopping
+	// the emulator.
+	mutex sync.Mutex
+
+	// created is true once the AVD has been created by this process.
+	created bool
+
+	// cmd is the running emulator process, or nil if one hasn't been started.
+	cmd *exec.Cmd
+
+	// exited is closed when cmd exits.
+	exited chan struct{}
+}
+
+// New returns a new *Emulator. The AVD isn't created and the emulator isn't
+// booted until they are first needed.
+func New(cfg Config) (*Emulator, error) {
+	if runtime.GOOS != "linux" {
+		return nil, skerr.Fmt("emulators are only supported on Linux, not %q", runtime.GOOS)
+	}
+	if cfg.Slot < 0 || cfg.Slot >= MaxSlots {
+		return nil, skerr.Fmt("slot %d is outside of [0, %d)", cfg.Slot, MaxSlots)
+	}
+	if cfg.SystemImage == "" {
+		return nil, skerr.Fmt("a system image must be given")
+	}
+	port := basePort + 2*cfg.Slot
+	serial := fmt.Sprintf("emulator-%d", port)
+	return &Emulator{
+		cfg:    cfg,
+		name:   fmt.Sprintf("%s%d", avdNamePrefix, cfg.Slot),
+		port:   port,
+		serial: serial,
+		adb:    adb.NewWithSerial(serial),
+	}, nil
+}
+
+// Name returns the name of the AVD.
+func (e *Emulator) Name() string {
+	return e.name
+}
+
+// Serial returns the adb serial number of the emulator.
+func (e *Emulator) Serial() string {
+	return e.serial
+}
+
+// EnsureRunning creates the AVD and boots the emulator if it isn't already
+// running.
+func (e *Emulator) EnsureRunning(ctx context.Context) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.isRunning() {
+		return nil
+	}
+	if !e.created {
+		if err := e.create(ctx); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return skerr.Wrap(e.boot(ctx, false))
+}
+
+// Wipe stops the emulator and boots it again with all user data erased, so
+// that nothing left behind by one task can affect the next one.
+func (e *Emulator) Wipe(ctx context.Context) error {
+	sklog.Infof("Wiping emulator %s.", e.name)
+	return e.restart(ctx, true)
+}
+
+// restart stops the emulator if it is running and boots it again, optionally
+// erasing all user data.
+func (e *Emulator) restart(ctx context.Context, wipe bool) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if err := e.kill(ctx); err != nil {
+		return skerr.Wrap(err)
+	}
+	if !e.created {
+		if err := e.create(ctx); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return skerr.Wrap(e.boot(ctx, wipe))
+}
+
+// isRunning returns true if the emulator process is running. The caller must
+// hold the mutex.
+func (e *Emulator) isRunning() bool {
+	if e.cmd == nil {
+		return false
+	}
+	select {
+	case <-e.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// create the AVD, replacing any AVD of the same name left behind by a
+// previous run. The caller must hold the mutex.
+func (e *Emulator) create(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+	args := []string{"create", "avd", "--name", e.name, "--package", e.cfg.SystemImage, "--force"}
+	if e.cfg.Device != "" {
+		args = append(args, "--device", e.cfg.Device)
+	}
+	cmd := executil.CommandContext(ctx, "avdmanager", args...)
+	// Decline creating a custom hardware profile when prompted.
+	cmd.Stdin = strings.NewReader("no\n")
+	if b, err := cmd.CombinedOutput(); err != nil {
+		return skerr.Wrapf(err, "creating AVD %s: %s", e.name, b)
+	}
+	e.created = true
+	return nil
+}
+
+// boot starts the emulator and waits for Android to finish booting. The caller
+// must hold the mutex.
+func (e *Emulator) boot(ctx context.Context, wipe bool) error {
+	args := []string{
+		"-avd", e.name,
+		"-port", strconv.Itoa(e.port),
+		"-no-window",
+		"-no-audio",
+		"-no-boot-anim",
+		"-no-snapshot",
+		"-gpu", "swiftshader_indirect",
+	}
+	if wipe {
+		args = append(args, "-wipe-data")
+	}
+	// The emulator needs to outlive the request that caused it to boot.
+	cmd := executil.CommandContext(context.WithoutCancel(ctx), "emulator", args...)
+	if err := cmd.Start(); err != nil {
+		return skerr.Wrapf(err, "starting emulator %s", e.name)
+	}
+	exited := make(chan struct{})
+	go func() {
+		err := cmd.Wait()
+		sklog.Infof("Emulator %s exited: %v", e.name, err)
+		close(exited)
+	}()
+	e.cmd = cmd
+	e.exited = exited
+
+	return skerr.Wrap(e.waitForBoot(ctx, exited))
+}
+
+// waitForBoot waits until Android has finished booting on the emulator.
+func (e *Emulator) waitForBoot(ctx context.Context, exited <-chan struct{}) error {
+	ctx, cancel := context.WithTimeout(ctx, bootTimeout)
+	defer cancel()
+	for {
+		props, err := e.adb.RawProperties(ctx)
+		if err == nil && strings.Contains(props, bootCompletedProperty) {
+			sklog.Infof("Emulator %s has booted.", e.name)
+			return nil
+		}
+		select {
+		case <-exited:
+			return skerr.Fmt("emulator %s exited before it finished booting", e.name)
+		case <-ctx.Done():
+			return skerr.Wrapf(ctx.Err(), "waiting for emulator %s to boot", e.name)
+		case <-time.After(bootPollInterval):
+		}
+	}
+}
+
+// kill the emulator if it is running, and wait for it to exit. The caller
+// must hold the mutex.
+func (e *Emulator) kill(ctx context.Context) error {
+	if !e.isRunning() {
+		return nil
+	}
+	cmdCtx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+	if b, err := executil.CommandContext(cmdCtx, "adb", "-s", e.serial, "emu", "kill").CombinedOutput(); err != nil {
+		sklog.Warningf("Failed to ask emulator %s to exit, killing it: %s: %s", e.name, err, b)
+		if err := e.cmd.Process.Kill(); err != nil {
+			return skerr.Wrapf(err, "killing emulator %s", e.name)
+		}
+	}
+	select {
+	case <-e.exited:
+		return nil
+	case <-time.After(killTimeout):
+	}
+	sklog.Warningf("Emulator %s didn't exit, killing it.", e.name)
+	if err := e.cmd.Process.Kill(); err != nil {
+		return skerr.Wrapf(err, "killing emulator %s", e.name)
+	}
+	<-e.exited
+	return nil
+}
+
+// EnsureOnline implements the adb.Adb interface. It boots the emulator if it
+// isn't running.
+func (e *Emulator) EnsureOnline(ctx context.Context) error {
+	if err := e.EnsureRunning(ctx); err != nil {
+		return skerr.Wrap(err)
+	}
+	return e.adb.EnsureOnline(ctx)
+}
+
+// RawProperties implements the adb.Adb interface.
+func (e *Emulator) RawProperties(ctx context.Context) (string, error) {
+	return e.adb.RawProperties(ctx)
+}
+
+// RawDumpSys implements the adb.Adb interface.
+//
+// Emulators may not report a battery, in which case a fully charged battery is
+// reported, and usually have no thermal HAL, in which case no temperatures are
+// reported, rather than returning an error.
+func (e *Emulator) RawDumpSys(ctx context.Context, service string) (string, error) {
+	stdout, err := e.adb.RawDumpSys(ctx, service)
+	switch service {
+	case "battery":
+		if err != nil || !strings.Contains(stdout, "level:") {
+			return emulatorDumpSysBattery, nil
+		}
+	case "thermalservice":
+		if err != nil {
+			return "", nil
+		}
+	}
+	return stdout, err
+}
+
+// Reboot implements the adb.Adb interface by restarting the emulator.
+func (e *Emulator) Reboot(ctx context.Context) error {
+	return e.restart(ctx, false)
+}
+
+// Uptime implements the adb.Adb interface.
+func (e *Emulator) Uptime(ctx context.Context) (time.Duration, error) {
+	return e.adb.Uptime(ctx)
+}
+
+// Assert that Emulator implements the adb.Adb interface.
+var _ adb.Adb = (*Emulator)(nil)
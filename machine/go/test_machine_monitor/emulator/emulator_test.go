@@ -0,0 +1,231 @@
+package emulator
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/executil"
+)
+
+const (
+	systemImage = "system-images;android-33;google_apis;x86_64"
+
+	adbShellGetPropBooted = `[ro.kernel.qemu]: [1]
+[ro.product.device]: [emu64x]
+[sys.boot_completed]: [1]
+`
+
+	nonZeroExitCode = 123
+)
+
+func newForTest(t *testing.T) *Emulator {
+	e, err := New(Config{
+		Slot:        1,
+		SystemImage: systemImage,
+		Device:      "pixel_5",
+	})
+	require.NoError(t, err)
+	return e
+}
+
+// waitForExit waits for the faked emulator process to exit, so that tests
+// don't race with it.
+func waitForExit(e *Emulator) {
+	e.mutex.Lock()
+	exited := e.exited
+	e.mutex.Unlock()
+	<-exited
+}
+
+func TestNew_SlotOutOfRange_ReturnsError(t *testing.T) {
+	_, err := New(Config{Slot: MaxSlots, SystemImage: systemImage})
+	require.Error(t, err)
+}
+
+func TestNew_NoSystemImage_ReturnsError(t *testing.T) {
+	_, err := New(Config{Slot: 0})
+	require.Error(t, err)
+}
+
+func TestNew_SlotDeterminesNameAndSerial(t *testing.T) {
+	e := newForTest(t)
+	assert.Equal(t, "tmm-avd-1", e.Name())
+	assert.Equal(t, "emulator-5556", e.Serial())
+}
+
+func TestMachineID_DependsOnHostAndSlot(t *testing.T) {
+	assert.Equal(t, "skia-rpi2-0001-emulator0", MachineID("skia-rpi2-0001", 0))
+	assert.Equal(t, "skia-rpi2-0001-emulator3", MachineID("skia-rpi2-0001", 3))
+}
+
+func TestMonitorPorts_DependOnSlot(t *testing.T) {
+	port, promPort := MonitorPorts(0)
+	assert.Equal(t, ":11001", port)
+	assert.Equal(t, ":20001", promPort)
+	port, promPort = MonitorPorts(3)
+	assert.Equal(t, ":11004", port)
+	assert.Equal(t, ":20004", promPort)
+}
+
+func TestEnsureOnline_NotRunning_CreatesAndBootsEmulator(t *testing.T) {
+	ctx := executil.FakeTestsContext(
+		"Test_FakeExe_AvdManagerCreate_Success",
+		"Test_FakeExe_Emulator_Success",
+		"Test_FakeExe_AdbShellGetProp_Booted",
+		"Test_FakeExe_AdbGetState_Success",
+	)
+	e := newForTest(t)
+
+	require.NoError(t, e.EnsureOnline(ctx))
+	require.Equal(t, 4, executil.FakeCommandsReturned(ctx))
+	require.True(t, e.created)
+}
+
+func TestEnsureOnline_CreateFails_ReturnsError(t *testing.T) {
+	ctx := executil.FakeTestsContext("Test_FakeExe_ExitCodeNonZero")
+	e := newForTest(t)
+
+	require.Error(t, e.EnsureOnline(ctx))
+	require.False(t, e.created)
+}
+
+func TestWipe_EmulatorHasExited_AVDIsNotRecreatedAndEmulatorBootsWithWipedData(t *testing.T) {
+	ctx := executil.FakeTestsContext(
+		"Test_FakeExe_AvdManagerCreate_Success",
+		"Test_FakeExe_Emulator_Success",
+		"Test_FakeExe_AdbShellGetProp_Booted",
+		"Test_FakeExe_EmulatorWipeData_Success",
+		"Test_FakeExe_AdbShellGetProp_Booted",
+	)
+	e := newForTest(t)
+	require.NoError(t, e.EnsureRunning(ctx))
+	waitForExit(e)
+
+	require.NoError(t, e.Wipe(ctx))
+	require.Equal(t, 5, executil.FakeCommandsReturned(ctx))
+}
+
+func TestEnsureRunning_EmulatorExitsBeforeBooting_ReturnsError(t *testing.T) {
+	ctx := executil.FakeTestsContext(
+		"Test_FakeExe_AvdManagerCreate_Success",
+		"Test_FakeExe_ExitCodeNonZero",
+		"Test_FakeExe_ExitCodeNonZero",
+	)
+	e := newForTest(t)
+
+	err := e.EnsureRunning(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exited before it finished booting")
+}
+
+func TestRawDumpSys_BatteryFails_ReturnsFullBattery(t *testing.T) {
+	ctx := executil.FakeTestsContext("Test_FakeExe_ExitCodeNonZero")
+	e := newForTest(t)
+
+	got, err := e.RawDumpSys(ctx, "battery")
+	require.NoError(t, err)
+	assert.Equal(t, emulatorDumpSysBattery, got)
+}
+
+func TestRawDumpSys_ThermalServiceFails_ReturnsEmpty(t *testing.T) {
+	ctx := executil.FakeTestsContext("Test_FakeExe_ExitCodeNonZero")
+	e := newForTest(t)
+
+	got, err := e.RawDumpSys(ctx, "thermalservice")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestRawDumpSys_OtherServiceFails_ReturnsError(t *testing.T) {
+	ctx := executil.FakeTestsContext("Test_FakeExe_ExitCodeNonZero")
+	e := newForTest(t)
+
+	_, err := e.RawDumpSys(ctx, "meminfo")
+	require.Error(t, err)
+}
+
+func Test_FakeExe_AvdManagerCreate_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	// Check the input arguments to make sure they were as expected.
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"avdmanager", "create", "avd", "--name", "tmm-avd-1", "--package", systemImage, "--force", "--device", "pixel_5"}, args)
+
+	// Confirm the custom hardware profile prompt is declined.
+	b, err := io.ReadAll(os.Stdin)
+	require.NoError(t, err)
+	require.Equal(t, "no\n", string(b))
+
+	// Force exit so we don't get PASS in the output.
+	os.Exit(0)
+}
+
+func Test_FakeExe_Emulator_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	// Check the input arguments to make sure they were as expected.
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"emulator", "-avd", "tmm-avd-1", "-port", "5556", "-no-window", "-no-audio", "-no-boot-anim", "-no-snapshot", "-gpu", "swiftshader_indirect"}, args)
+
+	// Force exit so we don't get PASS in the output.
+	os.Exit(0)
+}
+
+func Test_FakeExe_EmulatorWipeData_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	// Check the input arguments to make sure they were as expected.
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"emulator", "-avd", "tmm-avd-1", "-port", "5556", "-no-window", "-no-audio", "-no-boot-anim", "-no-snapshot", "-gpu", "swiftshader_indirect", "-wipe-data"}, args)
+
+	// Force exit so we don't get PASS in the output.
+	os.Exit(0)
+}
+
+func Test_FakeExe_AdbShellGetProp_Booted(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	// Check the input arguments to make sure they were as expected.
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"adb", "-s", "emulator-5556", "shell", "getprop"}, args)
+
+	fmt.Print(adbShellGetPropBooted)
+
+	// Force exit so we don't get PASS in the output.
+	os.Exit(0)
+}
+
+func Test_FakeExe_AdbGetState_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	// Check the input arguments to make sure they were as expected.
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"adb", "-s", "emulator-5556", "get-state"}, args)
+	fmt.Print("device")
+
+	// Force exit so we don't get PASS in the output.
+	os.Exit(0)
+}
+
+func Test_FakeExe_ExitCodeNonZero(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "error: no devices/emulators found")
+	os.Exit(nonZeroExitCode)
+}
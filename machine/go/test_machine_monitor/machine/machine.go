@@ -32,6 +32,7 @@ import (
 	"go.skia.org/infra/machine/go/machineserver/config"
 	"go.skia.org/infra/machine/go/machineserver/rpc"
 	"go.skia.org/infra/machine/go/test_machine_monitor/adb"
+	"go.skia.org/infra/machine/go/test_machine_monitor/emulator"
 	"go.skia.org/infra/machine/go/test_machine_monitor/ios"
 	"go.skia.org/infra/machine/go/test_machine_monitor/ssh"
 	"go.skia.org/infra/machine/go/test_machine_monitor/standalone"
@@ -76,6 +77,10 @@ type Machine struct {
 	// adb makes calls to the adb server.
 	adb adb.Adb
 
+	// emulator is the Android emulator this machine runs, or nil if it
+	// doesn't run one.
+	emulator *emulator.Emulator
+
 	// ios is an interface through which we talk to iOS devices.
 	ios ios.IOS
 
@@ -113,6 +118,10 @@ type Machine struct {
 	// to tell swarming, what our current mode is, etc.
 	description machine.Description
 
+	// lastRecoveryReboot is the StepStarted time of the most recent
+	// machine.RecoveryStepRebootDevice we acted on.
+	lastRecoveryReboot time.Time
+
 	// sshMachineLocation is the name and path of the file to write the JSON data that specifies
 	// to recipes how to communicate with the device under test.
 	sshMachineLocation string
@@ -121,8 +130,9 @@ type Machine struct {
 	triggerInterrogationCh <-chan bool
 }
 
-// New return an instance of *Machine.
-func New(ctx context.Context, local bool, instanceConfig config.InstanceConfig, version string, startSwarming bool, machineServerHost string, triggerInterrogationCh <-chan bool) (*Machine, error) {
+// New return an instance of *Machine. The emulator may be nil if this machine
+// doesn't run an Android emulator.
+func New(ctx context.Context, local bool, instanceConfig config.InstanceConfig, version string, startSwarming bool, machineServerHost string, emu *emulator.Emulator, triggerInterrogationCh <-chan bool) (*Machine, error) {
 
 	hostname, err := os.Hostname()
 	if err != nil {
@@ -170,6 +180,7 @@ func New(ctx context.Context, local bool, instanceConfig config.InstanceConfig,
 		httpSink:                       httpSink,
 		sseChangeSource:                sseChangeSource,
 		adb:                            adb.New(),
+		emulator:                       emu,
 		ios:                            ios.New(),
 		ssh:                            ssh.ExeImpl{},
 		sshMachineLocation:             defaultSSHMachineFileLocation,
@@ -221,6 +232,12 @@ func (m *Machine) interrogate(ctx context.Context) (machine.Event, error) {
 			sklog.Infof("Successful communication with adb device: %#v", ae)
 			ret.Android = ae
 		}
+	case machine.AttachedDeviceEmulator:
+		var ae machine.Android
+		if ae, err = m.tryInterrogatingAndroidEmulator(ctx); err == nil {
+			sklog.Infof("Successful communication with Android emulator: %#v", ae)
+			ret.Android = ae
+		}
 	case machine.AttachedDeviceIOS:
 		var ie machine.IOS
 		if ie, err = m.tryInterrogatingIOSDevice(ctx); err == nil {
@@ -337,9 +354,31 @@ func (m *Machine) retrieveDescription(ctx context.Context) error {
 	}
 	m.UpdateDescription(desc)
 	m.descriptionWatchArrivalCounter.Inc(1)
+	if m.recoveryRebootRequested(desc) {
+		sklog.Infof("Rebooting device as requested by the recovery workflow.")
+		if err := m.RebootDevice(ctx); err != nil {
+			sklog.Warningf("Failed to reboot device for recovery: %s", err)
+		}
+	}
 	return nil
 }
 
+// recoveryRebootRequested returns true if machineserver's recovery workflow
+// has asked for the attached device to be rebooted and we haven't already done
+// so. Reboots are postponed while a task is running.
+func (m *Machine) recoveryRebootRequested(desc machine.Description) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if desc.Recovery.Step != machine.RecoveryStepRebootDevice || !desc.Recovery.StepStarted.After(m.lastRecoveryReboot) {
+		return false
+	}
+	if m.runningTask {
+		return false
+	}
+	m.lastRecoveryReboot = desc.Recovery.StepStarted
+	return true
+}
+
 // startDescriptionWatch starts a loop that continually looks for updates to the
 // machine Description. This function does not return unless the context is
 // cancelled.
@@ -396,14 +435,20 @@ func (m *Machine) IsRunningSwarmingTask() bool {
 }
 
 // RebootDevice reboots the attached device.
+//
+// Android emulators are wiped instead, since this is called after every task,
+// so that each task starts with a freshly booted emulator.
 func (m *Machine) RebootDevice(ctx context.Context) error {
 	m.mutex.Lock()
+	shouldWipeEmulator := m.description.AttachedDevice == machine.AttachedDeviceEmulator && m.emulator != nil
 	shouldRebootAndroid := len(m.description.Dimensions[machine.DimAndroidDevices]) > 0
 	shouldRebootIOS := util.In("iOS", m.description.Dimensions[machine.DimOS])
 	sshUserIP := m.description.SSHUserIP
 	m.mutex.Unlock()
 
-	if shouldRebootAndroid {
+	if shouldWipeEmulator {
+		return m.emulator.Wipe(ctx)
+	} else if shouldRebootAndroid {
 		return m.adb.Reboot(ctx)
 	} else if shouldRebootIOS {
 		return m.ios.Reboot(ctx)
@@ -423,31 +468,51 @@ func (m *Machine) tryInterrogatingAndroidDevice(ctx context.Context) (machine.An
 		"type":    "android",
 	}).Inc(1)
 	sklog.Info("tryInterrogatingAndroidDevice")
+	return interrogateAndroid(ctx, m.adb)
+}
+
+// tryInterrogatingAndroidEmulator is tryInterrogatingAndroidDevice for the
+// Android emulator this machine runs, which is booted if it isn't running.
+func (m *Machine) tryInterrogatingAndroidEmulator(ctx context.Context) (machine.Android, error) {
+	metrics2.GetCounter("test_machine_monitor_interrogate_device_type", map[string]string{
+		"machine": m.MachineID,
+		"type":    "emulator",
+	}).Inc(1)
+	sklog.Info("tryInterrogatingAndroidEmulator")
+	if m.emulator == nil {
+		return machine.Android{}, skerr.Fmt("No Android emulator is configured, see the --emulator_system_image flag.")
+	}
+	return interrogateAndroid(ctx, m.emulator)
+}
+
+// interrogateAndroid gathers the information about an Android device, or
+// emulator, that is reachable via the given adb.Adb.
+func interrogateAndroid(ctx context.Context, a adb.Adb) (machine.Android, error) {
 	var ret machine.Android
 
-	if err := m.adb.EnsureOnline(ctx); err != nil {
+	if err := a.EnsureOnline(ctx); err != nil {
 		sklog.Warningf("No Android device is available: %s", err)
 		return ret, skerr.Wrapf(err, "No Android device is available")
 	}
-	if uptime, err := m.adb.Uptime(ctx); err != nil {
+	if uptime, err := a.Uptime(ctx); err != nil {
 		return ret, skerr.Wrapf(err, "Failed to read uptime - assuming there is no Android device attached.")
 	} else {
 		ret.Uptime = uptime
 	}
 
-	props, err := m.adb.RawProperties(ctx)
+	props, err := a.RawProperties(ctx)
 	if err != nil {
 		return ret, skerr.Wrapf(err, "Failed to read android properties.")
 	}
 	ret.GetProp = props
 
-	if battery, err := m.adb.RawDumpSys(ctx, "battery"); err == nil {
+	if battery, err := a.RawDumpSys(ctx, "battery"); err == nil {
 		ret.DumpsysBattery = battery
 	} else {
 		sklog.Warningf("Failed to read android battery status: %s", err)
 	}
 
-	if thermal, err := m.adb.RawDumpSys(ctx, "thermalservice"); err == nil {
+	if thermal, err := a.RawDumpSys(ctx, "thermalservice"); err == nil {
 		ret.DumpsysThermalService = thermal
 	} else {
 		sklog.Warningf("Failed to read android thermal status.", err)
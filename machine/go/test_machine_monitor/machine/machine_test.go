@@ -23,8 +23,10 @@ import (
 	"go.skia.org/infra/machine/go/machine"
 	"go.skia.org/infra/machine/go/machine/change/source/mocks"
 	sinkMocks "go.skia.org/infra/machine/go/machine/event/sink/mocks"
+	"go.skia.org/infra/machine/go/machine/machinetest"
 	"go.skia.org/infra/machine/go/machineserver/rpc"
 	"go.skia.org/infra/machine/go/test_machine_monitor/adb"
+	"go.skia.org/infra/machine/go/test_machine_monitor/emulator"
 	"go.skia.org/infra/machine/go/test_machine_monitor/ios"
 	"go.skia.org/infra/machine/go/test_machine_monitor/ssh"
 )
@@ -264,6 +266,22 @@ func TestInterrogate_AndroidDeviceAttached_Success(t *testing.T) {
 	}, actual)
 }
 
+func TestInterrogate_EmulatorAttachedButNotConfigured_ReturnsError(t *testing.T) {
+	ctx := executil.FakeTestsContext() // Any exe call will panic
+
+	m := &Machine{
+		adb:              adb.New(),
+		MachineID:        "some-machine",
+		interrogateTimer: noop.Float64SummaryMetric{},
+		description: machine.Description{
+			AttachedDevice: machine.AttachedDeviceEmulator,
+		},
+	}
+	actual, err := m.interrogate(ctx)
+	require.Error(t, err)
+	assert.False(t, actual.Android.IsPopulated())
+}
+
 func goodIOSInterrogationResult(timePlaceholder time.Time) (*Machine, machine.Event) {
 	m := &Machine{
 		adb:              adb.New(),
@@ -438,6 +456,31 @@ func TestRebootDevice_AndroidDeviceAttached_ErrOnNonZeroExitCode(t *testing.T) {
 	assert.Equal(t, 3, executil.FakeCommandsReturned(ctx))
 }
 
+func TestRebootDevice_EmulatorAttached_WipesEmulatorInsteadOfRebooting(t *testing.T) {
+
+	// The emulator isn't running, so wiping it starts by creating the AVD,
+	// which fails here.
+	ctx := executil.FakeTestsContext(
+		"Test_FakeExe_ExitCodeOne",
+	)
+
+	emu, err := emulator.New(emulator.Config{SystemImage: "system-images;android-33;google_apis;x86_64"})
+	require.NoError(t, err)
+	m := &Machine{
+		adb:      adb.New(),
+		emulator: emu,
+		description: machine.Description{
+			AttachedDevice: machine.AttachedDeviceEmulator,
+			Dimensions: machine.SwarmingDimensions{
+				machine.DimAndroidDevices: []string{"1"},
+			},
+		},
+	}
+
+	require.Error(t, m.RebootDevice(ctx))
+	assert.Equal(t, 1, executil.FakeCommandsReturned(ctx))
+}
+
 func TestRebootDevice_NoErrorIfNoDevicesAttached(t *testing.T) {
 
 	ctx := executil.FakeTestsContext() // Any exe call will panic
@@ -965,6 +1008,46 @@ func TestRetrieveDescription_EndpointReturnsNewDescription_DescriptionIsUpdated(
 	require.Equal(t, int64(1), m.descriptionWatchArrivalCounter.Get())
 }
 
+func TestRecoveryRebootRequested_NewRebootStep_ReturnsTrueOnlyOnce(t *testing.T) {
+	m := &Machine{}
+	desc := machine.Description{
+		Recovery: machine.RecoveryState{
+			Step:        machine.RecoveryStepRebootDevice,
+			StepStarted: machinetest.MockTime,
+		},
+	}
+	require.True(t, m.recoveryRebootRequested(desc))
+	require.False(t, m.recoveryRebootRequested(desc))
+
+	desc.Recovery.StepStarted = machinetest.MockTime.Add(time.Hour)
+	require.True(t, m.recoveryRebootRequested(desc))
+}
+
+func TestRecoveryRebootRequested_RunningTask_RebootIsPostponed(t *testing.T) {
+	m := &Machine{runningTask: true}
+	desc := machine.Description{
+		Recovery: machine.RecoveryState{
+			Step:        machine.RecoveryStepRebootDevice,
+			StepStarted: machinetest.MockTime,
+		},
+	}
+	require.False(t, m.recoveryRebootRequested(desc))
+
+	m.runningTask = false
+	require.True(t, m.recoveryRebootRequested(desc))
+}
+
+func TestRecoveryRebootRequested_OtherStep_ReturnsFalse(t *testing.T) {
+	m := &Machine{}
+	desc := machine.Description{
+		Recovery: machine.RecoveryState{
+			Step:        machine.RecoveryStepPowerCycle,
+			StepStarted: machinetest.MockTime,
+		},
+	}
+	require.False(t, m.recoveryRebootRequested(desc))
+}
+
 func TestStartDescriptionWatch_ChannelIsClosed_FunctionExits(t *testing.T) {
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -6,13 +6,16 @@ import (
 	"encoding/json"
 	"flag"
 	"io/fs"
+	"os"
 	"strings"
 	"time"
 
 	"go.skia.org/infra/go/common"
+	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/machine/go/configs"
 	"go.skia.org/infra/machine/go/machineserver/config"
+	"go.skia.org/infra/machine/go/test_machine_monitor/emulator"
 	"go.skia.org/infra/machine/go/test_machine_monitor/machine"
 	"go.skia.org/infra/machine/go/test_machine_monitor/server"
 	"go.skia.org/infra/machine/go/test_machine_monitor/swarming"
@@ -28,16 +31,19 @@ const (
 
 // flags
 var (
-	configFlag        = flag.String("config", "prod.json", "The name to the configuration file, such as prod.json or test.json, as found in machine/go/configs.")
-	local             = flag.Bool("local", false, "Running locally if true. As opposed to in production.")
-	machineServerHost = flag.String("machine_server", "https://machines.skia.org", "A URL with the scheme and domain name of the machine hosting the machine server API.")
-	metadataURL       = flag.String("metadata_url", "http://metadata:8000/computeMetadata/v1/instance/service-accounts/default/token", "The URL of the metadata server that provides service account tokens.")
-	port              = flag.String("port", ":11000", "HTTP service address (e.g., 'localhost:8000' or ':8000')")
-	promPort          = flag.String("prom_port", ":20000", "Metrics service address (e.g., 'localhost:10110' or ':10110')")
-	pythonExe         = flag.String("python_exe", "", "Absolute path to Python.")
-	startSwarming     = flag.Bool("start_swarming", false, "Start swarming_bot.zip.")
-	swarmingBotZip    = flag.String("swarming_bot_zip", "", "Absolute path to where the swarming_bot.zip code should run from.")
-	username          = flag.String("username", "chrome-bot", "The username of the account that accepts SSH connections.")
+	configFlag          = flag.String("config", "prod.json", "The name to the configuration file, such as prod.json or test.json, as found in machine/go/configs.")
+	emulatorDevice      = flag.String("emulator_device", "", "The avdmanager device profile of the Android emulator, e.g. pixel_5. Uses the avdmanager default if empty.")
+	emulatorSlot        = flag.Int("emulator_slot", 0, "The slot of the Android emulator on this host, in [0, 64). Each test_machine_monitor running an emulator on the same host needs a different slot. The slot also determines the machine ID, --port and --prom_port, unless they are given explicitly.")
+	emulatorSystemImage = flag.String("emulator_system_image", "", "The system image to run the Android emulator from, e.g. 'system-images;android-33;google_apis;x86_64'. If empty then no emulator is run.")
+	local               = flag.Bool("local", false, "Running locally if true. As opposed to in production.")
+	machineServerHost   = flag.String("machine_server", "https://machines.skia.org", "A URL with the scheme and domain name of the machine hosting the machine server API.")
+	metadataURL         = flag.String("metadata_url", "http://metadata:8000/computeMetadata/v1/instance/service-accounts/default/token", "The URL of the metadata server that provides service account tokens.")
+	port                = flag.String("port", ":11000", "HTTP service address (e.g., 'localhost:8000' or ':8000')")
+	promPort            = flag.String("prom_port", ":20000", "Metrics service address (e.g., 'localhost:10110' or ':10110')")
+	pythonExe           = flag.String("python_exe", "", "Absolute path to Python.")
+	startSwarming       = flag.Bool("start_swarming", false, "Start swarming_bot.zip.")
+	swarmingBotZip      = flag.String("swarming_bot_zip", "", "Absolute path to where the swarming_bot.zip code should run from.")
+	username            = flag.String("username", "chrome-bot", "The username of the account that accepts SSH connections.")
 )
 
 var (
@@ -48,6 +54,15 @@ var (
 func main() {
 	var err error
 
+	// The defaults of some flags depend on the emulator slot, so parse the
+	// flags before they are used. common.InitWith parses them again.
+	flag.Parse()
+	if *emulatorSystemImage != "" {
+		if err := setEmulatorDefaults(*emulatorSlot); err != nil {
+			sklog.Fatalf("Failed to configure the Android emulator: %s", err)
+		}
+	}
+
 	for {
 		err = common.InitWith(
 			"test_machine_monitor",
@@ -91,9 +106,21 @@ func main() {
 		sklog.Fatalf("Failed to open config file: %q: %s", *configFlag, err)
 	}
 
+	var emu *emulator.Emulator
+	if *emulatorSystemImage != "" {
+		emu, err = emulator.New(emulator.Config{
+			Slot:        *emulatorSlot,
+			SystemImage: *emulatorSystemImage,
+			Device:      *emulatorDevice,
+		})
+		if err != nil {
+			sklog.Fatalf("Failed to configure the Android emulator: %s", err)
+		}
+	}
+
 	ctx := context.Background()
 	triggerInterrogationCh := make(chan bool, interrogationChannelSize)
-	machineState, err := machine.New(ctx, *local, instanceConfig, Version, *startSwarming, *machineServerHost, triggerInterrogationCh)
+	machineState, err := machine.New(ctx, *local, instanceConfig, Version, *startSwarming, *machineServerHost, emu, triggerInterrogationCh)
 	if err != nil {
 		sklog.Fatalf("Failed to create machine: %s", err)
 	}
@@ -127,3 +154,31 @@ func main() {
 		select {}
 	}
 }
+
+// setEmulatorDefaults derives the machine ID, --port and --prom_port from the
+// emulator slot, so that each test_machine_monitor in a pool of emulators on
+// one host is a separate machine. Flags which were given explicitly are left
+// alone. The machine ID is passed on via the Swarming bot ID environment
+// variable, which is also read by the Swarming bot.
+func setEmulatorDefaults(slot int) error {
+	set := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	monitorPort, monitorPromPort := emulator.MonitorPorts(slot)
+	if !set["port"] {
+		*port = monitorPort
+	}
+	if !set["prom_port"] {
+		*promPort = monitorPromPort
+	}
+	hostID := os.Getenv(swarming.SwarmingBotIDEnvVar)
+	if hostID == "" {
+		var err error
+		hostID, err = os.Hostname()
+		if err != nil {
+			return skerr.Wrapf(err, "determine hostname")
+		}
+	}
+	return skerr.Wrap(os.Setenv(swarming.SwarmingBotIDEnvVar, emulator.MachineID(hostID, slot)))
+}
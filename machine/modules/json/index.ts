@@ -13,6 +13,79 @@ export interface SetAttachedDevice {
 	AttachedDevice: AttachedDevice;
 }
 
+export interface ReserveMachineRequest {
+	Dimensions: SwarmingDimensions;
+	Duration: string;
+	Reason: string;
+	PowerCycleOnRelease: boolean;
+}
+
+export interface Reservation {
+	User: string;
+	Reason: string;
+	Start: string;
+	Expires: string;
+	PowerCycleOnRelease: boolean;
+	ReminderSent: boolean;
+}
+
+export interface ConnectionInfo {
+	Host: string;
+	AttachedDevice: AttachedDevice;
+	SSHUserIP: string;
+}
+
+export interface ReserveMachineResponse {
+	MachineID: string;
+	Reservation: Reservation;
+	Connection: ConnectionInfo;
+}
+
+export interface TelemetrySample {
+	MachineID: string;
+	Timestamp: string;
+	Battery: number;
+	Temperature: { [key: string]: number } | null;
+	DeviceUptime: number;
+}
+
+export interface TaskOutcome {
+	ID: string;
+	Finished: string;
+	Status: TaskStatus;
+}
+
+export interface MachineTelemetryResponse {
+	MachineID: string;
+	Samples: TelemetrySample[] | null;
+	TaskOutcomes: TaskOutcome[] | null;
+}
+
+export interface MachineTrend {
+	MachineID: string;
+	Pool: string;
+	Metric: TrendMetric;
+	Change: number;
+	PoolMedianChange: number;
+}
+
+export interface MachineTrendsResponse {
+	Computed: string;
+	Trends: MachineTrend[] | null;
+}
+
+export interface DimensionDrift {
+	Dimension: string;
+	Expected: string[] | null;
+	Actual: string[] | null;
+}
+
+export interface DriftedMachine {
+	MachineID: string;
+	Pool: string;
+	Drift: DimensionDrift[] | null;
+}
+
 export interface Annotation {
 	Message: string;
 	User: string;
@@ -49,6 +122,18 @@ export interface TaskRequest {
 	TaskSchedulerTaskID: string;
 }
 
+export interface RecoveryAttempt {
+	Step: RecoveryStep;
+	Message: string;
+	Timestamp: string;
+}
+
+export interface RecoveryState {
+	Step: RecoveryStep;
+	StepStarted: string;
+	History: RecoveryAttempt[];
+}
+
 export interface Description {
 	MaintenanceMode: string;
 	IsQuarantined: boolean;
@@ -71,16 +156,26 @@ export interface Description {
 	Dimensions: SwarmingDimensions;
 	TaskRequest?: TaskRequest;
 	TaskStarted: string;
+	Recovery: RecoveryState;
+	Reservation: Reservation;
 }
 
 export type SwarmingDimensions = { [key: string]: string[] | null } | null;
 
-export type AttachedDevice = 'nodevice' | 'adb' | 'ios' | 'ssh';
+export type AttachedDevice = 'nodevice' | 'adb' | 'ios' | 'ssh' | 'emulator';
+
+export type TaskStatus = string;
+
+export type TrendMetric = 'battery' | 'thermal' | 'task_failures';
+
+export type ListDriftedMachinesResponse = DriftedMachine[] | null;
 
 export type PowerCycleState = 'not_available' | 'available' | 'in_error';
 
 export type Duration = number;
 
+export type RecoveryStep = string;
+
 export type ListMachinesResponse = Description[];
 
 export type TaskRequestor = 'swarming' | 'sktask';
@@ -48,6 +48,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -89,6 +102,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -130,6 +156,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -171,6 +210,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -212,6 +264,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -257,6 +322,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -298,6 +376,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -343,6 +434,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -389,6 +493,19 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
   {
     MaintenanceMode: '',
@@ -434,5 +551,18 @@ export const descriptions: ListMachinesResponse = [
     RecoveryStart: '2022-02-26T16:40:38.008347Z',
     SuppliedDimensions: {},
     TaskStarted: '2022-02-26T16:40:38.008347Z',
+    Recovery: {
+      Step: '',
+      StepStarted: '0001-01-01T00:00:00Z',
+      History: [],
+    },
+    Reservation: {
+      User: '',
+      Reason: '',
+      Start: '0001-01-01T00:00:00Z',
+      Expires: '0001-01-01T00:00:00Z',
+      PowerCycleOnRelease: false,
+      ReminderSent: false,
+    },
   },
 ];
@@ -83,6 +83,7 @@ export type MachineTableSkChangeEventDetail = string;
 const attachedDeviceDisplayName: Record<string, AttachedDevice> = {
   '-': 'nodevice',
   Android: 'adb',
+  Emulator: 'emulator',
   iOS: 'ios',
   SSH: 'ssh',
 };
@@ -121,6 +121,19 @@ describe('SortHistory', () => {
       RecoveryStart: '2022-02-26T16:40:38.008347Z',
       SuppliedDimensions: {},
       TaskStarted: '2022-02-26T16:40:38.008347Z',
+      Recovery: {
+        Step: '',
+        StepStarted: '0001-01-01T00:00:00Z',
+        History: [],
+      },
+      Reservation: {
+        User: '',
+        Reason: '',
+        Start: '0001-01-01T00:00:00Z',
+        Expires: '0001-01-01T00:00:00Z',
+        PowerCycleOnRelease: false,
+        ReminderSent: false,
+      },
     };
 
     // Now create desc2 based on its differences from desc1.
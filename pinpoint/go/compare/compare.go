@@ -10,6 +10,13 @@
 //
 // See [thresholds] for more context on the thresholds
 //
+// # Sequential testing:
+//
+// Bisections add runs and compare again while the verdict is unknown. Every
+// look at the data is another chance for a false positive, so a sequential
+// comparison spends its low threshold across its looks instead, see
+// [SequentialLook].
+//
 // # Functional bisections vs performance bisections:
 //
 // Most bisections are performance, meaning they measure performance regressions
@@ -206,6 +213,11 @@ type CompareResults struct {
 // in a benchmark measurement. i.e. expectedErrRate = 0.5 means the culprit is
 // causing the benchmark to fail 50% of the time more often.
 func CompareFunctional(valuesA, valuesB []float64, expectedErrRate float64) (*CompareResults, error) {
+	return compareFunctional(valuesA, valuesB, expectedErrRate, thresholds.LowThreshold)
+}
+
+// compareFunctional is CompareFunctional with the given low threshold.
+func compareFunctional(valuesA, valuesB []float64, expectedErrRate, lowThreshold float64) (*CompareResults, error) {
 	// This is technically not possible. It would imply that there were no benchmark runs scheduled
 	// or all scheduled runs terminally errored out.
 	if len(valuesA) == 0 || len(valuesB) == 0 {
@@ -221,7 +233,7 @@ func CompareFunctional(valuesA, valuesB []float64, expectedErrRate float64) (*Co
 		expectedErrRate = DefaultFunctionalErrRate
 	}
 
-	LowThreshold := thresholds.LowThreshold
+	LowThreshold := lowThreshold
 	HighThreshold, err := thresholds.HighThresholdFunctional(expectedErrRate, avgSampleSize)
 	if err != nil {
 		return &CompareResults{Verdict: ErrorVerdict}, skerr.Wrapf(err, "Could not get functional high threshold")
@@ -236,6 +248,11 @@ func CompareFunctional(valuesA, valuesB []float64, expectedErrRate float64) (*Co
 // rawMagnitude difference between valuesA and valuesB using the performance
 // low and high thresholds.
 func ComparePerformance(valuesA, valuesB []float64, rawMagnitude float64, direction ImprovementDir) (*CompareResults, error) {
+	return comparePerformance(valuesA, valuesB, rawMagnitude, direction, thresholds.LowThreshold)
+}
+
+// comparePerformance is ComparePerformance with the given low threshold.
+func comparePerformance(valuesA, valuesB []float64, rawMagnitude float64, direction ImprovementDir, lowThreshold float64) (*CompareResults, error) {
 	// This situation happens if all benchmark runs fail.
 	if len(valuesA) == 0 || len(valuesB) == 0 {
 		return &CompareResults{Verdict: NilVerdict}, nil
@@ -265,7 +282,7 @@ func ComparePerformance(valuesA, valuesB []float64, rawMagnitude float64, direct
 	// The samples may be imbalanced depending on the success of individual runs
 	avgSampleSize := len(all_values) / 2
 
-	LowThreshold := thresholds.LowThreshold
+	LowThreshold := lowThreshold
 	HighThreshold, err := thresholds.HighThresholdPerformance(normalizedMagnitude, avgSampleSize)
 	if err != nil {
 		return &CompareResults{Verdict: ErrorVerdict}, skerr.Wrapf(err, "Could not get high threshold for bisection")
@@ -0,0 +1,109 @@
+package compare
+
+import (
+	"math"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+// SequentialLook is one look at two samples that grow in batches, until a
+// comparison between them is confidently Same or Different.
+//
+// Comparing the samples again after every batch with the same low threshold
+// would raise the false positive rate with every look. Instead, each look
+// spends a part of Alpha, so that the false positive rate over all the looks
+// of the comparison is at most Alpha. The amount spent by the sample size n
+// follows the Lan-DeMets Pocock-type spending function
+//
+//	Alpha * ln(1 + (e - 1) * n / MaxSampleSize)
+//
+// which spends more of Alpha early than an O'Brien-Fleming-type function,
+// so large differences are found with few runs. The low threshold of a look
+// is what it adds to the amount spent by the previous look.
+type SequentialLook struct {
+	// Alpha is the false positive rate over all the looks of the comparison,
+	// e.g. thresholds.LowThreshold.
+	Alpha float64
+
+	// MaxSampleSize is the sample size of the last look. A comparison that
+	// is still Unknown at the last look is Same.
+	MaxSampleSize int
+
+	// SampleSize is the size of each sample at this look.
+	SampleSize int
+
+	// PreviousSampleSize is the SampleSize of the previous look of the
+	// comparison, 0 for the first look.
+	PreviousSampleSize int
+}
+
+// spent returns how much of Alpha is spent by the look at the sample size.
+func (l SequentialLook) spent(sampleSize int) float64 {
+	if sampleSize <= 0 {
+		return 0
+	}
+	t := min(float64(sampleSize)/float64(l.MaxSampleSize), 1)
+	return l.Alpha * math.Log(1+(math.E-1)*t)
+}
+
+// LowThreshold returns the low threshold of the look. It is 0 if the samples
+// didn't grow since the previous look, as the look adds no information.
+func (l SequentialLook) LowThreshold() float64 {
+	return l.spent(l.SampleSize) - l.spent(l.PreviousSampleSize)
+}
+
+// IsLast returns true if this is the last look of the comparison.
+func (l SequentialLook) IsLast() bool {
+	return l.SampleSize >= l.MaxSampleSize
+}
+
+// Validate returns an error if the look is invalid.
+func (l SequentialLook) Validate() error {
+	if l.Alpha <= 0 || l.Alpha >= 1 {
+		return skerr.Fmt("alpha %f must be between 0 and 1", l.Alpha)
+	}
+	if l.MaxSampleSize <= 0 {
+		return skerr.Fmt("max sample size %d must be positive", l.MaxSampleSize)
+	}
+	if l.SampleSize <= 0 {
+		return skerr.Fmt("sample size %d must be positive", l.SampleSize)
+	}
+	if l.SampleSize < l.PreviousSampleSize {
+		return skerr.Fmt("sample size %d must not be smaller than the previous sample size %d", l.SampleSize, l.PreviousSampleSize)
+	}
+	return nil
+}
+
+// finish makes the verdict of the last look Same if it is Unknown.
+func (l SequentialLook) finish(r *CompareResults) *CompareResults {
+	if l.IsLast() && r.Verdict == Unknown {
+		r.Verdict = Same
+	}
+	return r
+}
+
+// CompareFunctionalSequential is CompareFunctional for one look of a
+// sequential comparison.
+func CompareFunctionalSequential(valuesA, valuesB []float64, expectedErrRate float64, look SequentialLook) (*CompareResults, error) {
+	if err := look.Validate(); err != nil {
+		return &CompareResults{Verdict: ErrorVerdict}, skerr.Wrap(err)
+	}
+	r, err := compareFunctional(valuesA, valuesB, expectedErrRate, look.LowThreshold())
+	if err != nil {
+		return r, skerr.Wrap(err)
+	}
+	return look.finish(r), nil
+}
+
+// ComparePerformanceSequential is ComparePerformance for one look of a
+// sequential comparison.
+func ComparePerformanceSequential(valuesA, valuesB []float64, rawMagnitude float64, direction ImprovementDir, look SequentialLook) (*CompareResults, error) {
+	if err := look.Validate(); err != nil {
+		return &CompareResults{Verdict: ErrorVerdict}, skerr.Wrap(err)
+	}
+	r, err := comparePerformance(valuesA, valuesB, rawMagnitude, direction, look.LowThreshold())
+	if err != nil {
+		return r, skerr.Wrap(err)
+	}
+	return look.finish(r), nil
+}
@@ -0,0 +1,153 @@
+package compare
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/pinpoint/go/compare/thresholds"
+)
+
+const (
+	testMaxSampleSize = 160
+	testBatchSize     = 5
+	testMinSampleSize = 10
+)
+
+func newTestLook(sampleSize, previousSampleSize int) SequentialLook {
+	return SequentialLook{
+		Alpha:              thresholds.LowThreshold,
+		MaxSampleSize:      testMaxSampleSize,
+		SampleSize:         sampleSize,
+		PreviousSampleSize: previousSampleSize,
+	}
+}
+
+func TestSequentialLookLowThreshold_AllLooks_SpendAlpha(t *testing.T) {
+	spent := 0.0
+	previous := 0
+	for size := testMinSampleSize; size <= testMaxSampleSize; size += testBatchSize {
+		look := newTestLook(size, previous)
+		assert.Greater(t, look.LowThreshold(), 0.0)
+		assert.Less(t, look.LowThreshold(), thresholds.LowThreshold)
+		spent += look.LowThreshold()
+		previous = size
+	}
+	assert.InDelta(t, thresholds.LowThreshold, spent, 1e-9)
+}
+
+func TestSequentialLookLowThreshold_FirstLook_SpendsInProportionToSampleSize(t *testing.T) {
+	// 0.05 * ln(1 + (e - 1) * 10 / 160)
+	assert.InDelta(t, 0.0051004, newTestLook(10, 0).LowThreshold(), 1e-6)
+}
+
+func TestSequentialLookValidate_InvalidLooks_ReturnError(t *testing.T) {
+	test := func(name string, look SequentialLook) {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, look.Validate())
+		})
+	}
+	test("no alpha", SequentialLook{MaxSampleSize: 160, SampleSize: 10})
+	test("no max sample size", SequentialLook{Alpha: 0.05, SampleSize: 10})
+	test("no sample size", SequentialLook{Alpha: 0.05, MaxSampleSize: 160})
+	test("sample size shrank", SequentialLook{Alpha: 0.05, MaxSampleSize: 160, SampleSize: 10, PreviousSampleSize: 15})
+}
+
+func TestSequentialLookLowThreshold_SampleSizeDidNotGrow_ReturnsZero(t *testing.T) {
+	look := newTestLook(20, 20)
+	require.NoError(t, look.Validate())
+	assert.Zero(t, look.LowThreshold())
+}
+
+func TestComparePerformanceSequential_InvalidLook_ReturnsErrorVerdict(t *testing.T) {
+	result, err := ComparePerformanceSequential([]float64{1}, []float64{2}, 1, UnknownDir, SequentialLook{})
+	require.Error(t, err)
+	assert.Equal(t, ErrorVerdict, result.Verdict)
+}
+
+func TestComparePerformanceSequential_LargeDifference_DifferentAtFirstLook(t *testing.T) {
+	x := []float64{10, 10.1, 9.9, 10.2, 9.8, 10, 10.1, 9.9, 10.2, 9.8}
+	y := []float64{12, 12.1, 11.9, 12.2, 11.8, 12, 12.1, 11.9, 12.2, 11.8}
+	result, err := ComparePerformanceSequential(x, y, 2, UnknownDir, newTestLook(10, 0))
+	require.NoError(t, err)
+	assert.Equal(t, Different, result.Verdict)
+	assert.Less(t, result.LowThreshold, thresholds.LowThreshold)
+}
+
+func TestComparePerformanceSequential_UnknownAtLastLook_ReturnsSame(t *testing.T) {
+	x := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	y := []float64{1.5, 2.5, 3.5, 4.5, 5.5, 6.5, 7.5, 8.5, 9.5, 10.5}
+	const rawMagnitude = 1.5
+
+	result, err := ComparePerformanceSequential(x, y, rawMagnitude, UnknownDir, newTestLook(10, 0))
+	require.NoError(t, err)
+	require.Equal(t, Unknown, result.Verdict)
+
+	look := newTestLook(10, 0)
+	look.MaxSampleSize = 10
+	result, err = ComparePerformanceSequential(x, y, rawMagnitude, UnknownDir, look)
+	require.NoError(t, err)
+	assert.Equal(t, Same, result.Verdict)
+}
+
+func TestCompareFunctionalSequential_UnknownAtLastLook_ReturnsSame(t *testing.T) {
+	x := []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	y := []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+
+	result, err := CompareFunctionalSequential(x, y, 0.5, newTestLook(10, 0))
+	require.NoError(t, err)
+	require.Equal(t, Unknown, result.Verdict)
+
+	result, err = CompareFunctionalSequential(x, y, 0.5, newTestLook(testMaxSampleSize, 0))
+	require.NoError(t, err)
+	assert.Equal(t, Same, result.Verdict)
+}
+
+// runSequential adds batches of samples from the given distributions until
+// the comparison decides, and returns the verdict and the sample size used.
+func runSequential(t *testing.T, r *rand.Rand, meanA, meanB float64) (Verdict, int) {
+	var x, y []float64
+	previous := 0
+	for size := testMinSampleSize; size <= testMaxSampleSize; size += testBatchSize {
+		for len(x) < size {
+			x = append(x, meanA+r.NormFloat64())
+			y = append(y, meanB+r.NormFloat64())
+		}
+		result, err := ComparePerformanceSequential(x, y, 1, UnknownDir, newTestLook(size, previous))
+		require.NoError(t, err)
+		if result.Verdict != Unknown {
+			return result.Verdict, size
+		}
+		previous = size
+	}
+	t.Fatal("the last look must decide")
+	return Unknown, 0
+}
+
+func TestComparePerformanceSequential_SameDistribution_ControlsFalsePositiveRate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const trials = 500
+	different := 0
+	for i := 0; i < trials; i++ {
+		if v, _ := runSequential(t, r, 10, 10); v == Different {
+			different++
+		}
+	}
+	assert.LessOrEqual(t, float64(different)/trials, thresholds.LowThreshold)
+}
+
+func TestComparePerformanceSequential_LargeShift_StopsEarly(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	const trials = 100
+	different, runs := 0, 0
+	for i := 0; i < trials; i++ {
+		v, size := runSequential(t, r, 10, 12)
+		if v == Different {
+			different++
+		}
+		runs += size
+	}
+	assert.GreaterOrEqual(t, different, 95)
+	assert.Less(t, runs/trials, 20)
+}
@@ -0,0 +1,412 @@
+// Package command implements the execution interfaces for any git repository,
+// by running the commands of a Config in a checkout of each commit.
+//
+// Builds are kept on the local disk of the worker that made them, so all the
+// activities of a job that uses this backend need to run on the same worker,
+// or on workers that share WorkDir. Failed builds are removed right away, and
+// successful builds once they haven't been used for maxBuildAge.
+package command
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.skia.org/infra/go/exec"
+	"go.skia.org/infra/go/git"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/perf/go/ingest/format"
+	"go.skia.org/infra/pinpoint/go/execution"
+)
+
+const (
+	// RevisionEnvVar is set to the git hash of the commit for the build and
+	// benchmark commands.
+	RevisionEnvVar = "PINPOINT_REVISION"
+
+	// ResultsEnvVar is set to the path of the file the benchmark command must
+	// write its results to, in perf ingestion format. See
+	// //perf/go/ingest/format.
+	ResultsEnvVar = "PINPOINT_RESULTS"
+
+	// successMarker is written to the checkout once its build succeeded, so
+	// the build can be reused.
+	successMarker = ".pinpoint_build_succeeded"
+
+	// maxLogBytes is how much of the end of the output of a command is kept.
+	maxLogBytes = 4096
+
+	// maxBuildAge is how long a build is kept after it was last used. It is
+	// longer than a job may run for, so builds aren't removed while a job
+	// still needs them.
+	maxBuildAge = 24 * time.Hour
+)
+
+// Config describes how to build and benchmark a git repository.
+type Config struct {
+	// Repo is the URL or the local path of the git repository.
+	Repo string `json:"repo"`
+
+	// WorkDir is the local directory that holds a mirror of the repository
+	// and a checkout for every commit that is built.
+	WorkDir string `json:"-"`
+
+	// BuildCommand is run in the checkout of a commit to build it, e.g.
+	// ["make", "bench"]. If it fails the commit fails to build.
+	BuildCommand []string `json:"build_command"`
+
+	// BenchmarkCommand is run in the checkout of a commit after it was built,
+	// e.g. ["out/bench", "--iterations=10"]. It must write its results to the
+	// file given in $PINPOINT_RESULTS. If it fails, or doesn't write valid
+	// results, the benchmark run fails.
+	BenchmarkCommand []string `json:"benchmark_command"`
+}
+
+// Validate returns an error if the Config is incomplete.
+func (c Config) Validate() error {
+	if c.Repo == "" {
+		return skerr.Fmt("a repo must be given")
+	}
+	if c.WorkDir == "" {
+		return skerr.Fmt("a work dir must be given")
+	}
+	if len(c.BuildCommand) == 0 {
+		return skerr.Fmt("a build command must be given")
+	}
+	if len(c.BenchmarkCommand) == 0 {
+		return skerr.Fmt("a benchmark command must be given")
+	}
+	return nil
+}
+
+// LoadConfigs reads named Configs from the given JSON file, which maps each
+// name to a Config, e.g.
+//
+//	{
+//	  "skia": {
+//	    "repo": "https://skia.googlesource.com/skia.git",
+//	    "build_command": ["make", "bench"],
+//	    "benchmark_command": ["out/bench"]
+//	  }
+//	}
+//
+// The WorkDir of each Config is the subdirectory of workDir with its name.
+func LoadConfigs(path, workDir string) (map[string]Config, error) {
+	var configs map[string]Config
+	if err := util.WithReadFile(path, func(f io.Reader) error {
+		return json.NewDecoder(f).Decode(&configs)
+	}); err != nil {
+		return nil, skerr.Wrapf(err, "reading command backend configs from %s", path)
+	}
+	for name, cfg := range configs {
+		if name == "" || name != filepath.Base(name) || name == "." || name == ".." {
+			return nil, skerr.Fmt("invalid command backend config name %q", name)
+		}
+		cfg.WorkDir = filepath.Join(workDir, name)
+		if err := cfg.Validate(); err != nil {
+			return nil, skerr.Wrapf(err, "invalid command backend config %q", name)
+		}
+		configs[name] = cfg
+	}
+	return configs, nil
+}
+
+// Command builds and benchmarks commits by running commands.
+type Command struct {
+	cfg Config
+}
+
+// New returns a new *Command.
+func New(cfg Config) (*Command, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if err := os.MkdirAll(cfg.WorkDir, 0755); err != nil {
+		return nil, skerr.Wrapf(err, "creating work dir %s", cfg.WorkDir)
+	}
+	return &Command{cfg: cfg}, nil
+}
+
+var (
+	locksMutex sync.Mutex
+	locks      = map[string]*sync.Mutex{}
+)
+
+// lock the given key, e.g. a directory, across all the Commands in this
+// process. Activities run concurrently, so this keeps them from building the
+// same commit at once, and from running benchmarks at the same time as each
+// other, which would skew their results. The returned func unlocks the key.
+func lock(key string) func() {
+	m := mutex(key)
+	m.Lock()
+	return m.Unlock
+}
+
+// tryLock is like lock, but returns false instead of waiting if the key is
+// already locked.
+func tryLock(key string) (func(), bool) {
+	m := mutex(key)
+	if !m.TryLock() {
+		return nil, false
+	}
+	return m.Unlock, true
+}
+
+func mutex(key string) *sync.Mutex {
+	locksMutex.Lock()
+	defer locksMutex.Unlock()
+	m, ok := locks[key]
+	if !ok {
+		m = &sync.Mutex{}
+		locks[key] = m
+	}
+	return m
+}
+
+// mirror returns an up to date mirror of the repository, cloning it first if
+// needed.
+func (c *Command) mirror(ctx context.Context) (*git.Repo, error) {
+	dir := filepath.Join(c.cfg.WorkDir, "mirror")
+	defer lock(dir)()
+	repo, err := git.NewRepo(ctx, c.cfg.Repo, dir)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "cloning %s", c.cfg.Repo)
+	}
+	if err := repo.Update(ctx); err != nil {
+		return nil, skerr.Wrapf(err, "updating %s", c.cfg.Repo)
+	}
+	return repo, nil
+}
+
+// Build implements execution.Builder.
+func (c *Command) Build(ctx context.Context, commit string) (*execution.BuildResult, error) {
+	repo, err := c.mirror(ctx)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	hash, err := repo.RevParse(ctx, "--verify", commit+"^{commit}")
+	if err != nil {
+		return nil, skerr.Wrapf(err, "unknown commit %s", commit)
+	}
+
+	if err := c.removeOldBuilds(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	dir := filepath.Join(c.cfg.WorkDir, "builds", hash)
+	defer lock(dir)()
+	ret := &execution.BuildResult{
+		Commit:   hash,
+		Location: dir,
+	}
+	if err := touch(filepath.Join(dir, successMarker)); err == nil {
+		ret.Success = true
+		return ret, nil
+	}
+
+	// Start from a clean checkout, in case an earlier build was interrupted.
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if _, err := git.GitDir(c.cfg.WorkDir).Git(ctx, "clone", "--quiet", "--no-checkout", repo.Dir(), dir); err != nil {
+		return nil, skerr.Wrapf(err, "cloning the mirror into %s", dir)
+	}
+	if _, err := git.GitDir(dir).Git(ctx, "checkout", "--quiet", "--detach", hash); err != nil {
+		return nil, skerr.Wrapf(err, "checking out %s", hash)
+	}
+
+	ret.Log, ret.Success, err = runCommand(ctx, dir, c.cfg.BuildCommand, RevisionEnvVar+"="+hash)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "building %s", hash)
+	}
+	if !ret.Success {
+		// Failed builds are never benchmarked or reused.
+		if err := os.RemoveAll(dir); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		return ret, nil
+	}
+	if err := os.WriteFile(filepath.Join(dir, successMarker), nil, 0644); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return ret, nil
+}
+
+// touch updates the modification time of the existing file.
+func touch(path string) error {
+	now := time.Now()
+	return os.Chtimes(path, now, now)
+}
+
+// removeOldBuilds removes the builds which haven't been built, reused or
+// benchmarked for maxBuildAge, along with the leftovers of interrupted builds
+// of that age.
+func (c *Command) removeOldBuilds() error {
+	buildsDir := filepath.Join(c.cfg.WorkDir, "builds")
+	entries, err := os.ReadDir(buildsDir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return skerr.Wrap(err)
+	}
+	for _, e := range entries {
+		dir := filepath.Join(buildsDir, e.Name())
+		if err := removeBuildIfOld(dir); err != nil {
+			return skerr.Wrapf(err, "removing old build %s", dir)
+		}
+	}
+	return nil
+}
+
+// removeBuildIfOld removes the build in dir if it wasn't used for maxBuildAge.
+// Builds that are locked are in use, and are skipped.
+func removeBuildIfOld(dir string) error {
+	unlock, ok := tryLock(dir)
+	if !ok {
+		return nil
+	}
+	defer unlock()
+	fi, err := os.Stat(filepath.Join(dir, successMarker))
+	if os.IsNotExist(err) {
+		fi, err = os.Stat(dir)
+	}
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	if time.Since(fi.ModTime()) < maxBuildAge {
+		return nil
+	}
+	return skerr.Wrap(os.RemoveAll(dir))
+}
+
+// Run implements execution.BenchmarkRunner.
+func (c *Command) Run(ctx context.Context, build *execution.BuildResult) (*execution.RunResult, error) {
+	if !build.Success {
+		return nil, skerr.Fmt("can't benchmark the failed build of %s", build.Commit)
+	}
+	f, err := os.CreateTemp(c.cfg.WorkDir, "results-*.json")
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	resultsPath := f.Name()
+	defer func() {
+		_ = os.Remove(resultsPath)
+	}()
+	if err := f.Close(); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	unlock := lock(c.cfg.WorkDir)
+	unlockBuild := lock(build.Location)
+	// Mark the build as used, so it isn't removed as old while benchmarked.
+	if err := touch(filepath.Join(build.Location, successMarker)); err != nil {
+		unlockBuild()
+		unlock()
+		return nil, skerr.Wrapf(err, "the build of %s was removed", build.Commit)
+	}
+	log, success, err := runCommand(ctx, build.Location, c.cfg.BenchmarkCommand, RevisionEnvVar+"="+build.Commit, ResultsEnvVar+"="+resultsPath)
+	unlockBuild()
+	unlock()
+	if err != nil {
+		return nil, skerr.Wrapf(err, "benchmarking %s", build.Commit)
+	}
+	ret := &execution.RunResult{
+		Success: success,
+		Log:     log,
+	}
+	if !success {
+		return ret, nil
+	}
+
+	results, err := readResults(resultsPath)
+	if err != nil {
+		ret.Success = false
+		ret.Log = tail(fmt.Sprintf("%s\nInvalid results: %s", log, err))
+		return ret, nil
+	}
+	ret.Results = results
+	return ret, nil
+}
+
+// Midpoint implements execution.Repository. Only the first-parent history
+// between the commits is considered.
+func (c *Command) Midpoint(ctx context.Context, lower, higher string) (string, error) {
+	repo, err := c.mirror(ctx)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	// The commits are listed from newest to oldest, starting with higher and
+	// excluding lower.
+	commits, err := repo.RevList(ctx, "--first-parent", "--ancestry-path", fmt.Sprintf("%s..%s", lower, higher))
+	if err != nil {
+		return "", skerr.Wrapf(err, "listing commits between %s and %s", lower, higher)
+	}
+	if len(commits) <= 1 {
+		return lower, nil
+	}
+	return commits[len(commits)/2], nil
+}
+
+// runCommand runs the command in the given directory, with the given
+// additional environment variables. It returns the tail of the output of the
+// command, and whether it succeeded. Errors are only returned if the command
+// couldn't be run at all.
+func runCommand(ctx context.Context, dir string, command []string, env ...string) (string, bool, error) {
+	var out bytes.Buffer
+	err := exec.Run(ctx, &exec.Command{
+		Name:           command[0],
+		Args:           command[1:],
+		Dir:            dir,
+		Env:            env,
+		InheritEnv:     true,
+		CombinedOutput: &out,
+	})
+	log := tail(out.String())
+	if err == nil {
+		return log, true, nil
+	}
+	if ctx.Err() != nil {
+		return log, false, skerr.Wrap(ctx.Err())
+	}
+	var exitErr *osexec.ExitError
+	if errors.As(err, &exitErr) {
+		return log, false, nil
+	}
+	return log, false, skerr.Wrap(err)
+}
+
+func readResults(path string) (*format.Format, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	defer util.Close(f)
+	results, err := format.Parse(f)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return &results, nil
+}
+
+func tail(s string) string {
+	if len(s) <= maxLogBytes {
+		return s
+	}
+	return s[len(s)-maxLogBytes:]
+}
+
+// Make sure Command fulfills the execution interfaces.
+var (
+	_ execution.Builder         = (*Command)(nil)
+	_ execution.BenchmarkRunner = (*Command)(nil)
+	_ execution.Repository      = (*Command)(nil)
+)
@@ -0,0 +1,227 @@
+package command
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	git_testutils "go.skia.org/infra/go/git/testutils"
+	"go.skia.org/infra/pinpoint/go/execution"
+)
+
+// The build copies the "value" file of the commit into out/value, and the
+// benchmark reports it as the "time" chart.
+var (
+	buildCommand     = []string{"sh", "-c", `mkdir -p out && cp value out/value`}
+	benchmarkCommand = []string{"sh", "-c", `printf '{"version": 1, "git_hash": "%s", "results": [{"key": {"test": "time"}, "measurement": %s}]}' "$PINPOINT_REVISION" "$(cat out/value)" > "$PINPOINT_RESULTS"`}
+)
+
+// setup returns a repo with a commit for each of the values, and their hashes.
+func setup(t *testing.T, values ...string) (*Command, []string) {
+	ctx := context.Background()
+	gb := git_testutils.GitInit(t, ctx)
+	t.Cleanup(gb.Cleanup)
+	var hashes []string
+	for _, v := range values {
+		gb.Add(ctx, "value", v)
+		hashes = append(hashes, gb.CommitMsg(ctx, "value "+v))
+	}
+	c, err := New(Config{
+		Repo:             gb.Dir(),
+		WorkDir:          t.TempDir(),
+		BuildCommand:     buildCommand,
+		BenchmarkCommand: benchmarkCommand,
+	})
+	require.NoError(t, err)
+	return c, hashes
+}
+
+func TestNew_IncompleteConfig_ReturnsError(t *testing.T) {
+	_, err := New(Config{
+		Repo:         "https://example.com/repo.git",
+		WorkDir:      t.TempDir(),
+		BuildCommand: buildCommand,
+	})
+	require.Error(t, err)
+}
+
+func TestLoadConfigs_ValidConfigs_ReturnsConfigsWithWorkDirs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"skia": {"repo": "https://skia.googlesource.com/skia.git", "build_command": ["make", "bench"], "benchmark_command": ["out/bench"]}}`), 0644))
+
+	configs, err := LoadConfigs(path, "/work")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Config{
+		"skia": {
+			Repo:             "https://skia.googlesource.com/skia.git",
+			WorkDir:          "/work/skia",
+			BuildCommand:     []string{"make", "bench"},
+			BenchmarkCommand: []string{"out/bench"},
+		},
+	}, configs)
+}
+
+func TestLoadConfigs_IncompleteConfig_ReturnsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"skia": {"repo": "https://skia.googlesource.com/skia.git"}}`), 0644))
+
+	_, err := LoadConfigs(path, "/work")
+	assert.ErrorContains(t, err, `invalid command backend config "skia"`)
+}
+
+func TestLoadConfigs_NameIsAPath_ReturnsError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "configs.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"../skia": {"repo": "https://skia.googlesource.com/skia.git", "build_command": ["make"], "benchmark_command": ["out/bench"]}}`), 0644))
+
+	_, err := LoadConfigs(path, "/work")
+	assert.ErrorContains(t, err, `invalid command backend config name "../skia"`)
+}
+
+func TestBuildAndRun_Success_ReturnsResults(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1.5", "2.5")
+
+	b, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	require.True(t, b.Success, b.Log)
+	assert.Equal(t, hashes[0], b.Commit)
+
+	r, err := c.Run(ctx, b)
+	require.NoError(t, err)
+	require.True(t, r.Success, r.Log)
+	assert.Equal(t, hashes[0], r.Results.GitHash)
+	assert.Equal(t, []float64{1.5}, execution.ValuesForChart(r.Results, "time"))
+
+	b, err = c.Build(ctx, hashes[1])
+	require.NoError(t, err)
+	r, err = c.Run(ctx, b)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{2.5}, execution.ValuesForChart(r.Results, "time"))
+}
+
+func TestBuild_AlreadyBuilt_ReusesBuild(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1")
+
+	b, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	require.True(t, b.Success)
+	// Remove the build output, which a rebuild would recreate.
+	require.NoError(t, os.Remove(filepath.Join(b.Location, "out", "value")))
+
+	b, err = c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	require.True(t, b.Success)
+	assert.NoFileExists(t, filepath.Join(b.Location, "out", "value"))
+}
+
+func TestBuild_BuildCommandFails_ReturnsFailedBuild(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1")
+	c.cfg.BuildCommand = []string{"sh", "-c", "echo compile error && exit 1"}
+
+	b, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	assert.False(t, b.Success)
+	assert.Contains(t, b.Log, "compile error")
+}
+
+func TestBuild_BuildCommandFails_RemovesBuild(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1")
+	c.cfg.BuildCommand = []string{"sh", "-c", "exit 1"}
+
+	b, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	require.False(t, b.Success)
+	assert.NoDirExists(t, b.Location)
+}
+
+func TestBuild_UnusedBuilds_RemovesOldBuilds(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1", "2", "3")
+
+	old, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	recent, err := c.Build(ctx, hashes[1])
+	require.NoError(t, err)
+	longAgo := time.Now().Add(-maxBuildAge - time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(old.Location, successMarker), longAgo, longAgo))
+
+	_, err = c.Build(ctx, hashes[2])
+	require.NoError(t, err)
+	assert.NoDirExists(t, old.Location)
+	assert.DirExists(t, recent.Location)
+}
+
+func TestBuild_UnknownCommit_ReturnsError(t *testing.T) {
+	c, _ := setup(t, "1")
+	_, err := c.Build(context.Background(), "0123456789012345678901234567890123456789")
+	require.Error(t, err)
+}
+
+func TestBuild_BuildCommandDoesNotExist_ReturnsError(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1")
+	c.cfg.BuildCommand = []string{"this-command-does-not-exist"}
+
+	_, err := c.Build(ctx, hashes[0])
+	require.Error(t, err)
+}
+
+func TestRun_BenchmarkCommandFails_ReturnsFailedRun(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1")
+	c.cfg.BenchmarkCommand = []string{"sh", "-c", "echo crashed && exit 1"}
+
+	b, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	r, err := c.Run(ctx, b)
+	require.NoError(t, err)
+	assert.False(t, r.Success)
+	assert.Contains(t, r.Log, "crashed")
+	assert.Nil(t, r.Results)
+}
+
+func TestRun_InvalidResults_ReturnsFailedRun(t *testing.T) {
+	ctx := context.Background()
+	c, hashes := setup(t, "1")
+	c.cfg.BenchmarkCommand = []string{"sh", "-c", `echo "not json" > "$PINPOINT_RESULTS"`}
+
+	b, err := c.Build(ctx, hashes[0])
+	require.NoError(t, err)
+	r, err := c.Run(ctx, b)
+	require.NoError(t, err)
+	assert.False(t, r.Success)
+	assert.Contains(t, r.Log, "Invalid results")
+}
+
+func TestRun_FailedBuild_ReturnsError(t *testing.T) {
+	c, _ := setup(t, "1")
+	_, err := c.Run(context.Background(), &execution.BuildResult{Commit: "abc"})
+	require.Error(t, err)
+}
+
+func TestMidpoint_CommitsInBetween_ReturnsMiddleCommit(t *testing.T) {
+	c, hashes := setup(t, "0", "1", "2", "3", "4")
+
+	mid, err := c.Midpoint(context.Background(), hashes[0], hashes[4])
+	require.NoError(t, err)
+	assert.Equal(t, hashes[2], mid)
+
+	mid, err = c.Midpoint(context.Background(), hashes[0], hashes[2])
+	require.NoError(t, err)
+	assert.Equal(t, hashes[1], mid)
+}
+
+func TestMidpoint_AdjacentCommits_ReturnsLower(t *testing.T) {
+	c, hashes := setup(t, "0", "1")
+
+	mid, err := c.Midpoint(context.Background(), hashes[0], hashes[1])
+	require.NoError(t, err)
+	assert.Equal(t, hashes[0], mid)
+}
@@ -0,0 +1,105 @@
+// Package execution defines how Pinpoint builds a commit and runs a benchmark
+// on the build, independent of the repository being bisected.
+//
+// The workflows reach every backend through the same workflow level
+// interface, see the backend interface of workflows/internal. Chromium is
+// built by Buildbucket and benchmarked by Telemetry on Swarming, in child
+// workflows, see build_chrome and run_benchmark. Backends that build and
+// benchmark within activities implement the interfaces below instead, see
+// execution/command for an implementation that runs commands in a checkout of
+// any git repository.
+package execution
+
+import (
+	"context"
+	"strings"
+
+	"go.skia.org/infra/perf/go/ingest/format"
+)
+
+// BuildResult is the result of building a single commit.
+type BuildResult struct {
+	// Commit is the git hash that was built.
+	Commit string
+
+	// Success is false if the commit failed to build.
+	Success bool
+
+	// Location of the build output. Its meaning depends on the backend, e.g.
+	// a directory on the machine that built it. It is only understood by the
+	// BenchmarkRunner of the same backend.
+	Location string
+
+	// Log is the tail of the build output, to help diagnose failures.
+	Log string
+}
+
+// RunResult is the result of a single benchmark run.
+type RunResult struct {
+	// Success is false if the benchmark failed to run on the build.
+	Success bool
+
+	// Results of the benchmark, in perf ingestion format. Nil if Success is
+	// false.
+	Results *format.Format
+
+	// Log is the tail of the benchmark output, to help diagnose failures.
+	Log string
+}
+
+// Builder builds commits of a repository.
+type Builder interface {
+	// Build the given commit. A commit that fails to build is reported
+	// in the BuildResult, errors are only returned for infrastructure
+	// failures. Implementations may reuse an earlier build of the commit.
+	Build(ctx context.Context, commit string) (*BuildResult, error)
+}
+
+// BenchmarkRunner runs a benchmark on a build.
+type BenchmarkRunner interface {
+	// Run the benchmark once on the given successful build. A benchmark that
+	// fails is reported in the RunResult, errors are only returned for
+	// infrastructure failures.
+	Run(ctx context.Context, build *BuildResult) (*RunResult, error)
+}
+
+// Repository navigates the history of the repository being bisected.
+type Repository interface {
+	// Midpoint returns the commit halfway between lower and higher. It
+	// returns lower if the commits are adjacent.
+	Midpoint(ctx context.Context, lower, higher string) (string, error)
+}
+
+// ValuesForChart returns all the values for the given chart in the results.
+//
+// A result is part of the chart if its "test" key is the chart name, e.g.
+// "draw_a_circle". For results with multiple measurements, the chart name
+// also selects the measurement by its value, separated by a colon, e.g.
+// "draw_a_circle:median". Perf keys can't contain colons, so this is
+// unambiguous.
+func ValuesForChart(results *format.Format, chart string) []float64 {
+	if results == nil {
+		return nil
+	}
+	test, measurement, hasMeasurement := strings.Cut(chart, ":")
+	var ret []float64
+	for _, r := range results.Results {
+		if r.Key["test"] != test {
+			continue
+		}
+		if !hasMeasurement {
+			if len(r.Measurements) == 0 {
+				ret = append(ret, float64(r.Measurement))
+			}
+			continue
+		}
+		for _, measurements := range r.Measurements {
+			for _, m := range measurements {
+				if m.Value == measurement {
+					ret = append(ret, float64(m.Measurement))
+				}
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,52 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.skia.org/infra/perf/go/ingest/format"
+)
+
+var testResults = &format.Format{
+	Version: format.FileFormatVersion,
+	GitHash: "abc",
+	Results: []format.Result{
+		{
+			Key:         map[string]string{"test": "draw_a_square", "units": "ms"},
+			Measurement: 1.5,
+		},
+		{
+			Key:         map[string]string{"test": "draw_a_square", "units": "ms"},
+			Measurement: 2.5,
+		},
+		{
+			Key: map[string]string{"test": "draw_a_circle", "units": "ms"},
+			Measurements: map[string][]format.SingleMeasurement{
+				"stat": {
+					{Value: "min", Measurement: 1},
+					{Value: "median", Measurement: 2},
+				},
+			},
+		},
+	},
+}
+
+func TestValuesForChart_SingleMeasurements_ReturnsAllValues(t *testing.T) {
+	assert.Equal(t, []float64{1.5, 2.5}, ValuesForChart(testResults, "draw_a_square"))
+}
+
+func TestValuesForChart_MultipleMeasurements_ReturnsSelectedValue(t *testing.T) {
+	assert.Equal(t, []float64{2}, ValuesForChart(testResults, "draw_a_circle:median"))
+}
+
+func TestValuesForChart_MultipleMeasurementsWithoutSelection_ReturnsNoValues(t *testing.T) {
+	assert.Empty(t, ValuesForChart(testResults, "draw_a_circle"))
+}
+
+func TestValuesForChart_UnknownChart_ReturnsNoValues(t *testing.T) {
+	assert.Empty(t, ValuesForChart(testResults, "draw_a_triangle"))
+}
+
+func TestValuesForChart_NilResults_ReturnsNoValues(t *testing.T) {
+	assert.Empty(t, ValuesForChart(nil, "draw_a_square"))
+}
@@ -98,11 +98,24 @@ func (c *perfCASClient) ReadValuesByChart(ctx context.Context, benchmark string,
 		// res should be map[string]*PerfResults, right now we work around with the pointer.
 		pr := res[benchmark]
 		samples := (&pr).GetSampleValues(chart)
-		if aggMethod != nil && samples != nil {
-			values = append(values, aggMethod(perfresults.Histogram{SampleValues: samples}))
-		} else {
-			values = append(values, samples...)
-		}
+		values = append(values, aggregate(samples, aggMethod)...)
 	}
 	return values, nil
 }
+
+// AggregateValues applies the aggregation method to the sampled values of a
+// single benchmark run. If the method is empty the values are returned as is.
+func AggregateValues(samples []float64, agg string) ([]float64, error) {
+	aggMethod, ok := aggregationMapping[agg]
+	if !ok && agg != "" {
+		return nil, skerr.Fmt("unsupported aggregation method (%s).", agg)
+	}
+	return aggregate(samples, aggMethod), nil
+}
+
+func aggregate(samples []float64, aggMethod func(perfresults.Histogram) float64) []float64 {
+	if aggMethod != nil && samples != nil {
+		return []float64{aggMethod(perfresults.Histogram{SampleValues: samples})}
+	}
+	return samples
+}
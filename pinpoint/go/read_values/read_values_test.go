@@ -113,3 +113,20 @@ func TestAggData_NonBlankData_AggData(t *testing.T) {
 	test("std", 8.803408)
 	test("sum", 20.0)
 }
+
+func TestAggregateValues_NoMethod_ReturnsSamples(t *testing.T) {
+	actual, err := AggregateValues([]float64{8, 2}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{8, 2}, actual)
+}
+
+func TestAggregateValues_Max_ReturnsSingleValue(t *testing.T) {
+	actual, err := AggregateValues([]float64{8, 2, 15}, "max")
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{15}, actual)
+}
+
+func TestAggregateValues_UnsupportedMethod_ReturnsError(t *testing.T) {
+	_, err := AggregateValues([]float64{8, 2}, "median")
+	assert.Error(t, err)
+}
@@ -88,6 +88,8 @@ func validate(req *pb.ScheduleBisectRequest) error {
 		return skerr.Fmt("git hash is empty")
 	case !read_values.IsSupportedAggregation(req.AggregationMethod):
 		return skerr.Fmt("aggregation method (%s) is not available", req.AggregationMethod)
+	case req.SequentialBatchSize < 0:
+		return skerr.Fmt("sequential batch size (%d) is negative", req.SequentialBatchSize)
 	default:
 		return nil
 	}
@@ -133,10 +135,26 @@ func (s *server) ScheduleBisection(ctx context.Context, req *pb.ScheduleBisectRe
 			MaximumAttempts: 1,
 		},
 	}
-	wf, err := c.ExecuteWorkflow(ctx, wo, workflows.CatapultBisect, &workflows.BisectParams{
-		Request:    req,
-		Production: true,
-	})
+	var wf client.WorkflowRun
+	if name := req.GetCommandBackend(); name != "" {
+		// Bisections of other repositories aren't written to Catapult, and
+		// only run on the workers that enable the command backend. The
+		// workers define the commands of each config.
+		wo.TaskQueue = workflows.CommandBackendTaskQueue
+		wf, err = c.ExecuteWorkflow(ctx, wo, workflows.Bisect, &workflows.BisectParams{
+			Request:             req,
+			Production:          true,
+			JobID:               wo.ID,
+			CommandBackend:      &workflows.CommandBackend{Name: name},
+			SequentialBatchSize: req.SequentialBatchSize,
+		})
+	} else {
+		wf, err = c.ExecuteWorkflow(ctx, wo, workflows.CatapultBisect, &workflows.BisectParams{
+			Request:             req,
+			Production:          true,
+			SequentialBatchSize: req.SequentialBatchSize,
+		})
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Unable to start workflow (%v).", err)
 	}
@@ -11,6 +11,7 @@ import (
 	"go.skia.org/infra/pinpoint/go/service/mocks"
 	"go.skia.org/infra/pinpoint/go/workflows"
 	pb "go.skia.org/infra/pinpoint/proto/v1"
+	"go.temporal.io/sdk/client"
 	temporal_mocks "go.temporal.io/sdk/mocks"
 	"golang.org/x/time/rate"
 )
@@ -55,6 +56,60 @@ func TestScheduleBisection_ValidRequest_ReturnJobID(t *testing.T) {
 	assert.EqualValues(t, 1, counter, "CleanUp should be called exactly once.")
 }
 
+func TestScheduleBisection_SequentialBatchSize_SetsBisectParams(t *testing.T) {
+	tpm, tcm := newTemporalMock(t)
+	tpm.On("NewClient").Return(tcm, func() {}, nil)
+
+	const fakeID = "fake-job-id"
+	wfm := newWorkflowRunMock(t, fakeID)
+	tcm.On("ExecuteWorkflow", mock.Anything, mock.Anything, workflows.CatapultBisect, mock.MatchedBy(func(p *workflows.BisectParams) bool {
+		return p.SequentialBatchSize == 10
+	})).Return(wfm, nil)
+
+	ctx := context.Background()
+	svc := New(tpm, rate.NewLimiter(rate.Inf, 0))
+
+	resp, err := svc.ScheduleBisection(ctx, &pb.ScheduleBisectRequest{
+		StartGitHash:        "fake-start",
+		EndGitHash:          "fake-end",
+		SequentialBatchSize: 10,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, fakeID, resp.JobId)
+
+	resp, err = svc.ScheduleBisection(ctx, &pb.ScheduleBisectRequest{
+		StartGitHash:        "fake-start",
+		EndGitHash:          "fake-end",
+		SequentialBatchSize: -1,
+	})
+	assert.Nil(t, resp)
+	assert.ErrorContains(t, err, "sequential batch size (-1) is negative")
+}
+
+func TestScheduleBisection_CommandBackend_StartsBisectOnCommandBackendTaskQueue(t *testing.T) {
+	tpm, tcm := newTemporalMock(t)
+	tpm.On("NewClient").Return(tcm, func() {}, nil)
+
+	const fakeID = "fake-job-id"
+	wfm := newWorkflowRunMock(t, fakeID)
+	tcm.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(wo client.StartWorkflowOptions) bool {
+		return wo.TaskQueue == workflows.CommandBackendTaskQueue
+	}), workflows.Bisect, mock.MatchedBy(func(p *workflows.BisectParams) bool {
+		return p.CommandBackend.Name == "skia" && p.JobID != ""
+	})).Return(wfm, nil)
+
+	ctx := context.Background()
+	svc := New(tpm, rate.NewLimiter(rate.Inf, 0))
+
+	resp, err := svc.ScheduleBisection(ctx, &pb.ScheduleBisectRequest{
+		StartGitHash:   "fake-start",
+		EndGitHash:     "fake-end",
+		CommandBackend: "skia",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, fakeID, resp.JobId)
+}
+
 func TestScheduleBisection_RateLimitedRequests_ReturnError(t *testing.T) {
 	tpm, _ := newTemporalMock(t)
 	ctx := context.Background()
@@ -164,8 +164,5 @@ func CatapultBisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (*p
 
 	logger.Info(fmt.Sprintf("Datastore information for this job: %v", dsResp))
 
-	return &pinpoint_proto.BisectExecution{
-		JobId:    bisectExecution.JobId,
-		Culprits: bisectExecution.Culprits,
-	}, nil
+	return bisectExecution.ToProto(), nil
 }
@@ -62,7 +62,16 @@ func TestUpdateStatesWithComparisons_MultiComparison_Different(t *testing.T) {
 
 func TestCatapultBisectWorkflow_HappyPath_ReturnsDatastoreResponse(t *testing.T) {
 	mockBisectExecution := &internal.BisectExecution{
-		JobId: mockJobId,
+		JobId:     mockJobId,
+		TotalRuns: 20,
+		Looks: []*internal.ComparisonLook{
+			{
+				SampleSize:   10,
+				Verdict:      compare.Same,
+				PValue:       0.5,
+				LowThreshold: 0.01,
+			},
+		},
 	}
 	mockDSResp, err := unmarshalMockDatastoreResp(mockDatastoreResp)
 	require.NoError(t, err)
@@ -87,5 +96,11 @@ func TestCatapultBisectWorkflow_HappyPath_ReturnsDatastoreResponse(t *testing.T)
 	assert.NotNil(t, actual)
 	assert.Equal(t, mockJobId, actual.JobId)
 	assert.Empty(t, actual.Culprits)
+	assert.EqualValues(t, 20, actual.TotalRuns)
+	require.Len(t, actual.Looks, 1)
+	assert.EqualValues(t, 10, actual.Looks[0].SampleSize)
+	assert.Equal(t, string(compare.Same), actual.Looks[0].Verdict)
+	assert.Equal(t, 0.5, actual.Looks[0].PValue)
+	assert.Equal(t, 0.01, actual.Looks[0].LowThreshold)
 	env.AssertExpectations(t)
 }
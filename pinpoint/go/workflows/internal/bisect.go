@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/pinpoint/go/compare"
+	"go.skia.org/infra/pinpoint/go/compare/thresholds"
 	"go.skia.org/infra/pinpoint/go/midpoint"
 	"go.skia.org/infra/pinpoint/go/workflows"
 	"go.skia.org/infra/temporal/go/common"
@@ -55,6 +56,11 @@ func (t bisectRunTracker) get(r BisectRunIndex) *BisectRun {
 type CommitRangeTracker struct {
 	Lower  BisectRunIndex
 	Higher BisectRunIndex
+
+	// PreviousSampleSize is the number of runs of each commit at the previous
+	// comparison of this range, 0 if it wasn't compared yet. It is only used by
+	// sequential comparisons.
+	PreviousSampleSize int32
 }
 
 // CloneWithHigher clones itself with the overriden higher index.
@@ -85,6 +91,7 @@ func newRunnerParams(jobID string, p workflows.BisectParams, it int32, cc *midpo
 		Iterations:        it,
 		FinishedIteration: finishedIteration,
 		BotIds:            p.BotIds,
+		CommandBackend:    p.CommandBackend,
 	}
 }
 
@@ -101,6 +108,51 @@ type BisectExecution struct {
 	CreateTime  *timestamppb.Timestamp
 	Comparisons []*CombinedResults
 	RunData     []*BisectRun
+
+	// Looks has a ComparisonLook for each of the Comparisons, showing how the
+	// p-value of each pair of commits evolved as runs were added.
+	Looks []*ComparisonLook
+
+	// TotalRuns is the number of benchmark runs of all the commits.
+	TotalRuns int32
+}
+
+// ToProto returns the pinpoint_proto.BisectExecution of the bisection.
+func (be *BisectExecution) ToProto() *pinpoint_proto.BisectExecution {
+	looks := make([]*pinpoint_proto.ComparisonLook, 0, len(be.Looks))
+	for _, l := range be.Looks {
+		looks = append(looks, &pinpoint_proto.ComparisonLook{
+			Lower:        (*pinpoint_proto.CombinedCommit)(l.Lower),
+			Higher:       (*pinpoint_proto.CombinedCommit)(l.Higher),
+			SampleSize:   l.SampleSize,
+			Verdict:      string(l.Verdict),
+			PValue:       l.PValue,
+			LowThreshold: l.LowThreshold,
+		})
+	}
+	return &pinpoint_proto.BisectExecution{
+		JobId:     be.JobId,
+		Culprits:  be.Culprits,
+		TotalRuns: be.TotalRuns,
+		Looks:     looks,
+	}
+}
+
+// ComparisonLook records a comparison of two commits, with the number of runs
+// they had at the time.
+type ComparisonLook struct {
+	Lower  *midpoint.CombinedCommit
+	Higher *midpoint.CombinedCommit
+
+	// SampleSize is the number of runs of each commit.
+	SampleSize int32
+
+	Verdict compare.Verdict
+	PValue  float64
+
+	// LowThreshold is the p-value at or below which the commits are
+	// different. It shrinks with every look in sequential comparisons.
+	LowThreshold float64
 }
 
 // BisectWorkflow is a Workflow definition that takes a range of git hashes and finds the culprit.
@@ -148,10 +200,14 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 		}
 	}()
 
-	// Find the available bot list
-	if err := workflow.ExecuteActivity(ctx, FindAvailableBotsActivity, p.Request.Configuration, time.Now().UnixNano()).Get(ctx, &p.BotIds); err != nil {
+	backend := newBackend(p.CommandBackend)
+
+	// Find the available bot list.
+	botIds, err := backend.findBots(ctx, p)
+	if err != nil {
 		return nil, skerr.Wrapf(err, "failed to find available bots")
 	}
+	p.BotIds = botIds
 
 	magnitude := p.GetMagnitude()
 	improvementDir := p.GetImprovementDirection()
@@ -164,10 +220,18 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 		minSampleSize = benchmarkRunIterations[0]
 	}
 
+	// runSize returns the expected number of runs for the next comparison of two BisectRun.
+	runSize := func(br1, br2 *BisectRun) int32 {
+		if p.SequentialBatchSize > 0 {
+			return nextSequentialRunSize(br1, br2, minSampleSize, p.SequentialBatchSize)
+		}
+		return nextRunSize(br1, br2, minSampleSize)
+	}
+
 	// schedulePairRuns is a helper function to schedule new benchmark runs from two BisectRun.
 	// It captures common local variable and attempts to make the code cleaner in the for-loop below.
 	schedulePairRuns := func(lower, higher *BisectRun) (workflow.ChildWorkflowFuture, workflow.ChildWorkflowFuture, error) {
-		expected := nextRunSize(lower, higher, minSampleSize)
+		expected := runSize(lower, higher)
 		lf, err := lower.scheduleRuns(ctx, jobID, *p, expected-lower.totalRuns())
 		if err != nil {
 			logger.Warn("Failed to schedule more runs.", "commit", lower.Build.Commit, "error", err)
@@ -223,7 +287,17 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 			}
 			pendings--
 			lower, higher := tracker.get(cr.Lower), tracker.get(cr.Higher)
-			compareResult, err := compareRuns(ctx, lower, higher, p.Request.Chart, magnitude, improvementDir)
+			sampleSize := int32(min(len(lower.Runs), len(higher.Runs)))
+			var look *compare.SequentialLook
+			if p.SequentialBatchSize > 0 {
+				look = &compare.SequentialLook{
+					Alpha:              thresholds.LowThreshold,
+					MaxSampleSize:      int(getMaxSampleSize()),
+					SampleSize:         int(sampleSize),
+					PreviousSampleSize: int(cr.PreviousSampleSize),
+				}
+			}
+			compareResult, err := compareRuns(ctx, lower, higher, p.Request.Chart, magnitude, improvementDir, look)
 			// The compare fails but we continue to bisect for the remainings.
 			// TODO(sunxiaodi@): Revisit compare runs error handling. compare.ComparePerformance
 			// and compare.CompareFunctional should not return error but are written to return error.
@@ -236,6 +310,14 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 			be.Comparisons = append(be.Comparisons, compareResult)
 
 			result := compareResult.Result
+			be.Looks = append(be.Looks, &ComparisonLook{
+				Lower:        lower.Build.Commit,
+				Higher:       higher.Build.Commit,
+				SampleSize:   sampleSize,
+				Verdict:      result.Verdict,
+				PValue:       result.PValue,
+				LowThreshold: result.LowThreshold,
+			})
 			switch result.Verdict {
 			case compare.Unknown:
 				// Only push to stack if less than getMaxSampleSize(). At normalized magnitudes
@@ -254,6 +336,7 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 					logger.Warn(fmt.Sprintf("Failed to schedule more runs (%v)", err))
 					break
 				}
+				cr.PreviousSampleSize = sampleSize
 
 				pendings++
 				futures := append(lower.totalPendings(), higher.totalPendings()...)
@@ -275,17 +358,11 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 				})
 
 			case compare.Different:
-				var mid *midpoint.CombinedCommit
-				if err := workflow.ExecuteActivity(ctx, FindMidCommitActivity, lower.Build.Commit, higher.Build.Commit).Get(ctx, &mid); err != nil {
+				mid, equal, err := backend.findMidCommit(ctx, lower.Build.Commit, higher.Build.Commit)
+				if err != nil {
 					logger.Warn(fmt.Sprintf("Failed to find middle commit: %v", err))
 					break
 				}
-
-				var equal bool
-				if err := workflow.ExecuteActivity(ctx, CheckCombinedCommitEqualActivity, lower.Build.Commit, mid).Get(ctx, &equal); err != nil {
-					logger.Warn("Failed to determine equality between two combined commits")
-					break
-				}
 				if equal {
 					// TODO(b/329502712): Append additional info to bisectionExecution
 					// such as p-values, average difference
@@ -294,7 +371,7 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 				}
 
 				midRunIdx, midRun := tracker.newRun(mid)
-				mf, err := midRun.scheduleRuns(ctx, be.JobId, *p, nextRunSize(lower, midRun, minSampleSize))
+				mf, err := midRun.scheduleRuns(ctx, be.JobId, *p, runSize(lower, midRun))
 				if err != nil {
 					logger.Warn(fmt.Sprintf("Failed to schedule more runs for (%v): %v", mid, err))
 					break
@@ -319,8 +396,8 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 	})
 
 	// Schedule the first pair and wait for all to finish before continuing.
-	lowerIdx, lower := tracker.newRun(midpoint.NewCombinedCommit(midpoint.NewChromiumCommit(p.Request.StartGitHash)))
-	higherIdx, higher := tracker.newRun(midpoint.NewCombinedCommit(midpoint.NewChromiumCommit(p.Request.EndGitHash)))
+	lowerIdx, lower := tracker.newRun(backend.newCommit(p.Request.StartGitHash))
+	higherIdx, higher := tracker.newRun(backend.newCommit(p.Request.EndGitHash))
 	lf, hf, err := schedulePairRuns(lower, higher)
 	if err != nil {
 		// If we are able to schedule in the beginning, there is less chance we will fail in the middle.
@@ -346,6 +423,9 @@ func BisectWorkflow(ctx workflow.Context, p *workflows.BisectParams) (be *Bisect
 
 	be.RunData = make([]*BisectRun, len(tracker.runs))
 	copy(be.RunData, tracker.runs)
+	for _, r := range tracker.runs {
+		be.TotalRuns += int32(len(r.Runs))
+	}
 
 	return be, nil
 }
@@ -161,3 +161,18 @@ func nextRunSize(br1, br2 *BisectRun, minSampleSize int32) int32 {
 	}
 	return getMaxSampleSize()
 }
+
+// nextSequentialRunSize returns the expected number of runs for the next look
+// of a sequential comparison, which adds batchSize runs at a time.
+func nextSequentialRunSize(br1, br2 *BisectRun, minSampleSize, batchSize int32) int32 {
+	r1 := int32(len(br1.Runs))
+	r2 := int32(len(br2.Runs))
+
+	if r1 != r2 {
+		return max(r1, r2)
+	}
+	if r1 == 0 && minSampleSize > 0 {
+		return minSampleSize
+	}
+	return min(r1+batchSize, getMaxSampleSize())
+}
@@ -270,3 +270,25 @@ func TestNextRunSize_WithNonZero_ReturnMinSampleSize(t *testing.T) {
 	require.EqualValues(t, 10, nextRunSize(br, br, 10))
 	require.EqualValues(t, 20, nextRunSize(br, br, 20))
 }
+
+func TestNextSequentialRunSize_BothEqual_AddsBatchForBoth(t *testing.T) {
+	const hash1 = "fake-ffcaaab85ecf1a896da1d635aeca929edbe"
+	test := func(name string, runs, expected int) {
+		t.Run(name, func(t *testing.T) {
+			br := &BisectRun{CommitRun: *generateSingleCommitRuns(hash1, runs)}
+			assert.EqualValues(t, expected, nextSequentialRunSize(br, br, 10, 5))
+		})
+	}
+	test("0 runs each should expect the min sample size", 0, 10)
+	test("10 runs each should expect 15 runs", 10, 15)
+	test("158 runs each should expect the max sample size", 158, int(getMaxSampleSize()))
+}
+
+func TestNextSequentialRunSize_LowerCommitMoreRuns_OnlySchedulesMoreRunsForHigherCommit(t *testing.T) {
+	const hash1 = "fake1-1111111111111196da1d635aeca929edbe"
+	const hash2 = "fake2-2222222222222296da1d635aeca929edbe"
+	br1 := &BisectRun{CommitRun: *generateSingleCommitRuns(hash1, 15)}
+	br2 := &BisectRun{CommitRun: *generateSingleCommitRuns(hash2, 10)}
+	require.EqualValues(t, 15, nextSequentialRunSize(br1, br2, 10, 5))
+	require.EqualValues(t, 15, nextSequentialRunSize(br2, br1, 10, 5))
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/swarming"
 	"go.skia.org/infra/pinpoint/go/compare"
 	"go.skia.org/infra/pinpoint/go/midpoint"
+	"go.skia.org/infra/pinpoint/go/run_benchmark"
 	"go.skia.org/infra/pinpoint/go/workflows"
 	pb "go.skia.org/infra/pinpoint/proto/v1"
 	"go.temporal.io/sdk/testsuite"
@@ -104,3 +107,73 @@ func TestBisectRunTracker_ManyRuns_ReturnIndex(t *testing.T) {
 		require.Same(t, run, tracker.get(idx), "should be exact same addresses")
 	}
 }
+
+func TestBisectWorkflow_SequentialBatchSize_AddsBatchesWithShrinkingThresholds(t *testing.T) {
+	const chart = "time"
+	const batchSize = 10
+	// The end commit is slightly slower, so that more runs are needed to
+	// decide that it is the same as the start commit.
+	mockedSingleCommitRun := func(ctx workflow.Context, p *SingleCommitRunnerParams) (*CommitRun, error) {
+		shift := 0.0
+		if p.CombinedCommit.GetMainGitHash() == "end" {
+			shift = 0.5
+		}
+		runs := make([]*workflows.TestRun, p.Iterations)
+		for i := range runs {
+			it := int(p.FinishedIteration) + i
+			runs[i] = &workflows.TestRun{
+				Status: run_benchmark.State(swarming.TASK_STATE_COMPLETED),
+				Values: map[string][]float64{
+					chart: {float64((it*7)%10) + shift},
+				},
+			}
+		}
+		return &CommitRun{
+			Build: &workflows.Build{
+				BuildChromeParams: workflows.BuildChromeParams{
+					Commit: p.CombinedCommit,
+				},
+			},
+			Runs: runs,
+		}, nil
+	}
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(SingleCommitRunner, workflow.RegisterOptions{Name: workflows.SingleCommitRunner})
+	env.RegisterActivity(CompareSequentialActivity)
+	env.OnWorkflow(workflows.SingleCommitRunner, mock.Anything, mock.Anything).Return(mockedSingleCommitRun)
+	env.OnActivity(FindAvailableBotsActivity, mock.Anything, mock.Anything, mock.Anything).Return([]string{"bot"}, nil).Once()
+
+	env.ExecuteWorkflow(BisectWorkflow, &workflows.BisectParams{
+		Request: &pb.ScheduleBisectRequest{
+			StartGitHash:        "start",
+			EndGitHash:          "end",
+			Chart:               chart,
+			ComparisonMagnitude: "2",
+		},
+		SequentialBatchSize: batchSize,
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var be *BisectExecution
+	require.NoError(t, env.GetWorkflowResult(&be))
+	require.NotNil(t, be)
+	assert.Empty(t, be.Culprits)
+	require.Greater(t, len(be.Looks), 2)
+	for i, look := range be.Looks {
+		assert.EqualValues(t, benchmarkRunIterations[0]+int32(i)*batchSize, look.SampleSize)
+		if i == len(be.Looks)-1 {
+			assert.Equal(t, compare.Same, look.Verdict)
+		} else {
+			assert.Equal(t, compare.Unknown, look.Verdict)
+		}
+		if i > 0 {
+			assert.Less(t, look.LowThreshold, be.Looks[i-1].LowThreshold)
+		}
+	}
+	// Both commits ran as many times as the last look compared.
+	assert.Equal(t, 2*be.Looks[len(be.Looks)-1].SampleSize, be.TotalRuns)
+	env.AssertExpectations(t)
+}
@@ -0,0 +1,245 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/swarming"
+	"go.skia.org/infra/pinpoint/go/backends"
+	"go.skia.org/infra/pinpoint/go/execution"
+	"go.skia.org/infra/pinpoint/go/execution/command"
+	"go.skia.org/infra/pinpoint/go/midpoint"
+	"go.skia.org/infra/pinpoint/go/read_values"
+	"go.skia.org/infra/pinpoint/go/run_benchmark"
+	"go.skia.org/infra/pinpoint/go/workflows"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/workflow"
+)
+
+// heartbeatInterval is how often the command activities record a heartbeat
+// while a command runs, well within the HeartbeatTimeout of their options.
+const heartbeatInterval = time.Minute
+
+// commandBackend builds commits and runs benchmarks by running the commands
+// of a command backend config on the worker, see execution/command.
+type commandBackend struct {
+	// cb is shared with the params of the workflow, so that the fields set
+	// by findBots are passed on to the child workflows.
+	cb *workflows.CommandBackend
+}
+
+// onWorker returns the ctx for the activities that need to run on the worker
+// the bisection was pinned to by findBots.
+func (cb commandBackend) onWorker(ctx workflow.Context) workflow.Context {
+	if cb.cb.TaskQueue == "" {
+		return ctx
+	}
+	return workflow.WithTaskQueue(ctx, cb.cb.TaskQueue)
+}
+
+// findBots implements backend. The commands run on the workers rather than
+// on bots, so it picks the worker which runs all the activities of the
+// bisection instead. The builds are kept on the local disk of the worker that
+// made them, and benchmarks are only comparable on the same machine.
+func (cb commandBackend) findBots(ctx workflow.Context, p *workflows.BisectParams) ([]string, error) {
+	var ca CommandActivity
+	var resolved *workflows.CommandBackend
+	if err := workflow.ExecuteActivity(ctx, ca.ResolveCommandBackendActivity, cb.cb.Name).Get(ctx, &resolved); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	*cb.cb = *resolved
+	return nil, nil
+}
+
+func (cb commandBackend) newCommit(gitHash string) *midpoint.CombinedCommit {
+	return midpoint.NewCombinedCommit(midpoint.NewCommit(cb.cb.Repo, gitHash))
+}
+
+func (cb commandBackend) findMidCommit(ctx workflow.Context, lower, higher *midpoint.CombinedCommit) (*midpoint.CombinedCommit, bool, error) {
+	var ca CommandActivity
+	var mid string
+	if err := workflow.ExecuteActivity(cb.onWorker(ctx), ca.FindMidCommitWithCommandActivity, cb.cb.Name, lower.GetMainGitHash(), higher.GetMainGitHash()).Get(ctx, &mid); err != nil {
+		return nil, false, skerr.Wrap(err)
+	}
+	if mid == lower.GetMainGitHash() {
+		return lower, true, nil
+	}
+	return cb.newCommit(mid), false, nil
+}
+
+func (cb commandBackend) build(ctx workflow.Context, sc *SingleCommitRunnerParams) (*workflows.Build, error) {
+	ctx = cb.onWorker(workflow.WithActivityOptions(ctx, buildActivityOption))
+
+	var ca CommandActivity
+	var br *execution.BuildResult
+	if err := workflow.ExecuteActivity(ctx, ca.BuildCommandActivity, cb.cb.Name, sc.CombinedCommit.GetMainGitHash()).Get(ctx, &br); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if !br.Success {
+		return nil, skerr.Fmt("build fails at commit %v: %s", sc.CombinedCommit, br.Log)
+	}
+
+	return &workflows.Build{
+		BuildChromeParams: workflows.BuildChromeParams{
+			WorkflowID: sc.PinpointJobID,
+			Commit:     sc.CombinedCommit,
+		},
+		Status: buildbucketpb.Status_SUCCESS,
+		Result: br,
+	}, nil
+}
+
+func (cb commandBackend) runBenchmark(ctx workflow.Context, b *workflows.Build, sc *SingleCommitRunnerParams, iteration int32) (*workflows.TestRun, error) {
+	ctx = cb.onWorker(workflow.WithActivityOptions(ctx, runBenchmarkActivityOption))
+
+	var ca CommandActivity
+	var tr *workflows.TestRun
+	if err := workflow.ExecuteActivity(ctx, ca.RunCommandBenchmarkActivity, cb.cb.Name, b.Result, sc.Chart, sc.AggregationMethod).Get(ctx, &tr); err != nil {
+		return nil, err
+	}
+	return tr, nil
+}
+
+// CommandActivity wraps the command backend in Activities.
+//
+// The activities run the commands of the command backend configs of the
+// worker, which requests select by name. They are only registered on workers
+// started with --enable_command_backend, which poll a dedicated task queue,
+// see workflows.CommandBackendTaskQueue.
+type CommandActivity struct {
+	// Configs are the command backend configs of this worker by name.
+	Configs map[string]command.Config
+
+	// TaskQueue is a task queue that only this worker polls. The bisections
+	// that resolve their command backend on this worker run all their
+	// activities on it.
+	TaskQueue string
+}
+
+// config returns the named config.
+func (ca *CommandActivity) config(name string) (command.Config, error) {
+	cfg, ok := ca.Configs[name]
+	if !ok {
+		return command.Config{}, skerr.Fmt("unknown command backend config %q", name)
+	}
+	return cfg, nil
+}
+
+// newCommand returns the command.Command for the named config.
+func (ca *CommandActivity) newCommand(name string) (*command.Command, error) {
+	cfg, err := ca.config(name)
+	if err != nil {
+		return nil, err
+	}
+	return command.New(cfg)
+}
+
+// ResolveCommandBackendActivity returns the named command backend with the
+// fields of its config on this worker, pinned to this worker.
+func (ca *CommandActivity) ResolveCommandBackendActivity(ctx context.Context, name string) (*workflows.CommandBackend, error) {
+	cfg, err := ca.config(name)
+	if err != nil {
+		return nil, err
+	}
+	return &workflows.CommandBackend{
+		Name:      name,
+		Repo:      cfg.Repo,
+		TaskQueue: ca.TaskQueue,
+	}, nil
+}
+
+// BuildCommandActivity builds the commit with the build command.
+//
+// A commit that fails to build is reported in the result rather than as an
+// error, so it isn't retried.
+func (ca *CommandActivity) BuildCommandActivity(ctx context.Context, name, commit string) (*execution.BuildResult, error) {
+	logger := activity.GetLogger(ctx)
+
+	c, err := ca.newCommand(name)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	defer heartbeat(ctx, fmt.Sprintf("building %s", commit))()
+	br, err := c.Build(ctx, commit)
+	if err != nil {
+		logger.Error("Failed to build:", err)
+		return nil, err
+	}
+	if !br.Success {
+		logger.Warn("Build failed.", "commit", br.Commit, "log", br.Log)
+	}
+	return br, nil
+}
+
+// RunCommandBenchmarkActivity runs the benchmark command once on the build,
+// and returns the aggregated values of the chart.
+//
+// A benchmark that fails is reported as a benchmark failure of the test run,
+// the same way as a failed Swarming task.
+func (ca *CommandActivity) RunCommandBenchmarkActivity(ctx context.Context, name string, build *execution.BuildResult, chart, aggMethod string) (*workflows.TestRun, error) {
+	logger := activity.GetLogger(ctx)
+
+	c, err := ca.newCommand(name)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	defer heartbeat(ctx, fmt.Sprintf("benchmarking %s", build.Commit))()
+	rr, err := c.Run(ctx, build)
+	if err != nil {
+		logger.Error("Failed to run the benchmark:", err)
+		return nil, err
+	}
+	if !rr.Success {
+		logger.Warn("Benchmark failed.", "commit", build.Commit, "log", rr.Log)
+		return &workflows.TestRun{
+			Status: run_benchmark.State(backends.RunBenchmarkFailure),
+		}, nil
+	}
+
+	values, err := read_values.AggregateValues(execution.ValuesForChart(rr.Results, chart), aggMethod)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return &workflows.TestRun{
+		Status: run_benchmark.State(swarming.TASK_STATE_COMPLETED),
+		Values: map[string][]float64{
+			chart: values,
+		},
+	}, nil
+}
+
+// FindMidCommitWithCommandActivity returns the commit between lower and
+// higher, or lower if they are adjacent.
+func (ca *CommandActivity) FindMidCommitWithCommandActivity(ctx context.Context, name, lower, higher string) (string, error) {
+	c, err := ca.newCommand(name)
+	if err != nil {
+		return "", skerr.Wrap(err)
+	}
+	return c.Midpoint(ctx, lower, higher)
+}
+
+// heartbeat records heartbeats with the given details until the returned
+// func is called, so long running commands don't time out the activity.
+func heartbeat(ctx context.Context, details string) func() {
+	done := make(chan struct{})
+	go func() {
+		t := time.NewTicker(heartbeatInterval)
+		defer t.Stop()
+		for {
+			activity.RecordHeartbeat(ctx, details)
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	}()
+	return func() { close(done) }
+}
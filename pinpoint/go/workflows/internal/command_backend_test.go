@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+	git_testutils "go.skia.org/infra/go/git/testutils"
+	"go.skia.org/infra/pinpoint/go/execution/command"
+	"go.skia.org/infra/pinpoint/go/midpoint"
+	"go.skia.org/infra/pinpoint/go/workflows"
+	pb "go.skia.org/infra/pinpoint/proto/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	commandChart      = "time"
+	commandConfigName = "test"
+)
+
+// newTestCommandBackend returns the config of a command backend for a repo
+// with a commit for each of the values, and their hashes. The benchmark of
+// each commit reports its value for commandChart.
+func newTestCommandBackend(t *testing.T, values ...string) (command.Config, []string) {
+	ctx := context.Background()
+	gb := git_testutils.GitInit(t, ctx)
+	t.Cleanup(gb.Cleanup)
+	var hashes []string
+	for _, v := range values {
+		gb.Add(ctx, "value", v)
+		hashes = append(hashes, gb.CommitMsg(ctx, "value "+v))
+	}
+	return command.Config{
+		Repo:             gb.Dir(),
+		WorkDir:          t.TempDir(),
+		BuildCommand:     []string{"sh", "-c", `mkdir -p out && cp value out/value`},
+		BenchmarkCommand: []string{"sh", "-c", `printf '{"version": 1, "git_hash": "%s", "results": [{"key": {"test": "time"}, "measurement": %s}]}' "$PINPOINT_REVISION" "$(cat out/value)" > "$PINPOINT_RESULTS"`},
+	}, hashes
+}
+
+// newTestCommandActivity returns a CommandActivity with the config as
+// commandConfigName.
+func newTestCommandActivity(cfg command.Config) *CommandActivity {
+	return &CommandActivity{
+		Configs: map[string]command.Config{commandConfigName: cfg},
+	}
+}
+
+func executeCommandSingleCommitRunner(t *testing.T, cfg command.Config, commit string, iterations int32) *testsuite.TestWorkflowEnvironment {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterActivity(newTestCommandActivity(cfg))
+
+	env.ExecuteWorkflow(SingleCommitRunner, &SingleCommitRunnerParams{
+		PinpointJobID:  "job",
+		Iterations:     iterations,
+		Chart:          commandChart,
+		CombinedCommit: midpoint.NewCombinedCommit(midpoint.NewCommit(cfg.Repo, commit)),
+		CommandBackend: &workflows.CommandBackend{Name: commandConfigName, Repo: cfg.Repo},
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	return env
+}
+
+func TestSingleCommitRunner_CommandBackend_ReturnsValues(t *testing.T) {
+	cfg, hashes := newTestCommandBackend(t, "1.5")
+
+	env := executeCommandSingleCommitRunner(t, cfg, hashes[0], 3)
+	require.NoError(t, env.GetWorkflowError())
+
+	var cr *CommitRun
+	require.NoError(t, env.GetWorkflowResult(&cr))
+	require.NotNil(t, cr)
+	assert.Equal(t, buildbucketpb.Status_SUCCESS, cr.Build.Status)
+	assert.Equal(t, hashes[0], cr.Build.Result.Commit)
+	assert.Equal(t, []float64{1.5, 1.5, 1.5}, cr.AllValues(commandChart))
+	assert.Equal(t, []float64{0, 0, 0}, cr.AllErrorValues(commandChart))
+}
+
+func TestSingleCommitRunner_CommandBackendBuildFails_ReturnsError(t *testing.T) {
+	cfg, hashes := newTestCommandBackend(t, "1.5")
+	cfg.BuildCommand = []string{"sh", "-c", "echo compile error && exit 1"}
+
+	env := executeCommandSingleCommitRunner(t, cfg, hashes[0], 3)
+	require.Error(t, env.GetWorkflowError())
+	assert.Contains(t, env.GetWorkflowError().Error(), "build fails at commit")
+}
+
+func TestSingleCommitRunner_CommandBackendBenchmarkFails_ReturnsErrorValues(t *testing.T) {
+	cfg, hashes := newTestCommandBackend(t, "1.5")
+	cfg.BenchmarkCommand = []string{"sh", "-c", "exit 1"}
+
+	env := executeCommandSingleCommitRunner(t, cfg, hashes[0], 2)
+	require.NoError(t, env.GetWorkflowError())
+
+	var cr *CommitRun
+	require.NoError(t, env.GetWorkflowResult(&cr))
+	require.NotNil(t, cr)
+	assert.Empty(t, cr.AllValues(commandChart))
+	assert.Equal(t, []float64{1, 1}, cr.AllErrorValues(commandChart))
+}
+
+func TestFindMidCommitWithCommandActivity_CommitsInBetween_ReturnsMiddleCommit(t *testing.T) {
+	cfg, hashes := newTestCommandBackend(t, "0", "1", "2")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	ca := newTestCommandActivity(cfg)
+	env.RegisterActivity(ca)
+
+	res, err := env.ExecuteActivity(ca.FindMidCommitWithCommandActivity, commandConfigName, hashes[0], hashes[2])
+	require.NoError(t, err)
+	var mid string
+	require.NoError(t, res.Get(&mid))
+	assert.Equal(t, hashes[1], mid)
+
+	res, err = env.ExecuteActivity(ca.FindMidCommitWithCommandActivity, commandConfigName, hashes[0], hashes[1])
+	require.NoError(t, err)
+	require.NoError(t, res.Get(&mid))
+	assert.Equal(t, hashes[0], mid)
+}
+
+func TestBisectWorkflow_CommandBackend_FindsCulprit(t *testing.T) {
+	// The benchmark regresses at the fourth commit. The values are spelled
+	// differently so that every commit changes the file.
+	cfg, hashes := newTestCommandBackend(t, "1", "1.0", "1.00", "2", "2.0")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(SingleCommitRunner, workflow.RegisterOptions{Name: workflows.SingleCommitRunner})
+	ca := newTestCommandActivity(cfg)
+	ca.TaskQueue = "host-task-queue"
+	env.RegisterActivity(ca)
+	env.RegisterActivity(CompareActivity)
+
+	// All the command activities after the backend is resolved run on the
+	// worker that resolved it.
+	taskQueues := map[string]map[string]bool{}
+	env.SetOnActivityStartedListener(func(info *activity.Info, ctx context.Context, args converter.EncodedValues) {
+		if taskQueues[info.ActivityType.Name] == nil {
+			taskQueues[info.ActivityType.Name] = map[string]bool{}
+		}
+		taskQueues[info.ActivityType.Name][info.TaskQueue] = true
+	})
+
+	env.ExecuteWorkflow(BisectWorkflow, &workflows.BisectParams{
+		Request: &pb.ScheduleBisectRequest{
+			StartGitHash:        hashes[0],
+			EndGitHash:          hashes[4],
+			Chart:               commandChart,
+			ComparisonMagnitude: "1",
+		},
+		CommandBackend: &workflows.CommandBackend{Name: commandConfigName},
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var be *BisectExecution
+	require.NoError(t, env.GetWorkflowResult(&be))
+	require.NotNil(t, be)
+	require.Len(t, be.Culprits, 1)
+	assert.Equal(t, hashes[3], be.Culprits[0].Main.GitHash)
+	assert.Equal(t, cfg.Repo, be.Culprits[0].Main.Repository)
+	for _, name := range []string{"BuildCommandActivity", "RunCommandBenchmarkActivity", "FindMidCommitWithCommandActivity"} {
+		assert.Equal(t, map[string]bool{"host-task-queue": true}, taskQueues[name], name)
+	}
+}
+
+func TestBisectWorkflow_UnknownCommandBackend_ReturnsError(t *testing.T) {
+	cfg, hashes := newTestCommandBackend(t, "1", "2")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(SingleCommitRunner, workflow.RegisterOptions{Name: workflows.SingleCommitRunner})
+	env.RegisterActivity(newTestCommandActivity(cfg))
+
+	env.ExecuteWorkflow(BisectWorkflow, &workflows.BisectParams{
+		Request: &pb.ScheduleBisectRequest{
+			StartGitHash: hashes[0],
+			EndGitHash:   hashes[1],
+			Chart:        commandChart,
+		},
+		CommandBackend: &workflows.CommandBackend{Name: "unknown"},
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	assert.Contains(t, env.GetWorkflowError().Error(), `unknown command backend config "unknown"`)
+}
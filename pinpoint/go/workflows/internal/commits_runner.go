@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"errors"
+	"time"
 
 	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
 	apipb "go.chromium.org/luci/swarming/proto/api_v2"
@@ -57,6 +58,10 @@ type SingleCommitRunnerParams struct {
 
 	// Available bot list
 	BotIds []string
+
+	// CommandBackend if set builds and benchmarks the commit with the commands
+	// of a command backend config, see workflows.BisectParams.
+	CommandBackend *workflows.CommandBackend
 }
 
 // CommitRun stores benchmark tests runs for a single commit
@@ -100,6 +105,77 @@ func (cr *CommitRun) AllErrorValues(chart string) []float64 {
 	return vs
 }
 
+// backend builds commits and runs benchmarks on the builds for the workflows,
+// and navigates the history of the repository being bisected.
+//
+// The workflows only depend on the backend through this interface, so a new
+// backend implements it and is returned by newBackend.
+type backend interface {
+	// findBots returns the bots to run the benchmarks of the bisection on,
+	// or nil if the backend doesn't choose them.
+	findBots(ctx workflow.Context, p *workflows.BisectParams) ([]string, error)
+
+	// newCommit returns the commit of the repository at the given git hash.
+	newCommit(gitHash string) *midpoint.CombinedCommit
+
+	// findMidCommit returns the commit between lower and higher, and whether
+	// lower and higher are adjacent, in which case there is none.
+	findMidCommit(ctx workflow.Context, lower, higher *midpoint.CombinedCommit) (*midpoint.CombinedCommit, bool, error)
+
+	// build the commit of the runner params, returning an error if it fails to build.
+	build(ctx workflow.Context, sc *SingleCommitRunnerParams) (*workflows.Build, error)
+
+	// runBenchmark runs the given iteration of the benchmark on the build.
+	runBenchmark(ctx workflow.Context, b *workflows.Build, sc *SingleCommitRunnerParams, iteration int32) (*workflows.TestRun, error)
+}
+
+// newBackend returns the backend for the given command backend, which is nil
+// for Chrome.
+func newBackend(cb *workflows.CommandBackend) backend {
+	if cb != nil {
+		return commandBackend{cb: cb}
+	}
+	return chromeBackend{}
+}
+
+// chromeBackend builds Chrome on Buildbucket and runs benchmarks on Swarming.
+type chromeBackend struct{}
+
+func (chromeBackend) findBots(ctx workflow.Context, p *workflows.BisectParams) ([]string, error) {
+	var botIds []string
+	if err := workflow.ExecuteActivity(ctx, FindAvailableBotsActivity, p.Request.Configuration, time.Now().UnixNano()).Get(ctx, &botIds); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return botIds, nil
+}
+
+func (chromeBackend) newCommit(gitHash string) *midpoint.CombinedCommit {
+	return midpoint.NewCombinedCommit(midpoint.NewChromiumCommit(gitHash))
+}
+
+func (chromeBackend) findMidCommit(ctx workflow.Context, lower, higher *midpoint.CombinedCommit) (*midpoint.CombinedCommit, bool, error) {
+	var mid *midpoint.CombinedCommit
+	if err := workflow.ExecuteActivity(ctx, FindMidCommitActivity, lower, higher).Get(ctx, &mid); err != nil {
+		return nil, false, skerr.Wrap(err)
+	}
+
+	var equal bool
+	if err := workflow.ExecuteActivity(ctx, CheckCombinedCommitEqualActivity, lower, mid).Get(ctx, &equal); err != nil {
+		return nil, false, skerr.Wrapf(err, "failed to determine equality between two combined commits")
+	}
+	return mid, equal, nil
+}
+
+func (chromeBackend) build(ctx workflow.Context, sc *SingleCommitRunnerParams) (*workflows.Build, error) {
+	bctx := workflow.WithChildOptions(ctx, buildWorkflowOptions)
+	return buildChrome(bctx, sc.PinpointJobID, sc.BotConfig, sc.Benchmark, sc.CombinedCommit)
+}
+
+func (chromeBackend) runBenchmark(ctx workflow.Context, b *workflows.Build, sc *SingleCommitRunnerParams, iteration int32) (*workflows.TestRun, error) {
+	botDimensions := getBotDimension(sc.FinishedIteration, iteration, sc.BotIds)
+	return runBenchmark(ctx, sc.CombinedCommit, b.CAS, sc, botDimensions, iteration)
+}
+
 func buildChrome(ctx workflow.Context, jobID, bot, benchmark string, commit *midpoint.CombinedCommit) (*workflows.Build, error) {
 	t, err := bot_configs.GetIsolateTarget(bot, benchmark)
 	if err != nil {
@@ -175,9 +251,8 @@ func runBenchmark(ctx workflow.Context, cc *midpoint.CombinedCommit, cas *apipb.
 //
 // SingleCommitRunner builds, runs and collects benchmark sampled values from one single commit.
 func SingleCommitRunner(ctx workflow.Context, sc *SingleCommitRunnerParams) (*CommitRun, error) {
-	bctx := workflow.WithChildOptions(ctx, buildWorkflowOptions)
-
-	b, err := buildChrome(bctx, sc.PinpointJobID, sc.BotConfig, sc.Benchmark, sc.CombinedCommit)
+	backend := newBackend(sc.CommandBackend)
+	b, err := backend.build(ctx, sc)
 	if err != nil {
 		return nil, skerr.Wrap(err)
 	}
@@ -197,9 +272,7 @@ func SingleCommitRunner(ctx workflow.Context, sc *SingleCommitRunnerParams) (*Co
 		workflow.Go(ctx, func(gCtx workflow.Context) {
 			defer wg.Done()
 
-			botDimensions := getBotDimension(sc.FinishedIteration, iteration, sc.BotIds)
-			tr, err := runBenchmark(gCtx, sc.CombinedCommit, b.CAS, sc, botDimensions, iteration)
-
+			tr, err := backend.runBenchmark(gCtx, b, sc, iteration)
 			if err != nil {
 				ec.Send(gCtx, err)
 				return
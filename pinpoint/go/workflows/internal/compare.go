@@ -46,8 +46,34 @@ func GetAllDataForCompareLocalActivity(ctx context.Context, lbr *BisectRun, hbr
 // UI what two commits are being tested. Errors are recorded in the activity but
 // the ErrorVerdict is not passed back to the main workflow.
 func CompareActivity(ctx context.Context, allValues CommitPairValues, magnitude, errRate float64, direction compare.ImprovementDir) (*CombinedResults, error) {
+	return combineResults(allValues,
+		func(a, b []float64) (*compare.CompareResults, error) {
+			return compare.CompareFunctional(a, b, errRate)
+		},
+		func(a, b []float64) (*compare.CompareResults, error) {
+			return compare.ComparePerformance(a, b, magnitude, direction)
+		})
+}
+
+// CompareSequentialActivity is CompareActivity for one look of a sequential
+// comparison, see compare.SequentialLook.
+func CompareSequentialActivity(ctx context.Context, allValues CommitPairValues, magnitude, errRate float64, direction compare.ImprovementDir, look compare.SequentialLook) (*CombinedResults, error) {
+	return combineResults(allValues,
+		func(a, b []float64) (*compare.CompareResults, error) {
+			return compare.CompareFunctionalSequential(a, b, errRate, look)
+		},
+		func(a, b []float64) (*compare.CompareResults, error) {
+			return compare.ComparePerformanceSequential(a, b, magnitude, direction, look)
+		})
+}
+
+type compareFunc func(valuesA, valuesB []float64) (*compare.CompareResults, error)
+
+// combineResults runs the functional and performance comparisons and decides
+// which verdict to return.
+func combineResults(allValues CommitPairValues, compareFunctional, comparePerformance compareFunc) (*CombinedResults, error) {
 	// TODO(sunxiaodi@): skip functional analysis if there are no errors
-	funcResult, err := compare.CompareFunctional(allValues.Lower.ErrorValues, allValues.Higher.ErrorValues, errRate)
+	funcResult, err := compareFunctional(allValues.Lower.ErrorValues, allValues.Higher.ErrorValues)
 	if err != nil {
 		return &CombinedResults{Result: funcResult, ResultType: functional}, skerr.Wrap(err)
 	}
@@ -59,7 +85,7 @@ func CompareActivity(ctx context.Context, allValues CommitPairValues, magnitude,
 		}, nil
 	}
 
-	perfResult, err := compare.ComparePerformance(allValues.Lower.Values, allValues.Higher.Values, magnitude, direction)
+	perfResult, err := comparePerformance(allValues.Lower.Values, allValues.Higher.Values)
 	if err != nil {
 		return &CombinedResults{
 			Result:      funcResult,
@@ -92,13 +118,21 @@ func CompareActivity(ctx context.Context, allValues CommitPairValues, magnitude,
 	}, nil
 }
 
-func compareRuns(ctx workflow.Context, lRun, hRun *BisectRun, chart string, mag float64, dir compare.ImprovementDir) (*CombinedResults, error) {
+// compareRuns compares the runs of two commits. The comparison is one look of
+// a sequential comparison if look isn't nil.
+func compareRuns(ctx workflow.Context, lRun, hRun *BisectRun, chart string, mag float64, dir compare.ImprovementDir, look *compare.SequentialLook) (*CombinedResults, error) {
 	var commitPairAllValues CommitPairValues
 	if err := workflow.ExecuteLocalActivity(ctx, GetAllDataForCompareLocalActivity, lRun, hRun, chart).Get(ctx, &commitPairAllValues); err != nil {
 		return nil, skerr.Wrap(err)
 	}
 
 	var result *CombinedResults
+	if look != nil {
+		if err := workflow.ExecuteActivity(ctx, CompareSequentialActivity, commitPairAllValues, mag, compare.DefaultFunctionalErrRate, dir, *look).Get(ctx, &result); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		return result, nil
+	}
 	if err := workflow.ExecuteActivity(ctx, CompareActivity, commitPairAllValues, mag, compare.DefaultFunctionalErrRate, dir).Get(ctx, &result); err != nil {
 		return nil, skerr.Wrap(err)
 	}
@@ -175,3 +175,43 @@ func TestCompareActivity_FunctionalSame_ReturnsPerformance(t *testing.T) {
 	assert.Equal(t, expectedFunc, actual.OtherResult)
 	assert.Equal(t, performance, actual.ResultType)
 }
+
+func TestCompareSequentialActivity_UnknownAtLastLook_ReturnsSame(t *testing.T) {
+	xErr := []float64{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	x := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	y := []float64{1.5, 2.5, 3.5, 4.5, 5.5, 6.5, 7.5, 8.5, 9.5, 10.5}
+	const magnitude = 1.5
+	cpv := CommitPairValues{
+		Lower: CommitValues{
+			Values:      x,
+			ErrorValues: xErr,
+		},
+		Higher: CommitValues{
+			Values:      y,
+			ErrorValues: xErr,
+		},
+	}
+	look := compare.SequentialLook{
+		Alpha:         0.05,
+		MaxSampleSize: 20,
+		SampleSize:    10,
+	}
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(CompareSequentialActivity)
+	res, err := env.ExecuteActivity(CompareSequentialActivity, cpv, magnitude, 1.0, compare.UnknownDir, look)
+	require.NoError(t, err)
+	var actual *CombinedResults
+	require.NoError(t, res.Get(&actual))
+	assert.Equal(t, performance, actual.ResultType)
+	assert.Equal(t, compare.Unknown, actual.Result.Verdict)
+	assert.Equal(t, look.LowThreshold(), actual.Result.LowThreshold)
+
+	look.MaxSampleSize = 10
+	res, err = env.ExecuteActivity(CompareSequentialActivity, cpv, magnitude, 1.0, compare.UnknownDir, look)
+	require.NoError(t, err)
+	require.NoError(t, res.Get(&actual))
+	assert.Equal(t, performance, actual.ResultType)
+	assert.Equal(t, compare.Same, actual.Result.Verdict)
+}
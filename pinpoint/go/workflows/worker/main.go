@@ -3,10 +3,12 @@ package main
 import (
 	"flag"
 	"fmt"
+	"os"
 	"os/user"
 
 	"go.skia.org/infra/go/common"
 	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/pinpoint/go/execution/command"
 	"go.skia.org/infra/pinpoint/go/workflows"
 	"go.skia.org/infra/pinpoint/go/workflows/catapult"
 	"go.skia.org/infra/pinpoint/go/workflows/internal"
@@ -23,37 +25,15 @@ var (
 	promPort  = flag.String("promPort", ":8000", "Prometheus port that it listens on.")
 	namespace = flag.String("namespace", "default", "The namespace the worker registered to.")
 	taskQueue = flag.String("taskQueue", "", "Task queue name registered to worker services.")
-)
-
-func main() {
-	flag.Parse()
-
-	common.InitWithMust(
-		appName,
-		common.PrometheusOpt(promPort),
-	)
-
-	if *taskQueue == "" {
-		if u, err := user.Current(); err != nil {
-			sklog.Fatalf("Unable to get the current user: %s", err)
-		} else {
-			*taskQueue = fmt.Sprintf("localhost.%s", u.Username)
-		}
-	}
 
-	// The client and worker are heavyweight objects that should be created once per process.
-	c, err := client.Dial(client.Options{
-		MetricsHandler: metrics.NewMetricsHandler(map[string]string{}, nil),
-		HostPort:       *hostPort,
-		Namespace:      *namespace,
-	})
-	if err != nil {
-		sklog.Fatalf("Unable to create client: %s", err)
-	}
-	defer c.Close()
-
-	w := worker.New(c, *taskQueue, worker.Options{})
+	enableCommandBackend    = flag.Bool("enable_command_backend", false, "Also poll the task queue of bisections that use the command backend, which run the build and benchmark commands of --command_backend_configs on this worker.")
+	commandBackendConfigs   = flag.String("command_backend_configs", "", "JSON file of the command backend configs that bisections may select by name, see command.LoadConfigs. Required if --enable_command_backend is set.")
+	commandBackendTaskQueue = flag.String("command_backend_task_queue", workflows.CommandBackendTaskQueue, "Task queue of bisections that use the command backend, if --enable_command_backend is set.")
+	commandBackendWorkDir   = flag.String("command_backend_work_dir", "/tmp/pinpoint_command_backend", "Directory holding the checkouts and builds of the command backend, if --enable_command_backend is set.")
+)
 
+// registerWorkflows registers the workflows and activities of Pinpoint.
+func registerWorkflows(w worker.Worker) {
 	bca := &internal.BuildChromeActivity{}
 	w.RegisterActivity(bca)
 	w.RegisterWorkflowWithOptions(internal.BuildChrome, workflow.RegisterOptions{Name: workflows.BuildChrome})
@@ -67,6 +47,7 @@ func main() {
 	w.RegisterWorkflowWithOptions(internal.SingleCommitRunner, workflow.RegisterOptions{Name: workflows.SingleCommitRunner})
 
 	w.RegisterActivity(internal.CompareActivity)
+	w.RegisterActivity(internal.CompareSequentialActivity)
 	w.RegisterActivity(internal.FindMidCommitActivity)
 	w.RegisterActivity(internal.CheckCombinedCommitEqualActivity)
 	w.RegisterActivity(internal.ReportStatusActivity)
@@ -87,6 +68,75 @@ func main() {
 	w.RegisterActivity(catapult.WriteBisectToCatapultActivity)
 	w.RegisterWorkflowWithOptions(catapult.CatapultBisectWorkflow, workflow.RegisterOptions{Name: workflows.CatapultBisect})
 	w.RegisterWorkflowWithOptions(catapult.ConvertToCatapultResponseWorkflow, workflow.RegisterOptions{Name: workflows.ConvertToCatapultResponseWorkflow})
+}
+
+func main() {
+	flag.Parse()
+
+	common.InitWithMust(
+		appName,
+		common.PrometheusOpt(promPort),
+	)
+
+	if *taskQueue == "" {
+		if u, err := user.Current(); err != nil {
+			sklog.Fatalf("Unable to get the current user: %s", err)
+		} else {
+			*taskQueue = fmt.Sprintf("localhost.%s", u.Username)
+		}
+	}
+
+	// The client and worker are heavyweight objects that should be created once per process.
+	c, err := client.Dial(client.Options{
+		MetricsHandler: metrics.NewMetricsHandler(map[string]string{}, nil),
+		HostPort:       *hostPort,
+		Namespace:      *namespace,
+	})
+	if err != nil {
+		sklog.Fatalf("Unable to create client: %s", err)
+	}
+	defer c.Close()
+
+	w := worker.New(c, *taskQueue, worker.Options{})
+	registerWorkflows(w)
+
+	// The command backend runs the commands of its configs, so it only runs
+	// on workers which opt in, and on a task queue of its own. Requests only
+	// select a config by name.
+	if *enableCommandBackend {
+		if *commandBackendConfigs == "" {
+			sklog.Fatal("--command_backend_configs is required with --enable_command_backend")
+		}
+		configs, err := command.LoadConfigs(*commandBackendConfigs, *commandBackendWorkDir)
+		if err != nil {
+			sklog.Fatalf("Unable to load the command backend configs: %s", err)
+		}
+		hostname, err := os.Hostname()
+		if err != nil {
+			sklog.Fatalf("Unable to get the hostname: %s", err)
+		}
+		ca := &internal.CommandActivity{
+			Configs:   configs,
+			TaskQueue: fmt.Sprintf("%s.%s", *commandBackendTaskQueue, hostname),
+		}
+		cw := worker.New(c, *commandBackendTaskQueue, worker.Options{})
+		registerWorkflows(cw)
+		cw.RegisterActivity(ca)
+		if err := cw.Start(); err != nil {
+			sklog.Fatalf("Unable to start command backend worker: %s", err)
+		}
+		defer cw.Stop()
+
+		// Builds are kept on the local disk, so each bisection runs its
+		// command activities on the task queue of the worker it was pinned
+		// to, see CommandActivity.TaskQueue.
+		hw := worker.New(c, ca.TaskQueue, worker.Options{DisableWorkflowWorker: true})
+		hw.RegisterActivity(ca)
+		if err := hw.Start(); err != nil {
+			sklog.Fatalf("Unable to start command backend host worker: %s", err)
+		}
+		defer hw.Stop()
+	}
 
 	err = w.Run(worker.InterruptCh())
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
 	apipb "go.chromium.org/luci/swarming/proto/api_v2"
 	"go.skia.org/infra/pinpoint/go/compare"
+	"go.skia.org/infra/pinpoint/go/execution"
 	"go.skia.org/infra/pinpoint/go/midpoint"
 	"go.skia.org/infra/pinpoint/go/run_benchmark"
 	pb "go.skia.org/infra/pinpoint/proto/v1"
@@ -33,6 +34,11 @@ const (
 	BugUpdate                         = "perf.bug_update"
 )
 
+// CommandBackendTaskQueue is the task queue of the bisections that set
+// BisectParams.CommandBackend. Only workers started with
+// --enable_command_backend poll it, as they run the commands of their configs.
+const CommandBackendTaskQueue = "perf.perf-chrome-public.command-bisect"
+
 const defaultPairwiseAttemptCount int32 = 30
 
 // Workflow params definitions.
@@ -66,6 +72,9 @@ type Build struct {
 	Status buildbucketpb.Status
 	// CAS is the CAS address of the build isolate.
 	CAS *apipb.CASReference
+	// Result is the build made by the command backend, see
+	// BisectParams.CommandBackend. It is nil for Chrome builds.
+	Result *execution.BuildResult
 }
 
 // TestRun stores individual benchmark test run.
@@ -113,6 +122,36 @@ type BisectParams struct {
 	Production bool
 	// JobID for the bisect run
 	JobID string
+	// CommandBackend if set bisects the repository of the named command
+	// backend config of the workers, by building and benchmarking commits
+	// with the commands of the config on the worker, instead of building
+	// Chrome on Buildbucket and benchmarking it on Swarming.
+	// Request.StartGitHash and Request.EndGitHash are commits of that
+	// repository, and Request.Chart selects the results reported by the
+	// benchmark command. Request.Configuration and Request.Story are unused.
+	// The workflow must run on CommandBackendTaskQueue.
+	CommandBackend *CommandBackend
+	// SequentialBatchSize if positive compares commits with sequential
+	// testing, see compare.SequentialLook. Instead of doubling the runs of two
+	// commits until the thresholds decide, runs are added in batches of this
+	// size, and the comparison stops as soon as it is confidently the same or
+	// different.
+	SequentialBatchSize int32
+}
+
+// CommandBackend selects the command backend config that a bisection builds
+// and benchmarks commits with, see BisectParams.CommandBackend.
+type CommandBackend struct {
+	// Name of the config. The configs are defined by the workers, see
+	// --command_backend_configs, so requests can't run commands of their own.
+	Name string
+	// Repo is the repository of the config.
+	// This field is for internal use, it is set by the workflow.
+	Repo string
+	// TaskQueue is the task queue of the worker which builds and benchmarks
+	// all the commits of the bisection.
+	// This field is for internal use, it is set by the workflow.
+	TaskQueue string
 }
 
 // GetMagnitude returns the magnitude as float64.
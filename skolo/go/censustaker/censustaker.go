@@ -3,6 +3,11 @@
 // addresses are attached to which ports, so we need another source of data to give us a list of
 // hostnames and ip addresses to be able to generate the mapping of hostname to port number needed
 // by powercycle.
+//
+// The devices found can also be reconciled against the machines known to
+// machineserver, the previous census, and the powercycle config, to report
+// unknown devices, machines that moved to a different port, and powercycle
+// config entries that point at the wrong port.
 package main
 
 import (
@@ -13,6 +18,7 @@ import (
 	"os"
 	"regexp"
 
+	"go.skia.org/infra/go/httputils"
 	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/skolo/go/powercycle"
 )
@@ -74,7 +80,10 @@ func combineSources(ctx context.Context, names nameAddressGetter, ports addressP
 	return devices, nil
 }
 
-func makeConfig(ctx context.Context, address, user, password string, hostnameMatcher *regexp.Regexp) (powercycle.EdgeSwitchConfig, error) {
+// makeConfig returns the powercycle config for the devices attached to the
+// switch whose hostnames match hostnameMatcher, along with all the devices
+// found on the switch.
+func makeConfig(ctx context.Context, address, user, password string, hostnameMatcher *regexp.Regexp) (powercycle.EdgeSwitchConfig, []poeDevice, error) {
 	output := powercycle.EdgeSwitchConfig{
 		Address:    address,
 		User:       user,
@@ -85,14 +94,52 @@ func makeConfig(ctx context.Context, address, user, password string, hostnameMat
 	edgeswitch := newSwitchPortGetter(address, user, password)
 	devices, err := combineSources(ctx, arp, edgeswitch)
 	if err != nil {
-		return output, skerr.Wrap(err)
+		return output, nil, skerr.Wrap(err)
 	}
 	for _, device := range devices {
 		if hostnameMatcher.MatchString(device.Hostname) {
 			output.DevPortMap[powercycle.DeviceID(device.Hostname)] = device.POEPort
 		}
 	}
-	return output, nil
+	return output, devices, nil
+}
+
+// reconcileCensus reconciles the devices against the sources given by the
+// flags, skipping any that are empty, and updates the census file.
+func reconcileCensus(ctx context.Context, devices []poeDevice, hostnameMatcher *regexp.Regexp, switchAddress, machineServer, powercycleConfig, censusFile string) (report, error) {
+	var machineIDs []string
+	if machineServer != "" {
+		var err error
+		machineIDs, err = fetchMachineIDs(ctx, httputils.NewTimeoutClient(), machineServer)
+		if err != nil {
+			return report{}, skerr.Wrap(err)
+		}
+	}
+	var powercycleConfigs map[string]*powercycle.EdgeSwitchConfig
+	if powercycleConfig != "" {
+		var err error
+		powercycleConfigs, err = powercycle.EdgeSwitchConfigsFromJSON5(powercycleConfig)
+		if err != nil {
+			return report{}, skerr.Wrap(err)
+		}
+	}
+	var previous []poeDevice
+	if censusFile != "" {
+		var err error
+		previous, err = readCensus(censusFile)
+		if err != nil {
+			return report{}, skerr.Wrap(err)
+		}
+	}
+
+	ret := reconcile(devices, hostnameMatcher, switchAddress, machineIDs, previous, powercycleConfigs)
+
+	if censusFile != "" {
+		if err := writeCensus(censusFile, devices); err != nil {
+			return report{}, skerr.Wrap(err)
+		}
+	}
+	return ret, nil
 }
 
 func main() {
@@ -101,11 +148,16 @@ func main() {
 		switchUser     = flag.String("switch_user", "power", "Username of the switch")
 		switchPassword = flag.String("switch_password", "", "password for the switch user")
 		hostnameRegex  = flag.String("hostname_regex", "rpi", "Regex to match hostnames for")
+
+		machineServer    = flag.String("machine_server", "", "A URL with the scheme and domain name of the machine server, e.g. https://machines.skia.org. If set, devices matching hostname_regex that machineserver doesn't know about are reported.")
+		powercycleConfig = flag.String("powercycle_config", "", "Path to the powercycle JSON5 config. If set, EdgeSwitch entries that point at the wrong port are reported.")
+		censusFile       = flag.String("census_file", "", "Path to the JSON file the census is stored in. If set, machines whose port changed since the previous census are reported, and the file is updated.")
 	)
 	flag.Parse()
 
 	ctx := context.Background()
-	out, err := makeConfig(ctx, *switchAddress, *switchUser, *switchPassword, regexp.MustCompile(*hostnameRegex))
+	hostnameMatcher := regexp.MustCompile(*hostnameRegex)
+	out, devices, err := makeConfig(ctx, *switchAddress, *switchUser, *switchPassword, hostnameMatcher)
 	if err != nil {
 		fmt.Printf("Error: %s\n", err)
 		os.Exit(1)
@@ -116,4 +168,17 @@ func main() {
 		os.Exit(2)
 	}
 	fmt.Println(string(b))
+
+	if *machineServer == "" && *powercycleConfig == "" && *censusFile == "" {
+		return
+	}
+	// The report goes to stderr so that stdout remains valid JSON.
+	r, err := reconcileCensus(ctx, devices, hostnameMatcher, *switchAddress, *machineServer, *powercycleConfig, *censusFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reconciling: %s\n", err)
+		os.Exit(3)
+	}
+	if err := r.Write(os.Stderr); err != nil {
+		os.Exit(3)
+	}
 }
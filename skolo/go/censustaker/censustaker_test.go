@@ -20,7 +20,7 @@ func TestMakeConfig_Success(t *testing.T) {
 		"Test_FakeExe_EdgeSwitch_ReturnsTable",
 	)
 
-	out, err := makeConfig(ctx, fakeAddress, fakeUser, fakePassword, regexp.MustCompile("rpi"))
+	out, devices, err := makeConfig(ctx, fakeAddress, fakeUser, fakePassword, regexp.MustCompile("rpi"))
 	require.NoError(t, err)
 
 	// The following machines have a mac address that appears in both the arpOutput as well as
@@ -41,6 +41,8 @@ func TestMakeConfig_Success(t *testing.T) {
 			"skia-rpi-042": 42,
 		},
 	}, out)
+	// All devices are returned, regardless of the hostname matching.
+	assert.Len(t, devices, 9)
 }
 
 func Test_FakeExe_Arp_ReturnsTable(t *testing.T) {
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machineserver/rpc"
+	"go.skia.org/infra/skolo/go/powercycle"
+)
+
+// portChange is a device that was found on a different switch port than in
+// the previous census.
+type portChange struct {
+	Hostname     string
+	MACAddress   string
+	PreviousPort int
+	CurrentPort  int
+}
+
+// powerCycleMismatch is a powercycle config entry that doesn't agree with
+// where the device was found.
+type powerCycleMismatch struct {
+	MachineID string
+
+	// Switch is the name of the EdgeSwitch in the powercycle config.
+	Switch string
+
+	// ConfiguredPort is the port in the powercycle config.
+	ConfiguredPort int
+
+	// FoundPort is the port of the census switch the machine was found on, or
+	// 0 if it wasn't found.
+	FoundPort int
+
+	// PortOccupant is the hostname of a different device that was found on
+	// ConfiguredPort, if any. Power-cycling the machine would power-cycle this
+	// device instead.
+	PortOccupant string
+}
+
+// report is the result of reconciling a census against the machineserver
+// inventory, the previous census, and the powercycle config.
+type report struct {
+	// UnknownDevices are devices whose hostnames match the hostname regex, but
+	// that machineserver has no Description for. Other devices on the switch,
+	// e.g. routers and printers, are never reported.
+	UnknownDevices []poeDevice
+
+	// PortChanges are devices that moved since the previous census.
+	PortChanges []portChange
+
+	// PowerCycleMismatches are powercycle config entries that point at the
+	// wrong switch or port.
+	PowerCycleMismatches []powerCycleMismatch
+}
+
+// IsEmpty returns true if no discrepancies were found.
+func (r report) IsEmpty() bool {
+	return len(r.UnknownDevices) == 0 && len(r.PortChanges) == 0 && len(r.PowerCycleMismatches) == 0
+}
+
+// Write the report in a human readable form.
+func (r report) Write(w io.Writer) error {
+	var lines []string
+	for _, d := range r.UnknownDevices {
+		lines = append(lines, fmt.Sprintf("Unknown device %s (%s) is on port %d.", d.Hostname, d.MACAddress, d.POEPort))
+	}
+	for _, c := range r.PortChanges {
+		lines = append(lines, fmt.Sprintf("%s (%s) moved from port %d to port %d.", c.Hostname, c.MACAddress, c.PreviousPort, c.CurrentPort))
+	}
+	for _, m := range r.PowerCycleMismatches {
+		line := fmt.Sprintf("Powercycle config for %s points at port %d of %s", m.MachineID, m.ConfiguredPort, m.Switch)
+		if m.FoundPort != 0 {
+			line += fmt.Sprintf(", but it is on port %d", m.FoundPort)
+		}
+		if m.PortOccupant != "" {
+			line += fmt.Sprintf(", which %s is attached to", m.PortOccupant)
+		}
+		lines = append(lines, line+".")
+	}
+	for _, line := range lines {
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return skerr.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// reconcile compares the devices found attached to the switch at switchAddress
+// against the IDs of the machines known to machineserver, only considering the
+// devices whose hostnames match hostnameMatcher, the devices found in
+// the previous census, and the EdgeSwitch powercycle configs keyed by switch
+// name. Any of machineIDs, previous, and powercycleConfigs may be nil, in which
+// case that comparison is skipped.
+func reconcile(devices []poeDevice, hostnameMatcher *regexp.Regexp, switchAddress string, machineIDs []string, previous []poeDevice, powercycleConfigs map[string]*powercycle.EdgeSwitchConfig) report {
+	var ret report
+	byHostname := map[string]poeDevice{}
+	byPort := map[int]poeDevice{}
+	for _, d := range devices {
+		byHostname[d.Hostname] = d
+		byPort[d.POEPort] = d
+	}
+
+	if machineIDs != nil {
+		known := util.NewStringSet(machineIDs)
+		for _, d := range devices {
+			if hostnameMatcher.MatchString(d.Hostname) && !known[d.Hostname] {
+				ret.UnknownDevices = append(ret.UnknownDevices, d)
+			}
+		}
+		sort.Slice(ret.UnknownDevices, func(i, j int) bool {
+			return ret.UnknownDevices[i].POEPort < ret.UnknownDevices[j].POEPort
+		})
+	}
+
+	for _, p := range previous {
+		d, ok := byHostname[p.Hostname]
+		if !ok || d.POEPort == p.POEPort {
+			continue
+		}
+		ret.PortChanges = append(ret.PortChanges, portChange{
+			Hostname:     d.Hostname,
+			MACAddress:   d.MACAddress,
+			PreviousPort: p.POEPort,
+			CurrentPort:  d.POEPort,
+		})
+	}
+	sort.Slice(ret.PortChanges, func(i, j int) bool {
+		return ret.PortChanges[i].Hostname < ret.PortChanges[j].Hostname
+	})
+
+	for name, conf := range powercycleConfigs {
+		for id, port := range conf.DevPortMap {
+			mismatch := powerCycleMismatch{
+				MachineID:      string(id),
+				Switch:         name,
+				ConfiguredPort: port,
+			}
+			found, isFound := byHostname[string(id)]
+			if isFound {
+				mismatch.FoundPort = found.POEPort
+			}
+			if conf.Address != switchAddress {
+				// The census only knows about its own switch, so only report
+				// machines that are configured on another switch but were
+				// found on this one.
+				if isFound {
+					ret.PowerCycleMismatches = append(ret.PowerCycleMismatches, mismatch)
+				}
+				continue
+			}
+			if occupant, ok := byPort[port]; ok && occupant.Hostname != string(id) {
+				mismatch.PortOccupant = occupant.Hostname
+			}
+			if (isFound && found.POEPort != port) || mismatch.PortOccupant != "" {
+				ret.PowerCycleMismatches = append(ret.PowerCycleMismatches, mismatch)
+			}
+		}
+	}
+	sort.Slice(ret.PowerCycleMismatches, func(i, j int) bool {
+		return ret.PowerCycleMismatches[i].MachineID < ret.PowerCycleMismatches[j].MachineID
+	})
+
+	return ret
+}
+
+// fetchMachineIDs returns the IDs of all the machines known to the
+// machineserver at the given URL, e.g. "https://machines.skia.org".
+func fetchMachineIDs(ctx context.Context, client *http.Client, machineServer string) ([]string, error) {
+	u, err := url.Parse(machineServer)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "parsing machineserver URL %q", machineServer)
+	}
+	u.Path = rpc.MachineListURL
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "requesting the list of machines")
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, skerr.Fmt("requesting the list of machines: got status %s", resp.Status)
+	}
+	var descriptions []machine.Description
+	if err := json.NewDecoder(resp.Body).Decode(&descriptions); err != nil {
+		return nil, skerr.Wrapf(err, "decoding the list of machines")
+	}
+	ret := make([]string, 0, len(descriptions))
+	for _, d := range descriptions {
+		if ids := d.Dimensions[machine.DimID]; len(ids) > 0 {
+			ret = append(ret, ids[0])
+		}
+	}
+	return ret, nil
+}
+
+// readCensus reads the devices written by writeCensus. A census file that
+// doesn't exist yet is not an error, and nil is returned.
+func readCensus(path string) ([]poeDevice, error) {
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, skerr.Wrapf(err, "reading census %s", path)
+	}
+	var ret []poeDevice
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, skerr.Wrapf(err, "parsing census %s", path)
+	}
+	return ret, nil
+}
+
+// writeCensus writes the devices to the given file, sorted by port.
+func writeCensus(path string, devices []poeDevice) error {
+	sorted := append([]poeDevice{}, devices...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].POEPort < sorted[j].POEPort
+	})
+	return util.WithWriteFile(path, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return skerr.Wrap(enc.Encode(sorted))
+	})
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/machine/go/machine"
+	"go.skia.org/infra/machine/go/machineserver/rpc"
+	"go.skia.org/infra/skolo/go/powercycle"
+)
+
+const otherSwitchAddress = "192.168.1.118"
+
+var rpiMatcher = regexp.MustCompile("rpi")
+
+var censusDevices = []poeDevice{
+	{Hostname: "skia-rpi-001", POEPort: 1, MACAddress: "B8:27:EB:4F:5F:60"},
+	{Hostname: "skia-rpi-007", POEPort: 7, MACAddress: "B8:27:EB:77:A5:1A"},
+	{Hostname: "skia-rpi-011", POEPort: 11, MACAddress: "B8:27:EB:40:8A:F1"},
+	{Hostname: "192.168.1.100", POEPort: 20, MACAddress: "94:C6:91:18:57:D8"},
+}
+
+func TestReconcile_NothingToCompareAgainst_ReportIsEmpty(t *testing.T) {
+	r := reconcile(censusDevices, rpiMatcher, fakeAddress, nil, nil, nil)
+	assert.True(t, r.IsEmpty())
+}
+
+func TestReconcile_DevicesNotInMachineServer_ReportedAsUnknown(t *testing.T) {
+	r := reconcile(censusDevices, regexp.MustCompile(".*"), fakeAddress, []string{"skia-rpi-001", "skia-rpi-011", "skia-rpi-099"}, nil, nil)
+	assert.Equal(t, []poeDevice{censusDevices[1], censusDevices[3]}, r.UnknownDevices)
+	assert.Empty(t, r.PortChanges)
+	assert.Empty(t, r.PowerCycleMismatches)
+}
+
+func TestReconcile_DevicesNotInMachineServerNotMatchingHostnameRegex_NotReported(t *testing.T) {
+	r := reconcile(censusDevices, rpiMatcher, fakeAddress, []string{"skia-rpi-001", "skia-rpi-011"}, nil, nil)
+	// 192.168.1.100 isn't a test machine, e.g. it is a router.
+	assert.Equal(t, []poeDevice{censusDevices[1]}, r.UnknownDevices)
+}
+
+func TestReconcile_DevicesOnDifferentPortThanPreviousCensus_ReportedAsPortChanges(t *testing.T) {
+	previous := []poeDevice{
+		{Hostname: "skia-rpi-001", POEPort: 1, MACAddress: "B8:27:EB:4F:5F:60"},
+		{Hostname: "skia-rpi-007", POEPort: 8, MACAddress: "B8:27:EB:77:A5:1A"},
+		{Hostname: "skia-rpi-011", POEPort: 7, MACAddress: "B8:27:EB:40:8A:F1"},
+		// Devices that are gone aren't reported.
+		{Hostname: "skia-rpi-099", POEPort: 2, MACAddress: "B8:27:EB:00:00:00"},
+	}
+	r := reconcile(censusDevices, rpiMatcher, fakeAddress, nil, previous, nil)
+	assert.Equal(t, []portChange{
+		{Hostname: "skia-rpi-007", MACAddress: "B8:27:EB:77:A5:1A", PreviousPort: 8, CurrentPort: 7},
+		{Hostname: "skia-rpi-011", MACAddress: "B8:27:EB:40:8A:F1", PreviousPort: 7, CurrentPort: 11},
+	}, r.PortChanges)
+	assert.Empty(t, r.UnknownDevices)
+	assert.Empty(t, r.PowerCycleMismatches)
+}
+
+func TestReconcile_PowerCycleConfigDisagreesWithCensus_ReportedAsMismatches(t *testing.T) {
+	configs := map[string]*powercycle.EdgeSwitchConfig{
+		"rack01-shelf1-poe-switch": {
+			Address: fakeAddress,
+			DevPortMap: map[powercycle.DeviceID]int{
+				// Correct.
+				"skia-rpi-001": 1,
+				// Found on a different port.
+				"skia-rpi-007": 8,
+				// Not found, and a different device is on its port.
+				"skia-rpi-050": 11,
+				// Not found, and nothing is on its port.
+				"skia-rpi-051": 12,
+			},
+		},
+		"rack01-shelf2-poe-switch": {
+			Address: otherSwitchAddress,
+			DevPortMap: map[powercycle.DeviceID]int{
+				// Found on the census switch.
+				"skia-rpi-011": 3,
+				// Not on the census switch, so can't be checked.
+				"skia-rpi-052": 4,
+			},
+		},
+	}
+	r := reconcile(censusDevices, rpiMatcher, fakeAddress, nil, nil, configs)
+	assert.Equal(t, []powerCycleMismatch{
+		{MachineID: "skia-rpi-007", Switch: "rack01-shelf1-poe-switch", ConfiguredPort: 8, FoundPort: 7},
+		{MachineID: "skia-rpi-011", Switch: "rack01-shelf2-poe-switch", ConfiguredPort: 3, FoundPort: 11},
+		{MachineID: "skia-rpi-050", Switch: "rack01-shelf1-poe-switch", ConfiguredPort: 11, PortOccupant: "skia-rpi-011"},
+	}, r.PowerCycleMismatches)
+	assert.Empty(t, r.UnknownDevices)
+	assert.Empty(t, r.PortChanges)
+}
+
+func TestReportWrite_AllKindsOfDiscrepancies_WritesOneLineEach(t *testing.T) {
+	r := report{
+		UnknownDevices: []poeDevice{censusDevices[3]},
+		PortChanges: []portChange{
+			{Hostname: "skia-rpi-007", MACAddress: "B8:27:EB:77:A5:1A", PreviousPort: 8, CurrentPort: 7},
+		},
+		PowerCycleMismatches: []powerCycleMismatch{
+			{MachineID: "skia-rpi-007", Switch: "rack01-shelf1-poe-switch", ConfiguredPort: 8, FoundPort: 7},
+			{MachineID: "skia-rpi-050", Switch: "rack01-shelf1-poe-switch", ConfiguredPort: 11, PortOccupant: "skia-rpi-011"},
+		},
+	}
+	var b bytes.Buffer
+	require.NoError(t, r.Write(&b))
+	assert.Equal(t, `Unknown device 192.168.1.100 (94:C6:91:18:57:D8) is on port 20.
+skia-rpi-007 (B8:27:EB:77:A5:1A) moved from port 8 to port 7.
+Powercycle config for skia-rpi-007 points at port 8 of rack01-shelf1-poe-switch, but it is on port 7.
+Powercycle config for skia-rpi-050 points at port 11 of rack01-shelf1-poe-switch, which skia-rpi-011 is attached to.
+`, b.String())
+}
+
+func TestFetchMachineIDs_Success(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, rpc.MachineListURL, r.URL.Path)
+		require.NoError(t, json.NewEncoder(w).Encode([]machine.Description{
+			{Dimensions: machine.SwarmingDimensions{machine.DimID: {"skia-rpi-001"}}},
+			{Dimensions: machine.SwarmingDimensions{machine.DimID: {"skia-rpi-011"}}},
+			{Dimensions: machine.SwarmingDimensions{}},
+		}))
+	}))
+	defer ts.Close()
+
+	ids, err := fetchMachineIDs(context.Background(), ts.Client(), ts.URL)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"skia-rpi-001", "skia-rpi-011"}, ids)
+}
+
+func TestFetchMachineIDs_ServerReturnsError_ReturnsError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "oops", http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	_, err := fetchMachineIDs(context.Background(), ts.Client(), ts.URL)
+	require.Error(t, err)
+}
+
+func TestReadCensus_FileDoesNotExist_ReturnsNil(t *testing.T) {
+	devices, err := readCensus(filepath.Join(t.TempDir(), "census.json"))
+	require.NoError(t, err)
+	assert.Nil(t, devices)
+}
+
+func TestWriteCensus_ReadCensus_RoundTrips(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "census.json")
+	require.NoError(t, writeCensus(path, []poeDevice{censusDevices[3], censusDevices[0]}))
+	devices, err := readCensus(path)
+	require.NoError(t, err)
+	// Sorted by port.
+	assert.Equal(t, []poeDevice{censusDevices[0], censusDevices[3]}, devices)
+}
@@ -3,35 +3,25 @@
 package main
 
 import (
+	"context"
 	"flag"
-	"fmt"
 	"time"
 
 	"go.skia.org/infra/go/common"
-	"go.skia.org/infra/go/metrics2"
-	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/skolo/go/sensors"
 )
 
 var (
 	promPort      = flag.String("prom_port", ":20000", "Metrics service address (e.g., ':10110')")
-	serialDevice  = flag.String("serial_device", "", "Serial device (e.g., '/dev/ttyACM0' or 'COM1')")
+	serialDevice  = flag.String("serial_device", "", "Serial device of a DLP-TH1C sensor module (e.g., '/dev/ttyACM0' or 'COM1')")
+	configFile    = flag.String("config", "", "JSON5 file describing the sensors to read and the thresholds for their readings. See example.json5.")
 	metricsPrefix = flag.String("metric_prefix", "", "String to prefix metric names (e.g., 'skolo_')")
+	interval      = flag.Duration("interval", time.Minute, "How often to read the sensors.")
 )
 
-func openDevice(portName string) (*sensors.DLPTH1C, error) {
-	d, err := sensors.NewDLPTH1C(portName)
-	if err != nil {
-		return nil, skerr.Wrapf(err, `error opening port "%s"`, portName)
-	}
-	const maxPings = 5
-	err = d.ConfirmConnection(maxPings)
-	if err != nil {
-		return nil, skerr.Wrapf(err, "error confirming connection to device")
-	}
-	return d, nil
-}
+// serialDeviceSensorName is the name of the sensor given by --serial_device.
+const serialDeviceSensorName = "dlpth1c"
 
 func main() {
 	common.InitWithMust(
@@ -39,41 +29,35 @@ func main() {
 		common.PrometheusOpt(promPort),
 	)
 
-	if *serialDevice == "" {
-		sklog.Fatal(`"serial_device" is a required parameter.`)
+	if *serialDevice == "" && *configFile == "" {
+		sklog.Fatal(`At least one of "serial_device" or "config" is required.`)
 	}
 	if *metricsPrefix == "" {
 		sklog.Fatal(`"metric_prefix" is a required parameter.`)
 	}
 
-	d, err := openDevice(*serialDevice)
-	if err != nil {
-		sklog.Fatal(err)
-	}
-	tempMetric := metrics2.GetFloat64Metric(fmt.Sprintf("%stemp_c", *metricsPrefix))
-	humidityMetric := metrics2.GetFloat64Metric(fmt.Sprintf("%shumidity", *metricsPrefix))
-	lightMetric := metrics2.GetFloat64Metric(fmt.Sprintf("%slight", *metricsPrefix))
-	soundMetric := metrics2.GetFloat64Metric(fmt.Sprintf("%ssound_db", *metricsPrefix))
-	for range time.Tick(time.Minute) {
-		t, err := d.GetTemperature()
+	var cfg sensors.Config
+	if *configFile != "" {
+		var err error
+		cfg, err = sensors.ReadConfig(*configFile)
 		if err != nil {
-			sklog.Fatalf("Error reading temperature: %s", err)
+			sklog.Fatal(err)
 		}
-		tempMetric.Update(float64(t))
-		h, err := d.GetHumidity()
-		if err != nil {
-			sklog.Fatalf("Error reading humidity: %s", err)
-		}
-		humidityMetric.Update(float64(h))
-		light, err := d.GetLight()
-		if err != nil {
-			sklog.Fatalf("Error reading light level: %s", err)
-		}
-		lightMetric.Update(float64(light))
-		s, err := d.GetBroadbandSound()
-		if err != nil {
-			sklog.Fatalf("Error reading sound level: %s", err)
+	}
+	if *serialDevice != "" {
+		if cfg.DLPTH1C == nil {
+			cfg.DLPTH1C = map[string]*sensors.DLPTH1CConfig{}
 		}
-		soundMetric.Update(float64(s))
+		cfg.DLPTH1C[serialDeviceSensorName] = &sensors.DLPTH1CConfig{SerialDevice: *serialDevice}
+	}
+
+	s, err := cfg.Sensors()
+	if err != nil {
+		sklog.Fatal(err)
+	}
+	m, err := sensors.NewMonitor(*metricsPrefix, s, cfg.Thresholds)
+	if err != nil {
+		sklog.Fatal(err)
 	}
+	m.Start(context.Background(), *interval)
 }
@@ -63,6 +63,12 @@ type config struct {
 
 	// SynaccessPDU aggregates all PDUs produced by Synaccess (https://www.synaccess-net.com/)
 	SynaccessPDU map[controllerName]*SynaccessConfig `json:"synaccess"`
+
+	// Redfish aggregates all BMCs which implement the DMTF Redfish API.
+	Redfish map[controllerName]*RedfishConfig `json:"redfish"`
+
+	// SNMPPDU aggregates all PDUs which are managed over SNMP.
+	SNMPPDU map[controllerName]*SNMPConfig `json:"snmp"`
 }
 
 // multiController allows us to combine multiple Controller implementations into one.
@@ -178,6 +184,44 @@ func controllerFromConfig(ctx context.Context, conf config, connect bool, contro
 		}
 	}
 
+	// Add the Redfish devices.
+	for name, c := range conf.Redfish {
+		rf, err := newRedfishController(ctx, string(name), c, connect)
+		if err != nil {
+			sklog.Errorf("failed to initialize %s: %s", name, err)
+			if err := controllerInitCallback(updatePowerCycleStateRequestFromController(rf, machine.InError)); err != nil {
+				return nil, skerr.Wrap(err)
+			}
+			continue
+		}
+
+		if err := ret.add(rf); err != nil {
+			return nil, skerr.Wrapf(err, "incorporating %s", name)
+		}
+		if err := controllerInitCallback(updatePowerCycleStateRequestFromController(rf, machine.Available)); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+
+	// Add the SNMP PDU devices.
+	for name, c := range conf.SNMPPDU {
+		sn, err := newSNMPController(ctx, string(name), c, connect)
+		if err != nil {
+			sklog.Errorf("failed to initialize %s: %s", name, err)
+			if err := controllerInitCallback(updatePowerCycleStateRequestFromController(sn, machine.InError)); err != nil {
+				return nil, skerr.Wrap(err)
+			}
+			continue
+		}
+
+		if err := ret.add(sn); err != nil {
+			return nil, skerr.Wrapf(err, "incorporating %s", name)
+		}
+		if err := controllerInitCallback(updatePowerCycleStateRequestFromController(sn, machine.Available)); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+
 	return ret, nil
 }
 
@@ -193,6 +237,20 @@ func ControllerFromJSON5(ctx context.Context, path string, connect bool, cb Cont
 	return controllerFromConfig(ctx, conf, connect, cb)
 }
 
+// EdgeSwitchConfigsFromJSON5 returns the EdgeSwitch configurations in the
+// given JSON5 file, keyed by the name of the switch.
+func EdgeSwitchConfigsFromJSON5(path string) (map[string]*EdgeSwitchConfig, error) {
+	conf, err := readConfig(path)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	ret := make(map[string]*EdgeSwitchConfig, len(conf.EdgeSwitch))
+	for name, c := range conf.EdgeSwitch {
+		ret[string(name)] = c
+	}
+	return ret, nil
+}
+
 // ControllerFromJSON5Bytes parses a JSON5 file and instantiates the defined
 // devices. If connect is true, an attempt will be made to connect to the
 // subclients and errors will be returned if they are not accessible. The
@@ -32,6 +32,9 @@ func TestControllerFromJSON5_ConfigIsNonEmpty(t *testing.T) {
 		"skia-e-linux-011",
 		"skia-e-linux-012",
 		"skia-e-linux-013",
+		"skia-e-linux-101",
+		"skia-e-linux-102",
+		"skia-e-linux-103",
 		"test-relay-1",
 		"skia-rpi-003-device",
 		"skia-i-rpi-096",
@@ -67,6 +70,21 @@ func TestControllerFromJSON5_ConfigIsNonEmpty(t *testing.T) {
 	}
 }
 
+func TestEdgeSwitchConfigsFromJSON5_ReturnsSwitchesByName(t *testing.T) {
+	confs, err := EdgeSwitchConfigsFromJSON5("./example.json5")
+	require.NoError(t, err)
+	require.Len(t, confs, 3)
+	conf := confs["rack01-shelf2-poe-switch"]
+	require.NotNil(t, conf)
+	assert.Equal(t, "192.168.1.42", conf.Address)
+	assert.Equal(t, 23, conf.DevPortMap["skia-rpi-2-TEST"])
+}
+
+func TestEdgeSwitchConfigsFromJSON5_FileDoesNotExist_ReturnsError(t *testing.T) {
+	_, err := EdgeSwitchConfigsFromJSON5("./does-not-exist.json5")
+	require.Error(t, err)
+}
+
 func TestControllerFromJSON5_ControllerInitCBReturnsError_ControllerFromJSON5ReturnsError(t *testing.T) {
 
 	controllerInitCallback := func(update rpc.UpdatePowerCycleStateRequest) error {
@@ -0,0 +1,191 @@
+package powercycle
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"go.skia.org/infra/go/httputils"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+const (
+	// Amount of time to wait between turning a server off and on again.
+	powerOffDelayRedfish = 10 * time.Second
+
+	// Values for the ResetType of the ComputerSystem.Reset action.
+	redfishForceOff = "ForceOff"
+	redfishOn       = "On"
+
+	// Path of the collection of ComputerSystems on the BMC.
+	redfishSystemsPath = "/redfish/v1/Systems"
+
+	redfishPasswordEnvVar = "POWERCYCLE_REDFISH_PASSWORD"
+)
+
+// RedfishConfig contains configuration options for a single BMC which
+// implements the DMTF Redfish API, eg. iDRAC, iLO or OpenBMC. Authentication is
+// handled via HTTP basic auth.
+type RedfishConfig struct {
+	// Address of the BMC, i.e. https://192.168.1.50
+	Address string `json:"address"`
+
+	// User to authenticate as.
+	User string `json:"user"`
+
+	// Password for User. This can also be set by the environment variable
+	// "POWERCYCLE_REDFISH_PASSWORD".
+	Password string `json:"password"`
+
+	// InsecureSkipVerify disables verification of the BMC's TLS certificate,
+	// which is usually self-signed.
+	InsecureSkipVerify bool `json:"insecure_skip_verify"`
+
+	// Mapping between device id and the ID of the ComputerSystem on the BMC,
+	// i.e. "1" or "System.Embedded.1".
+	DevSystemMap map[DeviceID]string `json:"systems"`
+}
+
+// Validate returns an error if the configuration is not complete.
+func (c *RedfishConfig) Validate() error {
+	if c.User == "" || c.Address == "" {
+		return skerr.Fmt("You must specify a user and address.")
+	}
+	if c.getPassword() == "" {
+		return skerr.Fmt("You must specify the password.")
+	}
+	for id, system := range c.DevSystemMap {
+		if system == "" {
+			return skerr.Fmt("No system specified for %s", id)
+		}
+	}
+	return nil
+}
+
+// getPassword returns the password.
+func (c *RedfishConfig) getPassword() string {
+	if c.Password != "" {
+		return c.Password
+	}
+	return strings.TrimSpace(os.Getenv(redfishPasswordEnvVar))
+}
+
+// redfishClient implements the Controller interface.
+type redfishClient struct {
+	name       string
+	conf       *RedfishConfig
+	devIDs     []DeviceID
+	httpClient *http.Client
+}
+
+// newRedfishController returns a new instance of Controller for the BMC
+// identified by the given configuration. If connect is true, it makes a request
+// to verify that the BMC is reachable and accepts the credentials.
+//
+// The *redfishClient is always returned not nil, so even on error it can be
+// interrogated for the list of machines.
+func newRedfishController(ctx context.Context, name string, conf *RedfishConfig, connect bool) (*redfishClient, error) {
+	devIDs := make([]DeviceID, 0, len(conf.DevSystemMap))
+	for id := range conf.DevSystemMap {
+		devIDs = append(devIDs, id)
+	}
+	sortIDs(devIDs)
+	ret := &redfishClient{
+		name:   name,
+		conf:   conf,
+		devIDs: devIDs,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Dial: httputils.ConfiguredDialTimeout(httputils.DIAL_TIMEOUT),
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: conf.InsecureSkipVerify,
+				},
+			},
+			Timeout: httputils.REQUEST_TIMEOUT,
+		},
+	}
+	if err := conf.Validate(); err != nil {
+		return ret, skerr.Wrap(err)
+	}
+	if connect {
+		if err := ret.do(ctx, http.MethodGet, redfishSystemsPath, nil); err != nil {
+			return ret, skerr.Wrapf(err, "contacting Redfish BMC %s", conf.Address)
+		}
+		sklog.Infof("connected successfully to Redfish BMC %s", conf.Address)
+	}
+	return ret, nil
+}
+
+// DeviceIDs implements the Controller interface.
+func (r *redfishClient) DeviceIDs() []DeviceID {
+	return r.devIDs
+}
+
+// PowerCycle implements the Controller interface.
+func (r *redfishClient) PowerCycle(ctx context.Context, id DeviceID, delayOverride time.Duration) error {
+	system, ok := r.conf.DevSystemMap[id]
+	if !ok {
+		return skerr.Fmt("Unknown device ID: %s", id)
+	}
+	if err := r.reset(ctx, system, redfishForceOff); err != nil {
+		return skerr.Wrapf(err, "turning off system %s", system)
+	}
+
+	delay := powerOffDelayRedfish
+	if delayOverride > 0 {
+		delay = delayOverride
+	}
+	sklog.Infof("Switched %s system %s off. Waiting for %s.", r.name, system, delay)
+	time.Sleep(delay)
+
+	if err := r.reset(ctx, system, redfishOn); err != nil {
+		return skerr.Wrapf(err, "turning on system %s", system)
+	}
+	sklog.Infof("Switched %s system %s on.", r.name, system)
+	return nil
+}
+
+// reset performs the ComputerSystem.Reset action with the given ResetType on
+// the given system.
+func (r *redfishClient) reset(ctx context.Context, system, resetType string) error {
+	body, err := json.Marshal(map[string]string{"ResetType": resetType})
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	path := fmt.Sprintf("%s/%s/Actions/ComputerSystem.Reset", redfishSystemsPath, url.PathEscape(system))
+	return r.do(ctx, http.MethodPost, path, body)
+}
+
+// do makes a request to the given path on the BMC and returns an error if the
+// request fails or returns a non-2xx response.
+func (r *redfishClient) do(ctx context.Context, method, path string, body []byte) error {
+	u := strings.TrimSuffix(r.conf.Address, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	req.SetBasicAuth(r.conf.User, r.conf.getPassword())
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return skerr.Wrapf(err, "making request to %s", u)
+	}
+	respBody := httputils.ReadAndClose(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return skerr.Fmt("request to %s failed with status %d: %s", u, resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+var _ Controller = (*redfishClient)(nil)
@@ -0,0 +1,110 @@
+package powercycle
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBMC is a minimal Redfish BMC which records the ResetTypes it receives.
+type fakeBMC struct {
+	t          *testing.T
+	resetTypes []string
+	failReset  bool
+}
+
+func (f *fakeBMC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, password, ok := r.BasicAuth()
+	if !ok || user != "root" || password != "calvin" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == redfishSystemsPath:
+		_, err := w.Write([]byte(`{"Members": [{"@odata.id": "/redfish/v1/Systems/System.Embedded.1"}]}`))
+		require.NoError(f.t, err)
+	case r.Method == http.MethodPost && r.URL.Path == "/redfish/v1/Systems/System.Embedded.1/Actions/ComputerSystem.Reset":
+		var body struct {
+			ResetType string
+		}
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&body))
+		f.resetTypes = append(f.resetTypes, body.ResetType)
+		if f.failReset {
+			http.Error(w, "reset failed", http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestRedfishConfigValidate_MissingPassword_ReturnsError(t *testing.T) {
+	c := RedfishConfig{
+		Address: "https://192.168.1.50",
+		User:    "root",
+	}
+	require.Error(t, c.Validate())
+}
+
+func TestRedfishClient_PowerCycle_Success(t *testing.T) {
+	bmc := &fakeBMC{t: t}
+	s := httptest.NewServer(bmc)
+	defer s.Close()
+
+	rf, err := newRedfishController(context.Background(), "bmc", redfishConfig(s.URL), true)
+	require.NoError(t, err)
+
+	err = rf.PowerCycle(context.Background(), testDeviceOne, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, []string{redfishForceOff, redfishOn}, bmc.resetTypes)
+}
+
+func TestRedfishClient_PowerCycle_ResetFails_ReturnsError(t *testing.T) {
+	bmc := &fakeBMC{t: t, failReset: true}
+	s := httptest.NewServer(bmc)
+	defer s.Close()
+
+	rf, err := newRedfishController(context.Background(), "bmc", redfishConfig(s.URL), false)
+	require.NoError(t, err)
+
+	err = rf.PowerCycle(context.Background(), testDeviceOne, time.Millisecond)
+	require.Error(t, err)
+	assert.Equal(t, []string{redfishForceOff}, bmc.resetTypes)
+}
+
+func TestRedfishClient_PowerCycle_UnknownDevice_ReturnsError(t *testing.T) {
+	rf, err := newRedfishController(context.Background(), "bmc", redfishConfig("https://192.168.1.50"), false)
+	require.NoError(t, err)
+	require.Error(t, rf.PowerCycle(context.Background(), "not-a-device", time.Millisecond))
+}
+
+func TestNewRedfishController_BadCredentials_ControllerIsStillReturnedAndCanListMachines(t *testing.T) {
+	s := httptest.NewServer(&fakeBMC{t: t})
+	defer s.Close()
+
+	conf := redfishConfig(s.URL)
+	conf.Password = "wrong"
+	rf, err := newRedfishController(context.Background(), "bmc", conf, true)
+	require.Error(t, err)
+	require.NotNil(t, rf)
+	require.Equal(t, []DeviceID{testDeviceOne, testDeviceTwo}, rf.DeviceIDs())
+}
+
+func redfishConfig(address string) *RedfishConfig {
+	return &RedfishConfig{
+		Address:  address,
+		User:     "root",
+		Password: "calvin",
+		DevSystemMap: map[DeviceID]string{
+			testDeviceOne: "System.Embedded.1",
+			testDeviceTwo: "System.Embedded.2",
+		},
+	}
+}
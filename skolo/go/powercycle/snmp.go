@@ -0,0 +1,201 @@
+package powercycle
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.skia.org/infra/go/executil"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+)
+
+// Constants used to access SNMP-managed PDUs.
+const (
+	// Default amount of time to wait between turn off and on.
+	powerOffDelaySNMP = 10 * time.Second
+
+	// Default community with write access.
+	snmpDefaultCommunity = "private"
+
+	// Default OID of the outlet control object, without the outlet index. This
+	// is rPDUOutletControlOutletCommand from the APC PowerNet-MIB.
+	snmpDefaultOutletOID = ".1.3.6.1.4.1.318.1.1.12.3.3.1.1.4"
+
+	// Default values to write to the outlet control object to turn an outlet
+	// on and off, i.e. immediateOn(1) and immediateOff(2) in PowerNet-MIB.
+	snmpDefaultOnValue  = 1
+	snmpDefaultOffValue = 2
+
+	// OID of sysDescr, which is used to check connectivity.
+	snmpSysDescrOID = ".1.3.6.1.2.1.1.1.0"
+)
+
+// SNMPConfig contains configuration options for a single PDU which is managed
+// over SNMP v2c. The defaults work with APC PDUs; other vendors can be
+// supported by providing the OID and values for their outlet control object.
+// Requests are made using the net-snmp command line tools, which must be
+// installed.
+type SNMPConfig struct {
+	// Address of the device, i.e. 192.168.1.60 or 192.168.1.60:161
+	Address string `json:"address"`
+
+	// Community with write access. Defaults to "private".
+	Community string `json:"community"`
+
+	// OutletOID is the OID of the outlet control object, to which the port
+	// number is appended. Defaults to the APC rPDUOutletControlOutletCommand.
+	OutletOID string `json:"outlet_oid"`
+
+	// OnValue is the integer written to the outlet control object to turn the
+	// outlet on. Defaults to 1.
+	OnValue *int `json:"on_value"`
+
+	// OffValue is the integer written to the outlet control object to turn
+	// the outlet off. Defaults to 2.
+	OffValue *int `json:"off_value"`
+
+	// Mapping between device id and outlet on the PDU. These should be the
+	// labels on the physical device (i.e. 1-indexed).
+	DevPortMap map[DeviceID]int `json:"ports"`
+}
+
+// Validate returns an error if the configuration is not complete.
+func (c *SNMPConfig) Validate() error {
+	if c.Address == "" {
+		return skerr.Fmt("You must specify an address.")
+	}
+	for id, port := range c.DevPortMap {
+		if port < 1 {
+			return skerr.Fmt("invalid port for %s (%d)", id, port)
+		}
+	}
+	if c.onValue() == c.offValue() {
+		return skerr.Fmt("OnValue and OffValue must differ.")
+	}
+	return nil
+}
+
+// community returns the configured community or the default.
+func (c *SNMPConfig) community() string {
+	if c.Community != "" {
+		return c.Community
+	}
+	return snmpDefaultCommunity
+}
+
+// outletOID returns the OID of the outlet control object for the given port.
+func (c *SNMPConfig) outletOID(port int) string {
+	oid := c.OutletOID
+	if oid == "" {
+		oid = snmpDefaultOutletOID
+	}
+	return fmt.Sprintf("%s.%d", oid, port)
+}
+
+// onValue returns the configured on value or the default.
+func (c *SNMPConfig) onValue() int {
+	if c.OnValue != nil {
+		return *c.OnValue
+	}
+	return snmpDefaultOnValue
+}
+
+// offValue returns the configured off value or the default.
+func (c *SNMPConfig) offValue() int {
+	if c.OffValue != nil {
+		return *c.OffValue
+	}
+	return snmpDefaultOffValue
+}
+
+// snmpClient implements the Controller interface.
+type snmpClient struct {
+	name   string
+	conf   *SNMPConfig
+	devIDs []DeviceID
+}
+
+// newSNMPController returns a new instance of Controller for the PDU
+// identified by the given configuration. If connect is true, it reads the
+// PDU's sysDescr to verify that it is reachable.
+//
+// The *snmpClient is always returned not nil, so even on error it can be
+// interrogated for the list of machines.
+func newSNMPController(ctx context.Context, name string, conf *SNMPConfig, connect bool) (*snmpClient, error) {
+	devIDs := make([]DeviceID, 0, len(conf.DevPortMap))
+	for id := range conf.DevPortMap {
+		devIDs = append(devIDs, id)
+	}
+	sortIDs(devIDs)
+	ret := &snmpClient{
+		name:   name,
+		conf:   conf,
+		devIDs: devIDs,
+	}
+	if err := conf.Validate(); err != nil {
+		return ret, skerr.Wrap(err)
+	}
+	if connect {
+		out, err := ret.run(ctx, "snmpget", snmpSysDescrOID)
+		if err != nil {
+			return ret, skerr.Wrapf(err, "performing smoke test on SNMP PDU %s; output: %s", conf.Address, out)
+		}
+		sklog.Infof("connected successfully to SNMP PDU %s", conf.Address)
+	}
+	return ret, nil
+}
+
+// DeviceIDs implements the Controller interface.
+func (s *snmpClient) DeviceIDs() []DeviceID {
+	return s.devIDs
+}
+
+// PowerCycle implements the Controller interface.
+func (s *snmpClient) PowerCycle(ctx context.Context, id DeviceID, delayOverride time.Duration) error {
+	port, ok := s.conf.DevPortMap[id]
+	if !ok {
+		return skerr.Fmt("Unknown device ID: %s", id)
+	}
+	if err := s.setOutlet(ctx, port, s.conf.offValue()); err != nil {
+		return skerr.Wrapf(err, "turning off port %d", port)
+	}
+
+	delay := powerOffDelaySNMP
+	if delayOverride > 0 {
+		delay = delayOverride
+	}
+	sklog.Infof("Switched %s port %d off. Waiting for %s.", s.name, port, delay)
+	time.Sleep(delay)
+
+	if err := s.setOutlet(ctx, port, s.conf.onValue()); err != nil {
+		return skerr.Wrapf(err, "turning on port %d", port)
+	}
+	sklog.Infof("Switched %s port %d on.", s.name, port)
+	return nil
+}
+
+// setOutlet writes the given value to the outlet control object for the given
+// port.
+func (s *snmpClient) setOutlet(ctx context.Context, port, value int) error {
+	if out, err := s.run(ctx, "snmpset", s.conf.outletOID(port), "i", strconv.Itoa(value)); err != nil {
+		return skerr.Wrapf(err, "while setting outlet value - got output %s", out)
+	}
+	return nil
+}
+
+// run executes the given net-snmp command against the PDU and returns its
+// combined output.
+func (s *snmpClient) run(ctx context.Context, executable string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, execTimeout)
+	defer cancel()
+	args = append([]string{"-v2c", "-c", s.conf.community(), s.conf.Address}, args...)
+	out, err := executil.CommandContext(ctx, executable, args...).CombinedOutput()
+	if err != nil {
+		return string(out), skerr.Wrapf(err, "running %s %s", executable, args)
+	}
+	return string(out), nil
+}
+
+var _ Controller = (*snmpClient)(nil)
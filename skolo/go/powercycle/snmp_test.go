@@ -0,0 +1,120 @@
+package powercycle
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/executil"
+)
+
+func TestSNMPConfigValidate_SameOnAndOffValues_ReturnsError(t *testing.T) {
+	c := snmpConfig()
+	three := 3
+	c.OnValue = &three
+	c.OffValue = &three
+	require.Error(t, c.Validate())
+}
+
+func TestSNMPClient_PowerCycle_Success(t *testing.T) {
+	sn, err := newSNMPController(context.Background(), "pdu", snmpConfig(), false)
+	require.NoError(t, err)
+
+	ctx := executil.FakeTestsContext(
+		"Test_FakeExe_SNMPSetOutlet5Off_Success", // We expect to see the outlet turned off...
+		"Test_FakeExe_SNMPSetOutlet5On_Success",  // then back on.
+	)
+	err = sn.PowerCycle(ctx, testDeviceOne, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, executil.FakeCommandsReturned(ctx))
+}
+
+func TestSNMPClient_PowerCycle_CustomOIDAndValues_Success(t *testing.T) {
+	conf := snmpConfig()
+	conf.OutletOID = ".1.3.6.1.4.1.13742.6.4.1.2.1.2.1"
+	on, off := 1, 0
+	conf.OnValue = &on
+	conf.OffValue = &off
+	sn, err := newSNMPController(context.Background(), "pdu", conf, false)
+	require.NoError(t, err)
+
+	ctx := executil.FakeTestsContext(
+		"Test_FakeExe_SNMPSetCustomOutlet6Off_Success",
+		"Test_FakeExe_SNMPSetCustomOutlet6On_Success",
+	)
+	err = sn.PowerCycle(ctx, testDeviceTwo, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, executil.FakeCommandsReturned(ctx))
+}
+
+func TestNewSNMPController_NewFails_ControllerIsStillReturnedAndCanListMachines(t *testing.T) {
+	ctx := executil.FakeTestsContext("Test_FakeExe_SNMPGet_Timeout")
+	sn, err := newSNMPController(ctx, "pdu", snmpConfig(), true)
+	require.Error(t, err)
+	require.NotNil(t, sn)
+	require.Equal(t, []DeviceID{testDeviceOne, testDeviceTwo}, sn.DeviceIDs())
+}
+
+// This is a fake executable used to assert that a correct call to turn off
+// outlet 5 of the PDU was made. It is invoked using executil.FakeTestsContext.
+func Test_FakeExe_SNMPSetOutlet5Off_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"snmpset", "-v2c", "-c", "private", "192.168.1.60", ".1.3.6.1.4.1.318.1.1.12.3.3.1.1.4.5", "i", "2"}, args)
+	os.Exit(0)
+}
+
+// This is a fake executable used to assert that a correct call to turn on
+// outlet 5 of the PDU was made. It is invoked using executil.FakeTestsContext.
+func Test_FakeExe_SNMPSetOutlet5On_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"snmpset", "-v2c", "-c", "private", "192.168.1.60", ".1.3.6.1.4.1.318.1.1.12.3.3.1.1.4.5", "i", "1"}, args)
+	os.Exit(0)
+}
+
+func Test_FakeExe_SNMPSetCustomOutlet6Off_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"snmpset", "-v2c", "-c", "private", "192.168.1.60", ".1.3.6.1.4.1.13742.6.4.1.2.1.2.1.6", "i", "0"}, args)
+	os.Exit(0)
+}
+
+func Test_FakeExe_SNMPSetCustomOutlet6On_Success(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"snmpset", "-v2c", "-c", "private", "192.168.1.60", ".1.3.6.1.4.1.13742.6.4.1.2.1.2.1.6", "i", "1"}, args)
+	os.Exit(0)
+}
+
+// This is a fake executable which emulates snmpget failing to reach the PDU.
+func Test_FakeExe_SNMPGet_Timeout(t *testing.T) {
+	if !executil.IsCallingFakeCommand() {
+		return
+	}
+	args := executil.OriginalArgs()
+	require.Equal(t, []string{"snmpget", "-v2c", "-c", "private", "192.168.1.60", snmpSysDescrOID}, args)
+	_, _ = os.Stderr.WriteString("Timeout: No Response from 192.168.1.60\n")
+	os.Exit(1)
+}
+
+func snmpConfig() *SNMPConfig {
+	return &SNMPConfig{
+		Address: "192.168.1.60",
+		DevPortMap: map[DeviceID]int{
+			testDeviceOne: 5,
+			testDeviceTwo: 6,
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package sensors
+
+import (
+	"os"
+
+	"github.com/flynn/json5"
+	"go.skia.org/infra/go/httputils"
+	"go.skia.org/infra/go/skerr"
+)
+
+// DLPTH1CConfig is the configuration of a DLP-TH1C sensor module.
+type DLPTH1CConfig struct {
+	// SerialDevice is the serial port the module is attached to, e.g.
+	// "/dev/ttyACM0".
+	SerialDevice string `json:"serial_device"`
+}
+
+// Threshold is the range a reading is expected to stay within. Readings
+// outside of the range are reported in metrics, which are alerted on.
+type Threshold struct {
+	// Sensor is the name of the sensor the threshold applies to. If empty the
+	// threshold applies to the reading of every sensor.
+	Sensor string `json:"sensor"`
+
+	// Reading is the name of the reading, e.g. "temp_c".
+	Reading string `json:"reading"`
+
+	// Min is the lowest acceptable value, if any.
+	Min *float64 `json:"min"`
+
+	// Max is the highest acceptable value, if any.
+	Max *float64 `json:"max"`
+}
+
+// Config describes all the sensors to read, keyed by sensor name, and the
+// thresholds to apply to their readings.
+//
+// See environment_monitor_ansible/example.json5 for an example.
+type Config struct {
+	DLPTH1C   map[string]*DLPTH1CConfig   `json:"dlpth1c"`
+	ModbusTCP map[string]*ModbusTCPConfig `json:"modbus_tcp"`
+	HTTPJSON  map[string]*HTTPJSONConfig  `json:"http_json"`
+	NUT       map[string]*NUTConfig       `json:"nut"`
+
+	Thresholds []Threshold `json:"thresholds"`
+}
+
+// ReadConfig reads a Config from the given JSON5 file.
+func ReadConfig(path string) (Config, error) {
+	var ret Config
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return ret, skerr.Wrapf(err, "reading %s", path)
+	}
+	if err := json5.Unmarshal(b, &ret); err != nil {
+		return ret, skerr.Wrapf(err, "parsing %s", path)
+	}
+	return ret, nil
+}
+
+// Sensors creates all the sensors in the config, keyed by name. Sensor names
+// must be unique across all types of sensors.
+func (c Config) Sensors() (map[string]Sensor, error) {
+	ret := map[string]Sensor{}
+	add := func(name string, s Sensor, err error) error {
+		if err != nil {
+			return skerr.Wrapf(err, "creating sensor %q", name)
+		}
+		if _, ok := ret[name]; ok {
+			return skerr.Fmt("duplicate sensor name %q", name)
+		}
+		ret[name] = s
+		return nil
+	}
+	closeAll := func() {
+		for _, s := range ret {
+			_ = s.Close()
+		}
+	}
+
+	for name, cfg := range c.DLPTH1C {
+		d, err := NewDLPTH1C(cfg.SerialDevice)
+		if err == nil {
+			if err = d.ConfirmConnection(confirmConnectionPings); err != nil {
+				_ = d.Close()
+			}
+		}
+		if err := add(name, d, err); err != nil {
+			closeAll()
+			return nil, err
+		}
+	}
+	for name, cfg := range c.ModbusTCP {
+		m, err := NewModbusTCP(*cfg)
+		if err := add(name, m, err); err != nil {
+			closeAll()
+			return nil, err
+		}
+	}
+	client := httputils.NewTimeoutClient()
+	for name, cfg := range c.HTTPJSON {
+		h, err := NewHTTPJSON(*cfg, client)
+		if err := add(name, h, err); err != nil {
+			closeAll()
+			return nil, err
+		}
+	}
+	for name, cfg := range c.NUT {
+		n, err := NewNUT(*cfg)
+		if err := add(name, n, err); err != nil {
+			closeAll()
+			return nil, err
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,96 @@
+package sensors
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configJSON5 = `{
+	// Comments are allowed.
+	modbus_tcp: {
+		rack4_modbus: {
+			address: "192.168.1.50",
+			registers: [
+				{name: "temp_c", address: 256, input: true, signed: true, scale: 0.1},
+			],
+		},
+	},
+	http_json: {
+		rack4_http: {
+			url: "http://192.168.1.51/status.json",
+			fields: [
+				{name: "humidity", path: "sensors.0.humidity"},
+			],
+		},
+	},
+	nut: {
+		ups: {
+			address: "192.168.1.52",
+			ups: "ups1",
+		},
+	},
+	thresholds: [
+		{reading: "temp_c", max: 30},
+		{sensor: "ups", reading: "battery_charge", min: 50},
+	],
+}`
+
+func writeConfig(t *testing.T, contents string) string {
+	path := filepath.Join(t.TempDir(), "sensors.json5")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0644))
+	return path
+}
+
+func TestReadConfig_ValidConfig_SensorsAreCreated(t *testing.T) {
+
+	cfg, err := ReadConfig(writeConfig(t, configJSON5))
+	require.NoError(t, err)
+	require.Len(t, cfg.Thresholds, 2)
+	assert.Equal(t, 30.0, *cfg.Thresholds[0].Max)
+	assert.Nil(t, cfg.Thresholds[0].Min)
+
+	sensors, err := cfg.Sensors()
+	require.NoError(t, err)
+	require.Len(t, sensors, 3)
+	assert.IsType(t, &ModbusTCP{}, sensors["rack4_modbus"])
+	assert.IsType(t, &HTTPJSON{}, sensors["rack4_http"])
+	assert.IsType(t, &NUT{}, sensors["ups"])
+
+	_, err = NewMonitor("test_config_", sensors, cfg.Thresholds)
+	require.NoError(t, err)
+}
+
+func TestReadConfig_MissingFile_ReturnsError(t *testing.T) {
+
+	_, err := ReadConfig(filepath.Join(t.TempDir(), "missing.json5"))
+	require.Error(t, err)
+}
+
+func TestSensors_DuplicateNames_ReturnsError(t *testing.T) {
+
+	cfg := Config{
+		ModbusTCP: map[string]*ModbusTCPConfig{
+			"rack4": {Address: "192.168.1.50", Registers: []ModbusRegister{{Name: "temp_c"}}},
+		},
+		NUT: map[string]*NUTConfig{
+			"rack4": {Address: "192.168.1.52", UPS: "ups1"},
+		},
+	}
+	_, err := cfg.Sensors()
+	require.Error(t, err)
+}
+
+func TestSensors_InvalidSensor_ReturnsError(t *testing.T) {
+
+	cfg := Config{
+		DLPTH1C: map[string]*DLPTH1CConfig{
+			"rack4": {SerialDevice: "<Invalid Serial Port Name>"},
+		},
+	}
+	_, err := cfg.Sensors()
+	require.Error(t, err)
+}
@@ -5,6 +5,7 @@
 package sensors
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -109,12 +110,34 @@ func (f Frequencies) String() string {
 // Additional sensor information available at http://www.dlpdesign.com/usb/th1c.php
 type DLPTH1C struct {
 	portName string
-	port     si.Port
+
+	// port is the open serial port, or nil if it was closed after an error
+	// in Read.
+	port si.Port
+
+	// open opens the serial port, so that Read can reopen it.
+	open func() (si.Port, error)
+
+	// reopenAt is the earliest time Read may reopen the port, and
+	// reopenDelay is how long it waited after the last error.
+	reopenAt    time.Time
+	reopenDelay time.Duration
 }
 
-// NewDLPTH1C opens a serial connection to a DLP-TH1C sensor device and return a
-// sensor object for device interaction.
-func NewDLPTH1C(portName string) (*DLPTH1C, error) {
+const (
+	// confirmConnectionPings is the number of pings used to confirm the
+	// connection to a newly opened device.
+	confirmConnectionPings = 5
+
+	// minReopenDelay and maxReopenDelay bound how long Read waits to
+	// reopen the serial port after an error. The delay doubles with every
+	// consecutive error.
+	minReopenDelay = 30 * time.Second
+	maxReopenDelay = 10 * time.Minute
+)
+
+// openDLPTH1CPort opens the serial port of a DLP-TH1C sensor device.
+func openDLPTH1CPort(portName string) (si.Port, error) {
 	c := &serial.Config{
 		Name:        portName,
 		Baud:        115200,
@@ -124,18 +147,70 @@ func NewDLPTH1C(portName string) (*DLPTH1C, error) {
 	if err != nil {
 		return nil, skerr.Wrapf(err, "failed to open serial port")
 	}
+	return s, nil
+}
 
-	d := DLPTH1C{portName: portName, port: s}
+// NewDLPTH1C opens a serial connection to a DLP-TH1C sensor device and return a
+// sensor object for device interaction.
+func NewDLPTH1C(portName string) (*DLPTH1C, error) {
+	open := func() (si.Port, error) {
+		return openDLPTH1CPort(portName)
+	}
+	s, err := open()
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+
+	d := DLPTH1C{portName: portName, port: s, open: open}
 	return &d, nil
 }
 
 // Close the open connection to the device.
 func (d *DLPTH1C) Close() error {
-	return d.port.Close()
+	if d.port == nil {
+		return nil
+	}
+	err := d.port.Close()
+	d.port = nil
+	return err
+}
+
+// closeAfterError closes the port after an error, so that it is reopened by
+// a later Read, e.g. once the device is plugged back in. The delay before it
+// may be reopened backs off while the errors persist.
+func (d *DLPTH1C) closeAfterError() {
+	_ = d.Close()
+	d.reopenDelay = min(max(2*d.reopenDelay, minReopenDelay), maxReopenDelay)
+	d.reopenAt = time.Now().Add(d.reopenDelay)
+}
+
+// reopen reopens the port closed by closeAfterError, if the delay before it
+// may be reopened has passed.
+func (d *DLPTH1C) reopen() error {
+	if time.Now().Before(d.reopenAt) {
+		return skerr.Fmt("serial port %s was closed after an error; reopening at %s", d.portName, d.reopenAt.Format(time.RFC3339))
+	}
+	if d.open == nil {
+		return skerr.Fmt("serial port %s can't be reopened", d.portName)
+	}
+	port, err := d.open()
+	if err != nil {
+		d.closeAfterError()
+		return skerr.Wrapf(err, "reopening serial port %s", d.portName)
+	}
+	d.port = port
+	if err := d.ConfirmConnection(confirmConnectionPings); err != nil {
+		d.closeAfterError()
+		return skerr.Wrapf(err, "reopening serial port %s", d.portName)
+	}
+	return nil
 }
 
 // Write a single byte to the device.
 func (d *DLPTH1C) writeByte(b byte) error {
+	if d.port == nil {
+		return skerr.Fmt("serial port %s is closed", d.portName)
+	}
 	buf := make([]byte, 1)
 	buf[0] = b
 	_, err := d.port.Write(buf)
@@ -391,3 +466,53 @@ func (d *DLPTH1C) GetBroadbandSound() (SoundLevel, error) {
 	}
 	return SoundLevel(float32(v) / 100.0), nil
 }
+
+// Read implements the Sensor interface. It returns the ambient temperature,
+// humidity, light, and sound levels.
+//
+// If reading fails, the port is closed and reopened by a later Read, since the
+// device may have been unplugged or the port left with a partial response in
+// it. Until then Read returns an error, which is reported in metrics.
+func (d *DLPTH1C) Read(_ context.Context) ([]Reading, error) {
+	if d.port == nil {
+		if err := d.reopen(); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+	readings, err := d.readAll()
+	if err != nil {
+		d.closeAfterError()
+		return nil, skerr.Wrap(err)
+	}
+	d.reopenDelay = 0
+	return readings, nil
+}
+
+// readAll returns the readings of Read.
+func (d *DLPTH1C) readAll() ([]Reading, error) {
+	t, err := d.GetTemperature()
+	if err != nil {
+		return nil, skerr.Wrapf(err, "reading temperature")
+	}
+	h, err := d.GetHumidity()
+	if err != nil {
+		return nil, skerr.Wrapf(err, "reading humidity")
+	}
+	l, err := d.GetLight()
+	if err != nil {
+		return nil, skerr.Wrapf(err, "reading light level")
+	}
+	s, err := d.GetBroadbandSound()
+	if err != nil {
+		return nil, skerr.Wrapf(err, "reading sound level")
+	}
+	return []Reading{
+		{Name: "temp_c", Value: float64(t)},
+		{Name: "humidity", Value: float64(h)},
+		{Name: "light", Value: float64(l)},
+		{Name: "sound_db", Value: float64(s)},
+	}, nil
+}
+
+// Make sure DLPTH1C fulfills the Sensor interface.
+var _ Sensor = (*DLPTH1C)(nil)
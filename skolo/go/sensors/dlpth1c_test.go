@@ -1,11 +1,14 @@
 package sensors
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	si "go.skia.org/infra/go/serial"
 	"go.skia.org/infra/go/serial/mocks"
 	"go.skia.org/infra/go/skerr"
 )
@@ -378,3 +381,87 @@ func TestGetLight_NoDeviceResponse_ReturnsError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Zero(t, val)
 }
+
+func TestRead_AllValuesRead_ReturnsReadings(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(
+		0x10, 0x68, // Temperature.
+		0x0, 0xb8, 0x00, // Humidity.
+		0x0,        // Light.
+		0x12, 0x75, // Sound.
+	)
+	d := deviceFromFake(fsp)
+	readings, err := d.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Reading{
+		{Name: "temp_c", Value: 42},
+		{Name: "humidity", Value: 46},
+		{Name: "light", Value: 0},
+		{Name: "sound_db", Value: float64(SoundLevel(47.25))},
+	}, readings)
+	assert.Equal(t, []byte{getTemperatureCmd, getHumidityCmd, getLightCmd, getBroadbandSoundCmd}, fsp.getWrittenData())
+}
+
+func TestRead_NoDeviceResponse_ReturnsError(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(0x10, 0x68)
+	d := deviceFromFake(fsp)
+	_, err := d.Read(context.Background())
+	assert.Error(t, err)
+}
+
+func TestRead_NoDeviceResponse_ClosesPortUntilReopenDelayPasses(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(0x10, 0x68)
+	d := deviceFromFake(fsp)
+	opened := 0
+	d.open = func() (si.Port, error) {
+		opened++
+		return (&fakeSerialPort{}).setReadData(
+			pingResponseVal,
+			0x10, 0x68, // Temperature.
+			0x0, 0xb8, 0x00, // Humidity.
+			0x0,        // Light.
+			0x12, 0x75, // Sound.
+		), nil
+	}
+	_, err := d.Read(context.Background())
+	require.Error(t, err)
+	assert.True(t, fsp.closed)
+	assert.Nil(t, d.port)
+	assert.Equal(t, minReopenDelay, d.reopenDelay)
+
+	// The port isn't reopened before the delay passes.
+	_, err = d.Read(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 0, opened)
+
+	d.reopenAt = time.Time{}
+	readings, err := d.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, opened)
+	assert.Len(t, readings, 4)
+	assert.Zero(t, d.reopenDelay)
+}
+
+func TestRead_ReopenFails_BacksOff(t *testing.T) {
+
+	d := deviceFromFake(&fakeSerialPort{})
+	d.open = func() (si.Port, error) {
+		return nil, skerr.Fmt("expected error")
+	}
+	_, err := d.Read(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, minReopenDelay, d.reopenDelay)
+
+	d.reopenAt = time.Time{}
+	_, err = d.Read(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 2*minReopenDelay, d.reopenDelay)
+
+	d.reopenDelay = maxReopenDelay
+	d.reopenAt = time.Time{}
+	_, err = d.Read(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, maxReopenDelay, d.reopenDelay)
+}
@@ -0,0 +1,147 @@
+package sensors
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+)
+
+// JSONField describes a single value to extract from the JSON returned by an
+// HTTP sensor.
+type JSONField struct {
+	// Name of the reading, e.g. "temp_c".
+	Name string `json:"name"`
+
+	// Path to the value, with the keys of objects and the indices of arrays
+	// separated by periods, e.g. "sensors.0.temperature". The value may be a
+	// number, a boolean, or a string holding a number.
+	Path string `json:"path"`
+
+	// Scale is multiplied with the value. Defaults to 1.
+	Scale float64 `json:"scale"`
+}
+
+// HTTPJSONConfig is the configuration of a sensor that serves its readings as
+// JSON over HTTP.
+type HTTPJSONConfig struct {
+	// URL to GET the readings from.
+	URL string `json:"url"`
+
+	// Fields to extract from the JSON.
+	Fields []JSONField `json:"fields"`
+}
+
+// HTTPJSON is a networked sensor that serves its readings as JSON over HTTP.
+type HTTPJSON struct {
+	url    string
+	fields []JSONField
+	client *http.Client
+}
+
+// NewHTTPJSON returns a new *HTTPJSON that makes requests with the given
+// client.
+func NewHTTPJSON(cfg HTTPJSONConfig, client *http.Client) (*HTTPJSON, error) {
+	if cfg.URL == "" {
+		return nil, skerr.Fmt("a URL must be given")
+	}
+	if len(cfg.Fields) == 0 {
+		return nil, skerr.Fmt("at least one field must be given")
+	}
+	fields := make([]JSONField, 0, len(cfg.Fields))
+	for _, f := range cfg.Fields {
+		if f.Name == "" || f.Path == "" {
+			return nil, skerr.Fmt("fields must have a name and a path: %+v", f)
+		}
+		if f.Scale == 0 {
+			f.Scale = 1
+		}
+		fields = append(fields, f)
+	}
+	return &HTTPJSON{
+		url:    cfg.URL,
+		fields: fields,
+		client: client,
+	}, nil
+}
+
+// Read implements the Sensor interface.
+func (h *HTTPJSON) Read(ctx context.Context) ([]Reading, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", h.url, nil)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "requesting %s", h.url)
+	}
+	defer util.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, skerr.Fmt("requesting %s: got status %s", h.url, resp.Status)
+	}
+	var body interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, skerr.Wrapf(err, "decoding response from %s", h.url)
+	}
+	ret := make([]Reading, 0, len(h.fields))
+	for _, f := range h.fields {
+		value, err := numberAtPath(body, f.Path)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "extracting %q", f.Name)
+		}
+		ret = append(ret, Reading{Name: f.Name, Value: value * f.Scale})
+	}
+	return ret, nil
+}
+
+// numberAtPath returns the number found at the given period separated path
+// into the decoded JSON value.
+func numberAtPath(value interface{}, path string) (float64, error) {
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			child, ok := v[key]
+			if !ok {
+				return 0, skerr.Fmt("key %q not found in %q", key, path)
+			}
+			value = child
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return 0, skerr.Fmt("invalid index %q in %q", key, path)
+			}
+			value = v[i]
+		default:
+			return 0, skerr.Fmt("can't look up %q in a %T in %q", key, value, path)
+		}
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, skerr.Wrapf(err, "value at %q isn't a number", path)
+		}
+		return f, nil
+	default:
+		return 0, skerr.Fmt("value at %q is a %T, not a number", path, value)
+	}
+}
+
+// Close implements the Sensor interface.
+func (h *HTTPJSON) Close() error {
+	return nil
+}
+
+// Make sure HTTPJSON fulfills the Sensor interface.
+var _ Sensor = (*HTTPJSON)(nil)
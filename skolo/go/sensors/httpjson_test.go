@@ -0,0 +1,93 @@
+package sensors
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sensorJSON = `{
+	"name": "rack4",
+	"sensors": [
+		{"temperature": "22.5", "humidity": 4150},
+		{"temperature": 30.25, "door_open": true}
+	]
+}`
+
+func httpJSONForTest(t *testing.T, status int, body string, fields ...JSONField) *HTTPJSON {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, err := w.Write([]byte(body))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(s.Close)
+	h, err := NewHTTPJSON(HTTPJSONConfig{
+		URL:    s.URL,
+		Fields: fields,
+	}, s.Client())
+	require.NoError(t, err)
+	return h
+}
+
+func TestNewHTTPJSON_FieldWithoutPath_ReturnsError(t *testing.T) {
+
+	_, err := NewHTTPJSON(HTTPJSONConfig{
+		URL:    "http://192.168.1.51/json",
+		Fields: []JSONField{{Name: "temp_c"}},
+	}, http.DefaultClient)
+	assert.Error(t, err)
+}
+
+func TestHTTPJSONRead_ValidResponse_ReturnsReadings(t *testing.T) {
+
+	h := httpJSONForTest(t, http.StatusOK, sensorJSON,
+		JSONField{Name: "temp_c", Path: "sensors.0.temperature"},
+		JSONField{Name: "humidity", Path: "sensors.0.humidity", Scale: 0.01},
+		JSONField{Name: "exhaust_temp_c", Path: "sensors.1.temperature"},
+		JSONField{Name: "door_open", Path: "sensors.1.door_open"},
+	)
+
+	readings, err := h.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Reading{
+		{Name: "temp_c", Value: 22.5},
+		{Name: "humidity", Value: 41.5},
+		{Name: "exhaust_temp_c", Value: 30.25},
+		{Name: "door_open", Value: 1},
+	}, readings)
+}
+
+func TestHTTPJSONRead_InvalidPaths_ReturnsError(t *testing.T) {
+
+	test := func(name, path string) {
+		t.Run(name, func(t *testing.T) {
+			h := httpJSONForTest(t, http.StatusOK, sensorJSON, JSONField{Name: "temp_c", Path: path})
+			_, err := h.Read(context.Background())
+			require.Error(t, err)
+		})
+	}
+
+	test("MissingKey", "sensors.0.pressure")
+	test("IndexOutOfRange", "sensors.2.temperature")
+	test("IndexIntoObject", "name.0")
+	test("NotANumber", "name")
+	test("NotALeaf", "sensors.0")
+}
+
+func TestHTTPJSONRead_ErrorStatus_ReturnsError(t *testing.T) {
+
+	h := httpJSONForTest(t, http.StatusServiceUnavailable, "", JSONField{Name: "temp_c", Path: "temp"})
+	_, err := h.Read(context.Background())
+	require.Error(t, err)
+}
+
+func TestHTTPJSONRead_InvalidJSON_ReturnsError(t *testing.T) {
+
+	h := httpJSONForTest(t, http.StatusOK, "<html>", JSONField{Name: "temp_c", Path: "temp"})
+	_, err := h.Read(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,196 @@
+package sensors
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+const (
+	defaultModbusPort = "502"
+
+	modbusReadHoldingRegisters byte = 0x03
+	modbusReadInputRegisters   byte = 0x04
+
+	// modbusExceptionFlag is set on the function code of a response if the
+	// request failed.
+	modbusExceptionFlag byte = 0x80
+
+	// modbusHeaderSize is the size of the MBAP header that precedes every
+	// Modbus TCP request and response.
+	modbusHeaderSize = 7
+)
+
+// ModbusRegister describes a single 16-bit register to read from a Modbus
+// device.
+type ModbusRegister struct {
+	// Name of the reading, e.g. "temp_c".
+	Name string `json:"name"`
+
+	// Address of the register.
+	Address uint16 `json:"address"`
+
+	// Input is true if the register is an input register (function code
+	// 0x04), otherwise it is a holding register (function code 0x03).
+	Input bool `json:"input"`
+
+	// Signed is true if the register holds a two's complement value.
+	Signed bool `json:"signed"`
+
+	// Scale is multiplied with the raw register value, e.g. 0.1 for a
+	// temperature reported in tenths of a degree. Defaults to 1.
+	Scale float64 `json:"scale"`
+}
+
+// ModbusTCPConfig is the configuration of a Modbus TCP sensor.
+type ModbusTCPConfig struct {
+	// Address is the host:port of the sensor. The port defaults to 502.
+	Address string `json:"address"`
+
+	// UnitID is the Modbus unit identifier of the sensor, which is needed
+	// when talking to the sensor through a gateway.
+	UnitID uint8 `json:"unit_id"`
+
+	// Registers to read.
+	Registers []ModbusRegister `json:"registers"`
+}
+
+// ModbusTCP is a networked sensor that is read over Modbus TCP.
+//
+// See https://modbus.org/docs/Modbus_Messaging_Implementation_Guide_V1_0b.pdf
+type ModbusTCP struct {
+	unitID    uint8
+	registers []ModbusRegister
+	dial      dialFunc
+
+	// conn is the connection to the sensor, or nil if not connected.
+	conn io.ReadWriteCloser
+
+	// transactionID is incremented for every request, and echoed back by the
+	// sensor in the response.
+	transactionID uint16
+}
+
+// NewModbusTCP returns a new *ModbusTCP. The sensor isn't connected to until
+// it is first read.
+func NewModbusTCP(cfg ModbusTCPConfig) (*ModbusTCP, error) {
+	if cfg.Address == "" {
+		return nil, skerr.Fmt("an address must be given")
+	}
+	if len(cfg.Registers) == 0 {
+		return nil, skerr.Fmt("at least one register must be given")
+	}
+	address := cfg.Address
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultModbusPort)
+	}
+	registers := make([]ModbusRegister, 0, len(cfg.Registers))
+	for _, r := range cfg.Registers {
+		if r.Name == "" {
+			return nil, skerr.Fmt("register %d has no name", r.Address)
+		}
+		if r.Scale == 0 {
+			r.Scale = 1
+		}
+		registers = append(registers, r)
+	}
+	return &ModbusTCP{
+		unitID:    cfg.UnitID,
+		registers: registers,
+		dial:      tcpDialer(address),
+	}, nil
+}
+
+// Read implements the Sensor interface.
+func (m *ModbusTCP) Read(ctx context.Context) ([]Reading, error) {
+	if m.conn == nil {
+		conn, err := m.dial(ctx)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		m.conn = conn
+	}
+	if err := setDeadline(m.conn); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	ret := make([]Reading, 0, len(m.registers))
+	for _, r := range m.registers {
+		raw, err := m.readRegister(r)
+		if err != nil {
+			// The connection may be left with a partial response in it, so
+			// start afresh on the next Read.
+			_ = m.Close()
+			return nil, skerr.Wrapf(err, "reading register %q", r.Name)
+		}
+		value := float64(raw)
+		if r.Signed {
+			value = float64(int16(raw))
+		}
+		ret = append(ret, Reading{Name: r.Name, Value: value * r.Scale})
+	}
+	return ret, nil
+}
+
+// readRegister reads the raw value of a single register.
+func (m *ModbusTCP) readRegister(r ModbusRegister) (uint16, error) {
+	functionCode := modbusReadHoldingRegisters
+	if r.Input {
+		functionCode = modbusReadInputRegisters
+	}
+	m.transactionID++
+
+	req := make([]byte, modbusHeaderSize+5)
+	binary.BigEndian.PutUint16(req[0:], m.transactionID)
+	binary.BigEndian.PutUint16(req[2:], 0) // Protocol identifier, always 0.
+	binary.BigEndian.PutUint16(req[4:], 6) // Length of the unit id and the PDU.
+	req[6] = m.unitID
+	req[7] = functionCode
+	binary.BigEndian.PutUint16(req[8:], r.Address)
+	binary.BigEndian.PutUint16(req[10:], 1) // Number of registers.
+	if _, err := m.conn.Write(req); err != nil {
+		return 0, skerr.Wrap(err)
+	}
+
+	header := make([]byte, modbusHeaderSize)
+	if _, err := io.ReadFull(m.conn, header); err != nil {
+		return 0, skerr.Wrapf(err, "reading response header")
+	}
+	if id := binary.BigEndian.Uint16(header[0:]); id != m.transactionID {
+		return 0, skerr.Fmt("response has transaction id %d, expected %d", id, m.transactionID)
+	}
+	length := binary.BigEndian.Uint16(header[4:])
+	if length < 3 {
+		return 0, skerr.Fmt("response is too short: %d bytes", length)
+	}
+	// The length includes the unit id, which is part of the header.
+	pdu := make([]byte, length-1)
+	if _, err := io.ReadFull(m.conn, pdu); err != nil {
+		return 0, skerr.Wrapf(err, "reading response")
+	}
+	if pdu[0] == functionCode|modbusExceptionFlag {
+		return 0, skerr.Fmt("sensor returned exception code 0x%02x", pdu[1])
+	}
+	if pdu[0] != functionCode {
+		return 0, skerr.Fmt("response has function code 0x%02x, expected 0x%02x", pdu[0], functionCode)
+	}
+	if pdu[1] != 2 || len(pdu) < 4 {
+		return 0, skerr.Fmt("response has %d bytes of register data, expected 2", pdu[1])
+	}
+	return binary.BigEndian.Uint16(pdu[2:]), nil
+}
+
+// Close implements the Sensor interface.
+func (m *ModbusTCP) Close() error {
+	if m.conn == nil {
+		return nil
+	}
+	err := m.conn.Close()
+	m.conn = nil
+	return skerr.Wrap(err)
+}
+
+// Make sure ModbusTCP fulfills the Sensor interface.
+var _ Sensor = (*ModbusTCP)(nil)
@@ -0,0 +1,115 @@
+package sensors
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/skerr"
+)
+
+func modbusFromFake(t *testing.T, fsp *fakeSerialPort) *ModbusTCP {
+	m, err := NewModbusTCP(ModbusTCPConfig{
+		Address: "192.168.1.50",
+		UnitID:  3,
+		Registers: []ModbusRegister{
+			{Name: "temp_c", Address: 0x0100, Input: true, Signed: true, Scale: 0.1},
+			{Name: "humidity", Address: 0x0002},
+		},
+	})
+	require.NoError(t, err)
+	m.dial = func(context.Context) (io.ReadWriteCloser, error) {
+		return fsp, nil
+	}
+	return m
+}
+
+func TestNewModbusTCP_NoRegisters_ReturnsError(t *testing.T) {
+
+	_, err := NewModbusTCP(ModbusTCPConfig{Address: "192.168.1.50"})
+	assert.Error(t, err)
+}
+
+func TestNewModbusTCP_RegisterWithoutName_ReturnsError(t *testing.T) {
+
+	_, err := NewModbusTCP(ModbusTCPConfig{
+		Address:   "192.168.1.50",
+		Registers: []ModbusRegister{{Address: 1}},
+	})
+	assert.Error(t, err)
+}
+
+func TestModbusTCPRead_ValidResponses_ReturnsScaledReadings(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(
+		// Transaction 1, input register 0x0100 holds -5.5 °C in tenths.
+		0x00, 0x01, 0x00, 0x00, 0x00, 0x05, 0x03,
+		0x04, 0x02, 0xff, 0xc9,
+		// Transaction 2, holding register 0x0002 holds 46 %RH.
+		0x00, 0x02, 0x00, 0x00, 0x00, 0x05, 0x03,
+		0x03, 0x02, 0x00, 0x2e,
+	)
+	m := modbusFromFake(t, fsp)
+
+	readings, err := m.Read(context.Background())
+	require.NoError(t, err)
+	require.Len(t, readings, 2)
+	assert.Equal(t, "temp_c", readings[0].Name)
+	assert.InDelta(t, -5.5, readings[0].Value, 0.001)
+	assert.Equal(t, Reading{Name: "humidity", Value: 46}, readings[1])
+	assert.Equal(t, []byte{
+		0x00, 0x01, 0x00, 0x00, 0x00, 0x06, 0x03, 0x04, 0x01, 0x00, 0x00, 0x01,
+		0x00, 0x02, 0x00, 0x00, 0x00, 0x06, 0x03, 0x03, 0x00, 0x02, 0x00, 0x01,
+	}, fsp.getWrittenData())
+	assert.False(t, fsp.closed)
+}
+
+func TestModbusTCPRead_ExceptionResponse_ReturnsErrorAndCloses(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(
+		// Illegal data address.
+		0x00, 0x01, 0x00, 0x00, 0x00, 0x03, 0x03,
+		0x84, 0x02,
+	)
+	m := modbusFromFake(t, fsp)
+
+	_, err := m.Read(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exception code 0x02")
+	assert.True(t, fsp.closed)
+}
+
+func TestModbusTCPRead_MismatchedTransactionID_ReturnsError(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(
+		0x00, 0x07, 0x00, 0x00, 0x00, 0x05, 0x03,
+		0x04, 0x02, 0x00, 0x01,
+	)
+	m := modbusFromFake(t, fsp)
+
+	_, err := m.Read(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "transaction id 7")
+}
+
+func TestModbusTCPRead_TruncatedResponse_ReturnsError(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(0x00, 0x01, 0x00)
+	m := modbusFromFake(t, fsp)
+
+	_, err := m.Read(context.Background())
+	require.Error(t, err)
+}
+
+func TestModbusTCPRead_DialFails_ReturnsError(t *testing.T) {
+
+	m := modbusFromFake(t, nil)
+	m.dial = func(context.Context) (io.ReadWriteCloser, error) {
+		return nil, skerr.Fmt("connection refused")
+	}
+
+	_, err := m.Read(context.Background())
+	require.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package sensors
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"go.skia.org/infra/go/metrics2"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+)
+
+// Monitor periodically reads a set of sensors and exports their readings as
+// metrics.
+//
+// Every reading is exported as <prefix><reading name>, tagged with the name of
+// the sensor. For every sensor <prefix>sensor_up is 1 if the sensor was read
+// successfully, and 0 otherwise. For every reading that has a Threshold
+// <prefix>sensor_out_of_range is 1 if the reading is outside of the
+// threshold, and 0 otherwise.
+type Monitor struct {
+	prefix  string
+	sensors map[string]Sensor
+
+	// names are the keys of sensors, sorted.
+	names []string
+
+	// thresholds maps sensor names to reading names to their threshold.
+	// Thresholds that apply to all sensors use the empty sensor name.
+	thresholds map[string]map[string]Threshold
+}
+
+// NewMonitor returns a new *Monitor which exports metrics with names that
+// start with the given prefix, e.g. "skolo_".
+func NewMonitor(prefix string, sensors map[string]Sensor, thresholds []Threshold) (*Monitor, error) {
+	ret := &Monitor{
+		prefix:     prefix,
+		sensors:    sensors,
+		thresholds: map[string]map[string]Threshold{},
+	}
+	for name := range sensors {
+		ret.names = append(ret.names, name)
+	}
+	sort.Strings(ret.names)
+
+	for _, t := range thresholds {
+		if t.Reading == "" {
+			return nil, skerr.Fmt("threshold has no reading: %+v", t)
+		}
+		if t.Min == nil && t.Max == nil {
+			return nil, skerr.Fmt("threshold for %q has neither a min nor a max", t.Reading)
+		}
+		if t.Min != nil && t.Max != nil && *t.Min > *t.Max {
+			return nil, skerr.Fmt("threshold for %q has min %g greater than max %g", t.Reading, *t.Min, *t.Max)
+		}
+		if _, ok := sensors[t.Sensor]; t.Sensor != "" && !ok {
+			return nil, skerr.Fmt("threshold for %q refers to unknown sensor %q", t.Reading, t.Sensor)
+		}
+		if _, ok := ret.thresholds[t.Sensor]; !ok {
+			ret.thresholds[t.Sensor] = map[string]Threshold{}
+		}
+		if _, ok := ret.thresholds[t.Sensor][t.Reading]; ok {
+			return nil, skerr.Fmt("duplicate threshold for %q of sensor %q", t.Reading, t.Sensor)
+		}
+		ret.thresholds[t.Sensor][t.Reading] = t
+	}
+	return ret, nil
+}
+
+// threshold returns the threshold for the given reading of the given sensor.
+// A threshold for the sensor takes precedence over a threshold that applies
+// to all sensors.
+func (m *Monitor) threshold(sensor, reading string) (Threshold, bool) {
+	if t, ok := m.thresholds[sensor][reading]; ok {
+		return t, true
+	}
+	t, ok := m.thresholds[""][reading]
+	return t, ok
+}
+
+// Step reads every sensor once and updates the metrics. It returns an error
+// if any sensor couldn't be read, after reading all the others.
+func (m *Monitor) Step(ctx context.Context) error {
+	var failed []string
+	for _, name := range m.names {
+		tags := map[string]string{"sensor": name}
+		up := metrics2.GetInt64Metric(m.prefix+"sensor_up", tags)
+		readings, err := m.sensors[name].Read(ctx)
+		if err != nil {
+			sklog.Errorf("Failed to read sensor %q: %s", name, err)
+			up.Update(0)
+			failed = append(failed, name)
+			continue
+		}
+		up.Update(1)
+		for _, r := range readings {
+			metrics2.GetFloat64Metric(m.prefix+r.Name, tags).Update(r.Value)
+			t, ok := m.threshold(name, r.Name)
+			if !ok {
+				continue
+			}
+			outOfRange := (t.Min != nil && r.Value < *t.Min) || (t.Max != nil && r.Value > *t.Max)
+			if outOfRange {
+				sklog.Warningf("Reading %q of sensor %q is out of range: %g", r.Name, name, r.Value)
+			}
+			metrics2.GetInt64Metric(m.prefix+"sensor_out_of_range", map[string]string{
+				"sensor":  name,
+				"reading": r.Name,
+			}).Update(boolToInt64(outOfRange))
+		}
+	}
+	if len(failed) > 0 {
+		return skerr.Fmt("failed to read sensors: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// Start reading the sensors every interval, until the context is cancelled.
+// This function does not return until then.
+func (m *Monitor) Start(ctx context.Context, interval time.Duration) {
+	util.RepeatCtx(ctx, interval, func(ctx context.Context) {
+		if err := m.Step(ctx); err != nil {
+			sklog.Error(err)
+		}
+	})
+}
+
+// Close all the sensors.
+func (m *Monitor) Close() error {
+	var err error
+	for _, name := range m.names {
+		if closeErr := m.sensors[name].Close(); closeErr != nil {
+			err = skerr.Wrapf(closeErr, "closing sensor %q", name)
+		}
+	}
+	return err
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
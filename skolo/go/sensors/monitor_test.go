@@ -0,0 +1,115 @@
+package sensors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/metrics2"
+	"go.skia.org/infra/go/skerr"
+)
+
+// fakeSensor implements Sensor for test purposes. It returns the given
+// readings, or err if set.
+type fakeSensor struct {
+	readings []Reading
+	err      error
+	closed   bool
+}
+
+func (f *fakeSensor) Read(context.Context) ([]Reading, error) {
+	return f.readings, f.err
+}
+
+func (f *fakeSensor) Close() error {
+	f.closed = true
+	return nil
+}
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func TestNewMonitor_InvalidThresholds_ReturnsError(t *testing.T) {
+
+	test := func(name string, threshold Threshold) {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewMonitor("test_", map[string]Sensor{"rack4": &fakeSensor{}}, []Threshold{threshold})
+			require.Error(t, err)
+		})
+	}
+
+	test("NoReading", Threshold{Max: float64Ptr(30)})
+	test("NoBounds", Threshold{Reading: "temp_c"})
+	test("MinAboveMax", Threshold{Reading: "temp_c", Min: float64Ptr(30), Max: float64Ptr(10)})
+	test("UnknownSensor", Threshold{Sensor: "rack5", Reading: "temp_c", Max: float64Ptr(30)})
+}
+
+func TestStep_ReadingsAreExportedAndCheckedAgainstThresholds(t *testing.T) {
+
+	const prefix = "test_step_"
+	sensors := map[string]Sensor{
+		"rack4": &fakeSensor{readings: []Reading{
+			{Name: "temp_c", Value: 31},
+			{Name: "humidity", Value: 45},
+		}},
+		"ups": &fakeSensor{readings: []Reading{
+			{Name: "temp_c", Value: 35},
+			{Name: "battery_charge", Value: 20},
+		}},
+	}
+	m, err := NewMonitor(prefix, sensors, []Threshold{
+		{Reading: "temp_c", Max: float64Ptr(30)},
+		// Overrides the threshold above for the UPS.
+		{Sensor: "ups", Reading: "temp_c", Min: float64Ptr(0), Max: float64Ptr(40)},
+		{Sensor: "ups", Reading: "battery_charge", Min: float64Ptr(50)},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, m.Step(context.Background()))
+
+	rack4 := map[string]string{"sensor": "rack4"}
+	ups := map[string]string{"sensor": "ups"}
+	assert.Equal(t, int64(1), metrics2.GetInt64Metric(prefix+"sensor_up", rack4).Get())
+	assert.Equal(t, int64(1), metrics2.GetInt64Metric(prefix+"sensor_up", ups).Get())
+	assert.Equal(t, 31.0, metrics2.GetFloat64Metric(prefix+"temp_c", rack4).Get())
+	assert.Equal(t, 45.0, metrics2.GetFloat64Metric(prefix+"humidity", rack4).Get())
+	assert.Equal(t, 35.0, metrics2.GetFloat64Metric(prefix+"temp_c", ups).Get())
+
+	outOfRange := func(sensor, reading string) int64 {
+		return metrics2.GetInt64Metric(prefix+"sensor_out_of_range", map[string]string{"sensor": sensor, "reading": reading}).Get()
+	}
+	assert.Equal(t, int64(1), outOfRange("rack4", "temp_c"))
+	assert.Equal(t, int64(0), outOfRange("ups", "temp_c"))
+	assert.Equal(t, int64(1), outOfRange("ups", "battery_charge"))
+}
+
+func TestStep_SensorFails_OtherSensorsAreStillRead(t *testing.T) {
+
+	const prefix = "test_step_fails_"
+	sensors := map[string]Sensor{
+		"a": &fakeSensor{err: skerr.Fmt("connection refused")},
+		"b": &fakeSensor{readings: []Reading{{Name: "temp_c", Value: 21}}},
+	}
+	m, err := NewMonitor(prefix, sensors, nil)
+	require.NoError(t, err)
+
+	err = m.Step(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read sensors: a")
+	assert.Equal(t, int64(0), metrics2.GetInt64Metric(prefix+"sensor_up", map[string]string{"sensor": "a"}).Get())
+	assert.Equal(t, int64(1), metrics2.GetInt64Metric(prefix+"sensor_up", map[string]string{"sensor": "b"}).Get())
+	assert.Equal(t, 21.0, metrics2.GetFloat64Metric(prefix+"temp_c", map[string]string{"sensor": "b"}).Get())
+}
+
+func TestClose_AllSensorsAreClosed(t *testing.T) {
+
+	a, b := &fakeSensor{}, &fakeSensor{}
+	m, err := NewMonitor("test_close_", map[string]Sensor{"a": a, "b": b}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Close())
+	assert.True(t, a.closed)
+	assert.True(t, b.closed)
+}
@@ -0,0 +1,184 @@
+package sensors
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+)
+
+const (
+	defaultNUTPort = "3493"
+
+	// nutVarNotSupported is the error NUT returns for variables the UPS
+	// doesn't report, which are skipped.
+	nutVarNotSupported = "ERR VAR-NOT-SUPPORTED"
+)
+
+// nutVariables maps the numeric NUT variables we read to the names of their
+// readings.
+//
+// See https://networkupstools.org/docs/developer-guide.chunked/apas02.html
+var nutVariables = []struct {
+	variable string
+	reading  string
+}{
+	{variable: "battery.charge", reading: "battery_charge"},
+	{variable: "battery.runtime", reading: "battery_runtime_s"},
+	{variable: "input.voltage", reading: "input_voltage"},
+	{variable: "ups.load", reading: "ups_load"},
+}
+
+// NUTConfig is the configuration of a UPS that is monitored through a Network
+// UPS Tools (NUT) server.
+type NUTConfig struct {
+	// Address is the host:port of the NUT server. The port defaults to 3493.
+	Address string `json:"address"`
+
+	// UPS is the name of the UPS on the NUT server.
+	UPS string `json:"ups"`
+}
+
+// NUT reads the status of a UPS from a Network UPS Tools server.
+//
+// Besides the numeric variables in nutVariables it reports the "on_battery"
+// and "low_battery" readings, which are 1 if the ups.status variable contains
+// the OB or LB flags, and 0 otherwise.
+//
+// See https://networkupstools.org/docs/developer-guide.chunked/ar01s09.html
+type NUT struct {
+	ups  string
+	dial dialFunc
+
+	// conn is the connection to the NUT server, or nil if not connected.
+	conn   io.ReadWriteCloser
+	reader *bufio.Reader
+}
+
+// NewNUT returns a new *NUT. The NUT server isn't connected to until the UPS
+// is first read.
+func NewNUT(cfg NUTConfig) (*NUT, error) {
+	if cfg.Address == "" {
+		return nil, skerr.Fmt("an address must be given")
+	}
+	if cfg.UPS == "" {
+		return nil, skerr.Fmt("a UPS name must be given")
+	}
+	address := cfg.Address
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, defaultNUTPort)
+	}
+	return &NUT{
+		ups:  cfg.UPS,
+		dial: tcpDialer(address),
+	}, nil
+}
+
+// Read implements the Sensor interface.
+func (n *NUT) Read(ctx context.Context) ([]Reading, error) {
+	if n.conn == nil {
+		conn, err := n.dial(ctx)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		n.conn = conn
+		n.reader = bufio.NewReader(conn)
+	}
+	if err := setDeadline(n.conn); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	ret, err := n.read()
+	if err != nil {
+		// The connection may be left with a partial response in it, so start
+		// afresh on the next Read.
+		_ = n.Close()
+		return nil, skerr.Wrap(err)
+	}
+	return ret, nil
+}
+
+func (n *NUT) read() ([]Reading, error) {
+	ret := []Reading{}
+	for _, v := range nutVariables {
+		value, ok, err := n.getVar(v.variable)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		if !ok {
+			continue
+		}
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "%s isn't a number: %q", v.variable, value)
+		}
+		ret = append(ret, Reading{Name: v.reading, Value: f})
+	}
+
+	status, ok, err := n.getVar("ups.status")
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	if !ok {
+		return nil, skerr.Fmt("UPS %q doesn't report ups.status", n.ups)
+	}
+	flags := strings.Fields(status)
+	ret = append(ret,
+		Reading{Name: "on_battery", Value: boolToFloat(util.In("OB", flags))},
+		Reading{Name: "low_battery", Value: boolToFloat(util.In("LB", flags))},
+	)
+	return ret, nil
+}
+
+// getVar returns the value of the given variable of the UPS. The returned
+// bool is false if the UPS doesn't support the variable.
+func (n *NUT) getVar(variable string) (string, bool, error) {
+	if _, err := fmt.Fprintf(n.conn, "GET VAR %s %s\n", n.ups, variable); err != nil {
+		return "", false, skerr.Wrap(err)
+	}
+	line, err := n.reader.ReadString('\n')
+	if err != nil {
+		return "", false, skerr.Wrapf(err, "reading %s", variable)
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if line == nutVarNotSupported {
+		return "", false, nil
+	}
+	if strings.HasPrefix(line, "ERR ") {
+		return "", false, skerr.Fmt("reading %s: %s", variable, line)
+	}
+	// The response looks like: VAR <ups> <variable> "<value>"
+	prefix := fmt.Sprintf("VAR %s %s \"", n.ups, variable)
+	if !strings.HasPrefix(line, prefix) || !strings.HasSuffix(line, "\"") || len(line) <= len(prefix) {
+		return "", false, skerr.Fmt("unexpected response reading %s: %q", variable, line)
+	}
+	return line[len(prefix) : len(line)-1], true, nil
+}
+
+// Close implements the Sensor interface.
+func (n *NUT) Close() error {
+	if n.conn == nil {
+		return nil
+	}
+	// Be polite, but the connection is closed either way.
+	_, _ = fmt.Fprint(n.conn, "LOGOUT\n")
+	err := n.conn.Close()
+	n.conn = nil
+	n.reader = nil
+	return skerr.Wrap(err)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Make sure NUT fulfills the Sensor interface.
+var _ Sensor = (*NUT)(nil)
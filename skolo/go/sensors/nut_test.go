@@ -0,0 +1,121 @@
+package sensors
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nutRequests = `GET VAR ups1 battery.charge
+GET VAR ups1 battery.runtime
+GET VAR ups1 input.voltage
+GET VAR ups1 ups.load
+GET VAR ups1 ups.status
+`
+
+func nutFromFake(t *testing.T, fsp *fakeSerialPort) *NUT {
+	n, err := NewNUT(NUTConfig{
+		Address: "192.168.1.52",
+		UPS:     "ups1",
+	})
+	require.NoError(t, err)
+	n.dial = func(context.Context) (io.ReadWriteCloser, error) {
+		return fsp, nil
+	}
+	return n
+}
+
+func nutResponses(lines ...string) []byte {
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+func TestNewNUT_NoUPS_ReturnsError(t *testing.T) {
+
+	_, err := NewNUT(NUTConfig{Address: "192.168.1.52"})
+	assert.Error(t, err)
+}
+
+func TestNUTRead_OnBattery_ReturnsReadings(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(nutResponses(
+		`VAR ups1 battery.charge "87"`,
+		`VAR ups1 battery.runtime "1260"`,
+		`VAR ups1 input.voltage "0.0"`,
+		`VAR ups1 ups.load "23"`,
+		`VAR ups1 ups.status "OB DISCHRG"`,
+	)...)
+	n := nutFromFake(t, fsp)
+
+	readings, err := n.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Reading{
+		{Name: "battery_charge", Value: 87},
+		{Name: "battery_runtime_s", Value: 1260},
+		{Name: "input_voltage", Value: 0},
+		{Name: "ups_load", Value: 23},
+		{Name: "on_battery", Value: 1},
+		{Name: "low_battery", Value: 0},
+	}, readings)
+	assert.Equal(t, nutRequests, string(fsp.getWrittenData()))
+}
+
+func TestNUTRead_UnsupportedVariablesAreSkipped(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(nutResponses(
+		`VAR ups1 battery.charge "100"`,
+		`ERR VAR-NOT-SUPPORTED`,
+		`ERR VAR-NOT-SUPPORTED`,
+		`VAR ups1 ups.load "40"`,
+		`VAR ups1 ups.status "OL"`,
+	)...)
+	n := nutFromFake(t, fsp)
+
+	readings, err := n.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Reading{
+		{Name: "battery_charge", Value: 100},
+		{Name: "ups_load", Value: 40},
+		{Name: "on_battery", Value: 0},
+		{Name: "low_battery", Value: 0},
+	}, readings)
+}
+
+func TestNUTRead_UnknownUPS_ReturnsErrorAndCloses(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(nutResponses(`ERR UNKNOWN-UPS`)...)
+	n := nutFromFake(t, fsp)
+
+	_, err := n.Read(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "UNKNOWN-UPS")
+	assert.True(t, fsp.closed)
+	assert.True(t, strings.HasSuffix(string(fsp.getWrittenData()), "LOGOUT\n"))
+}
+
+func TestNUTRead_MalformedResponse_ReturnsError(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(nutResponses(`VAR ups2 battery.charge "87"`)...)
+	n := nutFromFake(t, fsp)
+
+	_, err := n.Read(context.Background())
+	require.Error(t, err)
+}
+
+func TestNUTRead_NoStatus_ReturnsError(t *testing.T) {
+
+	fsp := (&fakeSerialPort{}).setReadData(nutResponses(
+		`ERR VAR-NOT-SUPPORTED`,
+		`ERR VAR-NOT-SUPPORTED`,
+		`ERR VAR-NOT-SUPPORTED`,
+		`ERR VAR-NOT-SUPPORTED`,
+		`ERR VAR-NOT-SUPPORTED`,
+	)...)
+	n := nutFromFake(t, fsp)
+
+	_, err := n.Read(context.Background())
+	require.Error(t, err)
+}
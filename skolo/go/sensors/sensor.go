@@ -0,0 +1,68 @@
+package sensors
+
+import (
+	"context"
+	"io"
+	"net"
+	"time"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+const (
+	// dialTimeout is how long to wait to connect to a networked sensor.
+	dialTimeout = 10 * time.Second
+
+	// ioTimeout is how long a single Read of a networked sensor may take.
+	ioTimeout = 10 * time.Second
+)
+
+// Reading is a single value measured by a Sensor.
+type Reading struct {
+	// Name of what was measured, e.g. "temp_c". It is used as the suffix of
+	// the name of the metric the value is exported as.
+	Name string
+
+	// Value is the measured value.
+	Value float64
+}
+
+// Sensor is a sensor module, or any other device, that can be polled for
+// readings of the physical world.
+type Sensor interface {
+	// Read returns the current readings of the sensor.
+	Read(ctx context.Context) ([]Reading, error)
+
+	// Close any connection to the sensor.
+	Close() error
+}
+
+// dialFunc opens a connection to a networked sensor.
+type dialFunc func(ctx context.Context) (io.ReadWriteCloser, error)
+
+// tcpDialer returns a dialFunc that connects to the given host:port.
+func tcpDialer(address string) dialFunc {
+	return func(ctx context.Context) (io.ReadWriteCloser, error) {
+		d := net.Dialer{Timeout: dialTimeout}
+		conn, err := d.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return nil, skerr.Wrapf(err, "connecting to %s", address)
+		}
+		return conn, nil
+	}
+}
+
+// deadliner is implemented by connections that support I/O deadlines, such
+// as net.Conn.
+type deadliner interface {
+	SetDeadline(t time.Time) error
+}
+
+// setDeadline limits how long I/O on the given connection may take, if the
+// connection supports deadlines.
+func setDeadline(conn io.ReadWriteCloser) error {
+	if d, ok := conn.(deadliner); ok {
+		return skerr.Wrap(d.SetDeadline(time.Now().Add(ioTimeout)))
+	}
+	return nil
+}
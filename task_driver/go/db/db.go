@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"go.skia.org/infra/go/deepequal"
+	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/util"
 	"go.skia.org/infra/task_driver/go/td"
 )
@@ -20,6 +21,8 @@ func init() {
 	gob.Register(td.ExecData{})
 	gob.Register(td.HttpRequestData{})
 	gob.Register(td.HttpResponseData{})
+	gob.Register(td.CacheData{})
+	gob.Register(td.TestSummaryData{})
 }
 
 // DB is an interface used for storing information about Task Drivers.
@@ -125,6 +128,34 @@ func (t *TaskDriverRun) Copy() *TaskDriverRun {
 	}
 }
 
+// TestSummary returns a td.TestSummaryData which combines the test summaries
+// attached to all steps of the TaskDriverRun, or nil if there are none.
+func (t *TaskDriverRun) TestSummary() (*td.TestSummaryData, error) {
+	// Sort the steps so that the failed tests are listed in a stable order.
+	stepIds := make([]string, 0, len(t.Steps))
+	for id := range t.Steps {
+		stepIds = append(stepIds, id)
+	}
+	sort.Strings(stepIds)
+	var summaries []*td.TestSummaryData
+	for _, id := range stepIds {
+		for _, data := range t.Steps[id].Data {
+			if data.Type != td.DataType_TestSummary {
+				continue
+			}
+			summary, err := td.DecodeTestSummaryData(data.Data)
+			if err != nil {
+				return nil, skerr.Wrapf(err, "failed to decode test summary of step %s", id)
+			}
+			summaries = append(summaries, summary)
+		}
+	}
+	if len(summaries) == 0 {
+		return nil, nil
+	}
+	return td.MergeTestSummaries(summaries...), nil
+}
+
 // UpdateFromMessage updates a TaskDriverRun from the given Message. This is NOT
 // thread-safe, so DB implementations will need to serialize calls to
 // UpdateFromMessage for a given TaskDriverRun.
@@ -0,0 +1,179 @@
+package filesystem
+
+/*
+	Package filesystem provides a read-only implementation of db.DB backed by
+	the directories written by td.FileReceiver, along with access to the logs
+	stored therein. Each subdirectory of the root directory contains a single
+	Task Driver run, named for its task ID.
+*/
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+	"go.skia.org/infra/task_driver/go/db"
+	"go.skia.org/infra/task_driver/go/logs"
+	"go.skia.org/infra/task_driver/go/td"
+)
+
+// maxMessageSize is the maximum size of a single encoded Message. Messages
+// may contain large amounts of step data, eg. command output.
+const maxMessageSize = 64 * 1024 * 1024
+
+// FileSystemDB is a read-only db.DB which reads Task Driver runs from the
+// directories written by td.FileReceiver. It also implements
+// handlers.LogSearcher.
+type FileSystemDB struct {
+	dir string
+}
+
+// NewFileSystemDB returns a FileSystemDB which reads Task Driver runs from
+// subdirectories of the given directory.
+func NewFileSystemDB(dir string) *FileSystemDB {
+	return &FileSystemDB{
+		dir: dir,
+	}
+}
+
+// runDir returns the directory containing the given Task Driver run.
+func (d *FileSystemDB) runDir(id string) (string, error) {
+	if id == "" || id == "." || id == ".." || strings.ContainsAny(id, "/\\") {
+		return "", skerr.Fmt("Invalid task ID %q", id)
+	}
+	return filepath.Join(d.dir, id), nil
+}
+
+// See documentation for db.DB interface.
+func (d *FileSystemDB) GetTaskDriver(ctx context.Context, id string) (*db.TaskDriverRun, error) {
+	runDir, err := d.runDir(id)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(runDir, td.FileReceiverMessagesFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	defer util.Close(f)
+	rv := &db.TaskDriverRun{
+		TaskId: id,
+	}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxMessageSize)
+	for scanner.Scan() {
+		var m td.Message
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			return nil, skerr.Wrapf(err, "failed to decode message for task %s", id)
+		}
+		// The directory may have been renamed; trust the directory name.
+		m.TaskId = id
+		if err := rv.UpdateFromMessage(&m); err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, skerr.Wrapf(err, "failed to read messages for task %s", id)
+	}
+	return rv, nil
+}
+
+// See documentation for db.DB interface.
+func (d *FileSystemDB) UpdateTaskDriver(context.Context, string, *td.Message) error {
+	return skerr.Fmt("FileSystemDB is read-only")
+}
+
+// See documentation for db.DB interface.
+func (d *FileSystemDB) Close() error {
+	// Close() is a no-op for FileSystemDB.
+	return nil
+}
+
+// List returns the IDs of all Task Driver runs in the directory, sorted.
+func (d *FileSystemDB) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	rv := []string{}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(d.dir, e.Name(), td.FileReceiverMessagesFile)); err == nil {
+			rv = append(rv, e.Name())
+		}
+	}
+	sort.Strings(rv)
+	return rv, nil
+}
+
+// Search returns log Entries for the given task, optionally limited to the
+// given step and log stream. Each log stream is returned as a single Entry.
+// Implements handlers.LogSearcher.
+func (d *FileSystemDB) Search(ctx context.Context, taskId, stepId, logId string) ([]*logs.Entry, error) {
+	runDir, err := d.runDir(taskId)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	if logId != "" {
+		logPath, err := td.FileReceiverLogPath(runDir, stepId, logId)
+		if err != nil {
+			return nil, err
+		}
+		paths = []string{logPath}
+	} else {
+		stepGlob := "*"
+		if stepId != "" {
+			// Use FileReceiverLogPath to validate the step ID.
+			logPath, err := td.FileReceiverLogPath(runDir, stepId, "*")
+			if err != nil {
+				return nil, err
+			}
+			stepGlob = filepath.Base(filepath.Dir(logPath))
+		}
+		paths, err = filepath.Glob(filepath.Join(runDir, td.FileReceiverLogsDir, stepGlob, "*"))
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+	}
+
+	rv := []*logs.Entry{}
+	for _, logPath := range paths {
+		fi, err := os.Stat(logPath)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		contents, err := os.ReadFile(logPath)
+		if err != nil {
+			return nil, skerr.Wrap(err)
+		}
+		rv = append(rv, &logs.Entry{
+			Labels: map[string]string{
+				"taskId": taskId,
+				"stepId": filepath.Base(filepath.Dir(logPath)),
+				"logId":  strings.TrimSuffix(filepath.Base(logPath), filepath.Ext(logPath)),
+			},
+			TextPayload: string(contents),
+			Timestamp:   fi.ModTime(),
+		})
+	}
+	// Sort by modification time, ie. the time of the last write to each log.
+	sort.SliceStable(rv, func(i, j int) bool {
+		return rv[i].Timestamp.Before(rv[j].Timestamp)
+	})
+	return rv, nil
+}
+
+// Assert that we implement the db.DB interface.
+var _ db.DB = &FileSystemDB{}
@@ -0,0 +1,137 @@
+package filesystem
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/task_driver/go/td"
+)
+
+const (
+	fakeTaskId = "fake-task-id"
+	fakeStepId = "fake-step-id"
+	fakeLogId  = "fake-log-id"
+)
+
+func setup(t *testing.T) (*FileSystemDB, time.Time) {
+	dir := t.TempDir()
+	rec, err := td.NewFileReceiver(filepath.Join(dir, fakeTaskId))
+	require.NoError(t, err)
+	ts := time.Unix(1571926390, 0).UTC()
+	for _, m := range []*td.Message{
+		{
+			Type: td.MsgType_RunStarted,
+			Run:  &td.RunProperties{Local: true},
+		},
+		{
+			Type:   td.MsgType_StepStarted,
+			StepId: td.StepIDRoot,
+			Step:   &td.StepProperties{Id: td.StepIDRoot, Name: "root"},
+		},
+		{
+			Type:   td.MsgType_StepStarted,
+			StepId: fakeStepId,
+			Step:   &td.StepProperties{Id: fakeStepId, Name: "step", Parent: td.StepIDRoot},
+		},
+		{
+			Type:     td.MsgType_StepData,
+			StepId:   fakeStepId,
+			DataType: td.DataType_Log,
+			Data: &td.LogData{
+				Name:     "stdout",
+				Id:       fakeLogId,
+				Severity: td.SeverityInfo.String(),
+			},
+		},
+		{
+			Type:   td.MsgType_StepFailed,
+			StepId: fakeStepId,
+			Error:  "oh no",
+		},
+		{
+			Type:   td.MsgType_StepFinished,
+			StepId: fakeStepId,
+		},
+		{
+			Type:   td.MsgType_StepFinished,
+			StepId: td.StepIDRoot,
+		},
+	} {
+		ts = ts.Add(time.Second)
+		m.ID = ts.String()
+		m.TaskId = fakeTaskId
+		m.Timestamp = ts
+		require.NoError(t, m.Validate())
+		require.NoError(t, rec.HandleMessage(m))
+	}
+	w, err := rec.LogStream(fakeStepId, fakeLogId, td.SeverityInfo)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("hello world\n"))
+	require.NoError(t, err)
+	require.NoError(t, rec.Close())
+	return NewFileSystemDB(dir), ts
+}
+
+func TestFileSystemDB_GetTaskDriver(t *testing.T) {
+	d, ts := setup(t)
+	ctx := context.Background()
+
+	run, err := d.GetTaskDriver(ctx, fakeTaskId)
+	require.NoError(t, err)
+	require.NotNil(t, run)
+	require.Equal(t, fakeTaskId, run.TaskId)
+	require.True(t, run.Properties.Local)
+	require.Equal(t, td.StepResultSuccess, run.Steps[td.StepIDRoot].Result)
+	require.True(t, ts.Equal(run.Steps[td.StepIDRoot].Finished))
+	step := run.Steps[fakeStepId]
+	require.Equal(t, td.StepResultFailure, step.Result)
+	require.Equal(t, []string{"oh no"}, step.Errors)
+	require.Len(t, step.Data, 1)
+	require.Equal(t, td.DataType_Log, step.Data[0].Type)
+
+	// Missing runs are not an error.
+	run, err = d.GetTaskDriver(ctx, "missing")
+	require.NoError(t, err)
+	require.Nil(t, run)
+
+	// Paths outside of the directory are not allowed.
+	_, err = d.GetTaskDriver(ctx, "..")
+	require.ErrorContains(t, err, "Invalid task ID")
+
+	// The DB is read-only.
+	require.Error(t, d.UpdateTaskDriver(ctx, fakeTaskId, &td.Message{}))
+}
+
+func TestFileSystemDB_List(t *testing.T) {
+	d, _ := setup(t)
+	ids, err := d.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{fakeTaskId}, ids)
+}
+
+func TestFileSystemDB_Search(t *testing.T) {
+	d, _ := setup(t)
+	ctx := context.Background()
+	check := func(taskId, stepId, logId string, expectCount int) {
+		entries, err := d.Search(ctx, taskId, stepId, logId)
+		require.NoError(t, err)
+		require.Len(t, entries, expectCount)
+		for _, e := range entries {
+			require.Equal(t, "hello world\n", e.TextPayload)
+			require.Equal(t, fakeStepId, e.Labels["stepId"])
+			require.Equal(t, fakeLogId, e.Labels["logId"])
+		}
+	}
+	check(fakeTaskId, "", "", 1)
+	check(fakeTaskId, fakeStepId, "", 1)
+	check(fakeTaskId, fakeStepId, fakeLogId, 1)
+	check(fakeTaskId, td.StepIDRoot, "", 0)
+	check(fakeTaskId, fakeStepId, "missing", 0)
+	check("missing", "", "", 0)
+
+	_, err := d.Search(ctx, fakeTaskId, "../..", "")
+	require.ErrorContains(t, err, "Invalid ID for log file")
+}
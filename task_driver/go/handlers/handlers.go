@@ -18,8 +18,15 @@ import (
 	"go.skia.org/infra/task_driver/go/td"
 )
 
-// logsHandler reads log entries from BigTable and writes them to the ResponseWriter.
-func logsHandler(w http.ResponseWriter, r *http.Request, lm *logs.LogsManager, taskId, stepId, logId string) {
+// LogSearcher is used to retrieve Task Driver logs. It is implemented by
+// logs.LogsManager.
+type LogSearcher interface {
+	// Search returns Entries matching the given search terms.
+	Search(ctx context.Context, taskId, stepId, logId string) ([]*logs.Entry, error)
+}
+
+// logsHandler reads log entries from the LogSearcher and writes them to the ResponseWriter.
+func logsHandler(w http.ResponseWriter, r *http.Request, lm LogSearcher, taskId, stepId, logId string) {
 	// TODO(borenet): If we had access to the Task Driver DB, we could first
 	// retrieve the run and then limit our search to its duration. That
 	// might speed up the search quite a bit.
@@ -70,7 +77,7 @@ func getVar(w http.ResponseWriter, r *http.Request, key string) string {
 }
 
 // taskLogsHandler returns a handler which serves logs for a given task.
-func taskLogsHandler(lm *logs.LogsManager) http.HandlerFunc {
+func taskLogsHandler(lm LogSearcher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		taskId := getVar(w, r, "taskId")
 		if taskId == "" {
@@ -81,7 +88,7 @@ func taskLogsHandler(lm *logs.LogsManager) http.HandlerFunc {
 }
 
 // stepLogsHandler returns a handler which serves logs for a given step.
-func stepLogsHandler(lm *logs.LogsManager) http.HandlerFunc {
+func stepLogsHandler(lm LogSearcher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		taskId := getVar(w, r, "taskId")
 		stepId := getVar(w, r, "stepId")
@@ -93,7 +100,7 @@ func stepLogsHandler(lm *logs.LogsManager) http.HandlerFunc {
 }
 
 // singleLogHandler returns a handler which serves logs for a single log ID.
-func singleLogHandler(lm *logs.LogsManager) http.HandlerFunc {
+func singleLogHandler(lm LogSearcher) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		taskId := getVar(w, r, "taskId")
 		stepId := getVar(w, r, "stepId")
@@ -163,6 +170,34 @@ func jsonTaskDriverHandler(d db.DB) http.HandlerFunc {
 	}
 }
 
+// testSummaryHandler returns a td.TestSummaryData which combines the test
+// summaries attached to all steps of the requested Task Driver.
+func testSummaryHandler(d db.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := trace.StartSpan(r.Context(), "testSummaryHandler")
+		defer span.End()
+		run := getTaskDriver(ctx, w, r, d)
+		if run == nil {
+			// Any error was handled by getTaskDriver.
+			return
+		}
+		summary, err := run.TestSummary()
+		if err != nil {
+			httputils.ReportError(w, err, "Failed to decode test summary.", http.StatusInternalServerError)
+			return
+		}
+		if summary == nil {
+			http.Error(w, "The task driver has no test results.", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(summary); err != nil {
+			httputils.ReportError(w, err, "Failed to encode response.", http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
 // fullErrorHandler returns the text of a given error.
 func fullErrorHandler(d db.DB) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -206,8 +241,9 @@ func fullErrorHandler(d db.DB) http.HandlerFunc {
 }
 
 // AddTaskDriverHandlers adds handlers for Task Drivers to the given Router.
-func AddTaskDriverHandlers(r chi.Router, d db.DB, lm *logs.LogsManager) {
+func AddTaskDriverHandlers(r chi.Router, d db.DB, lm LogSearcher) {
 	r.HandleFunc("/json/td/{taskId}", httputils.CorsHandler(jsonTaskDriverHandler(d)))
+	r.HandleFunc("/json/td/{taskId}/test_summary", httputils.CorsHandler(testSummaryHandler(d)))
 	r.HandleFunc("/errors/{taskId}/{errId}", fullErrorHandler(d))
 	r.HandleFunc("/errors/{taskId}/{stepId}/{errId}", fullErrorHandler(d))
 	r.HandleFunc("/logs/{taskId}", taskLogsHandler(lm))
@@ -0,0 +1,96 @@
+package cas
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"go.skia.org/infra/go/cas"
+	"go.skia.org/infra/go/gcs"
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/task_driver/go/td"
+)
+
+// StepCache is a td.StepCache which stores step outputs in CAS. Since CAS is
+// content-addressed, the digest of the outputs for each cache key is recorded
+// in GCS, which allows the outputs to be reused by runs on other machines, eg.
+// when a task is retried on a different bot after a mishap.
+type StepCache struct {
+	cas    cas.CAS
+	gcs    gcs.GCSClient
+	prefix string
+	root   string
+}
+
+// NewStepCache returns a StepCache which uploads outputs to the given CAS and
+// records their digests in GCS under the given prefix. Outputs are relative to
+// root.
+func NewStepCache(casClient cas.CAS, gcsClient gcs.GCSClient, prefix, root string) *StepCache {
+	return &StepCache{
+		cas:    casClient,
+		gcs:    gcsClient,
+		prefix: prefix,
+		root:   root,
+	}
+}
+
+// digestPath returns the path of the GCS file containing the digest for the
+// given cache key.
+func (c *StepCache) digestPath(key string) (string, error) {
+	if key == "" || strings.ContainsAny(key, "/\\") {
+		return "", skerr.Fmt("Invalid step cache key %q", key)
+	}
+	return path.Join(c.prefix, key), nil
+}
+
+// Restore implements td.StepCache.
+func (c *StepCache) Restore(ctx context.Context, key string, outputs []string) (bool, error) {
+	digestPath, err := c.digestPath(key)
+	if err != nil {
+		return false, err
+	}
+	digest, err := c.gcs.GetFileContents(ctx, digestPath)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, skerr.Wrapf(err, "failed to read digest for step cache key %s", key)
+	}
+	// Remove any existing outputs, so that stale files are not left behind.
+	for _, output := range outputs {
+		if !filepath.IsLocal(output) {
+			return false, skerr.Fmt("Invalid path for step cache: %q", output)
+		}
+		if err := os.RemoveAll(filepath.Join(c.root, output)); err != nil {
+			return false, skerr.Wrap(err)
+		}
+	}
+	if err := c.cas.Download(ctx, c.root, string(digest)); err != nil {
+		return false, skerr.Wrapf(err, "failed to download outputs for step cache key %s", key)
+	}
+	return true, nil
+}
+
+// Store implements td.StepCache.
+func (c *StepCache) Store(ctx context.Context, key string, outputs []string) error {
+	digestPath, err := c.digestPath(key)
+	if err != nil {
+		return err
+	}
+	for _, output := range outputs {
+		if !filepath.IsLocal(output) {
+			return skerr.Fmt("Invalid path for step cache: %q", output)
+		}
+	}
+	digest, err := c.cas.Upload(ctx, c.root, outputs, nil)
+	if err != nil {
+		return skerr.Wrapf(err, "failed to upload outputs for step cache key %s", key)
+	}
+	return skerr.Wrap(c.gcs.SetFileContents(ctx, digestPath, gcs.FILE_WRITE_OPTS_TEXT, []byte(digest)))
+}
+
+// Assert that StepCache implements td.StepCache.
+var _ td.StepCache = &StepCache{}
@@ -0,0 +1,50 @@
+package cas
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/cas/mocks"
+	"go.skia.org/infra/go/gcs/mem_gcsclient"
+	"go.skia.org/infra/go/testutils"
+)
+
+const fakeDigest = "abc123/45"
+
+func TestStepCache(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	casClient := &mocks.CAS{}
+	gcsClient := mem_gcsclient.New("fake-bucket")
+	c := NewStepCache(casClient, gcsClient, "step-cache", root)
+
+	// Nothing is stored yet.
+	hit, err := c.Restore(ctx, "key", []string{"out"})
+	require.NoError(t, err)
+	require.False(t, hit)
+
+	// Store the outputs.
+	casClient.On("Upload", testutils.AnyContext, root, []string{"out"}, []string(nil)).Return(fakeDigest, nil).Once()
+	require.NoError(t, c.Store(ctx, "key", []string{"out"}))
+	contents, err := gcsClient.GetFileContents(ctx, "step-cache/key")
+	require.NoError(t, err)
+	require.Equal(t, fakeDigest, string(contents))
+
+	// Restore the outputs. Stale outputs are removed first.
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "out"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "out", "stale.txt"), []byte("stale"), 0644))
+	casClient.On("Download", testutils.AnyContext, root, fakeDigest).Return(nil).Once()
+	hit, err = c.Restore(ctx, "key", []string{"out"})
+	require.NoError(t, err)
+	require.True(t, hit)
+	_, err = os.Stat(filepath.Join(root, "out", "stale.txt"))
+	require.True(t, os.IsNotExist(err))
+	casClient.AssertExpectations(t)
+
+	// Invalid keys and outputs are rejected.
+	require.ErrorContains(t, c.Store(ctx, "a/b", nil), "Invalid step cache key")
+	require.ErrorContains(t, c.Store(ctx, "key", []string{"../out"}), "Invalid path for step cache")
+}
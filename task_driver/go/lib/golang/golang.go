@@ -134,14 +134,19 @@ func InstallCommonDeps(ctx context.Context, workdir string) error {
 }
 
 // Test runs "go test", parses the output, and creates sub-steps for individual
-// tests.
+// tests. A summary of the test results is attached to the current step.
 func Test(ctx context.Context, cwd string, args ...string) error {
+	var events []*test2json.Event
+	defer func() {
+		td.TestSummary(ctx, log_parser.SummarizeTestResults(log_parser.TestResultsFromGoTestEvents(events)))
+	}()
 	return log_parser.Run(ctx, cwd, append([]string{"go", "test", "--json"}, args...), bufio.ScanLines, func(sm *log_parser.StepManager, line string) error {
 		// Decode an event.
 		event, err := test2json.ParseEvent(line)
 		if err != nil {
 			return err
 		}
+		events = append(events, event)
 
 		// Find or create the step associated with this event.
 		step := sm.FindStep(event.Package)
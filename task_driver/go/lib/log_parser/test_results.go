@@ -0,0 +1,422 @@
+package log_parser
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/test2json"
+	"go.skia.org/infra/task_driver/go/td"
+)
+
+// TestStatus indicates the result of a single test.
+type TestStatus string
+
+const (
+	// TestStatusPass indicates that a test passed.
+	TestStatusPass TestStatus = "pass"
+	// TestStatusFail indicates that a test failed.
+	TestStatusFail TestStatus = "fail"
+	// TestStatusSkip indicates that a test was skipped.
+	TestStatusSkip TestStatus = "skip"
+)
+
+// TestResult represents the result of a single test, as parsed from the output
+// of a test framework.
+type TestResult struct {
+	// Suite is the name of the suite, class or package which contains the
+	// test.
+	Suite string
+	// Name is the name of the test within its suite. May be empty, eg. for
+	// Go packages which failed to build.
+	Name string
+	// Status is the result of the test.
+	Status TestStatus
+	// Duration is the amount of time taken by the test.
+	Duration time.Duration
+	// Failure contains any failure message or output of the test. For
+	// skipped tests, it may contain the reason the test was skipped.
+	Failure string
+}
+
+// FullName returns the fully-qualified name of the test.
+func (r *TestResult) FullName() string {
+	if r.Name == "" {
+		return r.Suite
+	}
+	if r.Suite == "" {
+		return r.Name
+	}
+	return r.Suite + "." + r.Name
+}
+
+// SummarizeTestResults returns a TestSummaryData for the given TestResults.
+func SummarizeTestResults(results []*TestResult) *td.TestSummaryData {
+	rv := &td.TestSummaryData{
+		Total: len(results),
+	}
+	var duration time.Duration
+	for _, r := range results {
+		switch r.Status {
+		case TestStatusPass:
+			rv.Passed++
+		case TestStatusFail:
+			rv.Failed++
+			rv.FailedTests = append(rv.FailedTests, r.FullName())
+		case TestStatusSkip:
+			rv.Skipped++
+		}
+		duration += r.Duration
+	}
+	rv.DurationMs = duration.Milliseconds()
+	return rv
+}
+
+// ReportTestResults creates a step with the given name containing a sub-step
+// for each test suite, each of which contains a sub-step for each of its tests,
+// and attaches a TestSummaryData to it. Returns the summary along with an
+// error if any test failed.
+func ReportTestResults(ctx context.Context, name string, results []*TestResult) (*td.TestSummaryData, error) {
+	summary := SummarizeTestResults(results)
+	err := td.Do(ctx, td.Props(name), func(ctx context.Context) error {
+		td.TestSummary(ctx, summary)
+		// Group the results by suite, retaining the original order.
+		var suites []string
+		bySuite := map[string][]*TestResult{}
+		for _, r := range results {
+			if _, ok := bySuite[r.Suite]; !ok {
+				suites = append(suites, r.Suite)
+			}
+			bySuite[r.Suite] = append(bySuite[r.Suite], r)
+		}
+		for _, suite := range suites {
+			// Errors are reported on each step; we only need to know
+			// whether any failed.
+			_ = td.Do(ctx, td.Props(suite), func(ctx context.Context) error {
+				failed := 0
+				var suiteErr error
+				for _, r := range bySuite[suite] {
+					if r.Name == "" {
+						// This result applies to the suite itself.
+						suiteErr = reportTestResult(ctx, r)
+						continue
+					}
+					if err := td.Do(ctx, td.Props(r.Name), func(ctx context.Context) error {
+						return reportTestResult(ctx, r)
+					}); err != nil {
+						failed++
+					}
+				}
+				if suiteErr != nil {
+					return suiteErr
+				}
+				if failed > 0 {
+					return fmt.Errorf("%d tests failed", failed)
+				}
+				return nil
+			})
+		}
+		if summary.Failed > 0 {
+			return fmt.Errorf("%d of %d tests failed:\n%s", summary.Failed, summary.Total, strings.Join(summary.FailedTests, "\n"))
+		}
+		return nil
+	})
+	return summary, err
+}
+
+// reportTestResult attaches information about the given TestResult to the
+// current step and returns an error if the test failed.
+func reportTestResult(ctx context.Context, r *TestResult) error {
+	td.StepText(ctx, "Duration", r.Duration.String())
+	switch r.Status {
+	case TestStatusFail:
+		msg := r.Failure
+		if msg == "" {
+			msg = "Test failed with no output"
+		}
+		return errors.New(msg)
+	case TestStatusSkip:
+		td.StepText(ctx, "Skipped", r.Failure)
+	}
+	return nil
+}
+
+// TestResultsFromGoTestJSON parses the output of "go test -json" and returns
+// the TestResults it describes. Output which is not valid JSON, eg. build
+// errors printed by the go tool, is ignored.
+func TestResultsFromGoTestJSON(r io.Reader) ([]*TestResult, error) {
+	var events []*test2json.Event
+	for event := range test2json.EventStream(r) {
+		events = append(events, event)
+	}
+	return TestResultsFromGoTestEvents(events), nil
+}
+
+// TestResultsFromGoTestEvents returns the TestResults described by the given
+// events from "go test -json". Packages which failed without any failed tests,
+// eg. because they failed to build, are reported as a failed result with no
+// test name. Tests with subtests are only reported through their subtests,
+// unless the test itself failed while all of its subtests passed, so that each
+// failure is only counted once.
+func TestResultsFromGoTestEvents(events []*test2json.Event) []*TestResult {
+	type key struct {
+		pkg  string
+		test string
+	}
+	output := map[key]*strings.Builder{}
+	var rv []*TestResult
+	failedTests := map[string]bool{}
+	for _, e := range events {
+		k := key{pkg: e.Package, test: e.Test}
+		switch e.Action {
+		case test2json.ActionOutput:
+			b, ok := output[k]
+			if !ok {
+				b = &strings.Builder{}
+				output[k] = b
+			}
+			b.WriteString(e.Output)
+		case test2json.ActionPass, test2json.ActionFail, test2json.ActionSkip:
+			status := TestStatusPass
+			if e.Action == test2json.ActionFail {
+				status = TestStatusFail
+			} else if e.Action == test2json.ActionSkip {
+				status = TestStatusSkip
+			}
+			if e.Test == "" {
+				// Only report packages which failed without any failed
+				// tests, and don't report passed or skipped packages,
+				// since their tests are already reported.
+				if status != TestStatusFail || failedTests[e.Package] {
+					continue
+				}
+			} else if status == TestStatusFail {
+				failedTests[e.Package] = true
+			}
+			result := &TestResult{
+				Suite:    e.Package,
+				Name:     e.Test,
+				Status:   status,
+				Duration: time.Duration(e.Elapsed * float64(time.Second)),
+			}
+			if status != TestStatusPass {
+				if b, ok := output[k]; ok {
+					result.Failure = b.String()
+				}
+			}
+			rv = append(rv, result)
+		}
+	}
+	return withoutParentTests(rv)
+}
+
+// withoutParentTests removes the results of Go tests which have subtests, eg.
+// "TestFoo" when "TestFoo/bar" is also present, since their results and
+// durations are the combined results of their subtests. Parents which failed
+// while none of their subtests failed are kept, since the failure is their
+// own.
+func withoutParentTests(results []*TestResult) []*TestResult {
+	type key struct {
+		pkg  string
+		test string
+	}
+	hasSubtests := map[key]bool{}
+	hasFailedSubtests := map[key]bool{}
+	for _, r := range results {
+		// Mark every ancestor of the test, eg. "TestFoo" and "TestFoo/bar"
+		// for "TestFoo/bar/baz".
+		for i := 0; i < len(r.Name); i++ {
+			if r.Name[i] != '/' {
+				continue
+			}
+			k := key{pkg: r.Suite, test: r.Name[:i]}
+			hasSubtests[k] = true
+			if r.Status == TestStatusFail {
+				hasFailedSubtests[k] = true
+			}
+		}
+	}
+	rv := make([]*TestResult, 0, len(results))
+	for _, r := range results {
+		k := key{pkg: r.Suite, test: r.Name}
+		if hasSubtests[k] && (r.Status != TestStatusFail || hasFailedSubtests[k]) {
+			continue
+		}
+		rv = append(rv, r)
+	}
+	return rv
+}
+
+// junitTestSuites represents the root element of a JUnit XML file, as written
+// by eg. pytest --junitxml and gtest --gtest_output=xml.
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite represents a test suite in a JUnit XML file.
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+// junitFailure represents a failure, error or skip element in a JUnit XML file.
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// String returns the text of the junitFailure.
+func (f *junitFailure) String() string {
+	text := strings.TrimSpace(f.Text)
+	if text == "" {
+		return f.Message
+	} else if f.Message == "" || strings.Contains(text, f.Message) {
+		return text
+	}
+	return f.Message + "\n" + text
+}
+
+// junitTestCase represents a test case in a JUnit XML file.
+type junitTestCase struct {
+	Name      string         `xml:"name,attr"`
+	ClassName string         `xml:"classname,attr"`
+	Time      string         `xml:"time,attr"`
+	Failures  []junitFailure `xml:"failure"`
+	Errors    []junitFailure `xml:"error"`
+	Skipped   *junitFailure  `xml:"skipped"`
+	// The below are written by gtest.
+	Status string `xml:"status,attr"`
+	Result string `xml:"result,attr"`
+}
+
+// TestResultsFromJUnitXML parses a JUnit XML file, as written by eg. pytest
+// --junitxml or gtest --gtest_output=xml, and returns the TestResults it
+// describes.
+func TestResultsFromJUnitXML(r io.Reader) ([]*TestResult, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	// The root element may be either <testsuites> or a single <testsuite>.
+	var suites junitTestSuites
+	if err := xml.Unmarshal(b, &suites); err != nil {
+		var suite junitTestSuite
+		if err2 := xml.Unmarshal(b, &suite); err2 != nil {
+			return nil, skerr.Wrapf(err, "failed to parse JUnit XML")
+		}
+		suites.TestSuites = []junitTestSuite{suite}
+	}
+	var rv []*TestResult
+	for _, suite := range suites.TestSuites {
+		for _, tc := range suite.TestCases {
+			suiteName := tc.ClassName
+			if suiteName == "" {
+				suiteName = suite.Name
+			}
+			duration, err := parseSeconds(tc.Time)
+			if err != nil {
+				return nil, skerr.Wrapf(err, "invalid time for test %s.%s", suiteName, tc.Name)
+			}
+			result := &TestResult{
+				Suite:    suiteName,
+				Name:     tc.Name,
+				Status:   TestStatusPass,
+				Duration: duration,
+			}
+			var failures []string
+			for _, f := range append(tc.Failures, tc.Errors...) {
+				failures = append(failures, f.String())
+			}
+			if len(failures) > 0 {
+				result.Status = TestStatusFail
+				result.Failure = strings.Join(failures, "\n")
+			} else if tc.Skipped != nil {
+				result.Status = TestStatusSkip
+				result.Failure = tc.Skipped.String()
+			} else if tc.Status == "notrun" || tc.Result == "skipped" || tc.Result == "suppressed" {
+				result.Status = TestStatusSkip
+			}
+			rv = append(rv, result)
+		}
+	}
+	return rv, nil
+}
+
+// gtestJSON represents a JSON file written by gtest --gtest_output=json.
+type gtestJSON struct {
+	TestSuites []struct {
+		Name      string `json:"name"`
+		TestSuite []struct {
+			Name      string `json:"name"`
+			ClassName string `json:"classname"`
+			Status    string `json:"status"`
+			Result    string `json:"result"`
+			Time      string `json:"time"`
+			Failures  []struct {
+				Failure string `json:"failure"`
+			} `json:"failures"`
+		} `json:"testsuite"`
+	} `json:"testsuites"`
+}
+
+// TestResultsFromGTestJSON parses a JSON file written by gtest
+// --gtest_output=json and returns the TestResults it describes.
+func TestResultsFromGTestJSON(r io.Reader) ([]*TestResult, error) {
+	var data gtestJSON
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, skerr.Wrapf(err, "failed to parse gtest JSON")
+	}
+	var rv []*TestResult
+	for _, suite := range data.TestSuites {
+		for _, tc := range suite.TestSuite {
+			suiteName := tc.ClassName
+			if suiteName == "" {
+				suiteName = suite.Name
+			}
+			duration, err := parseSeconds(strings.TrimSuffix(tc.Time, "s"))
+			if err != nil {
+				return nil, skerr.Wrapf(err, "invalid time for test %s.%s", suiteName, tc.Name)
+			}
+			result := &TestResult{
+				Suite:    suiteName,
+				Name:     tc.Name,
+				Status:   TestStatusPass,
+				Duration: duration,
+			}
+			if len(tc.Failures) > 0 {
+				failures := make([]string, 0, len(tc.Failures))
+				for _, f := range tc.Failures {
+					failures = append(failures, f.Failure)
+				}
+				result.Status = TestStatusFail
+				result.Failure = strings.Join(failures, "\n")
+			} else if strings.EqualFold(tc.Status, "NOTRUN") || strings.EqualFold(tc.Result, "SKIPPED") || strings.EqualFold(tc.Result, "SUPPRESSED") {
+				result.Status = TestStatusSkip
+			}
+			rv = append(rv, result)
+		}
+	}
+	return rv, nil
+}
+
+// parseSeconds parses a (possibly fractional) number of seconds, as found in
+// JUnit XML and gtest output. Returns zero for an empty string.
+func parseSeconds(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	// Some writers include thousands separators.
+	secs, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	if err != nil {
+		return 0, skerr.Wrap(err)
+	}
+	return time.Duration(secs * float64(time.Second)), nil
+}
@@ -0,0 +1,209 @@
+package log_parser
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/task_driver/go/td"
+)
+
+const goTestJSON = `{"Action":"run","Package":"example.com/a","Test":"TestPass"}
+{"Action":"output","Package":"example.com/a","Test":"TestPass","Output":"=== RUN   TestPass\n"}
+{"Action":"pass","Package":"example.com/a","Test":"TestPass","Elapsed":0.5}
+{"Action":"run","Package":"example.com/a","Test":"TestFail"}
+{"Action":"run","Package":"example.com/a","Test":"TestFail/sub"}
+{"Action":"output","Package":"example.com/a","Test":"TestFail/sub","Output":"    a_test.go:10: oh no\n"}
+{"Action":"fail","Package":"example.com/a","Test":"TestFail/sub","Elapsed":0.25}
+{"Action":"fail","Package":"example.com/a","Test":"TestFail","Elapsed":0.25}
+{"Action":"run","Package":"example.com/a","Test":"TestSkip"}
+{"Action":"output","Package":"example.com/a","Test":"TestSkip","Output":"    a_test.go:20: not today\n"}
+{"Action":"skip","Package":"example.com/a","Test":"TestSkip"}
+{"Action":"fail","Package":"example.com/a","Elapsed":1}
+# example.com/b
+b.go:3:1: syntax error
+{"Action":"output","Package":"example.com/b","Output":"FAIL\texample.com/b [build failed]\n"}
+{"Action":"fail","Package":"example.com/b"}
+{"Action":"pass","Package":"example.com/c","Test":"TestOK","Elapsed":1}
+{"Action":"pass","Package":"example.com/c","Elapsed":1}
+`
+
+func TestTestResultsFromGoTestJSON(t *testing.T) {
+	results, err := TestResultsFromGoTestJSON(strings.NewReader(goTestJSON))
+	require.NoError(t, err)
+	require.Equal(t, []*TestResult{
+		{Suite: "example.com/a", Name: "TestPass", Status: TestStatusPass, Duration: 500 * time.Millisecond},
+		{Suite: "example.com/a", Name: "TestFail/sub", Status: TestStatusFail, Duration: 250 * time.Millisecond, Failure: "    a_test.go:10: oh no\n"},
+		{Suite: "example.com/a", Name: "TestSkip", Status: TestStatusSkip, Failure: "    a_test.go:20: not today\n"},
+		{Suite: "example.com/b", Status: TestStatusFail, Failure: "FAIL\texample.com/b [build failed]\n"},
+		{Suite: "example.com/c", Name: "TestOK", Status: TestStatusPass, Duration: time.Second},
+	}, results)
+}
+
+func TestTestResultsFromGoTestJSON_ParentFailsWithPassingSubtests_ReportsParent(t *testing.T) {
+	const data = `{"Action":"run","Package":"example.com/a","Test":"TestParent"}
+{"Action":"run","Package":"example.com/a","Test":"TestParent/sub"}
+{"Action":"pass","Package":"example.com/a","Test":"TestParent/sub","Elapsed":0.25}
+{"Action":"output","Package":"example.com/a","Test":"TestParent","Output":"    a_test.go:30: cleanup failed\n"}
+{"Action":"fail","Package":"example.com/a","Test":"TestParent","Elapsed":0.5}
+{"Action":"run","Package":"example.com/a","Test":"TestNested"}
+{"Action":"run","Package":"example.com/a","Test":"TestNested/a"}
+{"Action":"run","Package":"example.com/a","Test":"TestNested/a/b"}
+{"Action":"pass","Package":"example.com/a","Test":"TestNested/a/b","Elapsed":0.25}
+{"Action":"pass","Package":"example.com/a","Test":"TestNested/a","Elapsed":0.25}
+{"Action":"pass","Package":"example.com/a","Test":"TestNested","Elapsed":0.25}
+`
+	results, err := TestResultsFromGoTestJSON(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Equal(t, []*TestResult{
+		{Suite: "example.com/a", Name: "TestParent/sub", Status: TestStatusPass, Duration: 250 * time.Millisecond},
+		{Suite: "example.com/a", Name: "TestParent", Status: TestStatusFail, Duration: 500 * time.Millisecond, Failure: "    a_test.go:30: cleanup failed\n"},
+		{Suite: "example.com/a", Name: "TestNested/a/b", Status: TestStatusPass, Duration: 250 * time.Millisecond},
+	}, results)
+	summary := SummarizeTestResults(results)
+	require.Equal(t, 2, summary.Passed)
+	require.Equal(t, 1, summary.Failed)
+}
+
+func TestTestResultsFromJUnitXML_Pytest(t *testing.T) {
+	const xmlData = `<?xml version="1.0" encoding="utf-8"?>
+<testsuites>
+  <testsuite name="pytest" tests="4">
+    <testcase classname="tests.test_a" name="test_pass" time="0.010"/>
+    <testcase classname="tests.test_a" name="test_fail" time="1.5">
+      <failure message="AssertionError: 1 != 2">def test_fail():
+&gt;   assert 1 == 2
+E   AssertionError: 1 != 2</failure>
+    </testcase>
+    <testcase classname="tests.test_b" name="test_error" time="0">
+      <error message="fixture not found"/>
+    </testcase>
+    <testcase classname="tests.test_b" name="test_skip" time="0">
+      <skipped type="pytest.skip" message="not on linux">tests/test_b.py:12: not on linux</skipped>
+    </testcase>
+  </testsuite>
+</testsuites>`
+	results, err := TestResultsFromJUnitXML(strings.NewReader(xmlData))
+	require.NoError(t, err)
+	require.Equal(t, []*TestResult{
+		{Suite: "tests.test_a", Name: "test_pass", Status: TestStatusPass, Duration: 10 * time.Millisecond},
+		{Suite: "tests.test_a", Name: "test_fail", Status: TestStatusFail, Duration: 1500 * time.Millisecond, Failure: "def test_fail():\n>   assert 1 == 2\nE   AssertionError: 1 != 2"},
+		{Suite: "tests.test_b", Name: "test_error", Status: TestStatusFail, Failure: "fixture not found"},
+		{Suite: "tests.test_b", Name: "test_skip", Status: TestStatusSkip, Failure: "tests/test_b.py:12: not on linux"},
+	}, results)
+}
+
+func TestTestResultsFromJUnitXML_GTest(t *testing.T) {
+	// gtest writes a single <testsuite> when only one suite is run, and
+	// indicates disabled tests using the status attribute.
+	const xmlData = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="MathTest" tests="3" failures="1" time="0.002">
+  <testcase name="Add" status="run" result="completed" time="0.001" classname="MathTest"/>
+  <testcase name="Sub" status="run" result="completed" time="0.001" classname="MathTest">
+    <failure message="math_test.cc:10&#x0A;Expected equality" type=""><![CDATA[math_test.cc:10
+Expected equality]]></failure>
+  </testcase>
+  <testcase name="DISABLED_Div" status="notrun" result="suppressed" time="0" classname="MathTest"/>
+</testsuite>`
+	results, err := TestResultsFromJUnitXML(strings.NewReader(xmlData))
+	require.NoError(t, err)
+	require.Equal(t, []*TestResult{
+		{Suite: "MathTest", Name: "Add", Status: TestStatusPass, Duration: time.Millisecond},
+		{Suite: "MathTest", Name: "Sub", Status: TestStatusFail, Duration: time.Millisecond, Failure: "math_test.cc:10\nExpected equality"},
+		{Suite: "MathTest", Name: "DISABLED_Div", Status: TestStatusSkip},
+	}, results)
+}
+
+func TestTestResultsFromJUnitXML_Invalid(t *testing.T) {
+	_, err := TestResultsFromJUnitXML(strings.NewReader("not xml"))
+	require.ErrorContains(t, err, "failed to parse JUnit XML")
+}
+
+func TestTestResultsFromGTestJSON(t *testing.T) {
+	const jsonData = `{
+  "tests": 3,
+  "testsuites": [
+    {
+      "name": "MathTest",
+      "testsuite": [
+        {"name": "Add", "status": "RUN", "result": "COMPLETED", "time": "0.001s", "classname": "MathTest"},
+        {"name": "Sub", "status": "RUN", "result": "COMPLETED", "time": "0.002s", "classname": "MathTest",
+         "failures": [{"failure": "math_test.cc:10\nExpected equality", "type": ""}]},
+        {"name": "DISABLED_Div", "status": "NOTRUN", "result": "SUPPRESSED", "time": "0s", "classname": "MathTest"}
+      ]
+    }
+  ]
+}`
+	results, err := TestResultsFromGTestJSON(strings.NewReader(jsonData))
+	require.NoError(t, err)
+	require.Equal(t, []*TestResult{
+		{Suite: "MathTest", Name: "Add", Status: TestStatusPass, Duration: time.Millisecond},
+		{Suite: "MathTest", Name: "Sub", Status: TestStatusFail, Duration: 2 * time.Millisecond, Failure: "math_test.cc:10\nExpected equality"},
+		{Suite: "MathTest", Name: "DISABLED_Div", Status: TestStatusSkip},
+	}, results)
+}
+
+func TestSummarizeTestResults(t *testing.T) {
+	results := []*TestResult{
+		{Suite: "a", Name: "pass", Status: TestStatusPass, Duration: time.Second},
+		{Suite: "a", Name: "fail", Status: TestStatusFail, Duration: 500 * time.Millisecond},
+		{Suite: "b", Status: TestStatusFail},
+		{Suite: "b", Name: "skip", Status: TestStatusSkip},
+	}
+	require.Equal(t, &td.TestSummaryData{
+		Total:       4,
+		Passed:      1,
+		Failed:      2,
+		Skipped:     1,
+		DurationMs:  1500,
+		FailedTests: []string{"a.fail", "b"},
+	}, SummarizeTestResults(results))
+}
+
+func TestReportTestResults(t *testing.T) {
+	results := []*TestResult{
+		{Suite: "a", Name: "pass", Status: TestStatusPass, Duration: time.Second},
+		{Suite: "a", Name: "fail", Status: TestStatusFail, Failure: "oh no"},
+		{Suite: "b", Name: "skip", Status: TestStatusSkip, Failure: "not today"},
+	}
+	var summary *td.TestSummaryData
+	res := td.RunTestSteps(t, false, func(ctx context.Context) error {
+		var err error
+		summary, err = ReportTestResults(ctx, "tests", results)
+		require.ErrorContains(t, err, "1 of 3 tests failed")
+		return nil
+	})
+	require.Equal(t, 1, summary.Failed)
+
+	require.Len(t, res.Steps, 1)
+	testsStep := res.Steps[0]
+	require.Equal(t, "tests", testsStep.Name)
+	require.Equal(t, td.StepResultFailure, testsStep.Result)
+	var found bool
+	for _, d := range testsStep.Data {
+		if s, err := td.DecodeTestSummaryData(d); err == nil && s.Total == 3 {
+			require.Equal(t, summary, s)
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	require.Len(t, testsStep.Steps, 2)
+	suiteA := testsStep.Steps[0]
+	require.Equal(t, "a", suiteA.Name)
+	require.Equal(t, td.StepResultFailure, suiteA.Result)
+	require.Len(t, suiteA.Steps, 2)
+	require.Equal(t, "pass", suiteA.Steps[0].Name)
+	require.Equal(t, td.StepResultSuccess, suiteA.Steps[0].Result)
+	require.Equal(t, "fail", suiteA.Steps[1].Name)
+	require.Equal(t, td.StepResultFailure, suiteA.Steps[1].Result)
+	require.Equal(t, []string{"oh no"}, suiteA.Steps[1].Errors)
+
+	suiteB := testsStep.Steps[1]
+	require.Equal(t, "b", suiteB.Name)
+	require.Equal(t, td.StepResultSuccess, suiteB.Result)
+	require.Len(t, suiteB.Steps, 1)
+	require.Equal(t, td.StepResultSuccess, suiteB.Steps[0].Result)
+}
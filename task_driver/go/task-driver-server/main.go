@@ -227,13 +227,20 @@ func loadTemplates() {
 	))
 }
 
-// Run the web server.
-func runServer(ctx context.Context, serverURL string) {
+// newRouter returns a Router which serves Task Drivers from the DB, using the
+// given LogSearcher to retrieve logs.
+func newRouter(ls handlers.LogSearcher) chi.Router {
 	loadTemplates()
 	r := chi.NewRouter()
 	r.HandleFunc("/td/{taskId}", taskDriverHandler)
 	r.Handle("/dist/*", http.StripPrefix("/dist/", http.HandlerFunc(httputils.MakeResourceHandler(*resourcesDir))))
-	handlers.AddTaskDriverHandlers(r, d, lm)
+	handlers.AddTaskDriverHandlers(r, d, ls)
+	return r
+}
+
+// Run the web server.
+func runServer(ctx context.Context, serverURL string) {
+	r := newRouter(lm)
 	h := httputils.LoggingGzipRequestResponse(r)
 	h = httputils.XFrameOptionsDeny(h)
 	if !*local {
@@ -291,6 +298,10 @@ func handleMessage(ctx context.Context, msg *pubsub.Message) error {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == viewCmd {
+		runViewer(os.Args[2:])
+		return
+	}
 	common.InitWithMust(
 		"task-driver-server",
 		common.PrometheusOpt(promPort),
@@ -0,0 +1,91 @@
+package main
+
+/*
+	The "view" subcommand serves Task Driver runs which were persisted to the
+	local file system by td.FileReceiver, eg. by setting TASK_DRIVER_LOGS_DIR,
+	using the same UI as the server. This allows runs to be inspected offline,
+	eg. after downloading the outputs of a failed bot run.
+*/
+
+import (
+	"flag"
+	"html/template"
+	"net/http"
+
+	"go.skia.org/infra/go/httputils"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/task_driver/go/db/filesystem"
+	"go.skia.org/infra/task_driver/go/td"
+)
+
+const (
+	// viewCmd is the name of the subcommand which serves Task Driver runs
+	// from the local file system.
+	viewCmd = "view"
+)
+
+// viewIndexTemplate lists the Task Driver runs available to the viewer.
+var viewIndexTemplate = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>Task Drivers</title>
+  </head>
+  <body>
+    <h1>Task Drivers in {{.Dir}}</h1>
+    <ul>
+    {{range .Ids}}
+      <li><a href="/td/{{.}}">{{.}}</a></li>
+    {{else}}
+      <li>No Task Driver runs found.</li>
+    {{end}}
+    </ul>
+  </body>
+</html>
+`))
+
+// viewIndexHandler returns a handler which lists the Task Driver runs in the
+// given FileSystemDB.
+func viewIndexHandler(fsDB *filesystem.FileSystemDB, dir string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ids, err := fsDB.List(r.Context())
+		if err != nil {
+			httputils.ReportError(w, err, "Failed to list task drivers.", http.StatusInternalServerError)
+			return
+		}
+		page := struct {
+			Dir string
+			Ids []string
+		}{
+			Dir: dir,
+			Ids: ids,
+		}
+		w.Header().Set("Content-Type", "text/html")
+		if err := viewIndexTemplate.Execute(w, page); err != nil {
+			httputils.ReportError(w, err, "Server could not load page", http.StatusInternalServerError)
+			return
+		}
+	}
+}
+
+// runViewer runs the "view" subcommand with the given arguments.
+func runViewer(args []string) {
+	fs := flag.NewFlagSet(viewCmd, flag.ExitOnError)
+	dir := fs.String("dir", "", "Directory containing Task Driver runs, ie. the value of $"+td.EnvVarLogsDir+" when the Task Drivers were run.")
+	fs.StringVar(port, "port", *port, "HTTP service port (e.g., ':8000')")
+	fs.StringVar(resourcesDir, "resources_dir", *resourcesDir, "The directory to find templates, JS, and CSS files.")
+	if err := fs.Parse(args); err != nil {
+		sklog.Fatal(err)
+	}
+	if *dir == "" {
+		sklog.Fatal("--dir is required.")
+	}
+	// Reload templates on every request, as when running locally.
+	*local = true
+
+	fsDB := filesystem.NewFileSystemDB(*dir)
+	d = fsDB
+	r := newRouter(fsDB)
+	r.HandleFunc("/", viewIndexHandler(fsDB, *dir))
+	sklog.Infof("Serving Task Drivers from %s on http://localhost%s", *dir, *port)
+	sklog.Fatal(http.ListenAndServe(*port, httputils.LoggingGzipRequestResponse(r)))
+}
@@ -28,6 +28,10 @@ type Context struct {
 	// execRun provides a Run function to be called by execCtx. This is used
 	// for testing, where we may want to mock out subprocess invocations.
 	execRun func(context.Context, *exec.Command) error
+
+	// stepCache stores the outputs of cacheable steps, set via
+	// WithStepCache.
+	stepCache StepCache
 }
 
 // getCtx retrieves the current Context. Panics if none exists.
@@ -64,6 +68,9 @@ func withChildCtx(ctx context.Context, child *Context) context.Context {
 	if child.execRun == nil {
 		child.execRun = parent.execRun
 	}
+	if child.stepCache == nil {
+		child.stepCache = parent.stepCache
+	}
 	ctx = context.WithValue(ctx, contextKey, child)
 	// Any time we set the parent step, env, or execRun, we need to set a
 	// new execCtx to ensure that exec has access to the new information.
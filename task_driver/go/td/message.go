@@ -20,6 +20,8 @@ const (
 	DataType_Command      DataType = "command"
 	DataType_HttpRequest  DataType = "httpRequest"
 	DataType_HttpResponse DataType = "httpResponse"
+	DataType_Cache        DataType = "cache"
+	DataType_TestSummary  DataType = "testSummary"
 )
 
 // MessageType indicates the type of a Message.
@@ -123,6 +125,8 @@ func (m *Message) Validate() error {
 		case DataType_Command:
 		case DataType_HttpRequest:
 		case DataType_HttpResponse:
+		case DataType_Cache:
+		case DataType_TestSummary:
 		default:
 			return skerr.Fmt("Invalid DataType %q", m.DataType)
 		}
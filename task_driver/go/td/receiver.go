@@ -6,15 +6,32 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"sync"
 
 	"cloud.google.com/go/logging"
+	"go.skia.org/infra/go/skerr"
 	"go.skia.org/infra/go/sklog"
 	"go.skia.org/infra/go/util"
 )
 
+const (
+	// FileReceiverMessagesFile is the name of the file, within the directory
+	// used by a FileReceiver, to which Messages are written as JSON, one per
+	// line.
+	FileReceiverMessagesFile = "messages.json"
+
+	// FileReceiverLogsDir is the name of the subdirectory, within the
+	// directory used by a FileReceiver, to which log streams are written.
+	FileReceiverLogsDir = "logs"
+
+	// fileReceiverLogExt is the file extension used for log streams written
+	// by a FileReceiver.
+	fileReceiverLogExt = ".log"
+)
+
 // Severity indicates the importance of a LogStream, with greater values
 // indicating greater severity. Valid values include Debug, Info, Warning, and
 // Error.
@@ -147,6 +164,7 @@ type StepReport struct {
 	Exceptions []string      `json:"exceptions,omitempty"`
 	Logs       map[string]*bytes.Buffer
 	Result     StepResult    `json:"result,omitempty"`
+	CacheHit   bool          `json:"cacheHit,omitempty"`
 	Steps      []*StepReport `json:"steps,omitempty"`
 }
 
@@ -254,6 +272,9 @@ func (r *ReportReceiver) HandleMessage(m *Message) error {
 			return err
 		}
 		s.Data = append(s.Data, m.Data)
+		if d, ok := m.Data.(*CacheData); ok && d.Hit {
+			s.CacheHit = true
+		}
 	}
 	return nil
 }
@@ -381,3 +402,89 @@ func (r *CloudLoggingReceiver) LogStream(stepId, logId string, severity Severity
 func (r *CloudLoggingReceiver) Close() error {
 	return r.logger.Flush()
 }
+
+// FileReceiver is a Receiver which persists step metadata and logs to a
+// directory on the local file system, so that runs can be inspected after the
+// fact, eg. using the "view" subcommand of task-driver-server. Messages are
+// appended as JSON to FileReceiverMessagesFile and each log stream is written
+// to its own file; see FileReceiverLogPath.
+type FileReceiver struct {
+	dir      string
+	mtx      sync.Mutex
+	messages *os.File
+	logs     []*os.File
+}
+
+// NewFileReceiver returns a FileReceiver which writes to the given directory,
+// creating it if necessary. If the directory already contains messages, eg.
+// from a parent Task Driver, new messages are appended.
+func NewFileReceiver(dir string) (*FileReceiver, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, skerr.Wrapf(err, "failed to create %s", dir)
+	}
+	messages, err := os.OpenFile(filepath.Join(dir, FileReceiverMessagesFile), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	return &FileReceiver{
+		dir:      dir,
+		messages: messages,
+	}, nil
+}
+
+// FileReceiverLogPath returns the path of the file containing the given log
+// stream, within a directory written by a FileReceiver. Returns an error if
+// either ID is not usable as a file name.
+func FileReceiverLogPath(dir, stepId, logId string) (string, error) {
+	for _, id := range []string{stepId, logId} {
+		if id == "" || id == "." || id == ".." || strings.ContainsAny(id, "/\\") {
+			return "", skerr.Fmt("Invalid ID for log file: %q", id)
+		}
+	}
+	return filepath.Join(dir, FileReceiverLogsDir, stepId, logId+fileReceiverLogExt), nil
+}
+
+// HandleMessage implements Receiver.
+func (r *FileReceiver) HandleMessage(m *Message) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	_, err = r.messages.Write(append(b, '\n'))
+	return skerr.Wrap(err)
+}
+
+// LogStream implements Receiver.
+func (r *FileReceiver) LogStream(stepId, logId string, _ Severity) (io.Writer, error) {
+	logPath, err := FileReceiverLogPath(r.dir, stepId, logId)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(logPath), os.ModePerm); err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	f, err := os.OpenFile(logPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return nil, skerr.Wrapf(err, "Step %s already has a log with ID %s", stepId, logId)
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.logs = append(r.logs, f)
+	return f, nil
+}
+
+// Close implements Receiver.
+func (r *FileReceiver) Close() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	var rvErr error
+	for _, f := range append(r.logs, r.messages) {
+		if err := f.Close(); err != nil && rvErr == nil {
+			rvErr = skerr.Wrap(err)
+		}
+	}
+	r.logs = nil
+	return rvErr
+}
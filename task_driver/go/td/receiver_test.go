@@ -0,0 +1,77 @@
+package td
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileReceiver(t *testing.T) {
+	dir := t.TempDir()
+	rec, err := NewFileReceiver(dir)
+	require.NoError(t, err)
+	report := newReportReceiver("")
+	ctx := newRun(context.Background(), MultiReceiver{report, rec}, "fake-task-id", "fake-test-task", &RunProperties{Local: true})
+	_ = Do(ctx, Props("logging step"), func(ctx context.Context) error {
+		_, err := NewLogStream(ctx, "stdout", SeverityInfo).Write([]byte("hello world"))
+		require.NoError(t, err)
+		return errors.New("oh no")
+	})
+	finishStep(ctx, nil)
+	require.NoError(t, getCtx(ctx).run.Close())
+
+	// Every message was persisted, in order.
+	f, err := os.Open(filepath.Join(dir, FileReceiverMessagesFile))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	var types []MessageType
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var m Message
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &m))
+		require.NoError(t, m.Validate())
+		require.Equal(t, "fake-task-id", m.TaskId)
+		types = append(types, m.Type)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []MessageType{
+		MsgType_RunStarted,
+		MsgType_StepStarted,
+		MsgType_StepStarted,
+		MsgType_StepData,
+		MsgType_StepFailed,
+		MsgType_StepFinished,
+		MsgType_StepFinished,
+	}, types)
+
+	// The log stream was persisted.
+	step := report.root.Steps[0]
+	require.Len(t, step.Data, 1)
+	logData := step.Data[0].(*LogData)
+	logPath, err := FileReceiverLogPath(dir, step.Id, logData.Id)
+	require.NoError(t, err)
+	contents, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(contents))
+}
+
+func TestFileReceiverLogPath_InvalidIDs(t *testing.T) {
+	for _, ids := range [][2]string{
+		{"", "log"},
+		{"step", ""},
+		{"..", "log"},
+		{"step", "../../etc/passwd"},
+		{`a\b`, "log"},
+	} {
+		_, err := FileReceiverLogPath("dir", ids[0], ids[1])
+		require.ErrorContains(t, err, "Invalid ID for log file")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -44,6 +45,11 @@ const (
 	// EnvVarWrappedStepID indicates that a task driver is nested inside of
 	// another, with the given step ID as its parent.
 	EnvVarWrappedStepID = "TASK_DRIVER_WRAPPED_STEP_ID"
+
+	// EnvVarLogsDir indicates that step metadata and logs should be persisted
+	// to a subdirectory of the given directory, named for the task ID, using a
+	// FileReceiver.
+	EnvVarLogsDir = "TASK_DRIVER_LOGS_DIR"
 )
 
 var (
@@ -200,6 +206,15 @@ func StartRunWithErr(projectId, taskId, taskName, output *string, local *bool) (
 		newReportReceiver(*output),
 	})
 
+	// Persist step metadata and logs to the local file system if requested.
+	if logsDir := os.Getenv(EnvVarLogsDir); logsDir != "" {
+		fileReceiver, err := NewFileReceiver(filepath.Join(logsDir, *taskId))
+		if err != nil {
+			return nil, err
+		}
+		receiver = append(receiver, fileReceiver)
+	}
+
 	// Initialize Cloud Logging.
 	ctx := context.Background()
 	if *projectId != "" && *taskId != "" && *taskName != "" {
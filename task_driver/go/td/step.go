@@ -191,13 +191,23 @@ func StepText(ctx context.Context, label, value string) {
 }
 
 // Do is a convenience function which runs the given function as a Step. It
-// handles creation of the sub-step and calling EndStep() for you.
+// handles creation of the sub-step and calling EndStep() for you. If the step
+// has a CacheKey and a StepCache was provided via WithStepCache, the function
+// is skipped if the step's outputs can be restored from the cache, and the
+// outputs are stored in the cache after the function succeeds.
 func Do(ctx context.Context, props *StepProperties, fn func(context.Context) error) error {
 	ctx = StartStep(ctx, props)
 	defer EndStep(ctx)
+	cache := getStepCache(ctx)
+	if cache != nil && restoreStepOutputs(ctx, cache) {
+		return nil
+	}
 	if err := fn(ctx); err != nil {
 		return FailStep(ctx, err)
 	}
+	if cache != nil {
+		storeStepOutputs(ctx, cache)
+	}
 	return nil
 }
 
@@ -0,0 +1,222 @@
+package td
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/sklog"
+	"go.skia.org/infra/go/util"
+)
+
+// StepCache stores the outputs of steps so that they can be reused by later
+// runs, eg. when a task is retried after a mishap. Outputs are paths relative
+// to a root directory chosen by the implementation.
+type StepCache interface {
+	// Restore copies the outputs stored under the given key into place.
+	// Returns false with no error if nothing is stored under the key.
+	Restore(ctx context.Context, key string, outputs []string) (bool, error)
+
+	// Store saves the given outputs under the given key.
+	Store(ctx context.Context, key string, outputs []string) error
+}
+
+// CacheData is extra Step data generated for steps which have a CacheKey.
+type CacheData struct {
+	Key string `json:"key"`
+	Hit bool   `json:"hit"`
+}
+
+// CacheKey returns a key suitable for use as StepProperties.CacheKey, derived
+// from the given inputs, eg. commit hashes, command lines and file digests.
+func CacheKey(inputs ...string) string {
+	h := sha256.New()
+	for _, input := range inputs {
+		// Separate the inputs so that eg. ("ab", "c") and ("a", "bc")
+		// produce different keys.
+		_, _ = h.Write([]byte(input))
+		_, _ = h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// WithStepCache sets the StepCache used by steps which have a CacheKey.
+func WithStepCache(ctx context.Context, c StepCache) context.Context {
+	return withChildCtx(ctx, &Context{
+		stepCache: c,
+	})
+}
+
+// getStepCache returns the StepCache for the current step, or nil if the step
+// is not cacheable or no StepCache was provided.
+func getStepCache(ctx context.Context) StepCache {
+	if getCtx(ctx).step.CacheKey == "" {
+		return nil
+	}
+	return getCtx(ctx).stepCache
+}
+
+// restoreStepOutputs attempts to restore the outputs of the current step from
+// the StepCache and records the result as step data. Returns true if the
+// outputs were restored and the step does not need to run. Errors are logged
+// but otherwise ignored, so that a broken cache does not cause the step to
+// fail.
+func restoreStepOutputs(ctx context.Context, c StepCache) bool {
+	props := getCtx(ctx).step
+	hit, err := c.Restore(ctx, props.CacheKey, props.CacheOutputs)
+	if err != nil {
+		sklog.Warningf("Failed to restore outputs of step %q from cache: %s", props.Name, err)
+		hit = false
+	}
+	StepData(ctx, DataType_Cache, &CacheData{
+		Key: props.CacheKey,
+		Hit: hit,
+	})
+	return hit
+}
+
+// storeStepOutputs stores the outputs of the current step in the StepCache.
+// Errors are logged but otherwise ignored.
+func storeStepOutputs(ctx context.Context, c StepCache) {
+	props := getCtx(ctx).step
+	if err := c.Store(ctx, props.CacheKey, props.CacheOutputs); err != nil {
+		sklog.Warningf("Failed to store outputs of step %q in cache: %s", props.Name, err)
+	}
+}
+
+// validateCachePath returns an error if the given key or output is not usable
+// as a path within a cache directory.
+func validateCachePath(path string) error {
+	if path == "" || !filepath.IsLocal(path) {
+		return skerr.Fmt("Invalid path for step cache: %q", path)
+	}
+	return nil
+}
+
+// LocalStepCache is a StepCache which stores outputs in a directory on the
+// local file system, eg. a Swarming named cache.
+type LocalStepCache struct {
+	cacheDir string
+	root     string
+}
+
+// NewLocalStepCache returns a LocalStepCache which stores outputs in cacheDir.
+// Outputs are relative to root.
+func NewLocalStepCache(cacheDir, root string) *LocalStepCache {
+	return &LocalStepCache{
+		cacheDir: cacheDir,
+		root:     root,
+	}
+}
+
+// Restore implements StepCache.
+func (c *LocalStepCache) Restore(_ context.Context, key string, outputs []string) (bool, error) {
+	if err := validateCachePath(key); err != nil {
+		return false, err
+	}
+	entryDir := filepath.Join(c.cacheDir, key)
+	if _, err := os.Stat(entryDir); os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, skerr.Wrap(err)
+	}
+	for _, output := range outputs {
+		if err := validateCachePath(output); err != nil {
+			return false, err
+		}
+		dest := filepath.Join(c.root, output)
+		if err := os.RemoveAll(dest); err != nil {
+			return false, skerr.Wrap(err)
+		}
+		if err := copyPath(filepath.Join(entryDir, output), dest); err != nil {
+			return false, skerr.Wrapf(err, "failed to restore %s", output)
+		}
+	}
+	return true, nil
+}
+
+// Store implements StepCache.
+func (c *LocalStepCache) Store(_ context.Context, key string, outputs []string) error {
+	if err := validateCachePath(key); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.cacheDir, os.ModePerm); err != nil {
+		return skerr.Wrap(err)
+	}
+	// Copy the outputs to a temporary directory and move it into place once
+	// all outputs are copied, so that partially-stored entries are never
+	// restored.
+	tmp, err := os.MkdirTemp(c.cacheDir, "tmp-"+key)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	defer util.RemoveAll(tmp)
+	for _, output := range outputs {
+		if err := validateCachePath(output); err != nil {
+			return err
+		}
+		if err := copyPath(filepath.Join(c.root, output), filepath.Join(tmp, output)); err != nil {
+			return skerr.Wrapf(err, "failed to store %s", output)
+		}
+	}
+	entryDir := filepath.Join(c.cacheDir, key)
+	if _, err := os.Stat(entryDir); err == nil {
+		// The outputs were already stored, eg. by a concurrent run.
+		return nil
+	}
+	return skerr.Wrap(os.Rename(tmp, entryDir))
+}
+
+// copyPath copies the file, symlink or directory at src to dst, creating any
+// parent directories of dst.
+func copyPath(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm())
+		}
+		if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		}
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+// copyFile copies the regular file at src to dst with the given permissions.
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer util.Close(in)
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// Assert that LocalStepCache implements StepCache.
+var _ StepCache = &LocalStepCache{}
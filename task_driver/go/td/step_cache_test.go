@@ -0,0 +1,110 @@
+package td
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKey(t *testing.T) {
+	require.Equal(t, CacheKey("a", "b"), CacheKey("a", "b"))
+	require.NotEqual(t, CacheKey("a", "b"), CacheKey("b", "a"))
+	require.NotEqual(t, CacheKey("ab", "c"), CacheKey("a", "bc"))
+}
+
+func TestLocalStepCache(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	c := NewLocalStepCache(t.TempDir(), root)
+
+	// Nothing is stored yet.
+	hit, err := c.Restore(ctx, "key", []string{"out"})
+	require.NoError(t, err)
+	require.False(t, hit)
+
+	// Store a file and a directory.
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "out", "sub"), os.ModePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "out", "sub", "a.txt"), []byte("a"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "b.txt"), []byte("b"), 0755))
+	require.NoError(t, c.Store(ctx, "key", []string{"out", "b.txt"}))
+
+	// Remove the outputs and restore them.
+	require.NoError(t, os.RemoveAll(filepath.Join(root, "out")))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "b.txt"), []byte("modified"), 0644))
+	hit, err = c.Restore(ctx, "key", []string{"out", "b.txt"})
+	require.NoError(t, err)
+	require.True(t, hit)
+	contents, err := os.ReadFile(filepath.Join(root, "out", "sub", "a.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "a", string(contents))
+	contents, err = os.ReadFile(filepath.Join(root, "b.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "b", string(contents))
+	fi, err := os.Stat(filepath.Join(root, "b.txt"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0755), fi.Mode().Perm())
+
+	// Paths outside of the root are not allowed.
+	require.ErrorContains(t, c.Store(ctx, "key2", []string{"../out"}), "Invalid path for step cache")
+	_, err = c.Restore(ctx, "../key", nil)
+	require.ErrorContains(t, err, "Invalid path for step cache")
+}
+
+func TestDo_StepCache(t *testing.T) {
+	root := t.TempDir()
+	c := NewLocalStepCache(t.TempDir(), root)
+	runCount := 0
+	step := func(ctx context.Context) error {
+		return Do(WithStepCache(ctx, c), Props("cached step").Cache(CacheKey("inputs"), "out.txt"), func(ctx context.Context) error {
+			runCount++
+			return os.WriteFile(filepath.Join(root, "out.txt"), []byte("output"), 0644)
+		})
+	}
+
+	// The first run is a cache miss.
+	res := RunTestSteps(t, false, step)
+	require.Equal(t, 1, runCount)
+	require.Equal(t, StepResultSuccess, res.Steps[0].Result)
+	require.False(t, res.Steps[0].CacheHit)
+	require.Equal(t, []interface{}{&CacheData{Key: CacheKey("inputs"), Hit: false}}, res.Steps[0].Data)
+
+	// The second run is a cache hit, so the step doesn't run, but its
+	// outputs are restored.
+	require.NoError(t, os.Remove(filepath.Join(root, "out.txt")))
+	res = RunTestSteps(t, false, step)
+	require.Equal(t, 1, runCount)
+	require.Equal(t, StepResultSuccess, res.Steps[0].Result)
+	require.True(t, res.Steps[0].CacheHit)
+	contents, err := os.ReadFile(filepath.Join(root, "out.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "output", string(contents))
+
+	// Failed steps are not cached.
+	failing := func(ctx context.Context) error {
+		return Do(WithStepCache(ctx, c), Props("failing step").Cache(CacheKey("other inputs")), func(ctx context.Context) error {
+			runCount++
+			return errors.New("failed")
+		})
+	}
+	_ = RunTestSteps(t, false, failing)
+	res = RunTestSteps(t, false, failing)
+	require.Equal(t, 3, runCount)
+	require.Equal(t, StepResultFailure, res.Steps[0].Result)
+	require.False(t, res.Steps[0].CacheHit)
+
+	// Steps without a cache key are not cached.
+	uncached := func(ctx context.Context) error {
+		return Do(WithStepCache(ctx, c), Props("uncached step"), func(ctx context.Context) error {
+			runCount++
+			return nil
+		})
+	}
+	_ = RunTestSteps(t, false, uncached)
+	res = RunTestSteps(t, false, uncached)
+	require.Equal(t, 5, runCount)
+	require.Empty(t, res.Steps[0].Data)
+}
@@ -24,6 +24,17 @@ type StepProperties struct {
 	// Parent step ID. This is set by the framework and should not be set
 	// by callers.
 	Parent string `json:"parent,omitempty"`
+
+	// CacheKey identifies the inputs of this step, eg. as generated by
+	// CacheKey(). If set, and a StepCache has been provided via
+	// WithStepCache, Do skips the step if its outputs were already stored
+	// under this key by a previous run.
+	CacheKey string `json:"cacheKey,omitempty"`
+
+	// CacheOutputs are the paths of the outputs of this step, relative to the
+	// root directory of the StepCache, which are stored and restored using
+	// CacheKey.
+	CacheOutputs []string `json:"cacheOutputs,omitempty" go2ts:"ignorenil"`
 }
 
 // Props sets the name of the step. It returns a StepProperties instance which
@@ -48,17 +59,27 @@ func (p *StepProperties) Env(env []string) *StepProperties {
 	return p
 }
 
+// Cache marks the step as cacheable, with the given key identifying its
+// inputs and the given paths as its outputs. See StepProperties.CacheKey.
+func (p *StepProperties) Cache(key string, outputs ...string) *StepProperties {
+	p.CacheKey = key
+	p.CacheOutputs = outputs
+	return p
+}
+
 // Copy returns a deep copy of the StepProperties.
 func (p *StepProperties) Copy() *StepProperties {
 	if p == nil {
 		return nil
 	}
 	return &StepProperties{
-		Id:      p.Id,
-		Name:    p.Name,
-		IsInfra: p.IsInfra,
-		Environ: util.CopyStringSlice(p.Environ),
-		Parent:  p.Parent,
+		Id:           p.Id,
+		Name:         p.Name,
+		IsInfra:      p.IsInfra,
+		Environ:      util.CopyStringSlice(p.Environ),
+		Parent:       p.Parent,
+		CacheKey:     p.CacheKey,
+		CacheOutputs: util.CopyStringSlice(p.CacheOutputs),
 	}
 }
 
@@ -0,0 +1,68 @@
+package td
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.skia.org/infra/go/skerr"
+)
+
+// TestSummaryData is Step data which summarizes the results of a set of tests,
+// eg. as produced by the log_parser package. Status serves the summary of all
+// the tests of a Task Driver run, see the handlers package, and Datahopper
+// reports it as metrics for the most recent run of each task.
+type TestSummaryData struct {
+	// Total is the total number of tests which were run or skipped.
+	Total int `json:"total"`
+	// Passed is the number of tests which passed.
+	Passed int `json:"passed"`
+	// Failed is the number of tests which failed.
+	Failed int `json:"failed"`
+	// Skipped is the number of tests which were skipped.
+	Skipped int `json:"skipped"`
+	// DurationMs is the total duration of the tests, in milliseconds.
+	DurationMs int64 `json:"durationMs"`
+	// FailedTests contains the full names of the tests which failed.
+	FailedTests []string `json:"failedTests,omitempty"`
+}
+
+// TestSummary attaches the given TestSummaryData to this Step.
+func TestSummary(ctx context.Context, summary *TestSummaryData) {
+	StepData(ctx, DataType_TestSummary, summary)
+}
+
+// DecodeTestSummaryData returns the TestSummaryData contained in the given
+// step data, which may be either a *TestSummaryData or the result of decoding
+// one from JSON, eg. as stored by the Task Driver server.
+func DecodeTestSummaryData(data interface{}) (*TestSummaryData, error) {
+	switch d := data.(type) {
+	case *TestSummaryData:
+		return d, nil
+	case TestSummaryData:
+		return &d, nil
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, skerr.Wrap(err)
+	}
+	var rv TestSummaryData
+	if err := json.Unmarshal(b, &rv); err != nil {
+		return nil, skerr.Wrapf(err, "step data is not a TestSummaryData")
+	}
+	return &rv, nil
+}
+
+// MergeTestSummaries returns a TestSummaryData which combines all of the given
+// summaries, eg. those attached to different steps of the same run.
+func MergeTestSummaries(summaries ...*TestSummaryData) *TestSummaryData {
+	rv := &TestSummaryData{}
+	for _, s := range summaries {
+		rv.Total += s.Total
+		rv.Passed += s.Passed
+		rv.Failed += s.Failed
+		rv.Skipped += s.Skipped
+		rv.DurationMs += s.DurationMs
+		rv.FailedTests = append(rv.FailedTests, s.FailedTests...)
+	}
+	return rv
+}
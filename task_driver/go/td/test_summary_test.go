@@ -0,0 +1,43 @@
+package td
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTestSummaries(t *testing.T) {
+	a := &TestSummaryData{
+		Total:       3,
+		Passed:      1,
+		Failed:      1,
+		Skipped:     1,
+		DurationMs:  100,
+		FailedTests: []string{"pkg.TestA"},
+	}
+	b := &TestSummaryData{
+		Total:       2,
+		Failed:      2,
+		DurationMs:  50,
+		FailedTests: []string{"suite.B", "suite.C"},
+	}
+	require.Equal(t, &TestSummaryData{
+		Total:       5,
+		Passed:      1,
+		Failed:      3,
+		Skipped:     1,
+		DurationMs:  150,
+		FailedTests: []string{"pkg.TestA", "suite.B", "suite.C"},
+	}, MergeTestSummaries(a, b))
+	require.Equal(t, &TestSummaryData{}, MergeTestSummaries())
+}
+
+func TestDecodeTestSummaryData_FromJSONMap(t *testing.T) {
+	s, err := DecodeTestSummaryData(map[string]interface{}{
+		"total":       2,
+		"failed":      1,
+		"failedTests": []interface{}{"pkg.TestA"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, &TestSummaryData{Total: 2, Failed: 1, FailedTests: []string{"pkg.TestA"}}, s)
+}
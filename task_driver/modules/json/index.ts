@@ -25,6 +25,8 @@ export interface StepDisplay {
 	isInfra?: boolean;
 	environment?: string[];
 	parent?: string;
+	cacheKey?: string;
+	cacheOutputs?: string[];
 }
 
 export interface TaskDriverRunDisplay {
@@ -40,6 +42,8 @@ export interface TaskDriverRunDisplay {
 	isInfra?: boolean;
 	environment?: string[];
 	parent?: string;
+	cacheKey?: string;
+	cacheOutputs?: string[];
 }
 
 export type StepResult = string;
@@ -3,6 +3,7 @@ package cacher
 import (
 	"context"
 	"path/filepath"
+	"strings"
 
 	"go.skia.org/infra/go/cas"
 	"go.skia.org/infra/go/git"
@@ -69,6 +70,11 @@ func (c *CacherImpl) GetOrCacheRepoState(ctx context.Context, rs types.RepoState
 			if err != nil {
 				return skerr.Wrap(err)
 			}
+			changedFiles, err := getChangedFiles(ctx, cfg, co, rs)
+			if err != nil {
+				return skerr.Wrap(err)
+			}
+			cfg.ChangedFiles = changedFiles
 			for _, casSpec := range cfg.CasSpecs {
 				if casSpec.Digest == "" {
 					root := filepath.Join(co.Dir(), casSpec.Root)
@@ -104,5 +110,50 @@ func (c *CacherImpl) GetOrCacheRepoState(ctx context.Context, rs types.RepoState
 	return cv.Cfg, nil
 }
 
+// getChangedFiles returns the files modified by the given RepoState, relative
+// to its parent commit or, in the case of a try job, to its base revision. If
+// none of the JobSpecs in the TasksCfg have ChangedPaths, or if the changed
+// files can't be determined from the checkout, returns nil, which indicates
+// that the changed files are unknown.
+func getChangedFiles(ctx context.Context, cfg *specs.TasksCfg, co *git.TempCheckout, rs types.RepoState) ([]string, error) {
+	needed := false
+	for _, j := range cfg.Jobs {
+		if len(j.ChangedPaths) > 0 {
+			needed = true
+			break
+		}
+	}
+	if !needed {
+		return nil, nil
+	}
+	var output string
+	var err error
+	if rs.IsTryJob() {
+		if rs.PatchRepo != "" && rs.PatchRepo != rs.Repo {
+			// The patch applies to a dependency of the repo, so we
+			// don't know which files it modifies.
+			return nil, nil
+		}
+		// The patch is applied on top of the base revision, possibly
+		// without being committed, so compare the working tree.
+		output, err = co.Git(ctx, "diff", "--name-only", rs.Revision)
+	} else if _, parentErr := co.RevParse(ctx, "--verify", rs.Revision+"^"); parentErr != nil {
+		// This is the initial commit; every file is new.
+		output, err = co.Git(ctx, "diff-tree", "--no-commit-id", "--name-only", "-r", "--root", rs.Revision)
+	} else {
+		output, err = co.Git(ctx, "diff", "--name-only", rs.Revision+"^", rs.Revision)
+	}
+	if err != nil {
+		return nil, skerr.Wrapf(err, "failed to find changed files for %s", rs)
+	}
+	rv := []string{}
+	for _, line := range strings.Split(output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			rv = append(rv, line)
+		}
+	}
+	return rv, nil
+}
+
 // Assert that CacherImpl implements Cacher.
 var _ Cacher = &CacherImpl{}
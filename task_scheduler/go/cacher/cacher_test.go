@@ -3,6 +3,8 @@ package cacher
 import (
 	"context"
 	"os"
+	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
@@ -11,6 +13,7 @@ import (
 	"go.skia.org/infra/go/cas/rbe"
 	"go.skia.org/infra/go/deepequal/assertdeep"
 	depot_tools_testutils "go.skia.org/infra/go/depot_tools/testutils"
+	"go.skia.org/infra/go/git"
 	"go.skia.org/infra/go/git/repograph"
 	git_testutils "go.skia.org/infra/go/git/testutils"
 	"go.skia.org/infra/go/testutils"
@@ -120,3 +123,55 @@ func TestGetOrCacheRepoState_Unset_RBE(t *testing.T) {
 	require.NotNil(t, cached)
 	require.Equal(t, "fake-digest", cached.CasSpecs["my-cas"].Digest)
 }
+
+func TestGetChangedFiles(t *testing.T) {
+	ctx, gb, c1, c2 := tcc_testutils.SetupTestRepo(t)
+	defer gb.Cleanup()
+	gb.Add(ctx, "src/main.go", "package main")
+	gb.Add(ctx, "README.md", "hello")
+	c3 := gb.CommitMsg(ctx, "c3")
+
+	co, err := git.NewTempCheckout(ctx, gb.RepoUrl())
+	require.NoError(t, err)
+	defer co.Delete()
+
+	cfg := tcc_testutils.TasksCfg2.Copy()
+	test := func(rs types.RepoState, expect []string) {
+		actual, err := getChangedFiles(ctx, cfg, co, rs)
+		require.NoError(t, err)
+		if expect != nil {
+			sort.Strings(actual)
+		}
+		require.Equal(t, expect, actual)
+	}
+
+	// None of the JobSpecs have ChangedPaths, so we don't bother to find
+	// the changed files.
+	test(types.RepoState{Repo: gb.RepoUrl(), Revision: c3}, nil)
+
+	for _, j := range cfg.Jobs {
+		j.ChangedPaths = []string{"src/**"}
+	}
+	test(types.RepoState{Repo: gb.RepoUrl(), Revision: c1}, []string{"a.txt", "infra/bots/tasks.json", "somefile.txt"})
+	test(types.RepoState{Repo: gb.RepoUrl(), Revision: c2}, []string{"infra/bots/tasks.json"})
+	test(types.RepoState{Repo: gb.RepoUrl(), Revision: c3}, []string{"README.md", "src/main.go"})
+
+	// Simulate a try job by applying a patch on top of c2 in the checkout.
+	_, err = co.Git(ctx, "checkout", c2)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(co.Dir(), "somefile.txt"), []byte("modified"), os.ModePerm))
+	tryRS := types.RepoState{
+		Patch: types.Patch{
+			Issue:    "123",
+			Patchset: "1",
+			Server:   "https://fake-review.googlesource.com",
+		},
+		Repo:     gb.RepoUrl(),
+		Revision: c2,
+	}
+	test(tryRS, []string{"somefile.txt"})
+
+	// Patches to other repos are not considered.
+	tryRS.PatchRepo = "https://fake.googlesource.com/dep.git"
+	test(tryRS, nil)
+}
@@ -28,6 +28,7 @@ const (
 func fixJobTimestamps(job *types.Job) {
 	job.Created = firestore.FixTimestamp(job.Created)
 	job.DbModified = firestore.FixTimestamp(job.DbModified)
+	job.Deadline = firestore.FixTimestamp(job.Deadline)
 	job.Finished = firestore.FixTimestamp(job.Finished)
 	job.Requested = firestore.FixTimestamp(job.Requested)
 }
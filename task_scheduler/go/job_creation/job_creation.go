@@ -201,6 +201,12 @@ func (jc *JobCreator) gatherNewJobs(ctx context.Context, repoUrl string, repo *r
 			return skerr.Wrap(err)
 		}
 		alreadyScheduledAllJobs := true
+		// Jobs whose conditions are not met at this commit tell us nothing
+		// about whether we've already processed it, so we only trust
+		// alreadyScheduledAllJobs if at least one Job should run or no Jobs
+		// were skipped for this reason.
+		numShouldRun := 0
+		skippedByConditions := false
 		for name, spec := range cfg.Jobs {
 			shouldRun := false
 			if !util.In(spec.Trigger, specs.PERIODIC_TRIGGERS) {
@@ -224,7 +230,12 @@ func (jc *JobCreator) gatherNewJobs(ctx context.Context, repoUrl string, repo *r
 					shouldRun = true
 				}
 			}
+			if shouldRun && !cfg.JobConditionsMet(name) {
+				shouldRun = false
+				skippedByConditions = true
+			}
 			if shouldRun {
+				numShouldRun++
 				prevJobs, err := jc.jCache.GetJobsByRepoState(name, rs)
 				if err != nil {
 					return skerr.Wrap(err)
@@ -267,7 +278,7 @@ func (jc *JobCreator) gatherNewJobs(ctx context.Context, repoUrl string, repo *r
 		// If we'd already scheduled all of the jobs for this commit,
 		// stop recursing, under the assumption that we've already
 		// scheduled all of the jobs for the ones before it.
-		if alreadyScheduledAllJobs {
+		if alreadyScheduledAllJobs && (numShouldRun > 0 || !skippedByConditions) {
 			return repograph.ErrStopRecursing
 		}
 		if c.Hash == "50537e46e4f0999df0a4707b227000cfa8c800ff" {
@@ -359,6 +359,9 @@ type taskCandidateScoringDiagnostics struct {
 	// Priority calculated from all dependent Job priorities. (Note this is *not* the same as Score;
 	// Priority is an input to scoring while Score is the output.)
 	Priority float64 `json:"priority,omitempty"`
+	// True if Priority was increased because one or more of the dependent Jobs
+	// is nearing its deadline.
+	DeadlineBoost bool `json:"deadlineBoost,omitempty"`
 	// Hours since this candidate's earliest Job was created (only used for forced and try jobs).
 	JobCreatedHours float64 `json:"jobCreatedHours,omitempty"`
 	// Number of commits in this candidate's blamelist that previously were in Task's or candidate's
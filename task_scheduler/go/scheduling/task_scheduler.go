@@ -61,6 +61,12 @@ const (
 	// bisecting a successful task with a blamelist of 4 commits.
 	CANDIDATE_SCORE_FAILURE_OR_MISHAP_BONUS = 0.75
 
+	// DEADLINE_BOOST_START is the fraction of a Job's allotted time, ie. the
+	// time between its creation and its deadline, after which we begin to
+	// increase the priority of the Job. The priority increases linearly from
+	// the JobSpec priority at this point to 1.0 at the deadline.
+	DEADLINE_BOOST_START = 0.5
+
 	// MAX_BLAMELIST_COMMITS is the maximum number of commits which are
 	// allowed in a task blamelist before we stop tracing commit history.
 	MAX_BLAMELIST_COMMITS = 500
@@ -87,16 +93,17 @@ var (
 
 // TaskScheduler is a struct used for scheduling tasks on bots.
 type TaskScheduler struct {
-	busyBots            *busyBots
-	candidateMetrics    map[string]metrics2.Int64Metric
-	candidateMetricsMtx sync.Mutex
-	db                  db.DB
-	diagClient          gcs.GCSClient
-	diagInstance        string
-	rbeCas              cas.CAS
-	rbeCasInstance      string
-	jCache              cache.JobCache
-	lastScheduled       time.Time // protected by queueMtx.
+	busyBots              *busyBots
+	candidateMetrics      map[string]metrics2.Int64Metric
+	candidateMetricsMtx   sync.Mutex
+	db                    db.DB
+	deadlineExceededCount metrics2.Counter
+	diagClient            gcs.GCSClient
+	diagInstance          string
+	rbeCas                cas.CAS
+	rbeCasInstance        string
+	jCache                cache.JobCache
+	lastScheduled         time.Time // protected by queueMtx.
 
 	pendingInsert    map[string]bool
 	pendingInsertMtx sync.RWMutex
@@ -149,6 +156,7 @@ func NewTaskScheduler(ctx context.Context, d db.DB, bl *skip_tasks.DB, period ti
 		busyBots:              newBusyBots(debugBusyBots),
 		candidateMetrics:      map[string]metrics2.Int64Metric{},
 		db:                    d,
+		deadlineExceededCount: metrics2.GetCounter("task_scheduler_job_deadline_exceeded_count"),
 		diagClient:            diagClient,
 		diagInstance:          diagInstance,
 		jCache:                jCache,
@@ -718,6 +726,10 @@ func (s *TaskScheduler) scoreCandidate(ctx context.Context, c *TaskCandidate, cy
 		if j.Priority <= 1 && j.Priority > 0 {
 			jobPriority = j.Priority
 		}
+		if boosted := deadlineBoost(j, jobPriority, cycleStart); boosted > jobPriority {
+			jobPriority = boosted
+			diag.DeadlineBoost = true
+		}
 		inversePriorityProduct *= 1 - jobPriority
 	}
 	priority := 1 - inversePriorityProduct
@@ -770,6 +782,27 @@ func (s *TaskScheduler) scoreCandidate(ctx context.Context, c *TaskCandidate, cy
 	c.Score = score
 }
 
+// deadlineBoost returns the priority of the given Job, adjusted to account for
+// its approaching deadline, if any. Jobs which have used less than
+// DEADLINE_BOOST_START of their allotted time retain their original priority.
+func deadlineBoost(j *types.Job, priority float64, currentTime time.Time) float64 {
+	if j.Deadline.IsZero() {
+		return priority
+	}
+	allotted := j.Deadline.Sub(j.Created)
+	if allotted <= 0 {
+		return 1.0
+	}
+	elapsed := float64(currentTime.Sub(j.Created)) / float64(allotted)
+	if elapsed <= DEADLINE_BOOST_START {
+		return priority
+	}
+	if elapsed >= 1.0 {
+		return 1.0
+	}
+	return priority + (1.0-priority)*(elapsed-DEADLINE_BOOST_START)/(1.0-DEADLINE_BOOST_START)
+}
+
 // Process task candidates within a single task spec.
 func (s *TaskScheduler) processTaskCandidatesSingleTaskSpec(ctx context.Context, currentTime time.Time, repoUrl, name string, candidatesWithTryJobs []*TaskCandidate) ([]*TaskCandidate, error) {
 	ctx, span := trace.StartSpan(ctx, "processTaskCandidatesSingleTaskSpec", trace.WithSampler(trace.ProbabilitySampler(0.01)))
@@ -1745,9 +1778,20 @@ func (s *TaskScheduler) updateUnfinishedJobs(ctx context.Context) error {
 			}
 			summaries[k] = cpy
 		}
+		modified := false
 		if !reflect.DeepEqual(summaries, j.Tasks) {
 			j.Tasks = summaries
 			j.Status = j.DeriveStatus()
+			modified = true
+		}
+		if !j.Done() && j.DeadlineExceeded(now.Now(ctx)) {
+			sklog.Infof("Canceling job %s (%s); its deadline of %s has passed.", j.Id, j.Name, j.Deadline)
+			j.Status = types.JOB_STATUS_CANCELED
+			j.StatusDetails = fmt.Sprintf("Job exceeded its deadline of %s", j.Deadline.UTC().Format(time.RFC3339))
+			s.deadlineExceededCount.Inc(1)
+			modified = true
+		}
+		if modified {
 			if j.Done() {
 				j.Finished = now.Now(ctx)
 			}
@@ -4036,6 +4036,65 @@ func TestScoreCandidate_ForcedJob_OldestJobOnlyUsedForWaitTime(t *testing.T) {
 	test("two jobs, only one waited 2 hours", 76.5, "2021-10-01T13:00:00Z", "2021-10-01T14:55:00Z")
 }
 
+func TestScoreCandidate_ForcedJob_PrioritizedHigherNearDeadline(t *testing.T) {
+	ctx := context.Background()
+
+	created := rfc3339(t, "2021-10-01T15:00:00Z")
+	test := func(name, now string, deadline time.Time, expectedScore float64, expectBoost bool) {
+		t.Run(name, func(t *testing.T) {
+			s := TaskScheduler{}
+			job := types.Job{
+				Created:  created,
+				Deadline: deadline,
+				Priority: specs.DEFAULT_JOB_SPEC_PRIORITY,
+			}
+			tc := asForcedJob(TaskCandidate{
+				Jobs: []*types.Job{&job},
+			})
+			s.scoreCandidate(ctx, &tc, rfc3339(t, now), timeDoesNotMatter, nil)
+			assert.InDelta(t, expectedScore, tc.Score, 0.0001)
+			assert.Equal(t, expectBoost, tc.GetDiagnostics().Scoring.DeadlineBoost)
+		})
+	}
+
+	deadline := created.Add(2 * time.Hour)
+	test("no deadline", "2021-10-01T16:30:00Z", time.Time{}, 50.75, false)
+	test("just created", "2021-10-01T15:00:00Z", deadline, 50, false)
+	test("half of allotted time", "2021-10-01T16:00:00Z", deadline, 50.5, false)
+	test("three quarters of allotted time", "2021-10-01T16:30:00Z", deadline, 76.125, true)
+	test("at deadline", "2021-10-01T17:00:00Z", deadline, 102, true)
+	test("past deadline", "2021-10-01T18:00:00Z", deadline, 103, true)
+}
+
+func TestUpdateUnfinishedJobs_DeadlineExceeded_JobCanceled(t *testing.T) {
+	ctx, _, _, _, s, _, _, cleanup := setup(t)
+	defer cleanup()
+
+	jobs, err := s.jCache.InProgressJobs()
+	require.NoError(t, err)
+	require.NotEmpty(t, jobs)
+	currentTime := now.Now(ctx)
+	expired := jobs[0]
+	expired.Deadline = currentTime.Add(-time.Minute)
+	notExpired := jobs[1]
+	notExpired.Deadline = currentTime.Add(time.Hour)
+	require.NoError(t, s.putJobsInChunks(ctx, []*types.Job{expired, notExpired}))
+
+	require.NoError(t, s.updateUnfinishedJobs(ctx))
+	require.NoError(t, s.jCache.Update(ctx))
+
+	got, err := s.jCache.GetJob(expired.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.JOB_STATUS_CANCELED, got.Status)
+	require.Contains(t, got.StatusDetails, "deadline")
+	require.False(t, got.Finished.IsZero())
+
+	got, err = s.jCache.GetJob(notExpired.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.JOB_STATUS_IN_PROGRESS, got.Status)
+	require.True(t, got.Finished.IsZero())
+}
+
 func TestComputeBlamelist_NoExistingTests(t *testing.T) {
 	ctx := context.Background()
 
@@ -1,14 +1,21 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
 	"os"
 
 	"go.skia.org/infra/task_scheduler/go/specs"
 )
 
+var (
+	expand = flag.Bool("expand", false, "Print the tasks.json with all matrices expanded.")
+)
+
 func main() {
-	tasksJSONs := os.Args[1:]
+	flag.Parse()
+	tasksJSONs := flag.Args()
 	if len(tasksJSONs) == 0 {
 		log.Fatal("Specify at least one tasks.json to validate.")
 	}
@@ -17,8 +24,16 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
-		if _, err := specs.ParseTasksCfg(string(contents)); err != nil {
+		cfg, err := specs.ParseTasksCfg(string(contents))
+		if err != nil {
 			log.Fatalf("%s: %s", tasksJSON, err)
 		}
+		if *expand {
+			b, err := specs.EncodeTasksCfg(cfg)
+			if err != nil {
+				log.Fatalf("%s: %s", tasksJSON, err)
+			}
+			fmt.Print(string(b))
+		}
 	}
 }
@@ -0,0 +1,246 @@
+package specs
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.skia.org/infra/go/skerr"
+	"go.skia.org/infra/go/util"
+)
+
+const (
+	// MATRIX_AXIS_SYNTAX is the syntax used to refer to the value of a
+	// TaskMatrix axis within its templates.
+	MATRIX_AXIS_SYNTAX = "{{%s}}"
+)
+
+// TaskMatrix describes a set of TaskSpecs and JobSpecs which are generated by
+// crossing templates with every combination of a set of axis values. Any
+// string within a template, including the names of the TaskSpecs and JobSpecs
+// themselves, may refer to the value of an axis using MATRIX_AXIS_SYNTAX, eg.
+// "Test-{{os}}-{{gpu}}". Matrices are expanded when the TasksCfg is parsed.
+// Be sure to add any new fields to the Copy() method.
+type TaskMatrix struct {
+	// Axes maps axis names to the values they may take. One set of TaskSpecs
+	// and JobSpecs is generated for each combination of values.
+	Axes map[string][]string `json:"axes"`
+
+	// Exclude lists combinations of axis values which should not be
+	// generated. Each entry excludes every combination which has all of the
+	// given axis values.
+	Exclude []map[string]string `json:"exclude,omitempty"`
+
+	// Jobs are JobSpec templates, keyed by name template.
+	Jobs map[string]*JobSpec `json:"jobs,omitempty"`
+
+	// Tasks are TaskSpec templates, keyed by name template.
+	Tasks map[string]*TaskSpec `json:"tasks,omitempty"`
+}
+
+// Copy returns a deep copy of the TaskMatrix.
+func (m *TaskMatrix) Copy() *TaskMatrix {
+	var axes map[string][]string
+	if m.Axes != nil {
+		axes = make(map[string][]string, len(m.Axes))
+		for k, v := range m.Axes {
+			axes[k] = util.CopyStringSlice(v)
+		}
+	}
+	var exclude []map[string]string
+	if m.Exclude != nil {
+		exclude = make([]map[string]string, 0, len(m.Exclude))
+		for _, e := range m.Exclude {
+			exclude = append(exclude, util.CopyStringMap(e))
+		}
+	}
+	var jobs map[string]*JobSpec
+	if m.Jobs != nil {
+		jobs = make(map[string]*JobSpec, len(m.Jobs))
+		for k, v := range m.Jobs {
+			jobs[k] = v.Copy()
+		}
+	}
+	var tasks map[string]*TaskSpec
+	if m.Tasks != nil {
+		tasks = make(map[string]*TaskSpec, len(m.Tasks))
+		for k, v := range m.Tasks {
+			tasks[k] = v.Copy()
+		}
+	}
+	return &TaskMatrix{
+		Axes:    axes,
+		Exclude: exclude,
+		Jobs:    jobs,
+		Tasks:   tasks,
+	}
+}
+
+// Validate returns an error if the TaskMatrix is not valid. It does not
+// validate the generated TaskSpecs and JobSpecs; that is done after the
+// TaskMatrix is expanded.
+func (m *TaskMatrix) Validate() error {
+	if len(m.Axes) == 0 {
+		return skerr.Fmt("TaskMatrix must have at least one axis")
+	}
+	for axis, values := range m.Axes {
+		if axis == "" {
+			return skerr.Fmt("TaskMatrix axis names must not be empty")
+		}
+		if len(values) == 0 {
+			return skerr.Fmt("TaskMatrix axis %q has no values", axis)
+		}
+		if len(util.NewStringSet(values)) != len(values) {
+			return skerr.Fmt("TaskMatrix axis %q has duplicate values", axis)
+		}
+	}
+	for _, exclude := range m.Exclude {
+		for axis := range exclude {
+			if _, ok := m.Axes[axis]; !ok {
+				return skerr.Fmt("TaskMatrix exclusion refers to unknown axis %q", axis)
+			}
+		}
+	}
+	if len(m.Jobs) == 0 && len(m.Tasks) == 0 {
+		return skerr.Fmt("TaskMatrix must have at least one job or task template")
+	}
+	return nil
+}
+
+// combinations returns every combination of axis values which is not
+// excluded, in a deterministic order.
+func (m *TaskMatrix) combinations() []map[string]string {
+	axes := make([]string, 0, len(m.Axes))
+	for axis := range m.Axes {
+		axes = append(axes, axis)
+	}
+	sort.Strings(axes)
+	rv := []map[string]string{{}}
+	for _, axis := range axes {
+		next := make([]map[string]string, 0, len(rv)*len(m.Axes[axis]))
+		for _, combo := range rv {
+			for _, value := range m.Axes[axis] {
+				cpy := util.CopyStringMap(combo)
+				cpy[axis] = value
+				next = append(next, cpy)
+			}
+		}
+		rv = next
+	}
+	filtered := make([]map[string]string, 0, len(rv))
+	for _, combo := range rv {
+		if !m.excluded(combo) {
+			filtered = append(filtered, combo)
+		}
+	}
+	return filtered
+}
+
+// excluded returns true iff the given combination of axis values matches any
+// of the TaskMatrix's exclusions.
+func (m *TaskMatrix) excluded(combo map[string]string) bool {
+	for _, exclude := range m.Exclude {
+		match := true
+		for axis, value := range exclude {
+			if combo[axis] != value {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// expandString replaces references to axes in the given string with their
+// values in the given combination.
+func expandString(s string, combo map[string]string) string {
+	for axis, value := range combo {
+		s = strings.ReplaceAll(s, fmt.Sprintf(MATRIX_AXIS_SYNTAX, axis), value)
+	}
+	return s
+}
+
+// expandTemplate replaces references to axes in every string within the given
+// template, which must be JSON-encodable, with their values in the given
+// combination. The result is decoded into dest.
+func expandTemplate(tmpl, dest interface{}, combo map[string]string) error {
+	b, err := json.Marshal(tmpl)
+	if err != nil {
+		return skerr.Wrap(err)
+	}
+	enc := string(b)
+	for axis, value := range combo {
+		// Encode the value so that it is properly escaped within the
+		// JSON string, then strip the surrounding quotes.
+		encValue, err := json.Marshal(value)
+		if err != nil {
+			return skerr.Wrap(err)
+		}
+		enc = strings.ReplaceAll(enc, fmt.Sprintf(MATRIX_AXIS_SYNTAX, axis), string(encValue[1:len(encValue)-1]))
+	}
+	return skerr.Wrap(json.Unmarshal([]byte(enc), dest))
+}
+
+// expand generates the TaskSpecs and JobSpecs described by the TaskMatrix and
+// adds them to the given maps. Templates whose names do not refer to every
+// axis generate the same name for multiple combinations; this is allowed as
+// long as the generated specs are identical. Returns an error if any generated
+// name collides with an existing entry or still contains an unresolved axis
+// reference.
+func (m *TaskMatrix) expand(tasks map[string]*TaskSpec, jobs map[string]*JobSpec) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	checkName := func(kind, tmpl, name string) error {
+		if strings.Contains(name, "{{") {
+			return skerr.Fmt("TaskMatrix %s name template %q contains an unknown axis reference: %q", kind, tmpl, name)
+		}
+		return nil
+	}
+	generatedTasks := map[string]bool{}
+	generatedJobs := map[string]bool{}
+	for _, combo := range m.combinations() {
+		for tmplName, tmpl := range m.Tasks {
+			name := expandString(tmplName, combo)
+			if err := checkName("task", tmplName, name); err != nil {
+				return err
+			}
+			var spec TaskSpec
+			if err := expandTemplate(tmpl, &spec, combo); err != nil {
+				return skerr.Wrapf(err, "failed to expand TaskMatrix task %q", tmplName)
+			}
+			if existing, ok := tasks[name]; ok {
+				if generatedTasks[name] && reflect.DeepEqual(existing, &spec) {
+					continue
+				}
+				return skerr.Fmt("TaskMatrix generated task %q, which already exists", name)
+			}
+			tasks[name] = &spec
+			generatedTasks[name] = true
+		}
+		for tmplName, tmpl := range m.Jobs {
+			name := expandString(tmplName, combo)
+			if err := checkName("job", tmplName, name); err != nil {
+				return err
+			}
+			var spec JobSpec
+			if err := expandTemplate(tmpl, &spec, combo); err != nil {
+				return skerr.Wrapf(err, "failed to expand TaskMatrix job %q", tmplName)
+			}
+			if existing, ok := jobs[name]; ok {
+				if generatedJobs[name] && reflect.DeepEqual(existing, &spec) {
+					continue
+				}
+				return skerr.Fmt("TaskMatrix generated job %q, which already exists", name)
+			}
+			jobs[name] = &spec
+			generatedJobs[name] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package specs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.skia.org/infra/go/deepequal/assertdeep"
+)
+
+func fakeTaskMatrix() *TaskMatrix {
+	return &TaskMatrix{
+		Axes: map[string][]string{
+			"os":  {"Linux", "Win"},
+			"gpu": {"NVIDIA", "Intel"},
+		},
+		Exclude: []map[string]string{
+			{"os": "Win", "gpu": "Intel"},
+		},
+		Jobs: map[string]*JobSpec{
+			"Test-{{os}}-{{gpu}}": {
+				TaskSpecs: []string{"Test-{{os}}-{{gpu}}"},
+			},
+		},
+		Tasks: map[string]*TaskSpec{
+			"Build-{{os}}": {
+				CasSpec:    "my-cas",
+				Command:    []string{"build", "--os={{os}}"},
+				Dimensions: []string{"os:{{os}}"},
+			},
+			"Test-{{os}}-{{gpu}}": {
+				CasSpec:      "my-cas",
+				Command:      []string{"test"},
+				Dependencies: []string{"Build-{{os}}"},
+				Dimensions:   []string{"os:{{os}}", "gpu:{{gpu}}"},
+				Environment: map[string]string{
+					"GPU": "{{gpu}}",
+				},
+				ExecutionTimeout: time.Hour,
+			},
+		},
+	}
+}
+
+func TestCopyTaskMatrix(t *testing.T) {
+	v := fakeTaskMatrix()
+	assertdeep.Copy(t, v, v.Copy())
+}
+
+func TestTaskMatrixCombinations(t *testing.T) {
+	m := fakeTaskMatrix()
+	require.Equal(t, []map[string]string{
+		{"gpu": "NVIDIA", "os": "Linux"},
+		{"gpu": "NVIDIA", "os": "Win"},
+		{"gpu": "Intel", "os": "Linux"},
+	}, m.combinations())
+}
+
+func TestTasksCfgExpandMatrices(t *testing.T) {
+	cfg := &TasksCfg{
+		CasSpecs: map[string]*CasSpec{
+			"my-cas": {
+				Digest: "abc123/45",
+			},
+		},
+		Matrices: []*TaskMatrix{fakeTaskMatrix()},
+	}
+	require.NoError(t, cfg.ExpandMatrices())
+	require.Nil(t, cfg.Matrices)
+	require.NoError(t, cfg.Validate())
+
+	// Build-{{os}} is generated once per OS, even though it appears in
+	// multiple combinations.
+	require.Len(t, cfg.Tasks, 5)
+	require.Len(t, cfg.Jobs, 3)
+	assertdeep.Equal(t, &TaskSpec{
+		CasSpec:    "my-cas",
+		Command:    []string{"build", "--os=Win"},
+		Dimensions: []string{"os:Win"},
+	}, cfg.Tasks["Build-Win"])
+	assertdeep.Equal(t, &TaskSpec{
+		CasSpec:      "my-cas",
+		Command:      []string{"test"},
+		Dependencies: []string{"Build-Linux"},
+		Dimensions:   []string{"os:Linux", "gpu:Intel"},
+		Environment: map[string]string{
+			"GPU": "Intel",
+		},
+		ExecutionTimeout: time.Hour,
+	}, cfg.Tasks["Test-Linux-Intel"])
+	assertdeep.Equal(t, &JobSpec{
+		TaskSpecs: []string{"Test-Win-NVIDIA"},
+	}, cfg.Jobs["Test-Win-NVIDIA"])
+	require.NotContains(t, cfg.Jobs, "Test-Win-Intel")
+	require.NotContains(t, cfg.Tasks, "Test-Win-Intel")
+}
+
+func TestTasksCfgExpandMatrices_ValuesAreEscaped(t *testing.T) {
+	m := &TaskMatrix{
+		Axes: map[string][]string{
+			"arg": {`say "hello"\n`},
+		},
+		Tasks: map[string]*TaskSpec{
+			"Echo": {
+				Command: []string{"echo", "{{arg}}"},
+			},
+		},
+	}
+	cfg := &TasksCfg{Matrices: []*TaskMatrix{m}}
+	require.NoError(t, cfg.ExpandMatrices())
+	require.Equal(t, []string{"echo", `say "hello"\n`}, cfg.Tasks["Echo"].Command)
+}
+
+func TestTasksCfgExpandMatrices_Errors(t *testing.T) {
+	test := func(expectErr string, fn func(*TasksCfg)) {
+		cfg := &TasksCfg{
+			Matrices: []*TaskMatrix{fakeTaskMatrix()},
+		}
+		fn(cfg)
+		require.ErrorContains(t, cfg.ExpandMatrices(), expectErr)
+	}
+	test("at least one axis", func(cfg *TasksCfg) {
+		cfg.Matrices[0].Axes = nil
+	})
+	test("has no values", func(cfg *TasksCfg) {
+		cfg.Matrices[0].Axes["os"] = []string{}
+	})
+	test("duplicate values", func(cfg *TasksCfg) {
+		cfg.Matrices[0].Axes["os"] = []string{"Linux", "Linux"}
+	})
+	test("unknown axis \"cpu\"", func(cfg *TasksCfg) {
+		cfg.Matrices[0].Exclude = []map[string]string{{"cpu": "x86"}}
+	})
+	test("at least one job or task template", func(cfg *TasksCfg) {
+		cfg.Matrices[0].Jobs = nil
+		cfg.Matrices[0].Tasks = nil
+	})
+	test("unknown axis reference", func(cfg *TasksCfg) {
+		cfg.Matrices[0].Jobs["Perf-{{cpu}}"] = &JobSpec{}
+	})
+	test("generated task \"Build-Linux\", which already exists", func(cfg *TasksCfg) {
+		// The same name is generated for different combinations, but the
+		// generated specs differ.
+		cfg.Matrices[0].Tasks["Build-{{os}}"].Environment = map[string]string{"GPU": "{{gpu}}"}
+	})
+	test("generated task \"Build-Linux\", which already exists", func(cfg *TasksCfg) {
+		cfg.Tasks = map[string]*TaskSpec{"Build-Linux": {}}
+	})
+	test("generated job \"Test-Linux-Intel\", which already exists", func(cfg *TasksCfg) {
+		cfg.Jobs = map[string]*JobSpec{"Test-Linux-Intel": {}}
+	})
+}
+
+func TestParseTasksCfg_Matrix(t *testing.T) {
+	cfg, err := ParseTasksCfg(`{
+  "casSpecs": {
+    "my-cas": {
+      "digest": "abc123/45"
+    }
+  },
+  "jobs": {
+    "Lint": {
+      "tasks": ["Lint"],
+      "changed_paths": ["**/*.go"]
+    }
+  },
+  "tasks": {
+    "Lint": {
+      "casSpec": "my-cas",
+      "dimensions": ["os:Linux"]
+    }
+  },
+  "matrices": [
+    {
+      "axes": {
+        "os": ["Linux", "Mac"]
+      },
+      "jobs": {
+        "Build-{{os}}": {
+          "tasks": ["Build-{{os}}"]
+        }
+      },
+      "tasks": {
+        "Build-{{os}}": {
+          "casSpec": "my-cas",
+          "dimensions": ["os:{{os}}"]
+        }
+      }
+    }
+  ]
+}`)
+	require.NoError(t, err)
+	require.Nil(t, cfg.Matrices)
+	require.Len(t, cfg.Jobs, 3)
+	require.Len(t, cfg.Tasks, 3)
+	require.Equal(t, []string{"os:Mac"}, cfg.Tasks["Build-Mac"].Dimensions)
+	require.Equal(t, []string{"**/*.go"}, cfg.Jobs["Lint"].ChangedPaths)
+
+	// Generated tasks are validated like any other.
+	_, err = ParseTasksCfg(`{
+  "jobs": {},
+  "tasks": {},
+  "matrices": [
+    {
+      "axes": {
+        "os": ["Linux"]
+      },
+      "jobs": {
+        "Build-{{os}}": {
+          "tasks": ["Build-{{os}}"]
+        }
+      },
+      "tasks": {
+        "Build-{{os}}": {
+          "casSpec": "my-cas",
+          "dimensions": ["os:{{os}}"]
+        }
+      }
+    }
+  ]
+}`)
+	require.ErrorContains(t, err, "references non-existent CasSpec")
+}
+
+func TestTasksCfgValidate_UnexpandedMatrices(t *testing.T) {
+	cfg := &TasksCfg{
+		CasSpecs: map[string]*CasSpec{
+			"my-cas": {
+				Digest: "abc123/45",
+			},
+		},
+		Matrices: []*TaskMatrix{fakeTaskMatrix()},
+	}
+	require.NoError(t, cfg.Validate())
+	// Validate does not modify the TasksCfg.
+	require.Len(t, cfg.Matrices, 1)
+	require.Empty(t, cfg.Tasks)
+
+	cfg.Matrices[0].Tasks["Test-{{os}}-{{gpu}}"].Dependencies = []string{"Compile-{{os}}"}
+	require.ErrorContains(t, cfg.Validate(), "unknown task \"Compile-")
+}
@@ -121,6 +121,9 @@ func ParseTasksCfg(contents string) (*TasksCfg, error) {
 	if err := json.Unmarshal([]byte(contents), &rv); err != nil {
 		return nil, fmt.Errorf("Failed to read tasks cfg: could not parse file: %s\nContents:\n%s", err, string(contents))
 	}
+	if err := rv.ExpandMatrices(); err != nil {
+		return nil, skerr.Fmt("Invalid TasksCfg: %s", err)
+	}
 	if err := rv.Validate(); err != nil {
 		return nil, err
 	}
@@ -208,6 +211,19 @@ type TasksCfg struct {
 	// CommitQueue is a map whose keys are JobSpec names and values are
 	// CommitQueueJobConfig. All specified jobs will run on the Commit Queue.
 	CommitQueue map[string]*CommitQueueJobConfig `json:"commit_queue,omitempty"`
+
+	// Matrices describe additional TaskSpecs and JobSpecs which are generated
+	// from templates. They are expanded into Tasks and Jobs when the TasksCfg
+	// is parsed; see ExpandMatrices.
+	Matrices []*TaskMatrix `json:"matrices,omitempty"`
+
+	// ChangedFiles lists the files modified by the RepoState to which this
+	// TasksCfg belongs, ie. by the commit itself or by the patch in the case
+	// of a try job. It is not read from tasks.json but is filled in when the
+	// TasksCfg is cached. A nil value indicates that the changed files are not
+	// known, in which case the ChangedPaths of every JobSpec are considered to
+	// be satisfied.
+	ChangedFiles []string `json:"-"`
 }
 
 // Copy returns a deep copy of the TasksCfg.
@@ -234,16 +250,71 @@ func (c *TasksCfg) Copy() *TasksCfg {
 			commitQueue[k] = v.Copy()
 		}
 	}
+	var matrices []*TaskMatrix
+	if c.Matrices != nil {
+		matrices = make([]*TaskMatrix, 0, len(c.Matrices))
+		for _, m := range c.Matrices {
+			matrices = append(matrices, m.Copy())
+		}
+	}
 	return &TasksCfg{
-		Jobs:        jobs,
-		Tasks:       tasks,
-		CasSpecs:    casSpecs,
-		CommitQueue: commitQueue,
+		Jobs:         jobs,
+		Tasks:        tasks,
+		CasSpecs:     casSpecs,
+		CommitQueue:  commitQueue,
+		Matrices:     matrices,
+		ChangedFiles: util.CopyStringSlice(c.ChangedFiles),
 	}
 }
 
+// ExpandMatrices generates the TaskSpecs and JobSpecs described by the
+// TasksCfg's Matrices, adds them to Tasks and Jobs, and removes the Matrices.
+// Returns an error if any of the Matrices are invalid or if any generated
+// TaskSpec or JobSpec has the same name as an existing one.
+func (c *TasksCfg) ExpandMatrices() error {
+	if len(c.Matrices) == 0 {
+		return nil
+	}
+	if c.Tasks == nil {
+		c.Tasks = map[string]*TaskSpec{}
+	}
+	if c.Jobs == nil {
+		c.Jobs = map[string]*JobSpec{}
+	}
+	for idx, m := range c.Matrices {
+		if err := m.expand(c.Tasks, c.Jobs); err != nil {
+			return skerr.Wrapf(err, "failed to expand matrix %d", idx)
+		}
+	}
+	c.Matrices = nil
+	return nil
+}
+
+// JobConditionsMet returns true iff the conditions of the given JobSpec, eg.
+// its ChangedPaths, are satisfied by this TasksCfg's RepoState. Returns false
+// if no such JobSpec exists.
+func (c *TasksCfg) JobConditionsMet(name string) bool {
+	j, ok := c.Jobs[name]
+	if !ok {
+		return false
+	}
+	if c.ChangedFiles == nil {
+		return true
+	}
+	return j.MatchesChangedFiles(c.ChangedFiles)
+}
+
 // Validate returns an error if the TasksCfg is not valid.
 func (c *TasksCfg) Validate() error {
+	// Validate the expanded form of the TasksCfg, if it has any Matrices.
+	if len(c.Matrices) > 0 {
+		expanded := c.Copy()
+		if err := expanded.ExpandMatrices(); err != nil {
+			return skerr.Fmt("Invalid TasksCfg: %s", err)
+		}
+		return expanded.Validate()
+	}
+
 	// Validate all tasks.
 	for name, t := range c.Tasks {
 		if err := t.Validate(c); err != nil {
@@ -455,7 +526,19 @@ type CipdPackage = cipd.Package
 
 // JobSpec is a struct which describes a set of TaskSpecs to run as part of a
 // larger effort.
+// Be sure to add any new fields to the Copy() method.
 type JobSpec struct {
+	// ChangedPaths is a list of glob patterns. If non-empty, the Job is only
+	// triggered for commits and try jobs which modify at least one file
+	// matching one of the patterns. Patterns are matched against paths
+	// relative to the repo root using path.Match syntax, with the addition
+	// that a "**" path segment matches any number of path segments.
+	ChangedPaths []string `json:"changed_paths,omitempty"`
+	// Deadline is the maximum amount of time a Job may take, measured from
+	// its creation, before it is canceled. As the deadline approaches, the
+	// priority of the Job's tasks is increased. If zero, the Job has no
+	// deadline.
+	Deadline time.Duration `json:"deadline_ns,omitempty"`
 	// Priority indicates the relative priority of the job, with 0 < p <= 1,
 	// where higher values result in scheduling the job's tasks sooner. If
 	// unspecified or outside this range, DEFAULT_JOB_SPEC_PRIORITY is used.
@@ -469,6 +552,11 @@ type JobSpec struct {
 	// commits has the same score as another backfill task at the same
 	// commit with a priority of 0.4 that bisects a blamelist of 4 commits.
 	Priority float64 `json:"priority,omitempty"`
+	// SLA is the amount of time, measured from its creation, in which a Job
+	// is expected to finish. Unlike Deadline, it has no effect on scheduling;
+	// it is only used for reporting. If zero, the Job has no SLA. If both are
+	// specified, SLA must not be greater than Deadline.
+	SLA time.Duration `json:"sla_ns,omitempty"`
 	// The names of TaskSpecs that are direct dependencies of this JobSpec.
 	TaskSpecs []string `json:"tasks"`
 	// One of the TRIGGER_* constants; see documentation above.
@@ -487,6 +575,20 @@ func (j *JobSpec) Validate() error {
 	default:
 		return fmt.Errorf("Invalid job trigger %q", j.Trigger)
 	}
+	for _, pattern := range j.ChangedPaths {
+		if err := validatePathPattern(pattern); err != nil {
+			return fmt.Errorf("Invalid changed_paths pattern %q: %s", pattern, err)
+		}
+	}
+	if j.Deadline < 0 {
+		return fmt.Errorf("Job deadline must not be negative; got %s", j.Deadline)
+	}
+	if j.SLA < 0 {
+		return fmt.Errorf("Job SLA must not be negative; got %s", j.SLA)
+	}
+	if j.Deadline > 0 && j.SLA > j.Deadline {
+		return fmt.Errorf("Job SLA (%s) must not be greater than its deadline (%s)", j.SLA, j.Deadline)
+	}
 	return nil
 }
 
@@ -498,10 +600,82 @@ func (j *JobSpec) Copy() *JobSpec {
 		copy(taskSpecs, j.TaskSpecs)
 	}
 	return &JobSpec{
-		Priority:  j.Priority,
-		TaskSpecs: taskSpecs,
-		Trigger:   j.Trigger,
+		ChangedPaths: util.CopyStringSlice(j.ChangedPaths),
+		Deadline:     j.Deadline,
+		Priority:     j.Priority,
+		SLA:          j.SLA,
+		TaskSpecs:    taskSpecs,
+		Trigger:      j.Trigger,
+	}
+}
+
+// MatchesChangedFiles returns true iff the JobSpec has no ChangedPaths or at
+// least one of the given files matches one of its ChangedPaths.
+func (j *JobSpec) MatchesChangedFiles(files []string) bool {
+	if len(j.ChangedPaths) == 0 {
+		return true
+	}
+	for _, f := range files {
+		for _, pattern := range j.ChangedPaths {
+			if matchPath(pattern, f) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validatePathPattern returns an error if the given ChangedPaths pattern is
+// malformed.
+func validatePathPattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("pattern is empty")
+	}
+	for _, segment := range strings.Split(pattern, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchPath reports whether the given slash-separated file path matches the
+// given pattern. The pattern uses path.Match syntax for each path segment, and
+// a "**" segment matches zero or more segments. Malformed patterns match
+// nothing.
+func matchPath(pattern, file string) bool {
+	var match func(pattern, file []string) bool
+	match = func(pattern, file []string) bool {
+		for len(pattern) > 0 {
+			if pattern[0] == "**" {
+				for i := 0; i <= len(file); i++ {
+					if match(pattern[1:], file[i:]) {
+						return true
+					}
+				}
+				return false
+			}
+			if len(file) == 0 {
+				return false
+			}
+			if ok, err := path.Match(pattern[0], file[0]); err != nil || !ok {
+				return false
+			}
+			pattern = pattern[1:]
+			file = file[1:]
+		}
+		return len(file) == 0
+	}
+	return match(strings.Split(pattern, "/"), strings.Split(file, "/"))
+}
+
+// ApplyTo sets the deadline and SLA of the given Job according to the
+// JobSpec. The Job's Created timestamp must already be set.
+func (j *JobSpec) ApplyTo(job *types.Job) {
+	if j.Deadline > 0 {
+		job.Deadline = job.Created.Add(j.Deadline)
 	}
+	job.SLA = j.SLA
 }
 
 // GetTaskSpecDAG returns a map describing all of the dependencies of the
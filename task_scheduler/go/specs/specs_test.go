@@ -52,9 +52,12 @@ func fakeTaskSpec() *TaskSpec {
 
 func fakeJobSpec() *JobSpec {
 	return &JobSpec{
-		TaskSpecs: []string{"Build", "Test"},
-		Trigger:   "trigger-name",
-		Priority:  753,
+		ChangedPaths: []string{"src/**", "DEPS"},
+		Deadline:     3 * time.Hour,
+		TaskSpecs:    []string{"Build", "Test"},
+		Trigger:      "trigger-name",
+		Priority:     753,
+		SLA:          time.Hour,
 	}
 }
 
@@ -88,6 +91,8 @@ func TestCopyTasksCfg(t *testing.T) {
 		CommitQueue: map[string]*CommitQueueJobConfig{
 			"job-name": fakeCommitQueueJobConfig(),
 		},
+		Matrices:     []*TaskMatrix{fakeTaskMatrix()},
+		ChangedFiles: []string{"a/b.go"},
 	}
 	assertdeep.Copy(t, v, v.Copy())
 }
@@ -300,3 +305,66 @@ func TestGetTaskSpecDAG(t *testing.T) {
 		"g": {"d", "e", "f"},
 	}, []string{"a", "g"})
 }
+
+func TestMatchPath(t *testing.T) {
+	test := func(pattern, file string, expect bool) {
+		require.Equal(t, expect, matchPath(pattern, file), "%q vs %q", pattern, file)
+	}
+	test("DEPS", "DEPS", true)
+	test("DEPS", "src/DEPS", false)
+	test("src/*.go", "src/main.go", true)
+	test("src/*.go", "src/sub/main.go", false)
+	test("src/**", "src/sub/main.go", true)
+	test("src/**", "src", true)
+	test("src/**", "other/main.go", false)
+	test("**/*.md", "README.md", true)
+	test("**/*.md", "docs/a/b/README.md", true)
+	test("**/*.md", "docs/a/b/README.txt", false)
+	test("src/**/test/*.py", "src/test/a.py", true)
+	test("src/**/test/*.py", "src/a/b/test/a.py", true)
+	test("src/**/test/*.py", "src/a/b/test/c/a.py", false)
+	test("[", "[", false)
+}
+
+func TestJobSpecMatchesChangedFiles(t *testing.T) {
+	j := &JobSpec{}
+	require.True(t, j.MatchesChangedFiles(nil))
+	require.True(t, j.MatchesChangedFiles([]string{"a.go"}))
+
+	j.ChangedPaths = []string{"infra/**", "*.gn"}
+	require.False(t, j.MatchesChangedFiles(nil))
+	require.False(t, j.MatchesChangedFiles([]string{"src/a.go", "README.md"}))
+	require.True(t, j.MatchesChangedFiles([]string{"src/a.go", "BUILD.gn"}))
+	require.True(t, j.MatchesChangedFiles([]string{"infra/bots/tasks.json"}))
+}
+
+func TestJobSpecValidate_ChangedPaths(t *testing.T) {
+	j := &JobSpec{
+		ChangedPaths: []string{"src/**"},
+	}
+	require.NoError(t, j.Validate())
+
+	j.ChangedPaths = []string{"src/[a-"}
+	require.ErrorContains(t, j.Validate(), "Invalid changed_paths pattern")
+
+	j.ChangedPaths = []string{""}
+	require.ErrorContains(t, j.Validate(), "Invalid changed_paths pattern")
+}
+
+func TestTasksCfgJobConditionsMet(t *testing.T) {
+	cfg := makeTasksCfg(t, map[string][]string{"a": {}, "b": {}}, map[string][]string{"A": {"a"}, "B": {"b"}})
+	cfg.Jobs["B"].ChangedPaths = []string{"b/**"}
+
+	// Changed files are unknown.
+	require.True(t, cfg.JobConditionsMet("A"))
+	require.True(t, cfg.JobConditionsMet("B"))
+	require.False(t, cfg.JobConditionsMet("C"))
+
+	cfg.ChangedFiles = []string{"a/file.txt"}
+	require.True(t, cfg.JobConditionsMet("A"))
+	require.False(t, cfg.JobConditionsMet("B"))
+
+	cfg.ChangedFiles = []string{"a/file.txt", "b/file.txt"}
+	require.True(t, cfg.JobConditionsMet("A"))
+	require.True(t, cfg.JobConditionsMet("B"))
+}
@@ -286,14 +286,16 @@ func MakeJob(ctx context.Context, c TaskCfgCache, rs types.RepoState, name strin
 		return nil, err
 	}
 
-	return &types.Job{
+	j := &types.Job{
 		Created:      now.Now(ctx),
 		Dependencies: deps,
 		Name:         name,
 		Priority:     spec.Priority,
 		RepoState:    rs,
 		Tasks:        map[string][]*types.TaskSummary{},
-	}, nil
+	}
+	spec.ApplyTo(j)
+	return j, nil
 }
 
 // Cleanup removes cache entries which are outside of our scheduling window.
@@ -421,6 +421,10 @@ func (t *TryJobIntegrator) startJob(ctx context.Context, job *types.Job) error {
 	}
 
 	sklog.Infof("Starting job %s (build %d); lease key: %d", job.Id, job.BuildbucketBuildId, job.BuildbucketLeaseKey)
+	// conditionsMet is set to false if the Job's conditions, eg. its
+	// changed_paths, are not satisfied by the change under test, in which
+	// case there is nothing for the Job to do.
+	conditionsMet := true
 	startJobHelper := func() error {
 		sklog.Infof("Retrieving repo state information for job %s (build %d)", job.Id, job.BuildbucketBuildId)
 		repoGraph, err := t.getRepo(job.Repo)
@@ -470,6 +474,8 @@ func (t *TryJobIntegrator) startJob(ctx context.Context, job *types.Job) error {
 		}
 		job.Dependencies = deps
 		job.Tasks = map[string][]*types.TaskSummary{}
+		spec.ApplyTo(job)
+		conditionsMet = cfg.JobConditionsMet(job.Name)
 
 		// Determine if this is a manual retry of a previously-run try job. If
 		// so, set IsForce to ensure that we don't immediately de-duplicate all
@@ -512,6 +518,11 @@ func (t *TryJobIntegrator) startJob(ctx context.Context, job *types.Job) error {
 		job.Finished = now.Now(ctx)
 		job.Status = types.JOB_STATUS_MISHAP
 		job.StatusDetails = util.Truncate(fmt.Sprintf("Failed to start Job: %s", skerr.Unwrap(startJobErr)), 1024)
+	} else if !conditionsMet {
+		job.Started = now.Now(ctx)
+		job.Finished = job.Started
+		job.Status = types.JOB_STATUS_SUCCESS
+		job.StatusDetails = "None of the files changed by this CL match the Job's changed_paths; skipping."
 	} else {
 		job.Status = types.JOB_STATUS_IN_PROGRESS
 		job.Started = now.Now(ctx)
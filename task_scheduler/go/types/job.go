@@ -125,6 +125,12 @@ type Job struct {
 	// for this Job, or zero if the job is new.
 	DbModified time.Time `json:"dbModified"`
 
+	// Deadline is the time by which the Job must finish. Unfinished Jobs
+	// are canceled once their deadline has passed. A zero value indicates
+	// that the Job has no deadline. This property should never change for a
+	// given Job instance.
+	Deadline time.Time `json:"deadline"`
+
 	// Dependencies maps out the DAG of TaskSpec names upon which this Job
 	// depends. Keys are TaskSpec names and values are slices of TaskSpec
 	// names indicating which TaskSpecs that TaskSpec depends on. This
@@ -160,6 +166,12 @@ type Job struct {
 	// the server received a force trigger job request, etc.
 	Requested time.Time `json:"requested"`
 
+	// SLA is the amount of time, measured from Created, in which the Job is
+	// expected to finish. It is used only for reporting. A zero value
+	// indicates that the Job has no SLA. This property should never change
+	// for a given Job instance.
+	SLA time.Duration `json:"sla"`
+
 	// Started is the timestamp at which the Job first entered
 	// JOB_STATUS_IN_PROGRESS.
 	Started time.Time `json:"started"`
@@ -208,6 +220,7 @@ func (j *Job) Copy() *Job {
 		BuildbucketToken:       j.BuildbucketToken,
 		Created:                j.Created,
 		DbModified:             j.DbModified,
+		Deadline:               j.Deadline,
 		Dependencies:           deps,
 		Finished:               j.Finished,
 		Id:                     j.Id,
@@ -216,6 +229,7 @@ func (j *Job) Copy() *Job {
 		Priority:               j.Priority,
 		RepoState:              j.RepoState.Copy(),
 		Requested:              j.Requested,
+		SLA:                    j.SLA,
 		Started:                j.Started,
 		Status:                 j.Status,
 		StatusDetails:          j.StatusDetails,
@@ -227,6 +241,22 @@ func (j *Job) Done() bool {
 	return j.Status != JOB_STATUS_IN_PROGRESS && j.Status != JOB_STATUS_REQUESTED
 }
 
+// DeadlineExceeded returns true iff the Job has a deadline which has passed as
+// of the given time.
+func (j *Job) DeadlineExceeded(now time.Time) bool {
+	return !j.Deadline.IsZero() && now.After(j.Deadline)
+}
+
+// MetSLA returns true iff the Job has an SLA and finished successfully within
+// it. The second return value is false if the Job has no SLA or is not yet
+// finished, in which case the first return value is meaningless.
+func (j *Job) MetSLA() (bool, bool) {
+	if j.SLA == 0 || !j.Done() || j.Finished.IsZero() {
+		return false, false
+	}
+	return j.Status == JOB_STATUS_SUCCESS && !j.Finished.After(j.Created.Add(j.SLA)), true
+}
+
 // MakeTaskKey returns a TaskKey for the given Task name.
 func (j *Job) MakeTaskKey(taskName string) TaskKey {
 	rv := TaskKey{
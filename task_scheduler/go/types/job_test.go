@@ -102,3 +102,52 @@ func TestJobDeriveStatus(t *testing.T) {
 	t3.Status = TASK_STATUS_SUCCESS
 	require.Equal(t, j1.DeriveStatus(), JOB_STATUS_SUCCESS)
 }
+
+func TestJobDeadlineExceeded(t *testing.T) {
+	ts := time.Unix(1571926390, 0)
+	j := &Job{Created: ts}
+	require.False(t, j.DeadlineExceeded(ts.Add(24*time.Hour)))
+
+	j.Deadline = ts.Add(time.Hour)
+	require.False(t, j.DeadlineExceeded(ts))
+	require.False(t, j.DeadlineExceeded(ts.Add(time.Hour)))
+	require.True(t, j.DeadlineExceeded(ts.Add(time.Hour+time.Second)))
+}
+
+func TestJobMetSLA(t *testing.T) {
+	ts := time.Unix(1571926390, 0)
+	j := &Job{
+		Created: ts,
+		Status:  JOB_STATUS_SUCCESS,
+		SLA:     time.Hour,
+	}
+	check := func(finished time.Duration, expectMet, expectOk bool) {
+		j.Finished = ts.Add(finished)
+		met, ok := j.MetSLA()
+		require.Equal(t, expectOk, ok)
+		require.Equal(t, expectMet, met)
+	}
+
+	// Finished within the SLA.
+	check(30*time.Minute, true, true)
+	check(time.Hour, true, true)
+
+	// Finished after the SLA.
+	check(2*time.Hour, false, true)
+
+	// Finished within the SLA, but unsuccessfully.
+	j.Status = JOB_STATUS_FAILURE
+	check(30*time.Minute, false, true)
+
+	// Not finished.
+	j.Status = JOB_STATUS_IN_PROGRESS
+	j.Finished = time.Time{}
+	met, ok := j.MetSLA()
+	require.False(t, ok)
+	require.False(t, met)
+
+	// No SLA.
+	j.Status = JOB_STATUS_SUCCESS
+	j.SLA = 0
+	check(30*time.Minute, false, false)
+}
@@ -46,6 +46,7 @@ func MakeFullJob(now time.Time) *Job {
 		BuildbucketToken:       "9876",
 		Created:                now.Add(time.Nanosecond),
 		DbModified:             now.Add(time.Millisecond),
+		Deadline:               now.Add(time.Hour),
 		Dependencies:           map[string][]string{"A": {"B"}, "B": {}},
 		Finished:               now.Add(time.Second),
 		Id:                     "abc123",
@@ -56,6 +57,7 @@ func MakeFullJob(now time.Time) *Job {
 			Repo: DEFAULT_TEST_REPO,
 		},
 		Requested:     now,
+		SLA:           30 * time.Minute,
 		Started:       now.Add(5 * time.Nanosecond),
 		Status:        JOB_STATUS_SUCCESS,
 		StatusDetails: "All tasks succeeded!",